@@ -0,0 +1,36 @@
+package phonenumbers
+
+// RegionCode returns the region code of the number, e.g. "US". It's the same as calling GetRegionCodeForNumber.
+func (x *PhoneNumber) RegionCode() string {
+	return GetRegionCodeForNumber(x)
+}
+
+// Type returns the type of the number, e.g. MOBILE. It's the same as calling GetNumberType.
+func (x *PhoneNumber) Type() PhoneNumberType {
+	return GetNumberType(x)
+}
+
+// IsValid returns whether the number is valid. It's the same as calling IsValidNumber.
+func (x *PhoneNumber) IsValid() bool {
+	return IsValidNumber(x)
+}
+
+// IsPossible returns whether the number is possible. It's the same as calling IsPossibleNumber.
+func (x *PhoneNumber) IsPossible() bool {
+	return IsPossibleNumber(x)
+}
+
+// E164 returns the number formatted in E164 format, e.g. "+16502530000"
+func (x *PhoneNumber) E164() string {
+	return Format(x, E164)
+}
+
+// National returns the number formatted in national format, e.g. "(650) 253-0000"
+func (x *PhoneNumber) National() string {
+	return Format(x, NATIONAL)
+}
+
+// International returns the number formatted in international format, e.g. "+1 650-253-0000"
+func (x *PhoneNumber) International() string {
+	return Format(x, INTERNATIONAL)
+}
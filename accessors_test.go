@@ -0,0 +1,42 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessors(t *testing.T) {
+	tests := []struct {
+		input         string
+		region        string
+		regionCode    string
+		numberType    PhoneNumberType
+		valid         bool
+		possible      bool
+		e164          string
+		national      string
+		international string
+	}{
+		{"6502530000", "US", "US", FIXED_LINE_OR_MOBILE, true, true, "+16502530000", "(650) 253-0000", "+1 650-253-0000"},
+		{"0788 383 383", "RW", "RW", MOBILE, true, true, "+250788383383", "0788 383 383", "+250 788 383 383"},
+		{"+800 1234 5678", "", "001", TOLL_FREE, true, true, "+80012345678", "1234 5678", "+800 1234 5678"},
+		{"555 555 5555", "US", "", UNKNOWN, false, true, "+15555555555", "(555) 555-5555", "+1 555-555-5555"},
+		{"12", "GB", "", UNKNOWN, false, false, "+4412", "12", "+44 12"},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.input, tc.region)
+		require.NoError(t, err)
+
+		assert.Equal(t, GetRegionCodeForNumber(number), number.RegionCode(), "region code mismatch for %s", tc.input)
+		assert.Equal(t, tc.regionCode, number.RegionCode(), "region code mismatch for %s", tc.input)
+		assert.Equal(t, tc.numberType, number.Type(), "type mismatch for %s", tc.input)
+		assert.Equal(t, tc.valid, number.IsValid(), "valid mismatch for %s", tc.input)
+		assert.Equal(t, tc.possible, number.IsPossible(), "possible mismatch for %s", tc.input)
+		assert.Equal(t, tc.e164, number.E164(), "E164 mismatch for %s", tc.input)
+		assert.Equal(t, tc.national, number.National(), "national mismatch for %s", tc.input)
+		assert.Equal(t, tc.international, number.International(), "international mismatch for %s", tc.input)
+	}
+}
@@ -0,0 +1,49 @@
+package phonenumbers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Anonymize returns a de-identified copy of the passed in number which keeps its country code and
+// national destination code, such as the area code, but replaces the rest of its digits, the
+// subscriber number, with digits derived from an HMAC-SHA256 of the whole number with the passed in
+// key. The result has the same length as the original so is still a possible number, and the same
+// number anonymized with the same key always gives the same result, so anonymized data sets can still
+// be joined. Extensions and any other fields are dropped.
+//
+// Anyone with the key can confirm whether a given number was anonymized to a given result, so the key
+// should be kept as secret as the numbers themselves. Numbers without a national destination code keep
+// only their first digit.
+func Anonymize(number *PhoneNumber, key []byte) *PhoneNumber {
+	nsn := GetNationalSignificantNumber(number)
+
+	keep := GetLengthOfNationalDestinationCode(number)
+	if keep < 1 {
+		keep = 1
+	}
+	if keep > len(nsn) {
+		keep = len(nsn)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.Itoa(int(number.GetCountryCode())) + nsn))
+	sum := new(big.Int).SetBytes(mac.Sum(nil))
+
+	subscriberLength := len(nsn) - keep
+	modulus := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(subscriberLength)), nil)
+	subscriber := sum.Mod(sum, modulus).String()
+	if subscriberLength == 0 {
+		subscriber = ""
+	}
+
+	anonymized := nsn[:keep] + strings.Repeat("0", subscriberLength-len(subscriber)) + subscriber
+	nationalNumber, _ := strconv.ParseUint(anonymized, 10, 64)
+
+	result := &PhoneNumber{CountryCode: number.GetCountryCode(), NationalNumber: nationalNumber}
+	setItalianLeadingZerosForPhoneNumber(anonymized, result)
+	return result
+}
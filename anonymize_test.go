@@ -0,0 +1,46 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnonymize(t *testing.T) {
+	key := []byte("secret")
+
+	tests := []struct {
+		number string
+		keep   string // the prefix of the E164 form which should be kept
+	}{
+		{"+16502530000", "+1650"},
+		{"+442070313000", "+4420"},
+		{"+250788383383", "+250788"},
+		{"+33123456789", "+331"},
+		{"+80012345678", "+8"},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.number+";ext=123", UNKNOWN_REGION)
+		require.NoError(t, err)
+
+		anonymized := Anonymize(number, key)
+		e164 := Format(anonymized, E164)
+
+		assert.NotEqual(t, tc.number, e164, "number not anonymized: %s", tc.number)
+		assert.Len(t, e164, len(tc.number), "length changed for %s", tc.number)
+		assert.Equal(t, tc.keep, e164[:len(tc.keep)], "prefix not kept for %s", tc.number)
+		assert.True(t, IsPossibleNumber(anonymized), "anonymized %s isn't possible: %s", tc.number, e164)
+		assert.Equal(t, "", anonymized.GetExtension())
+
+		// the same number and key always give the same result, but other keys don't
+		assert.Equal(t, e164, Format(Anonymize(number, key), E164))
+		assert.NotEqual(t, e164, Format(Anonymize(number, []byte("other")), E164))
+	}
+
+	// numbers which only differ in their subscriber digits are anonymized differently
+	first, _ := Parse("+16502530000", UNKNOWN_REGION)
+	second, _ := Parse("+16502530001", UNKNOWN_REGION)
+	assert.NotEqual(t, Format(Anonymize(first, key), E164), Format(Anonymize(second, key), E164))
+}
@@ -0,0 +1,46 @@
+package phonenumbers
+
+import "strings"
+
+// GetAreaCode returns the geographical area code of the passed in number, e.g. "650" for
+// +1 650-253-0000, or an empty string if the number isn't geographical or its region doesn't use
+// area codes. Unlike slicing the national significant number with GetLengthOfGeographicalAreaCode,
+// the area code of mobile numbers in countries where they have one doesn't include any mobile
+// token, e.g. "11" for the Argentinian mobile number +54 9 11 8765 4321. Any leading zeros of the
+// national significant number are part of the area code.
+//
+// The same caveats as GetLengthOfGeographicalAreaCode apply, area codes change over time and
+// aren't a good way to group numbers for most purposes.
+func GetAreaCode(number *PhoneNumber) string {
+	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+	if metadata == nil {
+		return ""
+	}
+
+	// as with GetLengthOfGeographicalAreaCode, countries without a national prefix have a closed
+	// dialling plan with no area codes
+	if len(metadata.GetNationalPrefix()) == 0 && !number.GetItalianLeadingZero() {
+		return ""
+	}
+
+	countryCode := number.GetCountryCode()
+	numberType := GetNumberType(number)
+	if !isNumberTypeGeographical(numberType, countryCode) {
+		return ""
+	}
+
+	nsn := GetNationalSignificantNumber(number)
+	length := GetLengthOfNationalDestinationCode(number)
+	if length > len(nsn) {
+		length = len(nsn)
+	}
+	areaCode := nsn[:length]
+
+	// the national destination code of mobile numbers includes any mobile token
+	if numberType == MOBILE {
+		if token := GetCountryMobileToken(countryCode); token != "" && len(areaCode) > len(token) {
+			areaCode = strings.TrimPrefix(areaCode, token)
+		}
+	}
+	return areaCode
+}
@@ -0,0 +1,34 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAreaCode(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"+16502530000", "650"},
+		{"+18002530000", ""},     // toll free
+		{"+442070313000", "20"},  // fixed line
+		{"+447400123456", ""},    // mobile
+		{"+5491187654321", "11"}, // mobile with mobile token
+		{"+541187654321", "11"},  // fixed line
+		{"+5511961234567", "11"}, // mobile in a country where they are geographical
+		{"+4930123456", "30"},    // only two groups in international format
+		{"+6565218000", ""},      // no national prefix so no area codes
+		{"+80012345678", ""},     // non-geographical
+		{"+61236618300", "2"},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.number, UNKNOWN_REGION)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.expected, GetAreaCode(number), "area code mismatch for %s", tc.number)
+	}
+}
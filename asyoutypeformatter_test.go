@@ -0,0 +1,69 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsYouTypeFormatter(t *testing.T) {
+	tests := []struct {
+		region   string
+		input    string
+		expected []string
+	}{
+		{"US", "6502530000", []string{"6", "65", "650", "650-2", "650-25", "650-253", "650-2530", "(650) 253-00", "(650) 253-000", "(650) 253-0000"}},
+		{"US", "16502530000", []string{"1", "16", "1 65", "1 (650", "1 (650) 2", "1 (650) 25", "1 (650) 253", "1 (650) 253-0", "1 (650) 253-00", "1 (650) 253-000", "1 (650) 253-0000"}},
+		{"US", "+447912345678", []string{"+", "+4", "+44 ", "+44 7", "+44 79", "+44 791", "+44 7912", "+44 7912 3", "+44 7912 34", "+44 7912 345", "+44 7912 3456", "+44 7912 34567", "+44 7912 345678"}},
+		{"US", "011447912345678", []string{"0", "01", "011 ", "011 4", "011 44 ", "011 44 7", "011 44 79", "011 44 791", "011 44 7912", "011 44 7912 3", "011 44 7912 34", "011 44 7912 345", "011 44 7912 3456", "011 44 7912 34567", "011 44 7912 345678"}},
+		{"GB", "02070313000", []string{"0", "02", "020", "020 7", "020 70", "020 703", "020 7031", "020 7031 3", "020 7031 30", "020 7031 300", "020 7031 3000"}},
+		{"DE", "030123456", []string{"0", "03", "030", "030 1", "030 12", "030 123", "030 1234", "030 12345", "030 123456"}},
+		{"AU", "0412345678", []string{"0", "04", "041", "0412", "0412 3", "0412 34", "0412 345", "0412 345 6", "0412 345 67", "0412 345 678"}},
+		{"ZZ", "+48881231234", []string{"+", "+4", "+48 ", "+48 8", "+48 88", "+48 881", "+48 881 2", "+48 881 23", "+48 881 231", "+48 881 231 2", "+48 881 231 23", "+48 881 231 234"}},
+
+		// full-width digits and plus sign are normalized
+		{"US", "＋４４７９１２", []string{"＋", "＋４", "+44 ", "+44 7", "+44 79", "+44 791", "+44 7912"}},
+
+		// once the user enters their own formatting we leave their input alone
+		{"US", "650-253", []string{"6", "65", "650", "650-", "650-2", "650-25", "650-253"}},
+	}
+
+	for _, tc := range tests {
+		formatter := GetAsYouTypeFormatter(tc.region)
+		actual := make([]string, 0, len(tc.expected))
+		for _, c := range tc.input {
+			actual = append(actual, formatter.InputDigit(c))
+		}
+		assert.Equal(t, tc.expected, actual, "formatting %s for %s", tc.input, tc.region)
+	}
+}
+
+func TestAsYouTypeFormatterClear(t *testing.T) {
+	formatter := GetAsYouTypeFormatter("US")
+	for _, c := range "+447912" {
+		formatter.InputDigit(c)
+	}
+	formatter.Clear()
+
+	var output string
+	for _, c := range "6502530000" {
+		output = formatter.InputDigit(c)
+	}
+	assert.Equal(t, "(650) 253-0000", output)
+}
+
+func TestAsYouTypeFormatterRememberedPosition(t *testing.T) {
+	formatter := GetAsYouTypeFormatter("US")
+	assert.Equal(t, "6", formatter.InputDigitAndRememberPosition('6'))
+	assert.Equal(t, 1, formatter.GetRememberedPosition())
+
+	for _, c := range "502530" {
+		formatter.InputDigit(c)
+	}
+	assert.Equal(t, "650-2530", formatter.currentOutput)
+	assert.Equal(t, 1, formatter.GetRememberedPosition())
+
+	// the template changes and adds a parenthesis before our digit
+	assert.Equal(t, "(650) 253-00", formatter.InputDigit('0'))
+	assert.Equal(t, 2, formatter.GetRememberedPosition())
+}
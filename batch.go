@@ -0,0 +1,160 @@
+package phonenumbers
+
+import (
+	"regexp"
+	"sync"
+)
+
+// BatchResult is the result of validating a single number with ValidateBatch or ValidateNumbersBatch
+type BatchResult struct {
+	// Number is the parsed number, nil if it couldn't be parsed, in which case Err is set
+	Number *PhoneNumber
+	Err    error
+
+	// Region is the region of the number as returned by GetRegionCodeForNumber, Type its type as
+	// returned by GetNumberType and Valid whether IsValidNumber is true for it
+	Region string
+	Type   PhoneNumberType
+	Valid  bool
+}
+
+// ValidateBatch parses each of the passed in numbers, which must be in international format such
+// as "+16502530000", and works out their region, type and validity. The results are the same as
+// calling Parse, GetRegionCodeForNumber, GetNumberType and IsValidNumber for each number, but
+// the metadata for each calling code is only looked up once for the whole batch.
+//
+// If workers is greater than one the batch is split between that many goroutines, otherwise
+// it is processed on the calling goroutine. Results are always in the same order as numbers.
+func ValidateBatch(numbers []string, workers int) []BatchResult {
+	results := make([]BatchResult, len(numbers))
+	processBatch(len(numbers), workers, func(v *batchValidator, i int) {
+		number := &PhoneNumber{}
+		if err := ParseInto(numbers[i], UNKNOWN_REGION, number); err != nil {
+			results[i].Err = err
+			return
+		}
+		v.validate(number, &results[i])
+	})
+	return results
+}
+
+// ValidateNumbersBatch is the same as ValidateBatch for numbers which have already been parsed.
+// Numbers which are nil are left with an empty result.
+func ValidateNumbersBatch(numbers []*PhoneNumber, workers int) []BatchResult {
+	results := make([]BatchResult, len(numbers))
+	processBatch(len(numbers), workers, func(v *batchValidator, i int) {
+		if numbers[i] != nil {
+			v.validate(numbers[i], &results[i])
+		}
+	})
+	return results
+}
+
+// processBatch calls process for every index up to size, splitting them into contiguous chunks
+// between the passed in number of workers, each of which has its own validator
+func processBatch(size int, workers int, process func(*batchValidator, int)) {
+	if workers > size {
+		workers = size
+	}
+	if workers <= 1 {
+		v := newBatchValidator()
+		for i := 0; i < size; i++ {
+			process(v, i)
+		}
+		return
+	}
+
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			v := newBatchValidator()
+			for i := start; i < end; i++ {
+				process(v, i)
+			}
+		}(w*size/workers, (w+1)*size/workers)
+	}
+	wg.Wait()
+}
+
+// batchRegion is a region for a calling code along with what we need to check whether a number
+// belongs to it
+type batchRegion struct {
+	regionCode    string
+	metadata      *PhoneMetadata
+	leadingDigits *regexp.Regexp
+}
+
+// batchValidator validates numbers, remembering the regions for each calling code it has seen
+type batchValidator struct {
+	regions map[int32][]batchRegion
+	number  *PhoneNumber // parsed into by ParseColumns, reused for every row
+}
+
+func newBatchValidator() *batchValidator {
+	return &batchValidator{regions: make(map[int32][]batchRegion), number: &PhoneNumber{}}
+}
+
+// regionsFor returns the regions for the passed in calling code, looking them up the first time
+// we see it
+func (v *batchValidator) regionsFor(countryCode int32) []batchRegion {
+	regions, seen := v.regions[countryCode]
+	if seen {
+		return regions
+	}
+
+	for _, regionCode := range regionCodesForCountryCode(countryCode) {
+		region := batchRegion{
+			regionCode: regionCode,
+			metadata:   getMetadataForRegionOrCallingCode(countryCode, regionCode),
+		}
+		if leadingDigits := region.metadata.GetLeadingDigits(); leadingDigits != "" {
+			region.leadingDigits = regexFor("^(?:" + leadingDigits + ")")
+		}
+		regions = append(regions, region)
+	}
+	v.regions[countryCode] = regions
+	return regions
+}
+
+// validate fills in the region, type and validity of the passed in number
+func (v *batchValidator) validate(number *PhoneNumber, result *BatchResult) {
+	result.Number = number
+	result.Type = UNKNOWN
+
+	regions := v.regionsFor(number.GetCountryCode())
+	if len(regions) == 0 {
+		return
+	}
+	nationalNumber := GetNationalSignificantNumber(number)
+
+	// this mirrors GetRegionCodeForNumber
+	region := &regions[0]
+	if len(regions) > 1 {
+		region = nil
+		for i := range regions {
+			candidate := &regions[i]
+			if candidate.leadingDigits != nil {
+				if candidate.leadingDigits.MatchString(nationalNumber) {
+					region = candidate
+					break
+				}
+			} else if getNumberTypeHelper(nationalNumber, candidate.metadata) != UNKNOWN {
+				region = candidate
+				break
+			}
+		}
+		if region == nil {
+			return
+		}
+	}
+
+	result.Region = region.regionCode
+	if region.metadata == nil {
+		return
+	}
+	result.Type = getNumberTypeHelper(nationalNumber, region.metadata)
+	result.Valid = result.Type != UNKNOWN &&
+		(region.regionCode == REGION_CODE_FOR_NON_GEO_ENTITY || number.GetCountryCode() == region.metadata.GetCountryCode())
+}
@@ -0,0 +1,97 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var batchNumbers = []string{
+	"+16502530000",    // US
+	"+12423570000",    // BS, shares +1 with the US
+	"+14165550123",    // CA
+	"+1 650 253 0000", // formatting is allowed
+	"+442070313000",   // GB
+	"+447624123456",   // IM, shares +44 with GB
+	"+250788383383",   // RW
+	"+80012345678",    // non geographical
+	"+16502530000000", // too long
+	"+999123456",      // unknown calling code
+	"6502530000",      // not international
+	"",
+	"+79101234567", // RU
+	"+74955550123", // RU
+	"+77012345678", // KZ, shares +7 with RU
+}
+
+func TestValidateBatch(t *testing.T) {
+	for _, workers := range []int{0, 1, 4, 100} {
+		results := ValidateBatch(batchNumbers, workers)
+		assert.Len(t, results, len(batchNumbers))
+
+		for i, input := range batchNumbers {
+			result := results[i]
+
+			number, err := Parse(input, UNKNOWN_REGION)
+			if err != nil {
+				assert.Equal(t, err, result.Err, "error mismatch for %s", input)
+				assert.Nil(t, result.Number)
+				continue
+			}
+
+			assert.NoError(t, result.Err, "unexpected error for %s", input)
+			assert.Equal(t, Format(number, E164), Format(result.Number, E164), "number mismatch for %s", input)
+			assert.Equal(t, GetRegionCodeForNumber(number), result.Region, "region mismatch for %s", input)
+			assert.Equal(t, GetNumberType(number), result.Type, "type mismatch for %s", input)
+			assert.Equal(t, IsValidNumber(number), result.Valid, "validity mismatch for %s", input)
+		}
+	}
+
+	assert.Empty(t, ValidateBatch(nil, 4))
+
+	results := ValidateBatch([]string{"+16502530000", "+447624123456"}, 1)
+	assert.Equal(t, "US", results[0].Region)
+	assert.True(t, results[0].Valid)
+	assert.Equal(t, "IM", results[1].Region)
+	assert.Equal(t, MOBILE, results[1].Type)
+}
+
+func TestValidateNumbersBatch(t *testing.T) {
+	var numbers []*PhoneNumber
+	for _, input := range batchNumbers {
+		if number, err := Parse(input, UNKNOWN_REGION); err == nil {
+			numbers = append(numbers, number)
+		}
+	}
+	numbers = append(numbers, nil, &PhoneNumber{})
+
+	for _, workers := range []int{1, 3} {
+		results := ValidateNumbersBatch(numbers, workers)
+		assert.Len(t, results, len(numbers))
+
+		for i, number := range numbers {
+			if number == nil {
+				assert.Equal(t, BatchResult{}, results[i])
+				continue
+			}
+			assert.Equal(t, number, results[i].Number)
+			assert.Equal(t, GetRegionCodeForNumber(number), results[i].Region, "region mismatch for %s", number)
+			assert.Equal(t, GetNumberType(number), results[i].Type, "type mismatch for %s", number)
+			assert.Equal(t, IsValidNumber(number), results[i].Valid, "validity mismatch for %s", number)
+		}
+	}
+}
+
+func BenchmarkValidateBatch(b *testing.B) {
+	numbers := make([]string, 1000)
+	for i := range numbers {
+		numbers[i] = batchNumbers[i%len(batchNumbers)]
+	}
+	ValidateBatch(numbers, 1)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ValidateBatch(numbers, 1)
+	}
+}
@@ -566,6 +566,7 @@ func setRelevantDescPatterns(metadata *PhoneMetadata, element *TerritoryE, isSho
 		metadata.Emergency = processPhoneNumberDescElement(generalDesc, element.Emergency)
 		metadata.TollFree = processPhoneNumberDescElement(generalDesc, element.TollFree)
 		metadata.PremiumRate = processPhoneNumberDescElement(generalDesc, element.PremiumRate)
+		metadata.SmsServices = processPhoneNumberDescElement(generalDesc, element.SmsServices)
 	}
 }
 
@@ -671,11 +672,14 @@ type TerritoryE struct {
 	// <!ELEMENT voicemail (nationalNumberPattern, possibleLengths, exampleNumber)>
 	ShortCode *PhoneNumberDescE `xml:"shortCode"`
 
-	// <!ELEMENT uan (nationalNumberPattern, possibleLengths, exampleNumber)>
-	Emergency *PhoneNumberDescE `xml:"Emergency"`
+	// <!ELEMENT emergency (nationalNumberPattern, possibleLengths, exampleNumber)>
+	Emergency *PhoneNumberDescE `xml:"emergency"`
 
-	// <!ELEMENT voicemail (nationalNumberPattern, possibleLengths, exampleNumber)>
+	// <!ELEMENT carrierSpecific (nationalNumberPattern, possibleLengths, exampleNumber)>
 	CarrierSpecific *PhoneNumberDescE `xml:"carrierSpecific"`
+
+	// <!ELEMENT smsServices (nationalNumberPattern, possibleLengths, exampleNumber)>
+	SmsServices *PhoneNumberDescE `xml:"smsServices"`
 }
 
 // <!ELEMENT numberFormat (leadingDigits*, format, intlFormat*)>
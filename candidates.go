@@ -0,0 +1,63 @@
+package phonenumbers
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// separators which never appear inside a number, or the word "or" between numbers
+var candidateSeparatorPattern = regexp.MustCompile(`[\n\r;|,]|(?i:\s+or\s+)`)
+
+// how many digits both sides of a slash need for it to be treated as separating two numbers rather
+// than being punctuation within one, such as in 030/1234567 or 583-6985 x302/x2303
+const minSplitCandidateDigits = 6
+
+// SplitCandidates splits a string which may contain several numbers, such as "+49 30 1234567 / 0171 2345678"
+// or "home: 555-1234, work: 555-5678", into candidates for parsing, in the order they appear. Numbers are
+// separated by newlines, commas, semicolons, pipes, the word "or", or slashes with enough digits on both
+// sides. Labels in front of numbers are removed and parts without any digits are dropped. Candidates
+// aren't checked to be numbers, so still need to be parsed.
+func SplitCandidates(s string) []string {
+	var candidates []string
+	for _, part := range candidateSeparatorPattern.Split(s, -1) {
+		var current string
+		for _, piece := range strings.Split(part, "/") {
+			if current != "" && (countDigits(current) < minSplitCandidateDigits || countDigits(piece) < minSplitCandidateDigits) {
+				current += "/" + piece
+				continue
+			}
+			candidates = appendCandidate(candidates, current)
+			current = piece
+		}
+		candidates = appendCandidate(candidates, current)
+	}
+	return candidates
+}
+
+// appendCandidate trims any label and trailing punctuation from the passed in candidate, and appends
+// it to the list if anything which could be a number is left
+func appendCandidate(candidates []string, candidate string) []string {
+	start := VALID_START_CHAR_PATTERN.FindStringIndex(candidate)
+	if start == nil {
+		return candidates
+	}
+	// keep the opening bracket of an area code
+	if start[0] > 0 && candidate[start[0]-1] == '(' {
+		start[0]--
+	}
+	candidate = strings.TrimRightFunc(candidate[start[0]:], func(r rune) bool {
+		return unicode.IsSpace(r) || r == '.' || r == ':' || r == '-'
+	})
+	return append(candidates, candidate)
+}
+
+func countDigits(s string) int {
+	count := 0
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,29 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitCandidates(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"+49 30 1234567 / 0171 2345678", []string{"+49 30 1234567", "0171 2345678"}},
+		{"home: 555-1234, work: 555-5678", []string{"555-1234", "555-5678"}},
+		{"Tel.: 030/1234567; Fax: 030/1234568", []string{"030/1234567", "030/1234568"}},
+		{"(530) 583-6985 x302/x2303", []string{"(530) 583-6985 x302/x2303"}},
+		{"0788 383 383 or 0788 383 384", []string{"0788 383 383", "0788 383 384"}},
+		{"+1 650-253-0000 | +44 20 7031 3000\n+250 788 383 383", []string{"+1 650-253-0000", "+44 20 7031 3000", "+250 788 383 383"}},
+		{"mobile: +447400123456.", []string{"+447400123456"}},
+		{"6502530000", []string{"6502530000"}},
+		{"n/a, none", nil},
+		{"", nil},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, SplitCandidates(tc.input), "candidates mismatch for %s", tc.input)
+	}
+}
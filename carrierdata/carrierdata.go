@@ -0,0 +1,12 @@
+// Package carrierdata contains the prefix to carrier data used by phonenumbers.GetCarrierForNumber
+// and phonenumbers.GetCarrierWithPrefixForNumber. It is kept in its own module so that users who
+// don't need carrier lookups don't have to download it. Import it for its side effects:
+//
+//	import _ "github.com/nyaruka/phonenumbers/carrierdata"
+package carrierdata
+
+import "github.com/nyaruka/phonenumbers"
+
+func init() {
+	phonenumbers.RegisterCarrierData(carrierMapData)
+}
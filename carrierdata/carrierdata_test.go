@@ -0,0 +1,190 @@
+package carrierdata_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	_ "github.com/nyaruka/phonenumbers/carrierdata"
+)
+
+// this runs first so that every language is decoded while goroutines are racing to look it up, use -race
+func TestConcurrentFirstUse(t *testing.T) {
+	number, err := phonenumbers.Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatalf("Failed to parse number: %s", err)
+	}
+	expected := map[string]string{"en": "China Mobile", "zh": "中国移动", "zh_Hant": "中國移動"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		for lang, carrier := range expected {
+			wg.Add(1)
+			go func(lang, expected string) {
+				defer wg.Done()
+				carrier, err := phonenumbers.GetCarrierForNumber(number, lang)
+				if err != nil || carrier != expected {
+					t.Errorf("Expected '%s', got '%s' (%v) for '%s'", expected, carrier, err, lang)
+				}
+				phonenumbers.GetMemStats()
+			}(lang, carrier)
+		}
+	}
+	wg.Wait()
+}
+
+func TestGetCarrierForNumber(t *testing.T) {
+	tests := []struct {
+		num      string
+		lang     string
+		expected string
+	}{
+		{num: "+8613702032331", lang: "en", expected: "China Mobile"},
+		{num: "+8613702032331", lang: "zh", expected: "中国移动"},
+		{num: "+6281377468527", lang: "en", expected: "Telkomsel"},
+		{num: "+8613323241342", lang: "en", expected: "China Telecom"},
+		{num: "+61491570156", lang: "en", expected: "Telstra"},
+		{num: "+917999999543", lang: "en", expected: "Reliance Jio"},
+		{num: "+593992218722", lang: "en", expected: "Claro"},
+	}
+	for _, test := range tests {
+		number, err := phonenumbers.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("Failed to parse number %s: %s", test.num, err)
+		}
+		carrier, err := phonenumbers.GetCarrierForNumber(number, test.lang)
+		if err != nil {
+			t.Errorf("Failed to getCarrier for the number %s: %s", test.num, err)
+		}
+		if test.expected != carrier {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, carrier, test.num)
+		}
+	}
+}
+
+func TestGetCarrierWithPrefixForNumber(t *testing.T) {
+	tests := []struct {
+		num             string
+		lang            string
+		expectedCarrier string
+		expectedPrefix  int32
+	}{
+		{num: "+8613702032331", lang: "en", expectedCarrier: "China Mobile", expectedPrefix: 86137},
+		{num: "+8613702032331", lang: "zh", expectedCarrier: "中国移动", expectedPrefix: 86137},
+		{num: "+6281377468527", lang: "en", expectedCarrier: "Telkomsel", expectedPrefix: 62813},
+		{num: "+8613323241342", lang: "en", expectedCarrier: "China Telecom", expectedPrefix: 86133},
+		{num: "+61491570156", lang: "en", expectedCarrier: "Telstra", expectedPrefix: 6149},
+		{num: "+917999999543", lang: "en", expectedCarrier: "Reliance Jio", expectedPrefix: 917999},
+		{num: "+593992218722", lang: "en", expectedCarrier: "Claro", expectedPrefix: 5939922},
+		{num: "+201987654321", lang: "en", expectedCarrier: "", expectedPrefix: 0},
+	}
+	for _, test := range tests {
+		number, err := phonenumbers.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("Failed to parse number %s: %s", test.num, err)
+		}
+		carrier, prefix, err := phonenumbers.GetCarrierWithPrefixForNumber(number, test.lang)
+		if err != nil {
+			t.Errorf("Failed to getCarrierWithPrefix for the number %s: %s", test.num, err)
+		}
+		if test.expectedCarrier != carrier {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expectedCarrier, carrier, test.num)
+		}
+		if test.expectedPrefix != prefix {
+			t.Errorf("Expected '%d', got '%d' for '%s'", test.expectedPrefix, prefix, test.num)
+		}
+	}
+}
+
+// values are shared between every number they apply to, so looking them up doesn't allocate
+func TestGetCarrierForNumberAllocs(t *testing.T) {
+	number, err := phonenumbers.Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatalf("Failed to parse number: %s", err)
+	}
+	for _, lang := range []string{"en", "zh"} {
+		allocs := testing.AllocsPerRun(100, func() { phonenumbers.GetCarrierForNumber(number, lang) })
+		if allocs != 0 {
+			t.Errorf("Expected no allocations for '%s', got %f", lang, allocs)
+		}
+	}
+}
+
+type portedNumbers map[string]string
+
+func (p portedNumbers) ResolveCarrier(ctx context.Context, number *phonenumbers.PhoneNumber, lang string, offline string) (string, error) {
+	return p[phonenumbers.Format(number, phonenumbers.E164)], nil
+}
+
+func TestLookupCarrierForNumber(t *testing.T) {
+	phonenumbers.SetCarrierResolver(portedNumbers{"+8613702032331": "China Unicom"})
+	defer phonenumbers.SetCarrierResolver(nil)
+
+	tests := []struct {
+		num      string
+		expected string
+	}{
+		{num: "+8613702032331", expected: "China Unicom"},
+		{num: "+8613323241342", expected: "China Telecom"},
+		{num: "+201987654321", expected: ""},
+	}
+	for _, test := range tests {
+		number, err := phonenumbers.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("Failed to parse number %s: %s", test.num, err)
+		}
+		carrier, err := phonenumbers.LookupCarrierForNumber(context.Background(), number, "en")
+		if err != nil {
+			t.Errorf("Failed to lookup carrier for the number %s: %s", test.num, err)
+		}
+		if test.expected != carrier {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, carrier, test.num)
+		}
+	}
+}
+
+func TestCarrierOverlay(t *testing.T) {
+	overlay, err := phonenumbers.NewPrefixSet()
+	if err != nil {
+		t.Fatalf("Failed to create prefix set: %s", err)
+	}
+	overlay.Add("+6149157", "Sub Brand")
+	overlay.Add("+86137", "CM Rebranded")
+	overlay.Add("+59399", "Shorter")
+	overlay.Add("+201987", "Overlay Only")
+
+	phonenumbers.SetCarrierOverlay("en", overlay)
+	defer phonenumbers.SetCarrierOverlay("en", nil)
+
+	tests := []struct {
+		num             string
+		lang            string
+		expectedCarrier string
+		expectedPrefix  int32
+	}{
+		{num: "+61491570156", lang: "en", expectedCarrier: "Sub Brand", expectedPrefix: 6149157},
+		{num: "+61491000156", lang: "en", expectedCarrier: "Telstra", expectedPrefix: 6149},
+		{num: "+8613702032331", lang: "en", expectedCarrier: "CM Rebranded", expectedPrefix: 86137},
+		{num: "+8613702032331", lang: "zh", expectedCarrier: "中国移动", expectedPrefix: 86137},
+		{num: "+593992218722", lang: "en", expectedCarrier: "Claro", expectedPrefix: 5939922},
+		{num: "+201987654321", lang: "en", expectedCarrier: "Overlay Only", expectedPrefix: 201987},
+		{num: "+61491570156", lang: "zh", expectedCarrier: "Sub Brand", expectedPrefix: 6149157},
+	}
+	for _, test := range tests {
+		number, err := phonenumbers.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("Failed to parse number %s: %s", test.num, err)
+		}
+		carrier, prefix, err := phonenumbers.GetCarrierWithPrefixForNumber(number, test.lang)
+		if err != nil {
+			t.Errorf("Failed to getCarrierWithPrefix for the number %s: %s", test.num, err)
+		}
+		if test.expectedCarrier != carrier {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expectedCarrier, carrier, test.num)
+		}
+		if test.expectedPrefix != prefix {
+			t.Errorf("Expected '%d', got '%d' for '%s'", test.expectedPrefix, prefix, test.num)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package phonenumbers
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// the carrier overlays set with SetCarrierOverlay, as a map of language to *PrefixSet which is
+// replaced rather than modified, so that lookups don't need to take a lock
+var (
+	carrierOverlays      atomic.Value
+	carrierOverlaysMutex sync.Mutex
+)
+
+// SetCarrierOverlay sets a supplemental layer of carrier names in the passed in language on top of our
+// carrier data, e.g. to name the MVNO sub-brands which use ranges of a host network's numbers, which
+// the upstream data names only as the host network. The overlay is a PrefixSet of E164 prefixes
+// labelled with carrier names, such as one read with ReadPrefixSetCSV, and must not be added to once
+// set. Passing nil removes the overlay for the language. It's safe to call at any time.
+//
+// GetCarrierForNumber and GetCarrierWithPrefixForNumber use whichever of the overlay and our data has
+// the longest prefix matching a number, and the overlay when they match prefixes of the same length,
+// so an overlay entry for a whole host network replaces its name. As with our data, if there's no
+// carrier for a number in the requested language, English is used. Overlays can be used without
+// importing the carrierdata package, in which case they are all there is.
+func SetCarrierOverlay(lang string, overlay *PrefixSet) {
+	carrierOverlaysMutex.Lock()
+	defer carrierOverlaysMutex.Unlock()
+
+	current, _ := carrierOverlays.Load().(map[string]*PrefixSet)
+	updated := make(map[string]*PrefixSet, len(current)+1)
+	for l, o := range current {
+		updated[l] = o
+	}
+	if overlay != nil {
+		updated[lang] = overlay
+	} else {
+		delete(updated, lang)
+	}
+	carrierOverlays.Store(updated)
+	atomic.AddUint64(&lookupDataGeneration, 1)
+}
+
+// getCarrierWithOverlay returns the carrier for the passed in number in the passed in language
+// from our carrier data and any overlay for the language, following the precedence rules of
+// SetCarrierOverlay
+func getCarrierWithOverlay(number *PhoneNumber, lang string) (string, int32, error) {
+	overlays, _ := carrierOverlays.Load().(map[string]*PrefixSet)
+	overlay := overlays[lang]
+	if len(carrierMaps) == 0 && len(overlays) == 0 {
+		return "", 0, ErrCarrierDataNotLoaded
+	}
+
+	carrier, prefix, err := getValueForNumber(carrierMaps, lang, 10, number)
+	if err != nil {
+		return "", 0, err
+	}
+	if overlay == nil {
+		return carrier, prefix, nil
+	}
+
+	overlayPrefix, overlayCarrier, found := overlay.MatchNumber(number)
+	if !found || (carrier != "" && len(overlayPrefix) < len(strconv.Itoa(int(prefix)))) {
+		return carrier, prefix, nil
+	}
+
+	// prefixes too long to be an int32 are returned as zero
+	asInt, err := strconv.ParseInt(overlayPrefix, 10, 32)
+	if errors.Is(err, strconv.ErrRange) {
+		asInt = 0
+	} else if err != nil {
+		return "", 0, err
+	}
+	return overlayCarrier, int32(asInt), nil
+}
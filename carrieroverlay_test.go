@@ -0,0 +1,61 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCarrierOverlay(t *testing.T) {
+	number, err := Parse("+447700900123", "")
+	require.NoError(t, err)
+
+	// without carrier data or an overlay there's nothing to look in
+	_, err = GetCarrierForNumber(number, "en")
+	assert.ErrorIs(t, err, ErrCarrierDataNotLoaded)
+
+	overlay, err := NewPrefixSet()
+	require.NoError(t, err)
+	require.NoError(t, overlay.Add("+44 7700 9", "Sub Brand"))
+	require.NoError(t, overlay.Add("+44 7700 90", "Sub Sub Brand"))
+	require.NoError(t, overlay.Add("+44 7700 9009", "Long"))
+
+	SetCarrierOverlay("en", overlay)
+	defer SetCarrierOverlay("en", nil)
+
+	carrier, prefix, err := GetCarrierWithPrefixForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub Sub Brand", carrier)
+	assert.Equal(t, int32(44770090), prefix)
+
+	// other languages fall back to English
+	carrier, err = GetCarrierForNumber(number, "fr")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub Sub Brand", carrier)
+
+	number, err = Parse("+447700955555", "")
+	require.NoError(t, err)
+	carrier, prefix, err = GetCarrierWithPrefixForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub Brand", carrier)
+	assert.Equal(t, int32(4477009), prefix)
+
+	// prefixes which don't fit in an int32 are returned as zero
+	number, err = Parse("+447700900900", "")
+	require.NoError(t, err)
+	carrier, prefix, err = GetCarrierWithPrefixForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Long", carrier)
+	assert.Equal(t, int32(0), prefix)
+
+	number, err = Parse("+16502530000", "")
+	require.NoError(t, err)
+	carrier, err = GetCarrierForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "", carrier)
+
+	SetCarrierOverlay("en", nil)
+	_, err = GetCarrierForNumber(number, "en")
+	assert.ErrorIs(t, err, ErrCarrierDataNotLoaded)
+}
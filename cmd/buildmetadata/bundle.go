@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// bundleSchemaVersion is incremented whenever the bundle changes in a way that could break consumers
+const bundleSchemaVersion = 1
+
+// metadataBundle is the compiled metadata as a language neutral JSON document, for services and frontends
+// which need the same numbering snapshot as this library
+type metadataBundle struct {
+	SchemaVersion int                `json:"schema_version"`
+	CallingCodes  map[int32][]string `json:"calling_codes"`
+	Regions       []bundleRegion     `json:"regions"`
+}
+
+// bundleRegion is the metadata of a region or non-geographical entity, the latter having an id of 001
+type bundleRegion struct {
+	ID                           string                `json:"id"`
+	CountryCode                  int32                 `json:"country_code"`
+	MainCountryForCode           bool                  `json:"main_country_for_code,omitempty"`
+	LeadingDigits                string                `json:"leading_digits,omitempty"`
+	InternationalPrefix          string                `json:"international_prefix,omitempty"`
+	PreferredInternationalPrefix string                `json:"preferred_international_prefix,omitempty"`
+	NationalPrefix               string                `json:"national_prefix,omitempty"`
+	NationalPrefixForParsing     string                `json:"national_prefix_for_parsing,omitempty"`
+	NationalPrefixTransformRule  string                `json:"national_prefix_transform_rule,omitempty"`
+	PreferredExtnPrefix          string                `json:"preferred_extn_prefix,omitempty"`
+	MobileNumberPortable         bool                  `json:"mobile_number_portable,omitempty"`
+	General                      bundleDesc            `json:"general"`
+	Types                        map[string]bundleDesc `json:"types"`
+	Formats                      []bundleFormat        `json:"formats,omitempty"`
+	IntlFormats                  []bundleFormat        `json:"intl_formats,omitempty"`
+}
+
+// bundleDesc describes the numbers of one type, the pattern matching the whole national number
+type bundleDesc struct {
+	Pattern          string  `json:"pattern"`
+	PossibleLengths  []int32 `json:"possible_lengths"`
+	LocalOnlyLengths []int32 `json:"local_only_lengths,omitempty"`
+	Example          string  `json:"example,omitempty"`
+}
+
+// bundleFormat is a formatting rule, applying to numbers which match pattern and whose start matches the last of
+// leading_digits, with format using $1, $2 etc for the groups of pattern
+type bundleFormat struct {
+	Pattern                              string   `json:"pattern"`
+	Format                               string   `json:"format"`
+	LeadingDigits                        []string `json:"leading_digits,omitempty"`
+	NationalPrefixFormattingRule         string   `json:"national_prefix_formatting_rule,omitempty"`
+	NationalPrefixOptionalWhenFormatting bool     `json:"national_prefix_optional_when_formatting,omitempty"`
+	CarrierCodeFormattingRule            string   `json:"carrier_code_formatting_rule,omitempty"`
+}
+
+// the types a region can have numbers of, keyed by the names used in the bundle
+var bundleTypes = []struct {
+	name string
+	get  func(*phonenumbers.PhoneMetadata) *phonenumbers.PhoneNumberDesc
+}{
+	{"fixed_line", (*phonenumbers.PhoneMetadata).GetFixedLine},
+	{"mobile", (*phonenumbers.PhoneMetadata).GetMobile},
+	{"toll_free", (*phonenumbers.PhoneMetadata).GetTollFree},
+	{"premium_rate", (*phonenumbers.PhoneMetadata).GetPremiumRate},
+	{"shared_cost", (*phonenumbers.PhoneMetadata).GetSharedCost},
+	{"personal_number", (*phonenumbers.PhoneMetadata).GetPersonalNumber},
+	{"voip", (*phonenumbers.PhoneMetadata).GetVoip},
+	{"pager", (*phonenumbers.PhoneMetadata).GetPager},
+	{"uan", (*phonenumbers.PhoneMetadata).GetUan},
+	{"voicemail", (*phonenumbers.PhoneMetadata).GetVoicemail},
+	{"no_international_dialling", (*phonenumbers.PhoneMetadata).GetNoInternationalDialling},
+}
+
+// buildBundle builds the bundle for the passed in metadata, keeping the order of the collection
+func buildBundle(metadata *phonenumbers.PhoneMetadataCollection) *metadataBundle {
+	bundle := &metadataBundle{
+		SchemaVersion: bundleSchemaVersion,
+		CallingCodes:  phonenumbers.BuildCountryCodeToRegionMap(metadata),
+	}
+
+	for _, m := range metadata.GetMetadata() {
+		region := bundleRegion{
+			ID:                           m.GetId(),
+			CountryCode:                  m.GetCountryCode(),
+			MainCountryForCode:           m.GetMainCountryForCode(),
+			LeadingDigits:                m.GetLeadingDigits(),
+			InternationalPrefix:          m.GetInternationalPrefix(),
+			PreferredInternationalPrefix: m.GetPreferredInternationalPrefix(),
+			NationalPrefix:               m.GetNationalPrefix(),
+			NationalPrefixForParsing:     m.GetNationalPrefixForParsing(),
+			NationalPrefixTransformRule:  m.GetNationalPrefixTransformRule(),
+			PreferredExtnPrefix:          m.GetPreferredExtnPrefix(),
+			MobileNumberPortable:         m.GetMobileNumberPortableRegion(),
+			General:                      newBundleDesc(m.GetGeneralDesc(), nil),
+			Types:                        make(map[string]bundleDesc),
+			Formats:                      newBundleFormats(m.GetNumberFormat()),
+			IntlFormats:                  newBundleFormats(m.GetIntlNumberFormat()),
+		}
+
+		for _, typ := range bundleTypes {
+			desc := typ.get(m)
+			if pattern := desc.GetNationalNumberPattern(); pattern == "" || pattern == "NA" {
+				continue
+			}
+			region.Types[typ.name] = newBundleDesc(desc, m.GetGeneralDesc())
+		}
+
+		bundle.Regions = append(bundle.Regions, region)
+	}
+	return bundle
+}
+
+// newBundleDesc returns the passed in description, with possible lengths left out because they're the same as
+// those of general filled back in, so consumers never need to look elsewhere
+func newBundleDesc(desc *phonenumbers.PhoneNumberDesc, general *phonenumbers.PhoneNumberDesc) bundleDesc {
+	lengths := desc.GetPossibleLength()
+	if len(lengths) == 0 {
+		lengths = general.GetPossibleLength()
+	}
+	if lengths == nil {
+		lengths = []int32{}
+	}
+	return bundleDesc{
+		Pattern:          desc.GetNationalNumberPattern(),
+		PossibleLengths:  lengths,
+		LocalOnlyLengths: desc.GetPossibleLengthLocalOnly(),
+		Example:          desc.GetExampleNumber(),
+	}
+}
+
+func newBundleFormats(formats []*phonenumbers.NumberFormat) []bundleFormat {
+	bundled := make([]bundleFormat, 0, len(formats))
+	for _, f := range formats {
+		bundled = append(bundled, bundleFormat{
+			Pattern:                              f.GetPattern(),
+			Format:                               f.GetFormat(),
+			LeadingDigits:                        f.GetLeadingDigitsPattern(),
+			NationalPrefixFormattingRule:         f.GetNationalPrefixFormattingRule(),
+			NationalPrefixOptionalWhenFormatting: f.GetNationalPrefixOptionalWhenFormatting(),
+			CarrierCodeFormattingRule:            f.GetDomesticCarrierCodeFormattingRule(),
+		})
+	}
+	return bundled
+}
+
+// writeBundle writes the bundle for the passed in metadata to path
+func writeBundle(path string, metadata *phonenumbers.PhoneMetadataCollection) {
+	log.Println("Building metadata bundle")
+	data, err := json.MarshalIndent(buildBundle(metadata), "", "  ")
+	if err != nil {
+		log.Fatalf("Error marshalling metadata bundle: %s", err)
+	}
+	data = append(data, '\n')
+	report.addFile(path, len(data))
+
+	if !dryRun {
+		fmt.Printf("Writing new %s\n", path)
+		if err := os.WriteFile(path, data, os.FileMode(0664)); err != nil {
+			log.Fatalf("Error writing '%s': %s", path, err)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// cacheEntry is what we remember about an upstream resource from the last time we generated from it
+type cacheEntry struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	Hash         string `json:"hash"`
+}
+
+// fetchCache lets us skip regenerating artifacts whose upstream resources haven't changed. Updates are held
+// as pending until the generated files have been successfully written, so a failed run is retried in full.
+// The cache file is shared by every checkout using it, so entries are scoped to the repo we are generating
+// into and the options which change what we generate.
+type fetchCache struct {
+	path    string
+	force   bool
+	scope   string
+	entries map[string]*cacheEntry
+	pending map[string]*cacheEntry
+}
+
+var cache = &fetchCache{entries: map[string]*cacheEntry{}, pending: map[string]*cacheEntry{}}
+
+// defaultCachePath returns the path of our cache file in the user's cache directory
+func defaultCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "phonenumbers", "buildmetadata.json")
+}
+
+// cacheScope returns the scope of our cache entries for generating into the passed in repo directory with
+// the passed in options
+func cacheScope(repoDir string, options ...string) string {
+	abs, err := filepath.Abs(repoDir)
+	if err != nil {
+		log.Fatalf("Error resolving '%s': %s", repoDir, err)
+	}
+	return strings.Join(append([]string{abs}, options...), "|")
+}
+
+// load reads our cache file, a missing or invalid file just means everything will be regenerated
+func (c *fetchCache) load(path string, force bool, scope string) {
+	c.path = path
+	c.force = force
+	c.scope = scope
+	if path == "" {
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		log.Printf("Ignoring invalid cache file '%s': %s\n", path, err)
+		c.entries = map[string]*cacheEntry{}
+	}
+}
+
+// get returns the entry for the passed in key, or nil if we have none or are ignoring the cache
+func (c *fetchCache) get(key string) *cacheEntry {
+	if c.force {
+		return nil
+	}
+	return c.entries[c.scope+"|"+key]
+}
+
+// update records the latest entry for the resource with the passed in key as pending, returning whether
+// its content has changed since last time
+func (c *fetchCache) update(key string, entry *cacheEntry) bool {
+	previous := c.get(key)
+	c.pending[c.scope+"|"+key] = entry
+	return previous == nil || previous.Hash != entry.Hash
+}
+
+// save merges our pending entries and writes our cache file
+func (c *fetchCache) save() {
+	if c.path == "" || len(c.pending) == 0 {
+		return
+	}
+	for key, entry := range c.pending {
+		c.entries[key] = entry
+	}
+	c.pending = map[string]*cacheEntry{}
+
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		log.Fatalf("Error marshalling cache: %s", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), os.FileMode(0775)); err != nil {
+		log.Fatalf("Error creating cache directory: %s", err)
+	}
+	if err := os.WriteFile(c.path, data, os.FileMode(0664)); err != nil {
+		log.Fatalf("Error writing cache '%s': %s", c.path, err)
+	}
+}
+
+func hashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hashDir returns a hash of the names and contents of all the files in dir
+func hashDir(dir string) string {
+	var paths []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("Error hashing '%s': %s", dir, err)
+	}
+	sort.Strings(paths)
+
+	hash := sha256.New()
+	for _, path := range paths {
+		rel, _ := filepath.Rel(dir, path)
+		io.WriteString(hash, rel+"\n")
+
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("Error hashing '%s': %s", path, err)
+		}
+		_, err = io.Copy(hash, f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("Error hashing '%s': %s", path, err)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
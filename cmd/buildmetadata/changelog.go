@@ -0,0 +1,252 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// metadataChangelog is a machine readable summary of the differences between the metadata
+// currently embedded in the library and a newly built collection
+type metadataChangelog struct {
+	GeneratedAt         string         `json:"generated_at"`
+	RegionsAdded        []string       `json:"regions_added"`
+	RegionsRemoved      []string       `json:"regions_removed"`
+	RegionsChanged      []regionChange `json:"regions_changed"`
+	CallingCodesAdded   []int32        `json:"calling_codes_added"`
+	CallingCodesRemoved []int32        `json:"calling_codes_removed"`
+	PatternChanges      int            `json:"pattern_changes"`
+	FormatChanges       int            `json:"format_changes"`
+	Risky               bool           `json:"risky"`
+}
+
+// regionChange describes what changed for a single region, risky changes are those that
+// can make numbers which were previously valid invalid
+type regionChange struct {
+	Region         string             `json:"region"`
+	PatternChanges int                `json:"pattern_changes"`
+	FormatChanges  int                `json:"format_changes"`
+	RemovedTypes   []string           `json:"removed_types,omitempty"`
+	AddedTypes     []string           `json:"added_types,omitempty"`
+	RemovedLengths map[string][]int32 `json:"removed_lengths,omitempty"`
+	Risky          bool               `json:"risky"`
+}
+
+// the number descriptions we compare, in the order they appear in the proto
+var changelogDescs = []struct {
+	name string
+	get  func(*phonenumbers.PhoneMetadata) *phonenumbers.PhoneNumberDesc
+}{
+	{"general_desc", (*phonenumbers.PhoneMetadata).GetGeneralDesc},
+	{"fixed_line", (*phonenumbers.PhoneMetadata).GetFixedLine},
+	{"mobile", (*phonenumbers.PhoneMetadata).GetMobile},
+	{"toll_free", (*phonenumbers.PhoneMetadata).GetTollFree},
+	{"premium_rate", (*phonenumbers.PhoneMetadata).GetPremiumRate},
+	{"shared_cost", (*phonenumbers.PhoneMetadata).GetSharedCost},
+	{"personal_number", (*phonenumbers.PhoneMetadata).GetPersonalNumber},
+	{"voip", (*phonenumbers.PhoneMetadata).GetVoip},
+	{"pager", (*phonenumbers.PhoneMetadata).GetPager},
+	{"uan", (*phonenumbers.PhoneMetadata).GetUan},
+	{"voicemail", (*phonenumbers.PhoneMetadata).GetVoicemail},
+	{"no_international_dialling", (*phonenumbers.PhoneMetadata).GetNoInternationalDialling},
+}
+
+// metadataKey returns the key we use to identify a metadata entry, non geographical entities all
+// share the same id so we qualify those with their calling code
+func metadataKey(m *phonenumbers.PhoneMetadata) string {
+	if m.GetId() == phonenumbers.REGION_CODE_FOR_NON_GEO_ENTITY {
+		return fmt.Sprintf("%s+%d", m.GetId(), m.GetCountryCode())
+	}
+	return m.GetId()
+}
+
+func hasNumbers(desc *phonenumbers.PhoneNumberDesc) bool {
+	pattern := desc.GetNationalNumberPattern()
+	return pattern != "" && pattern != "NA"
+}
+
+func buildChangelog(previous, current *phonenumbers.PhoneMetadataCollection) *metadataChangelog {
+	changelog := &metadataChangelog{
+		GeneratedAt:         time.Now().UTC().Format(time.RFC3339),
+		RegionsAdded:        []string{},
+		RegionsRemoved:      []string{},
+		RegionsChanged:      []regionChange{},
+		CallingCodesAdded:   []int32{},
+		CallingCodesRemoved: []int32{},
+	}
+
+	previousByKey := make(map[string]*phonenumbers.PhoneMetadata)
+	previousCodes := make(map[int32]bool)
+	for _, m := range previous.GetMetadata() {
+		previousByKey[metadataKey(m)] = m
+		previousCodes[m.GetCountryCode()] = true
+	}
+
+	currentByKey := make(map[string]*phonenumbers.PhoneMetadata)
+	currentCodes := make(map[int32]bool)
+	for _, m := range current.GetMetadata() {
+		currentByKey[metadataKey(m)] = m
+		currentCodes[m.GetCountryCode()] = true
+	}
+
+	for key, m := range currentByKey {
+		old, found := previousByKey[key]
+		if !found {
+			changelog.RegionsAdded = append(changelog.RegionsAdded, key)
+			continue
+		}
+
+		change := diffRegion(key, old, m)
+		if change != nil {
+			changelog.RegionsChanged = append(changelog.RegionsChanged, *change)
+			changelog.PatternChanges += change.PatternChanges
+			changelog.FormatChanges += change.FormatChanges
+			changelog.Risky = changelog.Risky || change.Risky
+		}
+	}
+	for key := range previousByKey {
+		if _, found := currentByKey[key]; !found {
+			changelog.RegionsRemoved = append(changelog.RegionsRemoved, key)
+			changelog.Risky = true
+		}
+	}
+
+	for code := range currentCodes {
+		if !previousCodes[code] {
+			changelog.CallingCodesAdded = append(changelog.CallingCodesAdded, code)
+		}
+	}
+	for code := range previousCodes {
+		if !currentCodes[code] {
+			changelog.CallingCodesRemoved = append(changelog.CallingCodesRemoved, code)
+			changelog.Risky = true
+		}
+	}
+
+	sort.Strings(changelog.RegionsAdded)
+	sort.Strings(changelog.RegionsRemoved)
+	sort.Slice(changelog.RegionsChanged, func(i, j int) bool {
+		return changelog.RegionsChanged[i].Region < changelog.RegionsChanged[j].Region
+	})
+	sort.Slice(changelog.CallingCodesAdded, func(i, j int) bool {
+		return changelog.CallingCodesAdded[i] < changelog.CallingCodesAdded[j]
+	})
+	sort.Slice(changelog.CallingCodesRemoved, func(i, j int) bool {
+		return changelog.CallingCodesRemoved[i] < changelog.CallingCodesRemoved[j]
+	})
+
+	return changelog
+}
+
+// diffRegion compares two versions of the metadata for a region, returning nil if nothing we
+// track changed
+func diffRegion(key string, old, new *phonenumbers.PhoneMetadata) *regionChange {
+	change := &regionChange{Region: key}
+
+	for _, d := range changelogDescs {
+		oldDesc, newDesc := d.get(old), d.get(new)
+
+		if oldDesc.GetNationalNumberPattern() != newDesc.GetNationalNumberPattern() {
+			change.PatternChanges++
+		}
+
+		if hasNumbers(oldDesc) && !hasNumbers(newDesc) {
+			change.RemovedTypes = append(change.RemovedTypes, d.name)
+		} else if !hasNumbers(oldDesc) && hasNumbers(newDesc) {
+			change.AddedTypes = append(change.AddedTypes, d.name)
+		}
+
+		// descriptions without their own lengths inherit those of the general desc
+		oldLengths, newLengths := oldDesc.GetPossibleLength(), newDesc.GetPossibleLength()
+		if len(oldLengths) == 0 {
+			oldLengths = old.GetGeneralDesc().GetPossibleLength()
+		}
+		if len(newLengths) == 0 {
+			newLengths = new.GetGeneralDesc().GetPossibleLength()
+		}
+
+		if hasNumbers(newDesc) {
+			if removed := removedLengths(oldLengths, newLengths); len(removed) > 0 {
+				if change.RemovedLengths == nil {
+					change.RemovedLengths = make(map[string][]int32)
+				}
+				change.RemovedLengths[d.name] = removed
+			}
+		}
+	}
+
+	change.FormatChanges = diffFormats(old.GetNumberFormat(), new.GetNumberFormat()) +
+		diffFormats(old.GetIntlNumberFormat(), new.GetIntlNumberFormat())
+
+	if old.GetNationalPrefix() != new.GetNationalPrefix() ||
+		old.GetNationalPrefixForParsing() != new.GetNationalPrefixForParsing() ||
+		old.GetInternationalPrefix() != new.GetInternationalPrefix() ||
+		old.GetLeadingDigits() != new.GetLeadingDigits() {
+		change.PatternChanges++
+	}
+
+	change.Risky = len(change.RemovedTypes) > 0 || len(change.RemovedLengths) > 0
+
+	if change.PatternChanges == 0 && change.FormatChanges == 0 && len(change.AddedTypes) == 0 && !change.Risky {
+		return nil
+	}
+	return change
+}
+
+// removedLengths returns the lengths present in old that are no longer in new
+func removedLengths(old, new []int32) []int32 {
+	present := make(map[int32]bool, len(new))
+	for _, l := range new {
+		present[l] = true
+	}
+
+	var removed []int32
+	for _, l := range old {
+		if l != -1 && !present[l] {
+			removed = append(removed, l)
+		}
+	}
+	return removed
+}
+
+// diffFormats returns the number of formats that were added, removed or modified
+func diffFormats(old, new []*phonenumbers.NumberFormat) int {
+	formatKey := func(f *phonenumbers.NumberFormat) string {
+		return fmt.Sprintf("%s|%s|%v|%s", f.GetPattern(), f.GetFormat(), f.GetLeadingDigitsPattern(), f.GetNationalPrefixFormattingRule())
+	}
+
+	seen := make(map[string]int, len(old))
+	for _, f := range old {
+		seen[formatKey(f)]++
+	}
+
+	changes := 0
+	for _, f := range new {
+		key := formatKey(f)
+		if seen[key] > 0 {
+			seen[key]--
+		} else {
+			changes++
+		}
+	}
+	for _, count := range seen {
+		changes += count
+	}
+	return changes
+}
+
+func writeChangelog(path string, changelog *metadataChangelog) {
+	data, err := json.MarshalIndent(changelog, "", "  ")
+	if err != nil {
+		log.Fatalf("Error marshalling changelog: %s", err)
+	}
+
+	stageFile(path, append(data, '\n'))
+
+	log.Printf("Metadata changes: %d added, %d removed, %d changed regions (risky: %v)\n",
+		len(changelog.RegionsAdded), len(changelog.RegionsRemoved), len(changelog.RegionsChanged), changelog.Risky)
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	// fetchTimeout is the timeout for a single attempt at downloading a file
+	fetchTimeout = 2 * time.Minute
+
+	// svnTimeout is the timeout for a single attempt at exporting a directory with svn
+	svnTimeout = 30 * time.Minute
+
+	// fetchRetries is how many times we retry a failed download before giving up
+	fetchRetries = 5
+
+	// fetchBackoff is how long we wait before our first retry, doubling on each subsequent one
+	fetchBackoff = 2 * time.Second
+
+	// maxFetchSize is the largest file we are willing to download
+	maxFetchSize int64 = 64 * 1024 * 1024
+)
+
+// sanityCheck describes what we expect of a downloaded file, so that a truncated download or an
+// error page served with a 200 isn't mistaken for the real thing
+type sanityCheck struct {
+	minSize     int
+	mustContain string
+}
+
+var (
+	metadataSanity     = sanityCheck{minSize: 1024 * 1024, mustContain: "</phoneNumberMetadata>"}
+	shortNumberSanity  = sanityCheck{minSize: 64 * 1024, mustContain: "</phoneNumberMetadata>"}
+	testMetadataSanity = sanityCheck{minSize: 16 * 1024, mustContain: "</phoneNumberMetadata>"}
+	timezoneSanity     = sanityCheck{minSize: 16 * 1024}
+	mccMncSanity       = sanityCheck{minSize: 1}
+)
+
+func (c sanityCheck) check(body []byte) error {
+	if len(body) < c.minSize {
+		return fmt.Errorf("got %d bytes, expected at least %d", len(body), c.minSize)
+	}
+	if c.mustContain != "" && !bytes.Contains(body, []byte(c.mustContain)) {
+		return fmt.Errorf("body doesn't contain '%s'", c.mustContain)
+	}
+	return nil
+}
+
+// permanentError wraps errors that retrying won't fix, such as a 404
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// httpClient is used for all downloads, it honors the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables and also supports file:// URLs for reading from a local mirror
+var httpClient = newHTTPClient()
+
+func newHTTPClient() *http.Client {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	transport.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+	return &http.Client{Transport: transport}
+}
+
+// errNotModified is returned when a conditional request tells us our cached copy is still current
+var errNotModified = errors.New("not modified")
+
+// fetchIfChanged downloads the passed in URL, retrying with exponential backoff on failure. If the
+// resource is unchanged since we last generated from it, nil is returned.
+func fetchIfChanged(url string, sanity sanityCheck) []byte {
+	body, header, err := fetchWithRetries(url, sanity, cache.get(url))
+	if err == errNotModified {
+		log.Printf("%s not modified, skipping\n", url)
+		return nil
+	}
+	if err != nil {
+		log.Fatalf("Error fetching URL '%s': %s", url, err)
+	}
+
+	entry := &cacheEntry{ETag: header.Get("ETag"), LastModified: header.Get("Last-Modified"), Hash: hashBytes(body)}
+	if !cache.update(url, entry) {
+		log.Printf("%s unchanged, skipping\n", url)
+		return nil
+	}
+	return body
+}
+
+func fetchWithRetries(url string, sanity sanityCheck, cached *cacheEntry) ([]byte, http.Header, error) {
+	partial := &download{}
+
+	for attempt := 0; ; attempt++ {
+		header, err := fetchOnce(url, partial, cached)
+		if err == errNotModified {
+			return nil, nil, err
+		}
+		if err == nil {
+			if err = sanity.check(partial.body); err == nil {
+				return partial.body, header, nil
+			}
+
+			// the whole thing is suspect, start again from scratch
+			partial.reset()
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) || attempt >= fetchRetries {
+			return nil, nil, fmt.Errorf("giving up after %d attempts: %w", attempt+1, err)
+		}
+
+		wait := fetchBackoff << attempt
+		if len(partial.body) > 0 {
+			log.Printf("Error fetching '%s' after %d bytes: %s, resuming in %s", url, len(partial.body), err, wait)
+		} else {
+			log.Printf("Error fetching '%s': %s, retrying in %s", url, err, wait)
+		}
+		time.Sleep(wait)
+	}
+}
+
+// download is what we have so far of a body, along with the validator of the response it came from,
+// which we send as If-Range when resuming so that we never stitch together two versions of a file
+type download struct {
+	body      []byte
+	validator string
+}
+
+func (d *download) reset() {
+	d.body = nil
+	d.validator = ""
+}
+
+// rangeValidator returns the validator we can send as If-Range to resume the body of a response with
+// the passed in headers, which is its ETag unless that is weak, and otherwise its Last-Modified
+func rangeValidator(header http.Header) string {
+	if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return header.Get("Last-Modified")
+}
+
+// fetchOnce makes a single attempt at downloading the passed in URL. If partial contains the start of
+// the body from a previous attempt, we ask the server for just the remainder of that same version.
+// What we have of the body is always left in partial so that a subsequent attempt can resume from
+// there, unless the response can't be resumed. If cached is set, we ask the server to only send the
+// body if it has changed since.
+func fetchOnce(url string, partial *download, cached *cacheEntry) (http.Header, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, &permanentError{err}
+	}
+	if len(partial.body) > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", len(partial.body)))
+		req.Header.Set("If-Range", partial.validator)
+	} else if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && len(partial.body) == 0 && cached != nil:
+		return nil, errNotModified
+	case resp.StatusCode == http.StatusPartialContent && len(partial.body) > 0:
+		// resuming where we left off, as long as that's where the server is sending from
+		var start int
+		contentRange := resp.Header.Get("Content-Range")
+		if _, err := fmt.Sscanf(contentRange, "bytes %d-", &start); err != nil || start != len(partial.body) {
+			err := fmt.Errorf("unexpected content range '%s' resuming after %d bytes", contentRange, len(partial.body))
+			partial.reset()
+			return nil, err
+		}
+	case resp.StatusCode == http.StatusOK:
+		// either a fresh download, or the server doesn't support ranges or the file has changed, so start over
+		partial.reset()
+		partial.validator = rangeValidator(resp.Header)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	default:
+		partial.reset()
+		return nil, &permanentError{fmt.Errorf("unexpected status: %s", resp.Status)}
+	}
+
+	if int64(len(partial.body))+resp.ContentLength > maxFetchSize {
+		partial.reset()
+		return nil, &permanentError{fmt.Errorf("body of %d bytes is larger than max of %d", resp.ContentLength, maxFetchSize)}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFetchSize-int64(len(partial.body))+1))
+	partial.body = append(partial.body, body...)
+
+	if int64(len(partial.body)) > maxFetchSize {
+		partial.reset()
+		return nil, &permanentError{fmt.Errorf("body is larger than max of %d bytes", maxFetchSize)}
+	}
+	if err == nil && resp.ContentLength >= 0 && int64(len(body)) != resp.ContentLength {
+		err = fmt.Errorf("truncated body, got %d of %d bytes", len(body), resp.ContentLength)
+	}
+	if err != nil {
+		// without a validator we can't be sure a later response continues this one
+		if partial.validator == "" {
+			partial.reset()
+		}
+		return nil, err
+	}
+
+	return resp.Header, nil
+}
+
+// svnExportWithRetries exports the passed in URL to dir, retrying with exponential backoff on failure
+func svnExportWithRetries(dir string, url string) {
+	for attempt := 0; ; attempt++ {
+		err := svnExport(dir, url)
+		if err == nil {
+			return
+		}
+
+		if attempt >= fetchRetries {
+			log.Fatalf("Error exporting '%s', giving up after %d attempts: %s", url, attempt+1, err)
+		}
+
+		wait := fetchBackoff << attempt
+		log.Printf("Error exporting '%s': %s, retrying in %s", url, err, wait)
+		time.Sleep(wait)
+	}
+}
+
+func svnExport(dir string, url string) error {
+	os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), svnTimeout)
+	defer cancel()
+
+	args := []string{"export", url, dir, "--force"}
+
+	configDir, err := svnProxyConfig(url)
+	if err != nil {
+		return err
+	}
+	if configDir != "" {
+		defer os.RemoveAll(configDir)
+		args = append(args, "--config-dir", configDir)
+	}
+
+	cmd := exec.CommandContext(ctx, "svn", args...)
+
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = log.Writer()
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("timed out after %s", svnTimeout)
+		}
+		return fmt.Errorf("%s: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return nil
+}
+
+// svnProxyConfig writes a svn config directory which uses the proxy configured in our environment for
+// the passed in URL, as svn doesn't read HTTP(S)_PROXY itself, returning an empty path if there's no
+// proxy. It's written to a file rather than passed as arguments so that the proxy password doesn't
+// show up in the process list.
+func svnProxyConfig(rawURL string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", nil
+	}
+	proxy, err := http.ProxyFromEnvironment(req)
+	if err != nil || proxy == nil {
+		return "", nil
+	}
+
+	servers := &strings.Builder{}
+	servers.WriteString("[global]\n")
+	fmt.Fprintf(servers, "http-proxy-host = %s\n", proxy.Hostname())
+	if proxy.Port() != "" {
+		fmt.Fprintf(servers, "http-proxy-port = %s\n", proxy.Port())
+	}
+	if proxy.User != nil {
+		fmt.Fprintf(servers, "http-proxy-username = %s\n", proxy.User.Username())
+		if password, set := proxy.User.Password(); set {
+			fmt.Fprintf(servers, "http-proxy-password = %s\n", password)
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "buildmetadata-svn")
+	if err != nil {
+		return "", fmt.Errorf("error creating svn config dir: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "servers"), []byte(servers.String()), 0600); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("error writing svn config: %w", err)
+	}
+	return dir, nil
+}
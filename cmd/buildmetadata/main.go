@@ -1,18 +1,16 @@
 package main
 
 import (
-	"bufio"
 	"compress/gzip"
 	"encoding/base64"
 	"encoding/binary"
+	"flag"
 	"fmt"
-	"io"
+	"go/format"
 	"io/ioutil"
 	"log"
 	"math"
-	"net/http"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -27,8 +25,12 @@ import (
 type prefixBuild struct {
 	url     string
 	dir     string
+	pkgName string
 	srcPath string
 	varName string
+
+	// the column of our seed table holding the value for each prefix
+	seedColumn string
 }
 
 const (
@@ -38,105 +40,87 @@ const (
 	shortNumberMetadataURL  = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/ShortNumberMetadata.xml"
 	shortNumberMetadataPath = "shortnumber_metadata_bin.go"
 
+	testMetadataURL  = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/PhoneNumberMetadataForTesting.xml"
+	testMetadataPath = "testmetadata/metadata_bin.go"
+
 	tzURL  = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/timezones/map_data.txt"
-	tzPath = "prefix_to_timezone_bin.go"
+	tzPkg  = "timezonedata"
+	tzPath = "timezonedata/prefix_to_timezone_bin.go"
 	tzVar  = "timezoneMapData"
 
 	regionPath = "countrycode_to_region_bin.go"
 	regionVar  = "regionMapData"
+
+	digitPrefixPath = "digitprefixes_bin.go"
+	digitPrefixVar  = "digitPrefixData"
+
+	regionNamePath = "regionnames_bin.go"
+	regionNameVar  = "regionNameData"
+
+	// the languages we include region display names for by default
+	regionNameLanguages = "ar,bn,de,en,es,fa,fr,hi,id,it,ja,ko,nl,pl,pt,ru,sv,sw,th,tr,uk,ur,vi,zh,zh-Hant"
 )
 
+// languages is the set of languages to include in our carrier and geocoding data, empty meaning all
+var languages map[string]bool
+
 var carrier = prefixBuild{
 	url:     "https://github.com/googlei18n/libphonenumber/trunk/resources/carrier",
 	dir:     "carrier",
-	srcPath: "prefix_to_carriers_bin.go",
+	pkgName: "carrierdata",
+	srcPath: "carrierdata/prefix_to_carriers_bin.go",
 	varName: "carrierMapData",
+
+	seedColumn: "carrier",
 }
 
 var geocoding = prefixBuild{
 	url:     "https://github.com/googlei18n/libphonenumber/trunk/resources/geocoding",
 	dir:     "geocoding",
-	srcPath: "prefix_to_geocodings_bin.go",
+	pkgName: "geocodingdata",
+	srcPath: "geocodingdata/prefix_to_geocodings_bin.go",
 	varName: "geocodingMapData",
-}
-
-func fetchURL(url string) []byte {
-	resp, err := http.Get(url)
-	if err != nil || resp.StatusCode != 200 {
-		log.Fatalf("Error fetching URL '%s': %s", url, err)
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Fatalf("Error reading body: %s", err)
-	}
 
-	return body
+	seedColumn: "description",
 }
 
-func svnExport(dir string, url string) {
-	os.RemoveAll(dir)
-	cmd := exec.Command(
-		"/bin/bash",
-		"-c",
-		fmt.Sprintf("svn export %s --force", url),
-	)
+func buildRegions(metadata *phonenumbers.PhoneMetadataCollection) {
+	log.Println("Building region map")
+	regionMap := phonenumbers.BuildCountryCodeToRegionMap(metadata)
+	writeIntStringArrayMap(regionPath, "phonenumbers", regionVar, regionMap)
+}
 
-	stdout, err := cmd.StdoutPipe()
-	if err != nil {
-		log.Fatalf("error calling svn export: %s", err.Error())
-	}
-	stderr, err := cmd.StderrPipe()
-	if err != nil {
-		log.Fatalf("error calling svn export: %s", err.Error())
-	}
-	if err = cmd.Start(); err != nil {
-		log.Fatalf("error calling svn export: %s", err.Error())
-	}
-	data, err := ioutil.ReadAll(stderr)
+func buildDigitPrefixes(metadata *phonenumbers.PhoneMetadataCollection) {
+	log.Println("Building digit prefix table")
+	data, err := phonenumbers.BuildDigitPrefixData(metadata)
 	if err != nil {
-		log.Fatalf("error reading svn export: %s : %s", err.Error(), data)
-	}
-	outputBuf := bufio.NewReader(stdout)
-
-	for {
-		output, _, err := outputBuf.ReadLine()
-		if err != nil {
-			if err != io.EOF {
-				log.Fatal(err)
-			}
-			break
-		}
-		log.Println(string(output))
-	}
-
-	if err = cmd.Wait(); err != nil {
-		log.Fatal(err)
+		log.Fatalf("Error building digit prefixes: %s", err)
 	}
+	writeFile(digitPrefixPath, generateBinFile("phonenumbers", digitPrefixVar, data))
 }
 
-func writeFile(filePath string, data []byte) {
-	// file should already exist (likely running from wrong directory)
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		log.Fatalf("no such file: %s make sure you are running from the root of the repo directory", filePath)
+func buildRegionNames(metadata *phonenumbers.PhoneMetadataCollection, languages []string) {
+	log.Println("Building region names")
+	regions := make([]string, 0, len(metadata.GetMetadata()))
+	for _, m := range metadata.GetMetadata() {
+		if m.GetId() != "001" {
+			regions = append(regions, m.GetId())
+		}
 	}
 
-	fmt.Printf("Writing new %s\n", filePath)
-	err := ioutil.WriteFile(filePath, data, os.FileMode(0664))
+	data, err := phonenumbers.BuildRegionNameData(regions, languages)
 	if err != nil {
-		log.Fatalf("Error writing '%s': %s", filePath, err)
+		log.Fatalf("Error building region names: %s", err)
 	}
+	writeFile(regionNamePath, generateBinFile("phonenumbers", regionNameVar, data))
 }
 
-func buildRegions(metadata *phonenumbers.PhoneMetadataCollection) {
-	log.Println("Building region map")
-	regionMap := phonenumbers.BuildCountryCodeToRegionMap(metadata)
-	writeIntStringArrayMap(regionPath, regionVar, regionMap)
-}
-
-func buildTimezones() {
+func buildTimezones(url string) {
 	log.Println("Building timezone map")
-	body := fetchURL(tzURL)
+	body := fetchIfChanged(url, timezoneSanity)
+	if body == nil {
+		return
+	}
 
 	// build our map of prefix to timezones
 	prefixMap := make(map[int32][]string)
@@ -167,11 +151,15 @@ func buildTimezones() {
 		prefixMap[int32(prefix)] = zones
 	}
 
+	if seeding {
+		addTimezoneSeeds(prefixMap)
+	}
+
 	// then write our file
-	writeIntStringArrayMap(tzPath, tzVar, prefixMap)
+	writeIntStringArrayMap(tzPath, tzPkg, tzVar, prefixMap)
 }
 
-func writeIntStringArrayMap(path string, varName string, prefixMap map[int32][]string) {
+func writeIntStringArrayMap(path string, pkgName string, varName string, prefixMap map[int32][]string) {
 	// build lists of our keys and values
 	keys := make([]int, 0, len(prefixMap))
 	values := make([]string, 0, 255)
@@ -243,12 +231,16 @@ func writeIntStringArrayMap(path string, varName string, prefixMap map[int32][]s
 	}
 
 	// then write our file
-	writeFile(path, generateBinFile(varName, data.Bytes()))
+	writeFile(path, generateBinFile(pkgName, varName, data.Bytes()))
 }
 
-func buildMetadata() *phonenumbers.PhoneMetadataCollection {
-	log.Println("Fetching PhoneNumberMetadata.xml from Github")
-	body := fetchURL(metadataURL)
+// buildMetadata builds our metadata from the passed in URL, returning nil if it is unchanged
+func buildMetadata(url string) *phonenumbers.PhoneMetadataCollection {
+	log.Println("Fetching PhoneNumberMetadata.xml from " + url)
+	body := fetchIfChanged(url, metadataSanity)
+	if body == nil {
+		return nil
+	}
 
 	log.Println("Building new metadata collection")
 	collection, err := phonenumbers.BuildPhoneMetadataCollection(body, false, false, false)
@@ -262,14 +254,16 @@ func buildMetadata() *phonenumbers.PhoneMetadataCollection {
 		log.Fatalf("Error marshalling metadata: %v", err)
 	}
 
-	log.Println("Writing new metadata_bin.go")
-	writeFile(metadataPath, generateBinFile("metadataData", data))
+	writeFile(metadataPath, generateBinFile("phonenumbers", "metadataData", data))
 	return collection
 }
 
-func buildShortNumberMetadata() *phonenumbers.PhoneMetadataCollection {
-	log.Println("Fetching ShortNumberMetadata.xml from Github")
-	body := fetchURL(shortNumberMetadataURL)
+func buildShortNumberMetadata(url string) *phonenumbers.PhoneMetadataCollection {
+	log.Println("Fetching ShortNumberMetadata.xml from " + url)
+	body := fetchIfChanged(url, shortNumberSanity)
+	if body == nil {
+		return nil
+	}
 
 	log.Println("Building new short number metadata collection")
 	collection, err := phonenumbers.BuildPhoneMetadataCollection(body, false, false, true)
@@ -283,24 +277,46 @@ func buildShortNumberMetadata() *phonenumbers.PhoneMetadataCollection {
 		log.Fatalf("Error marshalling metadata: %v", err)
 	}
 
-	log.Println("Writing new metadata_bin.go")
-	writeFile(shortNumberMetadataPath, generateBinFile("shortNumberMetadataData", data))
+	writeFile(shortNumberMetadataPath, generateBinFile("phonenumbers", "shortNumberMetadataData", data))
 	return collection
 }
 
+func buildTestMetadata(url string) {
+	log.Println("Fetching PhoneNumberMetadataForTesting.xml from " + url)
+	body := fetchIfChanged(url, testMetadataSanity)
+	if body == nil {
+		return
+	}
+
+	log.Println("Building new test metadata collection")
+	collection, err := phonenumbers.BuildPhoneMetadataCollection(body, false, false, false)
+	if err != nil {
+		log.Fatalf("Error converting XML: %s", err)
+	}
+
+	// write it out as a protobuf
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		log.Fatalf("Error marshalling metadata: %v", err)
+	}
+
+	writeFile(testMetadataPath, generateBinFile("testmetadata", "metadataData", data))
+}
+
 // generates the file contents for a data file
-func generateBinFile(variableName string, data []byte) []byte {
+func generateBinFile(packageName string, variableName string, data []byte) []byte {
 	var compressed bytes.Buffer
 	w := gzip.NewWriter(&compressed)
 	w.Write(data)
 	w.Close()
 	encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+	report.addData(variableName, len(data), compressed.Len())
 
 	// create our output
 	output := &bytes.Buffer{}
 
 	// write our header
-	output.WriteString("package phonenumbers\n\nvar ")
+	output.WriteString("package " + packageName + "\n\nvar ")
 	output.WriteString(variableName)
 	output.WriteString(" = ")
 	output.WriteString(strconv.Quote(string(encoded)))
@@ -309,8 +325,16 @@ func generateBinFile(variableName string, data []byte) []byte {
 }
 
 func buildPrefixData(build *prefixBuild) {
-	log.Println("Fetching " + build.url + " from Github")
-	svnExport(build.dir, build.url)
+	log.Println("Fetching " + build.url)
+	svnExportWithRetries(build.dir, build.url)
+
+	// svn exports don't give us anything to make a conditional request with, so compare what we exported
+	// instead
+	entry := &cacheEntry{Hash: hashDir(build.dir)}
+	if !cache.update(build.url, entry) {
+		log.Printf("%s unchanged, skipping\n", build.url)
+		return
+	}
 
 	// get our top level language directories
 	dirs, err := filepath.Glob(build.dir + "/*")
@@ -331,6 +355,11 @@ func buildPrefixData(build *prefixBuild) {
 		// get our language code
 		parts := strings.Split(dir, "/")
 
+		// skip languages we weren't asked for
+		if len(languages) > 0 && !languages[parts[1]] {
+			continue
+		}
+
 		// build a map for that directory
 		mappings := readMappingsForDir(dir)
 
@@ -338,82 +367,42 @@ func buildPrefixData(build *prefixBuild) {
 		languageMappings[parts[1]] = mappings
 	}
 
-	output := bytes.Buffer{}
-	output.WriteString("package phonenumbers\n\n")
-	output.WriteString(fmt.Sprintf("var %s = map[string]string {\n", build.varName))
-
-	for lang, mappings := range languageMappings {
-		// iterate through our map, creating our full set of values and prefixes
-		prefixes := make([]int, 0, len(mappings))
-		seenValues := make(map[string]bool)
-		values := make([]string, 0, 255)
-		for prefix, value := range mappings {
-			prefixes = append(prefixes, int(prefix))
-			_, seen := seenValues[value]
-			if !seen {
-				values = append(values, value)
-				seenValues[value] = true
-			}
-		}
-
-		// make sure we won't overrun uint16s
-		if len(values) > math.MaxUint16 {
-			log.Fatal("too many values to represent in uint16")
-		}
-
-		// need sorted prefixes for our diff writing to work
-		sort.Ints(prefixes)
-
-		// sorted values compress better
-		sort.Strings(values)
-
-		// build our reverse mapping from value to offset
-		internMappings := make(map[string]uint16)
-		for i, value := range values {
-			internMappings[value] = uint16(i)
+	// an empty export means something went wrong upstream, don't overwrite our existing data with nothing
+	if len(languageMappings) == 0 {
+		log.Fatalf("No languages found in %s export", build.url)
+	}
+	for lang := range languages {
+		if _, found := languageMappings[lang]; !found {
+			log.Printf("No %s data for language: %s\n", build.dir, lang)
 		}
+	}
 
-		// write our map
-		data := &bytes.Buffer{}
+	if seeding {
+		addPrefixSeeds(build.dir, build.seedColumn, languageMappings)
+	}
 
-		// first write our values, as length of string and raw bytes
-		joinedValues := strings.Join(values, "\n")
-		if err = binary.Write(data, binary.LittleEndian, uint32(len(joinedValues))); err != nil {
-			log.Fatal(err)
-		}
-		if err = binary.Write(data, binary.LittleEndian, []byte(joinedValues)); err != nil {
-			log.Fatal(err)
-		}
+	output := bytes.Buffer{}
+	output.WriteString(fmt.Sprintf("package %s\n\n", build.pkgName))
+	output.WriteString(fmt.Sprintf("var %s = map[string]string{\n", build.varName))
 
-		// then then number of prefix / value pairs
-		if err = binary.Write(data, binary.LittleEndian, uint32(len(prefixes))); err != nil {
-			log.Fatal(err)
-		}
+	// sort our languages so our output is stable
+	langs := make([]string, 0, len(languageMappings))
+	for lang := range languageMappings {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
 
-		// we write our prefix / value pairs as a varint of the difference of the previous prefix
-		// and a uint16 of the value index
-		last := 0
-		intBuf := make([]byte, 6)
-		for _, prefix := range prefixes {
-			value := mappings[int32(prefix)]
-			valueIntern := internMappings[value]
-			diff := prefix - last
-			l := binary.PutUvarint(intBuf, uint64(diff))
-			if err = binary.Write(data, binary.LittleEndian, intBuf[:l]); err != nil {
-				log.Fatal(err)
-			}
-			if err = binary.Write(data, binary.LittleEndian, uint16(valueIntern)); err != nil {
-				log.Fatal(err)
-			}
+	for _, lang := range langs {
+		mappings := languageMappings[lang]
 
-			last = prefix
-		}
+		data := encodePrefixMap(mappings)
 
 		var compressed bytes.Buffer
 		w := gzip.NewWriter(&compressed)
 		w.Write(data.Bytes())
 		w.Close()
 		c := base64.StdEncoding.EncodeToString(compressed.Bytes())
+		report.addData(lang, data.Len(), compressed.Len())
 		output.WriteString("\t")
 		output.WriteString(strconv.Quote(lang))
 		output.WriteString(": ")
@@ -421,8 +410,95 @@ func buildPrefixData(build *prefixBuild) {
 		output.WriteString(",\n")
 	}
 
-	output.WriteString("}")
-	writeFile(build.srcPath, output.Bytes())
+	output.WriteString("}\n")
+
+	// let gofmt align our map values
+	source, err := format.Source(output.Bytes())
+	if err != nil {
+		log.Fatalf("Error formatting %s: %s", build.srcPath, err)
+	}
+	writeFile(build.srcPath, source)
+}
+
+// languageKey returns our set of languages as a stable string, empty meaning all
+func languageKey() string {
+	langs := make([]string, 0, len(languages))
+	for lang := range languages {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return strings.Join(langs, ",")
+}
+
+// encodePrefixMap encodes a map of prefixes to values in the format read by phonenumbers.loadPrefixMap
+func encodePrefixMap(mappings map[int32]string) *bytes.Buffer {
+	// iterate through our map, creating our full set of values and prefixes
+	prefixes := make([]int, 0, len(mappings))
+	seenValues := make(map[string]bool)
+	values := make([]string, 0, 255)
+	for prefix, value := range mappings {
+		prefixes = append(prefixes, int(prefix))
+		_, seen := seenValues[value]
+		if !seen {
+			values = append(values, value)
+			seenValues[value] = true
+		}
+	}
+
+	// make sure we won't overrun uint16s
+	if len(values) > math.MaxUint16 {
+		log.Fatal("too many values to represent in uint16")
+	}
+
+	// need sorted prefixes for our diff writing to work
+	sort.Ints(prefixes)
+
+	// sorted values compress better
+	sort.Strings(values)
+
+	// build our reverse mapping from value to offset
+	internMappings := make(map[string]uint16)
+	for i, value := range values {
+		internMappings[value] = uint16(i)
+	}
+
+	// write our map
+	data := &bytes.Buffer{}
+
+	// first write our values, as length of string and raw bytes
+	joinedValues := strings.Join(values, "\n")
+	if err := binary.Write(data, binary.LittleEndian, uint32(len(joinedValues))); err != nil {
+		log.Fatal(err)
+	}
+	if err := binary.Write(data, binary.LittleEndian, []byte(joinedValues)); err != nil {
+		log.Fatal(err)
+	}
+
+	// then then number of prefix / value pairs
+	if err := binary.Write(data, binary.LittleEndian, uint32(len(prefixes))); err != nil {
+		log.Fatal(err)
+	}
+
+	// we write our prefix / value pairs as a varint of the difference of the previous prefix
+	// and a uint16 of the value index
+	last := 0
+	intBuf := make([]byte, 6)
+	for _, prefix := range prefixes {
+		value := mappings[int32(prefix)]
+		valueIntern := internMappings[value]
+		diff := prefix - last
+		l := binary.PutUvarint(intBuf, uint64(diff))
+		if err := binary.Write(data, binary.LittleEndian, intBuf[:l]); err != nil {
+			log.Fatal(err)
+		}
+		if err := binary.Write(data, binary.LittleEndian, uint16(valueIntern)); err != nil {
+			log.Fatal(err)
+		}
+
+		last = prefix
+	}
+
+	return data
 }
 
 func readMappingsForDir(dir string) map[int32]string {
@@ -478,11 +554,110 @@ func readMappingsForDir(dir string) map[int32]string {
 	return mappings
 }
 
+// envOrDefault returns the value of the passed in environment variable, or def if it isn't set
+func envOrDefault(key string, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return def
+}
+
 func main() {
-	metadata := buildMetadata()
-	buildShortNumberMetadata()
-	buildRegions(metadata)
-	buildTimezones()
+	metadataURL := flag.String("metadata-url", envOrDefault("PHONENUMBERS_METADATA_URL", metadataURL), "URL of PhoneNumberMetadata.xml")
+	shortNumberMetadataURL := flag.String("shortnumber-url", envOrDefault("PHONENUMBERS_SHORTNUMBER_URL", shortNumberMetadataURL), "URL of ShortNumberMetadata.xml")
+	testMetadataURL := flag.String("testmetadata-url", envOrDefault("PHONENUMBERS_TESTMETADATA_URL", testMetadataURL), "URL of PhoneNumberMetadataForTesting.xml")
+	tzURL := flag.String("timezones-url", envOrDefault("PHONENUMBERS_TIMEZONES_URL", tzURL), "URL of the timezone map_data.txt")
+	flag.StringVar(&carrier.url, "carrier-url", envOrDefault("PHONENUMBERS_CARRIER_URL", carrier.url), "svn URL of the carrier resources directory")
+	mccMncURL := flag.String("mccmnc-url", os.Getenv("PHONENUMBERS_MCCMNC_URL"), "URL of prefix to MCC/MNC mappings, only built if set")
+	mccMncDir := flag.String("mccmnc-dir", "mccmncdata", "directory of the package to generate for MCC/MNC mappings")
+	flag.StringVar(&geocoding.url, "geocoding-url", envOrDefault("PHONENUMBERS_GEOCODING_URL", geocoding.url), "svn URL of the geocoding resources directory")
+	flag.DurationVar(&fetchTimeout, "timeout", fetchTimeout, "timeout for each attempt at downloading a file")
+	flag.DurationVar(&svnTimeout, "svn-timeout", svnTimeout, "timeout for each attempt at an svn export")
+	flag.IntVar(&fetchRetries, "retries", fetchRetries, "number of times to retry failed downloads")
+	flag.DurationVar(&fetchBackoff, "backoff", fetchBackoff, "delay before the first retry, doubling for each subsequent retry")
+	languageList := flag.String("languages", "", "comma separated list of languages to include in carrier and geocoding data, defaults to all")
+	regionNameLanguageList := flag.String("region-name-languages", regionNameLanguages, "comma separated list of languages to include region display names for")
+	flag.BoolVar(&dryRun, "dry-run", false, "build everything in memory and report the size of each artifact without writing any files")
+	flag.BoolVar(&checkGenerated, "check", checkGenerated, "build and vet with the generated files before writing them")
+	changelogPath := flag.String("changelog", "metadata_changelog.json", "path to write the JSON changelog of metadata changes to, empty to skip")
+	bundlePath := flag.String("bundle", "", "path to write the compiled metadata to as a JSON bundle, empty to skip")
+	cachePath := flag.String("cache", defaultCachePath(), "path of the cache used to skip unchanged upstream resources, empty to disable")
+	force := flag.Bool("force", false, "regenerate everything, even if upstream resources are unchanged")
+	seedDir := flag.String("seed", "", "directory to write seed files of the geocoding, carrier and timezone data to, empty to skip")
+	seedFormat := flag.String("seed-format", "sql", "format of seed files, sql or csv")
+	flag.Parse()
+
+	if *seedFormat != "sql" && *seedFormat != "csv" {
+		log.Fatalf("Unknown seed format: %s", *seedFormat)
+	}
+
+	seeding = *seedDir != ""
+
+	if *languageList != "" {
+		languages = make(map[string]bool)
+		for _, lang := range strings.Split(*languageList, ",") {
+			if lang = strings.TrimSpace(lang); lang != "" {
+				languages[lang] = true
+			}
+		}
+
+		// english is what we fall back to when there is no data for the requested language
+		if !languages["en"] {
+			log.Println("Warning: 'en' is not included in -languages, lookups will have no fallback language")
+		}
+	}
+
+	// seed files need all of our prefix data so we can't skip any of it, even if it's unchanged, and a
+	// dry run needs to build everything to report on it
+	cache.load(*cachePath, *force || seeding || dryRun, cacheScope(".",
+		"languages="+languageKey(),
+		"region-name-languages="+*regionNameLanguageList,
+		"mccmnc-dir="+*mccMncDir,
+	))
+
+	// grab the metadata we are currently built with so we can report what changed
+	previous, err := phonenumbers.MetadataCollection()
+	if err != nil {
+		log.Fatalf("Error loading current metadata: %s", err)
+	}
+
+	metadata := buildMetadata(*metadataURL)
+	if metadata != nil {
+		if *changelogPath != "" {
+			writeChangelog(*changelogPath, buildChangelog(previous, metadata))
+		}
+		buildRegions(metadata)
+		buildDigitPrefixes(metadata)
+		buildRegionNames(metadata, strings.Split(*regionNameLanguageList, ","))
+	}
+
+	// the bundle always matches what the library will be built with, even if upstream hasn't changed
+	if *bundlePath != "" {
+		if metadata != nil {
+			writeBundle(*bundlePath, metadata)
+		} else {
+			writeBundle(*bundlePath, previous)
+		}
+	}
+
+	buildShortNumberMetadata(*shortNumberMetadataURL)
+	buildTestMetadata(*testMetadataURL)
+	buildTimezones(*tzURL)
 	buildPrefixData(&carrier)
 	buildPrefixData(&geocoding)
+
+	if *mccMncURL != "" {
+		buildMccMnc(*mccMncURL, *mccMncDir)
+	}
+
+	if seeding {
+		writeSeeds(*seedDir, *seedFormat)
+	}
+
+	if dryRun {
+		report.print(os.Stdout)
+	} else {
+		commitFiles()
+		cache.save()
+	}
 }
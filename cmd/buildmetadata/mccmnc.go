@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	mccMncBinFile      = "mccmnc_bin.go"
+	mccMncRegisterFile = "mccmnc.go"
+	mccMncVar          = "mccMncMapData"
+)
+
+var (
+	mccPattern = regexp.MustCompile(`^\d{3}$`)
+	mncPattern = regexp.MustCompile(`^\d{2,3}$`)
+)
+
+// buildMccMnc builds a package in dir which registers the prefix to MCC/MNC mapping read from url. There is
+// no public upstream source for this data, so this is only done when a source is configured. The source should
+// contain one mapping per line in the form prefix|mcc|mnc, e.g. 447700|234|10, with # starting a comment.
+func buildMccMnc(url string, dir string) {
+	log.Println("Fetching MCC/MNC mappings from " + url)
+	body := fetchIfChanged(url, mccMncSanity)
+	if body == nil {
+		return
+	}
+
+	mappings := make(map[int32]string)
+	for i, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "|")
+		if len(fields) != 3 {
+			log.Fatalf("Invalid format in MCC/MNC mappings on line %d: %s", i+1, line)
+		}
+
+		prefix, err := strconv.ParseInt(strings.TrimSpace(fields[0]), 10, 32)
+		if err != nil || prefix <= 0 {
+			log.Fatalf("Invalid prefix in MCC/MNC mappings on line %d: %s", i+1, line)
+		}
+
+		// MNCs are kept as strings as 01 and 001 are different networks
+		mcc, mnc := strings.TrimSpace(fields[1]), strings.TrimSpace(fields[2])
+		if !mccPattern.MatchString(mcc) || !mncPattern.MatchString(mnc) {
+			log.Fatalf("Invalid MCC or MNC in MCC/MNC mappings on line %d: %s", i+1, line)
+		}
+
+		if _, repeat := mappings[int32(prefix)]; repeat {
+			log.Fatalf("Repeated prefix in MCC/MNC mappings on line %d: %s", i+1, line)
+		}
+		mappings[int32(prefix)] = mcc + "|" + mnc
+	}
+	log.Printf("Read %d MCC/MNC mappings\n", len(mappings))
+
+	if len(mappings) == 0 {
+		log.Fatalf("No mappings found in %s", url)
+	}
+
+	// the output package belongs to the user so may not exist yet
+	pkgName := filepath.Base(dir)
+	binPath := filepath.Join(dir, mccMncBinFile)
+	registerPath := filepath.Join(dir, mccMncRegisterFile)
+	if !dryRun {
+		if err := os.MkdirAll(dir, os.FileMode(0775)); err != nil {
+			log.Fatalf("Error creating directory '%s': %s", dir, err)
+		}
+	}
+
+	stageFile(binPath, generateBinFile(pkgName, mccMncVar, encodePrefixMap(mappings).Bytes()))
+	stageFile(registerPath, []byte(fmt.Sprintf(`// Package %s registers a prefix to MCC/MNC mapping for use by phonenumbers.GetMccMncForNumber.
+// It is generated by the buildmetadata command, import it for its side effects.
+package %s
+
+import "github.com/nyaruka/phonenumbers"
+
+func init() {
+	phonenumbers.RegisterMccMncData(%s)
+}
+`, pkgName, pkgName, mccMncVar)))
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+)
+
+// maxGeneratedSize is the largest generated file we are willing to write
+const maxGeneratedSize = 50 * 1024 * 1024
+
+var (
+	// checkGenerated is whether we build and vet with our generated files before writing them
+	checkGenerated = true
+
+	// pendingFiles are the generated files waiting to be checked and written
+	pendingFiles []pendingFile
+)
+
+type pendingFile struct {
+	path string
+	data []byte
+}
+
+// writeFile stages the new contents of one of our existing generated files
+func writeFile(filePath string, data []byte) {
+	// file should already exist (likely running from wrong directory)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		log.Fatalf("no such file: %s make sure you are running from the root of the repo directory", filePath)
+	}
+
+	stageFile(filePath, data)
+}
+
+// stageFile stages the contents of a generated file, nothing is written until commitFiles is called
+func stageFile(filePath string, data []byte) {
+	report.addFile(filePath, len(data))
+
+	if len(data) > maxGeneratedSize {
+		log.Fatalf("Generated %s is %d bytes, larger than max of %d", filePath, len(data), maxGeneratedSize)
+	}
+	if dryRun {
+		return
+	}
+
+	pendingFiles = append(pendingFiles, pendingFile{path: filePath, data: data})
+}
+
+// commitFiles writes all our staged files to temporary files alongside the files they replace, checks
+// that every module they belong to still builds and vets with them in place, and only then moves them
+// over the originals. If anything fails the originals are left untouched.
+func commitFiles() {
+	if len(pendingFiles) == 0 {
+		log.Println("Nothing changed upstream, no files to write")
+		return
+	}
+
+	temps := make(map[string]string, len(pendingFiles))
+	cleanup := func() {
+		for _, temp := range temps {
+			os.Remove(temp)
+		}
+	}
+
+	modules := make(map[string]bool)
+	for _, file := range pendingFiles {
+		path, err := filepath.Abs(file.path)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), os.FileMode(0775)); err != nil {
+			cleanup()
+			log.Fatalf("Error creating '%s': %s", filepath.Dir(path), err)
+		}
+
+		// temp files start with a . so they are ignored by the go tool
+		temp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+		if err != nil {
+			cleanup()
+			log.Fatalf("Error creating temp file for '%s': %s", file.path, err)
+		}
+		temps[path] = temp.Name()
+
+		_, err = temp.Write(file.data)
+		if closeErr := temp.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Chmod(temp.Name(), os.FileMode(0664))
+		}
+		if err != nil {
+			cleanup()
+			log.Fatalf("Error writing temp file for '%s': %s", file.path, err)
+		}
+
+		// only Go files can break the build, our other outputs are data
+		if filepath.Ext(path) == ".go" {
+			modules[findModuleDir(filepath.Dir(path))] = true
+		}
+	}
+
+	if checkGenerated {
+		if err := checkModules(modules, temps); err != nil {
+			cleanup()
+			log.Fatalf("Generated files failed checks, nothing written: %s", err)
+		}
+	}
+
+	// sort our paths so we always write in the same order
+	paths := make([]string, 0, len(temps))
+	for path := range temps {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		fmt.Printf("Writing new %s\n", path)
+		if err := os.Rename(temps[path], path); err != nil {
+			cleanup()
+			log.Fatalf("Error writing '%s': %s", path, err)
+		}
+		delete(temps, path)
+	}
+	pendingFiles = nil
+}
+
+// checkModules builds and vets each of the passed in modules with the overlay of our new files
+func checkModules(modules map[string]bool, overlay map[string]string) error {
+	overlayJSON, err := json.Marshal(map[string]interface{}{"Replace": overlay})
+	if err != nil {
+		return err
+	}
+	overlayFile, err := os.CreateTemp("", "buildmetadata-overlay-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(overlayFile.Name())
+
+	_, err = overlayFile.Write(overlayJSON)
+	if closeErr := overlayFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	dirs := make([]string, 0, len(modules))
+	for dir := range modules {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	overlayFlag := "-overlay=" + overlayFile.Name()
+	for _, dir := range dirs {
+		log.Printf("Checking module in %s\n", dir)
+
+		// copylocks is disabled as the generated protobuf structs trip it up throughout the package
+		for _, args := range [][]string{
+			{"build", overlayFlag, "./..."},
+			{"vet", overlayFlag, "-copylocks=false", "./..."},
+		} {
+			cmd := exec.Command("go", args...)
+			cmd.Dir = dir
+			output := &bytes.Buffer{}
+			cmd.Stdout = output
+			cmd.Stderr = output
+
+			if err := cmd.Run(); err != nil {
+				return fmt.Errorf("go %s failed in %s: %s\n%s", args[0], dir, err, output.String())
+			}
+		}
+	}
+	return nil
+}
+
+// findModuleDir returns the closest directory at or above dir that contains a go.mod
+func findModuleDir(dir string) string {
+	for current := dir; ; {
+		if _, err := os.Stat(filepath.Join(current, "go.mod")); err == nil {
+			return current
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
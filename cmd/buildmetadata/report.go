@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// dryRun is whether we should only report on what we would generate instead of writing any files
+var dryRun bool
+
+// dataSize is the size of a single piece of data within a generated file, e.g. one language of geocoding data
+type dataSize struct {
+	name       string
+	raw        int
+	compressed int
+}
+
+// fileSize is the size of a generated file and the data within it
+type fileSize struct {
+	path string
+	size int
+	data []dataSize
+}
+
+// sizeReport collects the sizes of everything we generate
+type sizeReport struct {
+	files   []fileSize
+	pending []dataSize
+}
+
+var report = &sizeReport{}
+
+// addData records the size of a piece of data, which will be attributed to the next file added
+func (r *sizeReport) addData(name string, raw int, compressed int) {
+	r.pending = append(r.pending, dataSize{name: name, raw: raw, compressed: compressed})
+}
+
+// addFile records the size of a generated file, along with all the data added since the last file
+func (r *sizeReport) addFile(path string, size int) {
+	r.files = append(r.files, fileSize{path: path, size: size, data: r.pending})
+	r.pending = nil
+}
+
+func (r *sizeReport) print(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Artifact\tUncompressed\tCompressed\tFile\t")
+
+	totalRaw, totalCompressed, totalSize := 0, 0, 0
+	for _, file := range r.files {
+		raw, compressed := 0, 0
+		for _, d := range file.data {
+			raw += d.raw
+			compressed += d.compressed
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", file.path, formatBytes(raw), formatBytes(compressed), formatBytes(file.size))
+
+		// only break down files which contain more than one piece of data
+		if len(file.data) > 1 {
+			for _, d := range file.data {
+				fmt.Fprintf(w, "  %s\t%s\t%s\t\t\n", d.name, formatBytes(d.raw), formatBytes(d.compressed))
+			}
+		}
+
+		totalRaw += raw
+		totalCompressed += compressed
+		totalSize += file.size
+	}
+
+	fmt.Fprintf(w, "Total\t%s\t%s\t%s\t\n", formatBytes(totalRaw), formatBytes(totalCompressed), formatBytes(totalSize))
+	w.Flush()
+}
+
+func formatBytes(size int) string {
+	switch {
+	case size >= 1024*1024:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+	case size >= 1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
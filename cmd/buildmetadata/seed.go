@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// seedBatchSize is how many rows we insert with each statement in SQL seed files
+const seedBatchSize = 500
+
+// seedTable is one of the tables of prefix data we write seed files for, prefixes being E164 numbers
+// without the + or the start of them
+type seedTable struct {
+	name    string
+	columns []string
+	rows    [][]string
+}
+
+// seeding is whether we collect the prefix data we build for seed files
+var seeding bool
+
+// seedTables are the tables we've collected data for, keyed by the name of the upstream data
+var seedTables = map[string]*seedTable{}
+
+// addPrefixSeeds collects the carrier or geocoding mappings for each language for our seed files
+func addPrefixSeeds(dir string, column string, languageMappings map[string]map[int32]string) {
+	table := &seedTable{name: "phone_" + dir, columns: []string{"language", "prefix", column}}
+
+	langs := make([]string, 0, len(languageMappings))
+	for lang := range languageMappings {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	for _, lang := range langs {
+		mappings := languageMappings[lang]
+		for _, prefix := range sortedPrefixes(mappings) {
+			table.rows = append(table.rows, []string{lang, strconv.Itoa(int(prefix)), mappings[prefix]})
+		}
+	}
+	seedTables[dir] = table
+}
+
+// addTimezoneSeeds collects the timezones of each prefix for our seed files, a row for each timezone
+func addTimezoneSeeds(prefixMap map[int32][]string) {
+	table := &seedTable{name: "phone_timezones", columns: []string{"prefix", "timezone"}}
+
+	prefixes := make([]int, 0, len(prefixMap))
+	for prefix := range prefixMap {
+		prefixes = append(prefixes, int(prefix))
+	}
+	sort.Ints(prefixes)
+
+	for _, prefix := range prefixes {
+		for _, zone := range prefixMap[int32(prefix)] {
+			table.rows = append(table.rows, []string{strconv.Itoa(prefix), zone})
+		}
+	}
+	seedTables["timezones"] = table
+}
+
+func sortedPrefixes(mappings map[int32]string) []int32 {
+	prefixes := make([]int32, 0, len(mappings))
+	for prefix := range mappings {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return prefixes[i] < prefixes[j] })
+	return prefixes
+}
+
+// writeSeeds stages a seed file in the passed in format, sql or csv, for each of our tables to dir
+func writeSeeds(dir string, format string) {
+	for _, key := range []string{"geocoding", "carrier", "timezones"} {
+		table := seedTables[key]
+		if table == nil {
+			log.Fatalf("No %s data to write seed file for", key)
+		}
+
+		var data []byte
+		if format == "csv" {
+			data = table.csv()
+		} else {
+			data = table.sql()
+		}
+
+		stageFile(filepath.Join(dir, table.name+"."+format), data)
+	}
+}
+
+// csv returns the table as CSV with a header row
+func (t *seedTable) csv() []byte {
+	output := &bytes.Buffer{}
+	w := csv.NewWriter(output)
+	w.Write(t.columns)
+	w.WriteAll(t.rows)
+	if err := w.Error(); err != nil {
+		log.Fatalf("Error writing %s seed: %s", t.name, err)
+	}
+	return output.Bytes()
+}
+
+// sql returns the table as SQL which creates it if needed and replaces its contents in a single
+// transaction, written to be portable across the usual warehouses and databases
+func (t *seedTable) sql() []byte {
+	output := &bytes.Buffer{}
+
+	keys := t.columns[:len(t.columns)-1]
+	fmt.Fprintf(output, "CREATE TABLE IF NOT EXISTS %s (\n", t.name)
+	for _, column := range keys {
+		fmt.Fprintf(output, "  %s VARCHAR(16) NOT NULL,\n", column)
+	}
+	fmt.Fprintf(output, "  %s TEXT NOT NULL\n);\n\n", t.columns[len(t.columns)-1])
+
+	output.WriteString("BEGIN;\n")
+	fmt.Fprintf(output, "DELETE FROM %s;\n", t.name)
+
+	for start := 0; start < len(t.rows); start += seedBatchSize {
+		end := start + seedBatchSize
+		if end > len(t.rows) {
+			end = len(t.rows)
+		}
+
+		fmt.Fprintf(output, "INSERT INTO %s (%s) VALUES\n", t.name, strings.Join(t.columns, ", "))
+		for i, row := range t.rows[start:end] {
+			values := make([]string, len(row))
+			for j, value := range row {
+				values[j] = "'" + strings.ReplaceAll(value, "'", "''") + "'"
+			}
+			output.WriteString("  (" + strings.Join(values, ", ") + ")")
+			if i < end-start-1 {
+				output.WriteString(",\n")
+			} else {
+				output.WriteString(";\n")
+			}
+		}
+	}
+
+	output.WriteString("COMMIT;\n")
+	return output.Bytes()
+}
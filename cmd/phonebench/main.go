@@ -0,0 +1,154 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	_ "github.com/nyaruka/phonenumbers/geocodingdata"
+)
+
+const libraryPath = "github.com/nyaruka/phonenumbers"
+
+// geocodeLanguage is the language the geocoding workload asks for
+var geocodeLanguage = "en"
+
+// result is what we measured for one run of a workload
+type result struct {
+	name        string
+	ops         int
+	nsPerOp     float64
+	bytesPerOp  uint64
+	allocsPerOp uint64
+	peakRSS     int64
+}
+
+// measure runs the passed in operation repeatedly for at least duration, after warming up with a pass over all our
+// inputs so that lazily loaded metadata and data is measured by peak RSS but not by ns/op
+func measure(name string, op func(i int), duration time.Duration) result {
+	for i := range benchNumbers {
+		op(i)
+	}
+	runtime.GC()
+
+	before := &runtime.MemStats{}
+	runtime.ReadMemStats(before)
+
+	ops := 0
+	start := time.Now()
+	for time.Since(start) < duration {
+		for end := ops + len(benchNumbers); ops < end; ops++ {
+			op(ops)
+		}
+	}
+	elapsed := time.Since(start)
+
+	after := &runtime.MemStats{}
+	runtime.ReadMemStats(after)
+
+	return result{
+		name:        name,
+		ops:         ops,
+		nsPerOp:     float64(elapsed.Nanoseconds()) / float64(ops),
+		bytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / uint64(ops),
+		allocsPerOp: (after.Mallocs - before.Mallocs) / uint64(ops),
+		peakRSS:     peakRSS(),
+	}
+}
+
+// write writes our result as a line in the format of go test -bench, so that runs can be compared with benchstat
+func (r result) write(out io.Writer) {
+	fmt.Fprintf(out, "Benchmark%s-%d\t%8d\t%10.1f ns/op\t%8d B/op\t%6d allocs/op", r.name, runtime.GOMAXPROCS(0), r.ops, r.nsPerOp, r.bytesPerOp, r.allocsPerOp)
+	if r.peakRSS > 0 {
+		fmt.Fprintf(out, "\t%10d peak-RSS-bytes", r.peakRSS)
+	}
+	fmt.Fprintln(out)
+}
+
+// writeHeader writes the configuration of this run, including the version of the library we were built against
+func writeHeader(out io.Writer) {
+	fmt.Fprintf(out, "goos: %s\n", runtime.GOOS)
+	fmt.Fprintf(out, "goarch: %s\n", runtime.GOARCH)
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		fmt.Fprintf(out, "go: %s\n", info.GoVersion)
+		for _, dep := range info.Deps {
+			if dep.Path != libraryPath {
+				continue
+			}
+			fmt.Fprintf(out, "phonenumbers: %s\n", dep.Version)
+			if dep.Replace != nil {
+				fmt.Fprintf(out, "replaced-by: %s\n", dep.Replace.Path)
+			}
+		}
+	}
+}
+
+// selectWorkloads returns the workloads with the passed in comma separated names, or all of them for "all"
+func selectWorkloads(names string) ([]workload, error) {
+	if names == "all" {
+		return workloads, nil
+	}
+
+	selected := make([]workload, 0, len(workloads))
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, w := range workloads {
+			if strings.EqualFold(w.name, name) {
+				selected = append(selected, w)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown workload '%s'", name)
+		}
+	}
+	return selected, nil
+}
+
+func main() {
+	workloadList := flag.String("workloads", "all", "comma separated workloads to run, any of parse, format and geocode, or all")
+	benchTime := flag.Duration("benchtime", time.Second, "how long to run each workload for")
+	count := flag.Int("count", 1, "how many times to run each workload, use 5 or more when comparing with benchstat")
+	flag.StringVar(&geocodeLanguage, "lang", geocodeLanguage, "language to ask for in the geocode workload")
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintln(out, "usage: phonebench [flags]")
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "Runs standardized workloads against the version of phonenumbers this binary was built with, writing")
+		fmt.Fprintln(out, "the results in the format of go test -bench so that versions can be compared with benchstat.")
+		fmt.Fprintln(out, "")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() > 0 || *count < 1 || *benchTime <= 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	selected, err := selectWorkloads(*workloadList)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid workloads: %s\n", err)
+		os.Exit(1)
+	}
+
+	writeHeader(os.Stdout)
+	for _, w := range selected {
+		op, err := w.prepare()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error preparing %s workload: %s\n", w.name, err)
+			os.Exit(1)
+		}
+		for i := 0; i < *count; i++ {
+			measure(w.name, op, *benchTime).write(os.Stdout)
+		}
+	}
+}
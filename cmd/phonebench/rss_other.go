@@ -0,0 +1,8 @@
+//go:build !unix
+
+package main
+
+// peakRSS returns zero as we don't know how to find the peak resident memory on this platform
+func peakRSS() int64 {
+	return 0
+}
@@ -0,0 +1,22 @@
+//go:build unix
+
+package main
+
+import (
+	"runtime"
+	"syscall"
+)
+
+// peakRSS returns the most memory this process has had resident at once, in bytes
+func peakRSS() int64 {
+	usage := &syscall.Rusage{}
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, usage); err != nil {
+		return 0
+	}
+
+	// darwin reports bytes, everything else kilobytes
+	if runtime.GOOS == "darwin" || runtime.GOOS == "ios" {
+		return int64(usage.Maxrss)
+	}
+	return int64(usage.Maxrss) * 1024
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// benchNumbers are the inputs of every workload, a fixed mix of regions and of the ways people write numbers, so
+// that results are comparable across versions of the library and its metadata
+var benchNumbers = []struct {
+	number string
+	region string
+}{
+	{"6502530000", "US"},
+	{"(650) 253-0000", "US"},
+	{"+1 650-253-0000 ext. 123", "US"},
+	{"1-800-FLOWERS", "US"},
+	{"011 44 20 7031 3000", "US"},
+	{"020 7031 3000", "GB"},
+	{"07912 345678", "GB"},
+	{"+44 (0) 1632 960 001", ""},
+	{"030 1234567", "DE"},
+	{"+49 151 12345678", ""},
+	{"01 42 68 53 00", "FR"},
+	{"+33 6 12 34 56 78", ""},
+	{"(11) 98765-4321", "BR"},
+	{"+55 21 2345-6789", ""},
+	{"98765 43210", "IN"},
+	{"+91 11 2345 6789", ""},
+	{"03-1234-5678", "JP"},
+	{"+81 90-1234-5678", ""},
+	{"0788 383 383", "RW"},
+	{"+250 788 383 383", ""},
+	{"0803 123 4567", "NG"},
+	{"+234 1 234 5678", ""},
+	{"138 0013 8000", "CN"},
+	{"+86 10 1234 5678", ""},
+	{"0412 345 678", "AU"},
+	{"+61 2 9876 5432", ""},
+	{"55 1234 5678", "MX"},
+	{"+52 1 55 1234 5678", ""},
+	{"+800 1234 5678", ""},
+	{"tel:+1-650-253-0000;ext=99", ""},
+}
+
+// workload is one of the standardized loads we measure, each operation of which works on one of benchNumbers
+type workload struct {
+	name string
+
+	// prepare is called once before measuring, returning the function which performs operation i
+	prepare func() (func(i int), error)
+}
+
+var workloads = []workload{
+	{"Parse", prepareParse},
+	{"Format", prepareFormat},
+	{"Geocode", prepareGeocode},
+}
+
+func prepareParse() (func(i int), error) {
+	return func(i int) {
+		input := benchNumbers[i%len(benchNumbers)]
+		phonenumbers.Parse(input.number, input.region)
+	}, nil
+}
+
+func prepareFormat() (func(i int), error) {
+	numbers, err := parseBenchNumbers()
+	if err != nil {
+		return nil, err
+	}
+	return func(i int) {
+		num := numbers[i%len(numbers)]
+		phonenumbers.Format(num, phonenumbers.E164)
+		phonenumbers.Format(num, phonenumbers.INTERNATIONAL)
+		phonenumbers.Format(num, phonenumbers.NATIONAL)
+		phonenumbers.FormatOutOfCountryCallingNumber(num, "GB")
+	}, nil
+}
+
+func prepareGeocode() (func(i int), error) {
+	numbers, err := parseBenchNumbers()
+	if err != nil {
+		return nil, err
+	}
+	return func(i int) {
+		phonenumbers.GetGeocodingForNumber(numbers[i%len(numbers)], geocodeLanguage)
+	}, nil
+}
+
+// parseBenchNumbers parses all our inputs, for workloads which start from parsed numbers
+func parseBenchNumbers() ([]*phonenumbers.PhoneNumber, error) {
+	numbers := make([]*phonenumbers.PhoneNumber, len(benchNumbers))
+	for i, input := range benchNumbers {
+		num, err := phonenumbers.Parse(input.number, input.region)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", input.number, err)
+		}
+		numbers[i] = num
+	}
+	return numbers, nil
+}
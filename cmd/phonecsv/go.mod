@@ -0,0 +1,12 @@
+module github.com/nyaruka/phonenumbers/cmd/phonecsv
+
+go 1.19
+
+replace github.com/nyaruka/phonenumbers => ../../
+
+require (
+	github.com/nyaruka/phonenumbers v0.0.0-00010101000000-000000000000
+	golang.org/x/text v0.12.0
+)
+
+require google.golang.org/protobuf v1.31.0 // indirect
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+var numberTypes = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.FIXED_LINE:           "FIXED_LINE",
+	phonenumbers.MOBILE:               "MOBILE",
+	phonenumbers.FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	phonenumbers.TOLL_FREE:            "TOLL_FREE",
+	phonenumbers.PREMIUM_RATE:         "PREMIUM_RATE",
+	phonenumbers.SHARED_COST:          "SHARED_COST",
+	phonenumbers.VOIP:                 "VOIP",
+	phonenumbers.PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	phonenumbers.PAGER:                "PAGER",
+	phonenumbers.UAN:                  "UAN",
+	phonenumbers.VOICEMAIL:            "VOICEMAIL",
+	phonenumbers.UNKNOWN:              "UNKNOWN",
+}
+
+// addedColumns are the columns we append to every row
+var addedColumns = []string{"e164", "valid", "type", "error"}
+
+// options is how we find the number and region in each row
+type options struct {
+	column       string
+	region       string
+	regionColumn string
+	header       bool
+	comma        rune
+}
+
+// processor parses the number in each row and appends our columns
+type processor struct {
+	opts        *options
+	numberIndex int
+	regionIndex int
+	regions     *regionResolver
+}
+
+// resolveColumn returns the index of the passed in column, which can be a name from the header or a 1 based index
+func resolveColumn(column string, header []string) (int, error) {
+	if index, err := strconv.Atoi(column); err == nil {
+		if index < 1 {
+			return -1, fmt.Errorf("invalid column index: %d", index)
+		}
+		return index - 1, nil
+	}
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			return i, nil
+		}
+	}
+	if header == nil {
+		return -1, fmt.Errorf("column '%s' must be given as an index when there is no header", column)
+	}
+	return -1, fmt.Errorf("no column named '%s' in header", column)
+}
+
+// newProcessor creates a new processor, resolving column names against the passed in header if we have one
+func newProcessor(opts *options, header []string) (*processor, error) {
+	p := &processor{opts: opts, regionIndex: -1}
+
+	var err error
+	p.numberIndex, err = resolveColumn(opts.column, header)
+	if err != nil {
+		return nil, err
+	}
+	if opts.regionColumn != "" {
+		p.regionIndex, err = resolveColumn(opts.regionColumn, header)
+		if err != nil {
+			return nil, err
+		}
+		p.regions = newRegionResolver()
+	}
+	return p, nil
+}
+
+// process parses the number in the passed in row, returning the row with our columns appended
+func (p *processor) process(row []string) []string {
+	region := p.opts.region
+	if p.regionIndex >= 0 && p.regionIndex < len(row) {
+		if r := strings.TrimSpace(row[p.regionIndex]); r != "" {
+			// values we don't recognize are passed on as is, parsing then failing unless the number is international
+			if resolved, found := p.regions.resolve(r); found {
+				region = resolved
+			} else {
+				region = strings.ToUpper(r)
+			}
+		}
+	}
+
+	if p.numberIndex >= len(row) || strings.TrimSpace(row[p.numberIndex]) == "" {
+		return append(row, "", "false", "", "missing number")
+	}
+
+	num, err := phonenumbers.Parse(row[p.numberIndex], region)
+	if err != nil {
+		return append(row, "", "false", "", err.Error())
+	}
+
+	return append(row,
+		phonenumbers.Format(num, phonenumbers.E164),
+		strconv.FormatBool(phonenumbers.IsValidNumber(num)),
+		numberTypes[phonenumbers.GetNumberType(num)],
+		"",
+	)
+}
+
+// processCSV reads rows from in, writing each to out with our columns appended
+func processCSV(in io.Reader, out io.Writer, opts *options) error {
+	reader := csv.NewReader(in)
+	reader.Comma = opts.comma
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	writer := csv.NewWriter(out)
+	writer.Comma = opts.comma
+
+	var header []string
+	if opts.header {
+		var err error
+		header, err = reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(append(header, addedColumns...)); err != nil {
+			return err
+		}
+	}
+
+	p, err := newProcessor(opts, header)
+	if err != nil {
+		return err
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(p.process(row)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// parseDelimiter returns the rune for the passed in delimiter, which can be a single character or "tab"
+func parseDelimiter(delimiter string) (rune, error) {
+	if strings.EqualFold(delimiter, "tab") || delimiter == `\t` {
+		return '\t', nil
+	}
+	runes := []rune(delimiter)
+	if len(runes) != 1 {
+		return 0, fmt.Errorf("delimiter must be a single character or 'tab'")
+	}
+	return runes[0], nil
+}
+
+func main() {
+	opts := &options{}
+	flag.StringVar(&opts.column, "column", "phone", "name or 1 based index of the column containing phone numbers")
+	flag.StringVar(&opts.region, "region", "", "two letter country to use for numbers not in international format")
+	flag.StringVar(&opts.regionColumn, "region-column", "", "name or 1 based index of a column containing the country of each number, as a region code, country name or locale")
+	noHeader := flag.Bool("no-header", false, "the input has no header row, columns must be given as indexes")
+	delimiter := flag.String("delimiter", "", "field delimiter, a single character or 'tab', defaults to tab for .tsv files and comma otherwise")
+	output := flag.String("output", "", "file to write to, defaults to stdout")
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintln(out, "usage: phonecsv [flags] [file]")
+		fmt.Fprintln(out, "")
+		fmt.Fprintf(out, "Reads CSV or TSV from file or stdin and writes it back with %s columns appended.\n", strings.Join(addedColumns, ", "))
+		fmt.Fprintln(out, "")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	opts.header = !*noHeader
+	opts.region = strings.ToUpper(opts.region)
+
+	in := os.Stdin
+	if flag.NArg() == 1 {
+		file, err := os.Open(flag.Arg(0))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening input: %s\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		in = file
+
+		if *delimiter == "" && strings.EqualFold(filepath.Ext(flag.Arg(0)), ".tsv") {
+			*delimiter = "tab"
+		}
+	}
+	if *delimiter == "" {
+		*delimiter = ","
+	}
+
+	var err error
+	opts.comma, err = parseDelimiter(*delimiter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid delimiter: %s\n", err)
+		os.Exit(1)
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output: %s\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := processCSV(in, out, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "Error processing input: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/nyaruka/phonenumbers"
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// nameLanguages are the languages we recognize country names in, those phonenumbers has region names for,
+// English first so that it wins when two languages use the same name for different countries
+var nameLanguages = []string{
+	"en", "ar", "bn", "de", "es", "fa", "fr", "hi", "id", "it", "ja", "ko", "nl", "pl", "pt", "ru", "sv", "sw", "th",
+	"tr", "uk", "ur", "vi", "zh", "zh-Hant",
+}
+
+// nameAliases are names which spreadsheets commonly use but which aren't the ones CLDR gives
+var nameAliases = map[string]string{
+	"us":                       "US",
+	"usa":                      "US",
+	"america":                  "US",
+	"united states of america": "US",
+	"uk":                       "GB",
+	"britain":                  "GB",
+	"great britain":            "GB",
+	"england":                  "GB",
+	"scotland":                 "GB",
+	"wales":                    "GB",
+	"northern ireland":         "GB",
+	"holland":                  "NL",
+	"korea":                    "KR",
+	"republic of korea":        "KR",
+	"russian federation":       "RU",
+	"ivory coast":              "CI",
+	"czech republic":           "CZ",
+	"burma":                    "MM",
+	"drc":                      "CD",
+	"uae":                      "AE",
+	"turkey":                   "TR",
+	"turkiye":                  "TR",
+	"cape verde":               "CV",
+	"swaziland":                "SZ",
+	"macedonia":                "MK",
+	"viet nam":                 "VN",
+}
+
+// regionResolver works out the region of a row from the value of its region column
+type regionResolver struct {
+	names map[string]string
+}
+
+// newRegionResolver creates a resolver, building our table of country names in each of nameLanguages
+func newRegionResolver() *regionResolver {
+	names := make(map[string]string)
+	for region := range phonenumbers.GetSupportedRegions() {
+		for _, lang := range nameLanguages {
+			addRegionName(names, phonenumbers.GetRegionDisplayName(region, lang), region)
+		}
+	}
+	for name, region := range nameAliases {
+		names[name] = region
+	}
+	return &regionResolver{names: names}
+}
+
+// addRegionName adds the passed in name to our table, as well as the name without any qualifier, e.g. "Myanmar"
+// for "Myanmar (Burma)"
+func addRegionName(names map[string]string, name string, region string) {
+	if name == "" {
+		return
+	}
+	if paren := strings.Index(name, " ("); paren > 0 {
+		addRegionName(names, name[:paren], region)
+	}
+	key := normalizeName(name)
+	if _, exists := names[key]; !exists {
+		names[key] = region
+	}
+}
+
+// resolve returns the region for the passed in value, which can be a region code such as "US", an alpha-3 or
+// numeric code such as "USA" or "840", a locale such as "en_US" or "pt-BR", or a country name such as "Germany" or
+// "Allemagne". Returns false if the value isn't one we recognize.
+func (r *regionResolver) resolve(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", false
+	}
+
+	if len(value) <= 3 {
+		if reg, err := language.ParseRegion(value); err == nil && phonenumbers.IsSupportedRegion(reg.String()) {
+			return reg.String(), true
+		}
+	}
+
+	if strings.ContainsAny(value, "-_") {
+		if tag, err := language.Parse(value); err == nil {
+			if reg, confidence := tag.Region(); confidence == language.Exact && phonenumbers.IsSupportedRegion(reg.String()) {
+				return reg.String(), true
+			}
+		}
+	}
+
+	region, found := r.names[normalizeName(value)]
+	return region, found
+}
+
+// normalizeName normalizes a country name for lookup, lower casing it, removing accents, punctuation and any
+// leading "the", so that "Cote d'Ivoire" finds "Côte d’Ivoire" and "The Netherlands" finds "Netherlands"
+func normalizeName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+	if stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name); err == nil {
+		name = stripped
+	}
+
+	// apostrophes and periods join rather than separate, e.g. "d'Ivoire" or "U.S.A."
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\'' || r == '’' || r == '.':
+			return -1
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return r
+		default:
+			return ' '
+		}
+	}, name)
+
+	name = strings.Join(strings.Fields(name), " ")
+	return strings.TrimPrefix(name, "the ")
+}
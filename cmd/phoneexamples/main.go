@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// the number types we emit examples of, in the order we emit them
+var exampleTypes = []phonenumbers.PhoneNumberType{
+	phonenumbers.FIXED_LINE,
+	phonenumbers.MOBILE,
+	phonenumbers.TOLL_FREE,
+	phonenumbers.PREMIUM_RATE,
+	phonenumbers.SHARED_COST,
+	phonenumbers.VOIP,
+	phonenumbers.PERSONAL_NUMBER,
+	phonenumbers.PAGER,
+	phonenumbers.UAN,
+	phonenumbers.VOICEMAIL,
+}
+
+var numberTypes = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.FIXED_LINE:           "FIXED_LINE",
+	phonenumbers.MOBILE:               "MOBILE",
+	phonenumbers.FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	phonenumbers.TOLL_FREE:            "TOLL_FREE",
+	phonenumbers.PREMIUM_RATE:         "PREMIUM_RATE",
+	phonenumbers.SHARED_COST:          "SHARED_COST",
+	phonenumbers.VOIP:                 "VOIP",
+	phonenumbers.PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	phonenumbers.PAGER:                "PAGER",
+	phonenumbers.UAN:                  "UAN",
+	phonenumbers.VOICEMAIL:            "VOICEMAIL",
+	phonenumbers.UNKNOWN:              "UNKNOWN",
+}
+
+// example is one example number, with what the library says about it
+type example struct {
+	Region        string `json:"region"`
+	CountryCode   int32  `json:"country_code"`
+	ExampleOf     string `json:"example_of"`
+	Type          string `json:"type"`
+	Valid         bool   `json:"valid"`
+	E164          string `json:"e164"`
+	International string `json:"international"`
+	National      string `json:"national"`
+	RFC3966       string `json:"rfc3966"`
+}
+
+var csvHeader = []string{"region", "country_code", "example_of", "type", "valid", "e164", "international", "national", "rfc3966"}
+
+func (e *example) csvRecord() []string {
+	return []string{
+		e.Region,
+		strconv.Itoa(int(e.CountryCode)),
+		e.ExampleOf,
+		e.Type,
+		strconv.FormatBool(e.Valid),
+		e.E164,
+		e.International,
+		e.National,
+		e.RFC3966,
+	}
+}
+
+// newExample describes the passed in number, which is an example of the passed in type, or of an invalid number
+func newExample(region, exampleOf string, num *phonenumbers.PhoneNumber) *example {
+	return &example{
+		Region:        region,
+		CountryCode:   num.GetCountryCode(),
+		ExampleOf:     exampleOf,
+		Type:          numberTypes[phonenumbers.GetNumberType(num)],
+		Valid:         phonenumbers.IsValidNumber(num),
+		E164:          phonenumbers.Format(num, phonenumbers.E164),
+		International: phonenumbers.Format(num, phonenumbers.INTERNATIONAL),
+		National:      phonenumbers.Format(num, phonenumbers.NATIONAL),
+		RFC3966:       phonenumbers.Format(num, phonenumbers.RFC3966),
+	}
+}
+
+// regionExamples returns the examples for the passed in region, one for each type it has numbers of, and an invalid
+// number, or for 001, those of each non-geographical entity
+func regionExamples(region string) []*example {
+	var examples []*example
+
+	if region == phonenumbers.REGION_CODE_FOR_NON_GEO_ENTITY {
+		codes := make([]int, 0, 16)
+		for code := range phonenumbers.GetSupportedGlobalNetworkCallingCodes() {
+			codes = append(codes, int(code))
+		}
+		sort.Ints(codes)
+
+		for _, code := range codes {
+			if num := phonenumbers.GetExampleNumberForNonGeoEntity(int32(code)); num != nil {
+				examples = append(examples, newExample(region, numberTypes[phonenumbers.GetNumberType(num)], num))
+			}
+		}
+		return examples
+	}
+
+	for _, typ := range exampleTypes {
+		if num := phonenumbers.GetExampleNumberForType(region, typ); num != nil {
+			examples = append(examples, newExample(region, numberTypes[typ], num))
+		}
+	}
+	if num := phonenumbers.GetInvalidExampleNumber(region); num != nil {
+		examples = append(examples, newExample(region, "INVALID", num))
+	}
+	return examples
+}
+
+// selectRegions returns the passed in comma separated regions, or all regions and 001 for "all"
+func selectRegions(list string) ([]string, error) {
+	supported := phonenumbers.GetSupportedRegions()
+
+	var regions []string
+	if list == "all" {
+		for region := range supported {
+			regions = append(regions, region)
+		}
+		sort.Strings(regions)
+		return append(regions, phonenumbers.REGION_CODE_FOR_NON_GEO_ENTITY), nil
+	}
+
+	for _, region := range strings.Split(list, ",") {
+		region = strings.ToUpper(strings.TrimSpace(region))
+		if !supported[region] && region != phonenumbers.REGION_CODE_FOR_NON_GEO_ENTITY {
+			return nil, fmt.Errorf("unsupported region '%s'", region)
+		}
+		regions = append(regions, region)
+	}
+	return regions, nil
+}
+
+// writeExamples writes the passed in examples to out in the passed in format
+func writeExamples(out io.Writer, format string, examples []*example) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(examples)
+	case "csv":
+		writer := csv.NewWriter(out)
+		writer.Write(csvHeader)
+		for _, e := range examples {
+			writer.Write(e.csvRecord())
+		}
+		writer.Flush()
+		return writer.Error()
+	}
+	return fmt.Errorf("unknown format '%s'", format)
+}
+
+func main() {
+	regionList := flag.String("regions", "all", "comma separated regions to emit examples for, 001 for non-geographical entities, or all")
+	format := flag.String("format", "json", "format to write, json or csv")
+	output := flag.String("output", "", "file to write to, defaults to stdout")
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintln(out, "usage: phoneexamples [flags]")
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "Writes an example number of every type for each region, and an invalid one, in every format, as fixtures")
+		fmt.Fprintln(out, "for tests of services which need to agree with the metadata this binary was built with.")
+		fmt.Fprintln(out, "")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() > 0 || (*format != "json" && *format != "csv") {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	regions, err := selectRegions(*regionList)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid regions: %s\n", err)
+		os.Exit(1)
+	}
+
+	examples := make([]*example, 0, len(regions)*4)
+	for _, region := range regions {
+		examples = append(examples, regionExamples(region)...)
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output: %s\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := writeExamples(out, *format, examples); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing examples: %s\n", err)
+		os.Exit(1)
+	}
+}
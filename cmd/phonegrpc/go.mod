@@ -0,0 +1,25 @@
+module github.com/nyaruka/phonenumbers/cmd/phonegrpc
+
+go 1.19
+
+replace (
+	github.com/nyaruka/phonenumbers => ../../
+	github.com/nyaruka/phonenumbers/geocodingdata => ../../geocodingdata
+	github.com/nyaruka/phonenumbers/service => ../../service
+)
+
+require (
+	github.com/nyaruka/phonenumbers/geocodingdata v0.0.0-00010101000000-000000000000
+	github.com/nyaruka/phonenumbers/service v0.0.0-00010101000000-000000000000
+	google.golang.org/grpc v1.58.3
+)
+
+require (
+	github.com/golang/protobuf v1.5.3 // indirect
+	github.com/nyaruka/phonenumbers v0.0.0-00010101000000-000000000000 // indirect
+	golang.org/x/net v0.12.0 // indirect
+	golang.org/x/sys v0.10.0 // indirect
+	golang.org/x/text v0.12.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20230711160842-782d3b101e98 // indirect
+	google.golang.org/protobuf v1.31.0 // indirect
+)
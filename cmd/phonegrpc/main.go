@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/nyaruka/phonenumbers/geocodingdata"
+	"github.com/nyaruka/phonenumbers/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	address := flag.String("address", envOrDefault("PHONEGRPC_ADDRESS", ":9090"), "address to listen on")
+	flag.Parse()
+
+	listener, err := net.Listen("tcp", *address)
+	if err != nil {
+		log.Fatalf("Error listening: %s", err)
+	}
+
+	server := grpc.NewServer()
+	service.RegisterPhoneNumbersServer(server, service.NewServer())
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	reflection.Register(server)
+
+	// shut down gracefully when we're asked to stop, letting in flight requests finish
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		<-signals
+
+		log.Println("Shutting down")
+		server.GracefulStop()
+	}()
+
+	log.Printf("Listening on %s", *address)
+	if err := server.Serve(listener); err != nil {
+		log.Fatalf("Error serving: %s", err)
+	}
+}
+
+// envOrDefault returns the value of the passed in environment variable, or def if it isn't set
+func envOrDefault(key string, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return def
+}
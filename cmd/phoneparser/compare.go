@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+var matchTypes = map[phonenumbers.MatchType]string{
+	phonenumbers.NOT_A_NUMBER:    "NOT_A_NUMBER",
+	phonenumbers.NO_MATCH:        "NO_MATCH",
+	phonenumbers.SHORT_NSN_MATCH: "SHORT_NSN_MATCH",
+	phonenumbers.NSN_MATCH:       "NSN_MATCH",
+	phonenumbers.EXACT_MATCH:     "EXACT_MATCH",
+}
+
+// comparison is the result of comparing two numbers
+type comparison struct {
+	First  string `json:"first"`
+	Second string `json:"second"`
+	Match  string `json:"match"`
+}
+
+// compare compares the two passed in numbers. If a region is given, numbers not in international format are
+// parsed as belonging to it, otherwise they are only compared by their national significant numbers.
+func compare(first string, second string, region string) *comparison {
+	c := &comparison{First: first, Second: second}
+
+	if region == "" {
+		c.Match = matchTypes[phonenumbers.IsNumberMatch(first, second)]
+		return c
+	}
+
+	firstNum, err := phonenumbers.Parse(first, region)
+	if err != nil {
+		c.Match = matchTypes[phonenumbers.NOT_A_NUMBER]
+		return c
+	}
+	secondNum, err := phonenumbers.Parse(second, region)
+	if err != nil {
+		c.Match = matchTypes[phonenumbers.NOT_A_NUMBER]
+		return c
+	}
+	c.Match = matchTypes[phonenumbers.IsNumberMatchWithNumbers(firstNum, secondNum)]
+	return c
+}
+
+func (c *comparison) write(out io.Writer, mode *outputMode) {
+	if mode.json {
+		writeJSON(out, c)
+		return
+	}
+	fmt.Fprintln(out, c.Match)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// exampleTypes are the number types we can generate examples for, in the order we print them
+var exampleTypes = []phonenumbers.PhoneNumberType{
+	phonenumbers.FIXED_LINE,
+	phonenumbers.MOBILE,
+	phonenumbers.TOLL_FREE,
+	phonenumbers.PREMIUM_RATE,
+	phonenumbers.SHARED_COST,
+	phonenumbers.VOIP,
+	phonenumbers.PERSONAL_NUMBER,
+	phonenumbers.PAGER,
+	phonenumbers.UAN,
+	phonenumbers.VOICEMAIL,
+}
+
+// example is an example number of a particular type
+type example struct {
+	Type string `json:"example_type"`
+	*result
+}
+
+// parseExampleType returns the example type with the passed in name, ignoring case
+func parseExampleType(name string) (phonenumbers.PhoneNumberType, bool) {
+	for _, typ := range exampleTypes {
+		if strings.EqualFold(numberTypes[typ], name) {
+			return typ, true
+		}
+	}
+	return phonenumbers.UNKNOWN, false
+}
+
+// examples returns an example number for each of the passed in types that the region has one for
+func examples(region string, types []phonenumbers.PhoneNumberType, include *lookups) []*example {
+	found := make([]*example, 0, len(types))
+	for _, typ := range types {
+		num := phonenumbers.GetExampleNumberForType(region, typ)
+		if num == nil {
+			continue
+		}
+		found = append(found, &example{
+			Type:   numberTypes[typ],
+			result: parse(phonenumbers.Format(num, phonenumbers.E164), region, include),
+		})
+	}
+	return found
+}
+
+// writeExamples writes the passed in examples, with a heading for each unless we are writing a single format
+func writeExamples(out io.Writer, found []*example, mode *outputMode) {
+	for i, e := range found {
+		switch {
+		case mode.format != "":
+			fmt.Fprintf(out, "%s\t%s\n", e.Type, formats[mode.format](e.result))
+		case mode.json:
+			writeJSON(out, e)
+		default:
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			fmt.Fprintf(out, "%s\n", e.Type)
+			e.writeText(out)
+		}
+	}
+}
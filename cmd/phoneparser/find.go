@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// found is a number found in text along with its byte offsets
+type found struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+	*result
+}
+
+// findInText reads all of in and writes every number the matcher finds in it to out. Numbers not in international
+// format are only found if a region is given.
+func findInText(in io.Reader, out io.Writer, region string, include *lookups, mode *outputMode) error {
+	text, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	if region == "" {
+		region = phonenumbers.UNKNOWN_REGION
+	}
+
+	writer := bufio.NewWriter(out)
+	defer writer.Flush()
+
+	matcher := phonenumbers.NewPhoneNumberMatcher(string(text), region)
+	for {
+		match, err := matcher.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		f := &found{Start: match.Start(), End: match.End(), result: describe(match.RawString(), &match.Number, include)}
+		f.write(writer, mode)
+	}
+}
+
+func (f *found) write(out io.Writer, mode *outputMode) {
+	switch {
+	case mode.format != "":
+		fmt.Fprintf(out, "%d\t%d\t%s\t%s\n", f.Start, f.End, f.Input, formats[mode.format](f.result))
+	case mode.json:
+		writeJSON(out, f)
+	default:
+		fmt.Fprintf(out, "%d\t%d\t%s\t%s\t%s\t%t\n", f.Start, f.End, f.Input, f.E164, f.Region, f.Valid)
+	}
+}
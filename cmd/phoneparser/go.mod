@@ -2,9 +2,19 @@ module github.com/nyaruka/phonenumbers/cmd/phoneparser
 
 go 1.19
 
-replace github.com/nyaruka/phonenumbers => ../../
+replace (
+	github.com/nyaruka/phonenumbers => ../../
+	github.com/nyaruka/phonenumbers/carrierdata => ../../carrierdata
+	github.com/nyaruka/phonenumbers/geocodingdata => ../../geocodingdata
+	github.com/nyaruka/phonenumbers/timezonedata => ../../timezonedata
+)
 
-require github.com/nyaruka/phonenumbers v0.0.0-00010101000000-000000000000
+require (
+	github.com/nyaruka/phonenumbers v0.0.0-00010101000000-000000000000
+	github.com/nyaruka/phonenumbers/carrierdata v0.0.0-00010101000000-000000000000
+	github.com/nyaruka/phonenumbers/geocodingdata v0.0.0-00010101000000-000000000000
+	github.com/nyaruka/phonenumbers/timezonedata v0.0.0-00010101000000-000000000000
+)
 
 require (
 	golang.org/x/text v0.12.0 // indirect
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// commonTypes are the types most numbers in real records are, which we consider more plausible than the others
+var commonTypes = map[phonenumbers.PhoneNumberType]bool{
+	phonenumbers.FIXED_LINE:           true,
+	phonenumbers.MOBILE:               true,
+	phonenumbers.FIXED_LINE_OR_MOBILE: true,
+}
+
+// candidate is a region a number might belong to
+type candidate struct {
+	Region   string `json:"region"`
+	E164     string `json:"e164"`
+	Type     string `json:"type"`
+	Valid    bool   `json:"valid"`
+	Possible bool   `json:"possible"`
+	score    int
+}
+
+// guessRegions parses the passed in number as if it belonged to each supported region, returning at most limit
+// regions for which it is at least possible, ranked by validity and then how plausible its type and format are
+func guessRegions(input string, limit int) []*candidate {
+	candidates := make([]*candidate, 0)
+	digits := phonenumbers.NormalizeDigitsOnly(input)
+
+	for region := range phonenumbers.GetSupportedRegions() {
+		num, err := phonenumbers.Parse(input, region)
+		if err != nil {
+			continue
+		}
+
+		c := &candidate{
+			Region:   region,
+			Valid:    phonenumbers.IsValidNumberForRegion(num, region),
+			Possible: phonenumbers.IsPossibleNumber(num),
+		}
+		if !c.Possible {
+			continue
+		}
+
+		typ := phonenumbers.GetNumberType(num)
+		c.E164 = phonenumbers.Format(num, phonenumbers.E164)
+		c.Type = numberTypes[typ]
+
+		if c.Valid {
+			c.score += 4
+		}
+		if typ != phonenumbers.UNKNOWN {
+			c.score++
+		}
+		if commonTypes[typ] {
+			c.score++
+		}
+
+		// a number written with the region's national prefix was likely written for that region
+		if prefix := phonenumbers.GetNddPrefixForRegion(region, true); prefix != "" && c.Valid && strings.HasPrefix(digits, prefix) {
+			c.score++
+		}
+		candidates = append(candidates, c)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].Region < candidates[j].Region
+	})
+
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
+
+func writeCandidates(out io.Writer, candidates []*candidate, mode *outputMode) {
+	for _, c := range candidates {
+		if mode.json {
+			writeJSON(out, c)
+		} else {
+			fmt.Fprintf(out, "%s\t%s\t%s\t%t\n", c.Region, c.E164, c.Type, c.Valid)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// runInteractive reads lines from in, feeding each to an as-you-type formatter one character at a time and
+// writing the output after each character to out. A line of the form "region XX" switches region.
+func runInteractive(in io.Reader, out io.Writer, region string) error {
+	fmt.Fprintf(out, "Formatting as you type for region %s, enter 'region XX' to switch region\n", region)
+	formatter := phonenumbers.GetAsYouTypeFormatter(region)
+
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+
+		input := strings.TrimSpace(scanner.Text())
+		if input == "" {
+			continue
+		}
+		if fields := strings.Fields(input); len(fields) == 2 && fields[0] == "region" {
+			region = strings.ToUpper(fields[1])
+			formatter = phonenumbers.GetAsYouTypeFormatter(region)
+			fmt.Fprintf(out, "Switched to region %s\n", region)
+			continue
+		}
+
+		formatter.Clear()
+		for _, c := range input {
+			fmt.Fprintf(out, "  %c  %s\n", c, formatter.InputDigit(c))
+		}
+	}
+}
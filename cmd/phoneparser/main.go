@@ -1,31 +1,389 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/nyaruka/phonenumbers"
+	_ "github.com/nyaruka/phonenumbers/carrierdata"
+	_ "github.com/nyaruka/phonenumbers/geocodingdata"
+	_ "github.com/nyaruka/phonenumbers/timezonedata"
 )
 
+var numberTypes = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.FIXED_LINE:           "FIXED_LINE",
+	phonenumbers.MOBILE:               "MOBILE",
+	phonenumbers.FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	phonenumbers.TOLL_FREE:            "TOLL_FREE",
+	phonenumbers.PREMIUM_RATE:         "PREMIUM_RATE",
+	phonenumbers.SHARED_COST:          "SHARED_COST",
+	phonenumbers.VOIP:                 "VOIP",
+	phonenumbers.PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	phonenumbers.PAGER:                "PAGER",
+	phonenumbers.UAN:                  "UAN",
+	phonenumbers.VOICEMAIL:            "VOICEMAIL",
+	phonenumbers.UNKNOWN:              "UNKNOWN",
+}
+
+// lookups is which of the optional lookups to include in our results
+type lookups struct {
+	lang      string
+	geocoding bool
+	carrier   bool
+	timezones bool
+}
+
+// outputMode is how we write our results
+type outputMode struct {
+	json   bool
+	format string
+}
+
+// result is everything we report about a single number
+type result struct {
+	Input          string   `json:"input"`
+	E164           string   `json:"e164,omitempty"`
+	National       string   `json:"national,omitempty"`
+	International  string   `json:"international,omitempty"`
+	RFC3966        string   `json:"rfc3966,omitempty"`
+	NationalNumber string   `json:"national_number,omitempty"`
+	Valid          bool     `json:"valid"`
+	Possible       bool     `json:"possible"`
+	Type           string   `json:"type,omitempty"`
+	Region         string   `json:"region,omitempty"`
+	CountryCode    int32    `json:"country_code,omitempty"`
+	Extension      string   `json:"extension,omitempty"`
+	Geocoding      string   `json:"geocoding,omitempty"`
+	Carrier        string   `json:"carrier,omitempty"`
+	Timezones      []string `json:"timezones,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
+
+func parse(input string, region string, include *lookups) *result {
+	num, err := phonenumbers.Parse(input, region)
+	if err != nil {
+		return &result{Input: input, Error: err.Error()}
+	}
+	return describe(input, num, include)
+}
+
+// describe builds the result for the passed in number, which was parsed from input
+func describe(input string, num *phonenumbers.PhoneNumber, include *lookups) *result {
+	r := &result{Input: input}
+	r.E164 = phonenumbers.Format(num, phonenumbers.E164)
+	r.National = phonenumbers.Format(num, phonenumbers.NATIONAL)
+	r.International = phonenumbers.Format(num, phonenumbers.INTERNATIONAL)
+	r.RFC3966 = phonenumbers.Format(num, phonenumbers.RFC3966)
+	r.NationalNumber = strconv.FormatUint(num.GetNationalNumber(), 10)
+	r.Valid = phonenumbers.IsValidNumber(num)
+	r.Possible = phonenumbers.IsPossibleNumber(num)
+	r.Type = numberTypes[phonenumbers.GetNumberType(num)]
+	r.Region = phonenumbers.GetRegionCodeForNumber(num)
+	r.CountryCode = num.GetCountryCode()
+	r.Extension = num.GetExtension()
+
+	// lookup errors just mean we have no data for this number
+	if include.geocoding {
+		r.Geocoding, _ = phonenumbers.GetGeocodingForNumber(num, include.lang)
+	}
+	if include.carrier {
+		r.Carrier, _ = phonenumbers.GetCarrierForNumber(num, include.lang)
+	}
+	if include.timezones {
+		r.Timezones, _ = phonenumbers.GetTimezonesForNumber(num)
+	}
+	return r
+}
+
+func (r *result) writeText(out io.Writer) {
+	if r.Error != "" {
+		fmt.Fprintf(out, "Error parsing number: %s\n", r.Error)
+		return
+	}
+
+	fmt.Fprintf(out, "            E164: %s\n", r.E164)
+	fmt.Fprintf(out, "National Dialing: %s\n", r.National)
+	fmt.Fprintf(out, "   International: %s\n", r.International)
+	fmt.Fprintf(out, "         RFC3966: %s\n", r.RFC3966)
+	fmt.Fprintf(out, "        National: %s\n", r.NationalNumber)
+	if r.Extension != "" {
+		fmt.Fprintf(out, "       Extension: %s\n", r.Extension)
+	}
+	fmt.Fprintf(out, "    Country Code: %d\n", r.CountryCode)
+	fmt.Fprintf(out, "          Region: %s\n", r.Region)
+	fmt.Fprintf(out, "            Type: %s\n", r.Type)
+	if r.Geocoding != "" {
+		fmt.Fprintf(out, "       Geocoding: %s\n", r.Geocoding)
+	}
+	if r.Carrier != "" {
+		fmt.Fprintf(out, "         Carrier: %s\n", r.Carrier)
+	}
+	if len(r.Timezones) > 0 {
+		fmt.Fprintf(out, "       Timezones: %s\n", strings.Join(r.Timezones, ", "))
+	}
+	fmt.Fprintf(out, "      IsPossible: %t\n", r.Possible)
+	fmt.Fprintf(out, "         IsValid: %t\n", r.Valid)
+}
+
+// writeLine writes our result as a single tab separated line, for batch mode
+func (r *result) writeLine(out io.Writer) {
+	if r.Error != "" {
+		fmt.Fprintf(out, "%s\terror: %s\n", r.Input, r.Error)
+		return
+	}
+	fmt.Fprintf(out, "%s\t%s\t%s\t%t\n", r.Input, r.E164, r.Region, r.Valid)
+}
+
+// formats are the representations that can be selected with -format
+var formats = map[string]func(*result) string{
+	"e164":          func(r *result) string { return r.E164 },
+	"national":      func(r *result) string { return r.National },
+	"international": func(r *result) string { return r.International },
+	"rfc3966":       func(r *result) string { return r.RFC3966 },
+}
+
+// writeFormat writes just the selected representation of our result, errors go to stderr and leave an empty
+// line so that output lines still match up with input lines
+func (r *result) writeFormat(out io.Writer, format string) {
+	if r.Error != "" {
+		fmt.Fprintf(os.Stderr, "Error parsing number '%s': %s\n", r.Input, r.Error)
+	}
+	fmt.Fprintln(out, formats[format](r))
+}
+
+// write writes our result in the selected output mode
+func (r *result) write(out io.Writer, mode *outputMode, batch bool) {
+	switch {
+	case mode.format != "":
+		r.writeFormat(out, mode.format)
+	case mode.json:
+		r.writeJSON(out)
+	case batch:
+		r.writeLine(out)
+	default:
+		r.writeText(out)
+	}
+}
+
+func (r *result) writeJSON(out io.Writer) {
+	writeJSON(out, r)
+}
+
+func writeJSON(out io.Writer, v interface{}) {
+	encoded, _ := json.Marshal(v)
+	out.Write(encoded)
+	out.Write([]byte("\n"))
+}
+
+// parseBatch parses each line read from in as a number, writing one result per line to out. Blank lines are
+// written back as blank lines so that output lines still match up with input lines.
+func parseBatch(in io.Reader, out io.Writer, region string, include *lookups, mode *outputMode) error {
+	writer := bufio.NewWriter(out)
+	defer writer.Flush()
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		input := strings.TrimSpace(scanner.Text())
+		if input == "" {
+			fmt.Fprintln(writer)
+			continue
+		}
+
+		parse(input, region, include).write(writer, mode, true)
+	}
+	return scanner.Err()
+}
+
 func main() {
-	if len(os.Args) != 3 {
-		fmt.Println("usage: phoneparser [number] [two letter coutry]")
-		os.Exit(1)
+	mode := &outputMode{}
+	flag.BoolVar(&mode.json, "json", false, "output results as JSON objects")
+	flag.StringVar(&mode.format, "format", "", "output only the number in this format, one of e164, national, international or rfc3966")
+	region := flag.String("region", "", "two letter country to use for numbers not in international format")
+	interactive := flag.Bool("interactive", false, "format numbers as they are typed, one character at a time")
+	compareNumbers := flag.Bool("compare", false, "compare two numbers and report whether they match")
+	showExamples := flag.Bool("example", false, "print example numbers for a region, optionally only of one type")
+	findNumbers := flag.Bool("find", false, "find and print all numbers in text read from a file or stdin")
+	guess := flag.Int("guess", 0, "guess which regions a number without one belongs to, printing at most this many")
+	showVersion := flag.Bool("version", false, "print the version of the library and metadata this binary was built with")
+	metadataFormat := flag.String("metadata", "", "export the metadata this binary was built with as xml or json, optionally only for some regions")
+	include := &lookups{}
+	flag.StringVar(&include.lang, "lang", "en", "language to use for geocoding and carrier names")
+	flag.BoolVar(&include.geocoding, "geocoding", true, "include the geocoding description")
+	flag.BoolVar(&include.carrier, "carrier", true, "include the carrier name")
+	flag.BoolVar(&include.timezones, "timezones", true, "include the timezones")
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintln(out, "usage: phoneparser [flags] [number] [two letter country]")
+		fmt.Fprintln(out, "       phoneparser [flags] < numbers.txt")
+		fmt.Fprintln(out, "       phoneparser -interactive [two letter country]")
+		fmt.Fprintln(out, "       phoneparser -compare [flags] [number] [number]")
+		fmt.Fprintln(out, "       phoneparser -example [flags] [two letter country] [type]")
+		fmt.Fprintln(out, "       phoneparser -find [flags] [file]")
+		fmt.Fprintln(out, "       phoneparser -guess=5 [flags] [number]")
+		fmt.Fprintln(out, "       phoneparser -metadata=xml [two letter country...]")
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "Without a number, numbers are read one per line from stdin and a result is written for each.")
+		fmt.Fprintln(out, "")
+		flag.PrintDefaults()
 	}
+	flag.Parse()
 
-	num, err := phonenumbers.Parse(os.Args[1], os.Args[2])
-	if err != nil {
-		fmt.Printf("Error parsing number: %s\n", err)
+	if mode.format != "" {
+		if _, valid := formats[mode.format]; !valid {
+			fmt.Fprintf(os.Stderr, "Unknown format: %s\n", mode.format)
+			os.Exit(1)
+		}
+		if mode.json {
+			fmt.Fprintln(os.Stderr, "-format and -json can't be used together")
+			os.Exit(1)
+		}
+
+		// we only output the formatted number, so don't pay for lookups
+		include.geocoding, include.carrier, include.timezones = false, false, false
 	}
 
-	metadata, err := phonenumbers.Parse(os.Args[1], os.Args[2])
-	if err != nil {
-		fmt.Printf("error parsing phone", err.Error())
+	if *showVersion {
+		readBuildVersion().write(os.Stdout, mode)
+		return
 	}
-	fmt.Printf("            E164: %s\n", phonenumbers.Format(num, phonenumbers.E164))
-	fmt.Printf("National Dialing: %s\n", phonenumbers.Format(num, phonenumbers.NATIONAL))
-	fmt.Printf("        National: %d\n", num.NationalNumber)
-	fmt.Printf("         IsValid: %s\n", strconv.FormatBool(phonenumbers.IsValidNumber(metadata)))
 
+	if *metadataFormat != "" {
+		writer := bufio.NewWriter(os.Stdout)
+		err := exportMetadata(writer, *metadataFormat, flag.Args())
+		writer.Flush()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting metadata: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *interactive {
+		if flag.NArg() > 1 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		if flag.NArg() == 1 {
+			*region = flag.Arg(0)
+		}
+		if err := runInteractive(os.Stdin, os.Stdout, *region); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading stdin: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *compareNumbers {
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+
+		c := compare(flag.Arg(0), flag.Arg(1), strings.ToUpper(*region))
+		c.write(os.Stdout, mode)
+
+		if c.Match == matchTypes[phonenumbers.NOT_A_NUMBER] {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *showExamples {
+		if flag.NArg() < 1 || flag.NArg() > 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+
+		region := strings.ToUpper(flag.Arg(0))
+		if !phonenumbers.GetSupportedRegions()[region] {
+			fmt.Fprintf(os.Stderr, "Unknown region: %s\n", flag.Arg(0))
+			os.Exit(1)
+		}
+
+		types := exampleTypes
+		if flag.NArg() == 2 {
+			typ, valid := parseExampleType(flag.Arg(1))
+			if !valid {
+				fmt.Fprintf(os.Stderr, "Unknown number type: %s\n", flag.Arg(1))
+				os.Exit(1)
+			}
+			types = []phonenumbers.PhoneNumberType{typ}
+		}
+
+		found := examples(region, types, include)
+		if len(found) == 0 {
+			fmt.Fprintf(os.Stderr, "No example numbers for %s\n", region)
+			os.Exit(1)
+		}
+		writeExamples(os.Stdout, found, mode)
+		return
+	}
+
+	if *findNumbers {
+		if flag.NArg() > 1 {
+			flag.Usage()
+			os.Exit(1)
+		}
+
+		in := os.Stdin
+		if flag.NArg() == 1 {
+			file, err := os.Open(flag.Arg(0))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error opening file: %s\n", err)
+				os.Exit(1)
+			}
+			defer file.Close()
+			in = file
+		}
+
+		if err := findInText(in, os.Stdout, strings.ToUpper(*region), include, mode); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading text: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *guess > 0 {
+		if flag.NArg() != 1 {
+			flag.Usage()
+			os.Exit(1)
+		}
+
+		candidates := guessRegions(flag.Arg(0), *guess)
+		if len(candidates) == 0 {
+			fmt.Fprintf(os.Stderr, "No regions where %s is a possible number\n", flag.Arg(0))
+			os.Exit(1)
+		}
+		writeCandidates(os.Stdout, candidates, mode)
+		return
+	}
+
+	if flag.NArg() == 0 {
+		if err := parseBatch(os.Stdin, os.Stdout, *region, include, mode); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading stdin: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if flag.NArg() > 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if flag.NArg() == 2 {
+		*region = flag.Arg(1)
+	}
+
+	r := parse(flag.Arg(0), *region, include)
+	r.write(os.Stdout, mode, false)
+
+	if r.Error != "" {
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// metadataFormats are the formats the embedded metadata can be exported in with -metadata
+var metadataFormats = map[string]func(io.Writer, *phonenumbers.PhoneMetadataCollection) error{
+	"xml":  phonenumbers.WriteMetadataXML,
+	"json": phonenumbers.WriteMetadataJSON,
+}
+
+// exportMetadata writes the metadata this binary carries in the passed in format, limited to the passed in regions
+// if there are any, with 001 selecting every non-geographical entity
+func exportMetadata(out io.Writer, format string, regions []string) error {
+	write, valid := metadataFormats[format]
+	if !valid {
+		return fmt.Errorf("unknown metadata format: %s", format)
+	}
+
+	collection, err := phonenumbers.MetadataCollection()
+	if err != nil {
+		return err
+	}
+
+	if len(regions) > 0 {
+		include := make(map[string]bool, len(regions))
+		for _, region := range regions {
+			include[strings.ToUpper(region)] = true
+		}
+
+		selected := &phonenumbers.PhoneMetadataCollection{}
+		for _, metadata := range collection.GetMetadata() {
+			if include[metadata.GetId()] {
+				selected.Metadata = append(selected.Metadata, metadata)
+				delete(include, metadata.GetId())
+			}
+		}
+		for region := range include {
+			return fmt.Errorf("unknown region: %s", region)
+		}
+		collection = selected
+	}
+
+	return write(out, collection)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"runtime/debug"
+)
+
+const libraryPath = "github.com/nyaruka/phonenumbers"
+
+// buildVersion is what we know about which metadata snapshot this binary carries. The library doesn't record a
+// metadata version of its own, so we report the version of the library module we were built against, which
+// pins the metadata, along with the commit and date of the build when available.
+type buildVersion struct {
+	Library  string `json:"library"`
+	Replace  string `json:"replace,omitempty"`
+	Revision string `json:"revision,omitempty"`
+	Time     string `json:"time,omitempty"`
+	Modified bool   `json:"modified,omitempty"`
+	Go       string `json:"go"`
+}
+
+func readBuildVersion() *buildVersion {
+	v := &buildVersion{Library: "unknown"}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return v
+	}
+	v.Go = info.GoVersion
+
+	for _, dep := range info.Deps {
+		if dep.Path != libraryPath {
+			continue
+		}
+		v.Library = dep.Version
+		if dep.Replace != nil {
+			v.Replace = dep.Replace.Path
+		}
+	}
+
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			v.Revision = setting.Value
+		case "vcs.time":
+			v.Time = setting.Value
+		case "vcs.modified":
+			v.Modified = setting.Value == "true"
+		}
+	}
+	return v
+}
+
+func (v *buildVersion) write(out io.Writer, mode *outputMode) {
+	if mode.json {
+		writeJSON(out, v)
+		return
+	}
+
+	fmt.Fprintf(out, "    phonenumbers: %s\n", v.Library)
+	if v.Replace != "" {
+		fmt.Fprintf(out, "     Replaced By: %s\n", v.Replace)
+	}
+	if v.Revision != "" {
+		modified := ""
+		if v.Modified {
+			modified = " (modified)"
+		}
+		fmt.Fprintf(out, "        Revision: %s%s\n", v.Revision, modified)
+	}
+	if v.Time != "" {
+		fmt.Fprintf(out, "      Build Date: %s\n", v.Time)
+	}
+	fmt.Fprintf(out, "              Go: %s\n", v.Go)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// redactor replaces phone numbers found in text with a mask
+type redactor struct {
+	region string
+	mask   string
+	keep   int
+}
+
+// redact returns the passed in text with every number the matcher finds in it replaced
+func (r *redactor) redact(text string) string {
+	matcher := phonenumbers.NewPhoneNumberMatcher(text, r.region)
+
+	var redacted strings.Builder
+	last := 0
+	for {
+		match, err := matcher.Next()
+		if err != nil {
+			break
+		}
+		redacted.WriteString(text[last:match.Start()])
+		redacted.WriteString(r.maskFor(match.RawString()))
+		last = match.End()
+	}
+
+	// nothing found, avoid copying the text
+	if last == 0 {
+		return text
+	}
+
+	redacted.WriteString(text[last:])
+	return redacted.String()
+}
+
+// maskFor returns the replacement for the passed in matched number, which is our mask followed by however many
+// of its trailing digits we've been asked to keep
+func (r *redactor) maskFor(raw string) string {
+	if r.keep <= 0 {
+		return r.mask
+	}
+	digits := phonenumbers.NormalizeDigitsOnly(raw)
+	if len(digits) <= r.keep {
+		return r.mask
+	}
+	return r.mask + digits[len(digits)-r.keep:]
+}
+
+// stream redacts each line read from in, writing it to out. Output is flushed whenever we've caught up with our
+// input so that we can sit in a pipeline following a log without holding lines back.
+func (r *redactor) stream(in io.Reader, out io.Writer) error {
+	reader := bufio.NewReader(in)
+	writer := bufio.NewWriter(out)
+	defer writer.Flush()
+
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			if _, werr := writer.WriteString(r.redact(line)); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if reader.Buffered() == 0 {
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func main() {
+	r := &redactor{}
+	flag.StringVar(&r.region, "region", phonenumbers.UNKNOWN_REGION, "two letter country to use for numbers not in international format, by default only numbers starting with + are redacted")
+	flag.StringVar(&r.mask, "mask", "[REDACTED]", "text to replace numbers with")
+	flag.IntVar(&r.keep, "keep", 0, "number of trailing digits of each number to keep after the mask")
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintln(out, "usage: phoneredact [flags] < input > output")
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "Copies stdin to stdout replacing any phone numbers found with a mask.")
+		fmt.Fprintln(out, "")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	r.region = strings.ToUpper(r.region)
+
+	if err := r.stream(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error redacting: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// maxBulkBytes is the largest request body /bulk accepts, around a million numbers
+const maxBulkBytes = 64 << 20
+
+// bulkSlots limits how many numbers are processed at once across every /bulk request, so that large batches
+// can't starve the single number endpoints of CPU
+var bulkSlots = make(chan struct{}, runtime.NumCPU())
+
+// bulkBodies limits how many /bulk requests can be in progress at once, as each holds its whole body in memory
+var bulkBodies = make(chan struct{}, 4)
+
+// bulkTimeout is how long a /bulk request has to send its body and read back its results, as a batch near
+// maxBulkBytes takes much longer either way than the server's timeouts allow for the single number endpoints
+var bulkTimeout = 10 * time.Minute
+
+type bulkRequest struct {
+	Number string `json:"number"`
+	Region string `json:"region"`
+}
+
+// bulkResult is the result for one line of a /bulk request, the same fields as /parse if the line could be parsed
+type bulkResult struct {
+	Number string `json:"number"`
+	*parseResponse
+	Error string `json:"error,omitempty"`
+}
+
+// handleBulk parses newline delimited JSON objects with a number and optional region, streaming back a line of
+// JSON for each in the same order. Lines are processed concurrently, limited by bulkSlots.
+func handleBulk(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// wait our turn rather than holding more bodies than bulkBodies allows in memory
+	select {
+	case bulkBodies <- struct{}{}:
+		defer func() { <-bulkBodies }()
+	case <-r.Context().Done():
+		return
+	}
+
+	// not every writer supports deadlines, in which case the server's timeouts still apply
+	deadline := time.Now().Add(bulkTimeout)
+	controller := http.NewResponseController(w)
+	_ = controller.SetReadDeadline(deadline)
+	_ = controller.SetWriteDeadline(deadline)
+
+	// HTTP/1.x doesn't let us read the request once we've started writing the response, so read it all first
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBulkBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body larger than %d bytes", maxBulkBytes))
+		} else {
+			writeError(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// results are queued in the order of their lines, which bounds how far ahead of the writer we can get
+	pending := make(chan chan *bulkResult, cap(bulkSlots))
+	go func() {
+		defer close(pending)
+
+		scanner := bufio.NewScanner(bytes.NewReader(body))
+		scanner.Buffer(make([]byte, 0, 4096), maxBulkBytes)
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+
+			// the scanner reuses its buffer for the next line while this one is still being processed
+			line = append([]byte(nil), line...)
+
+			select {
+			case bulkSlots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			result := make(chan *bulkResult, 1)
+			pending <- result
+			go func(line []byte) {
+				defer func() { <-bulkSlots }()
+				result <- processBulkLine(line)
+			}(line)
+		}
+	}()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	writer := bufio.NewWriter(w)
+	encoder := json.NewEncoder(writer)
+	for result := range pending {
+		if err := encoder.Encode(<-result); err != nil {
+			log.Printf("Error writing bulk response: %s", err)
+			cancel()
+
+			// let the lines already started finish so their slots are given back
+			for result := range pending {
+				<-result
+			}
+			return
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		log.Printf("Error writing bulk response: %s", err)
+	}
+}
+
+// processBulkLine parses the number in the passed in line of a /bulk request
+func processBulkLine(line []byte) *bulkResult {
+	request := &bulkRequest{}
+	if err := json.Unmarshal(line, request); err != nil {
+		return &bulkResult{Error: fmt.Sprintf("invalid JSON: %s", err)}
+	}
+	if request.Number == "" {
+		return &bulkResult{Error: "missing number"}
+	}
+
+	region := strings.ToUpper(request.Region)
+	num, err := phonenumbers.Parse(request.Number, region)
+	recordParse(region, err)
+	if err != nil {
+		return &bulkResult{Number: request.Number, Error: err.Error()}
+	}
+	return &bulkResult{Number: request.Number, parseResponse: newParseResponse(num)}
+}
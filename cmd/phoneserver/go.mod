@@ -1,15 +1,36 @@
 module github.com/nyaruka/phonenumbers/cmd/phoneserver
 
-go 1.19
+go 1.20
 
-replace github.com/nyaruka/phonenumbers => ../../
+replace (
+	github.com/nyaruka/phonenumbers => ../../
+	github.com/nyaruka/phonenumbers/carrierdata => ../../carrierdata
+	github.com/nyaruka/phonenumbers/geocodingdata => ../../geocodingdata
+	github.com/nyaruka/phonenumbers/timezonedata => ../../timezonedata
+)
 
 require (
 	github.com/aws/aws-lambda-go v1.13.1
 	github.com/nyaruka/phonenumbers v0.0.0-00010101000000-000000000000
+	github.com/nyaruka/phonenumbers/carrierdata v0.0.0-00010101000000-000000000000
+	github.com/nyaruka/phonenumbers/geocodingdata v0.0.0-00010101000000-000000000000
+	github.com/nyaruka/phonenumbers/timezonedata v0.0.0-00010101000000-000000000000
+	github.com/prometheus/client_golang v1.17.0
+	github.com/stretchr/testify v1.8.4
 )
 
 require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.4 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/prometheus/client_model v0.4.1-0.20230718164431-9a2bf3000d16 // indirect
+	github.com/prometheus/common v0.44.0 // indirect
+	github.com/prometheus/procfs v0.11.1 // indirect
+	golang.org/x/sys v0.11.0 // indirect
 	golang.org/x/text v0.12.0 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+var numberTypes = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.FIXED_LINE:           "FIXED_LINE",
+	phonenumbers.MOBILE:               "MOBILE",
+	phonenumbers.FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	phonenumbers.TOLL_FREE:            "TOLL_FREE",
+	phonenumbers.PREMIUM_RATE:         "PREMIUM_RATE",
+	phonenumbers.SHARED_COST:          "SHARED_COST",
+	phonenumbers.VOIP:                 "VOIP",
+	phonenumbers.PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	phonenumbers.PAGER:                "PAGER",
+	phonenumbers.UAN:                  "UAN",
+	phonenumbers.VOICEMAIL:            "VOICEMAIL",
+	phonenumbers.UNKNOWN:              "UNKNOWN",
+}
+
+var validationResults = map[phonenumbers.ValidationResult]string{
+	phonenumbers.IS_POSSIBLE:            "IS_POSSIBLE",
+	phonenumbers.INVALID_COUNTRY_CODE:   "INVALID_COUNTRY_CODE",
+	phonenumbers.TOO_SHORT:              "TOO_SHORT",
+	phonenumbers.TOO_LONG:               "TOO_LONG",
+	phonenumbers.IS_POSSIBLE_LOCAL_ONLY: "IS_POSSIBLE_LOCAL_ONLY",
+	phonenumbers.INVALID_LENGTH:         "INVALID_LENGTH",
+}
+
+var formats = map[string]phonenumbers.PhoneNumberFormat{
+	"e164":          phonenumbers.E164,
+	"national":      phonenumbers.NATIONAL,
+	"international": phonenumbers.INTERNATIONAL,
+	"rfc3966":       phonenumbers.RFC3966,
+}
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+type parseResponse struct {
+	E164           string `json:"e164"`
+	National       string `json:"national"`
+	International  string `json:"international"`
+	RFC3966        string `json:"rfc3966"`
+	CountryCode    int32  `json:"country_code"`
+	NationalNumber string `json:"national_number"`
+	Extension      string `json:"extension,omitempty"`
+	Region         string `json:"region"`
+	Type           string `json:"type"`
+	Valid          bool   `json:"valid"`
+	Possible       bool   `json:"possible"`
+}
+
+type formatResponse struct {
+	Format string `json:"format"`
+	Number string `json:"number"`
+}
+
+type validateResponse struct {
+	Valid    bool   `json:"valid"`
+	Possible bool   `json:"possible"`
+	Reason   string `json:"reason"`
+	Region   string `json:"region"`
+}
+
+type geocodeResponse struct {
+	Description string `json:"description"`
+}
+
+type carrierResponse struct {
+	Carrier string `json:"carrier"`
+}
+
+type timezonesResponse struct {
+	Timezones []string `json:"timezones"`
+}
+
+func handleParse(w http.ResponseWriter, r *http.Request) {
+	num := parseRequest(w, r)
+	if num == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newParseResponse(num))
+}
+
+// newParseResponse describes the passed in number for /parse and /bulk
+func newParseResponse(num *phonenumbers.PhoneNumber) *parseResponse {
+	return &parseResponse{
+		E164:           phonenumbers.Format(num, phonenumbers.E164),
+		National:       phonenumbers.Format(num, phonenumbers.NATIONAL),
+		International:  phonenumbers.Format(num, phonenumbers.INTERNATIONAL),
+		RFC3966:        phonenumbers.Format(num, phonenumbers.RFC3966),
+		CountryCode:    num.GetCountryCode(),
+		NationalNumber: strconv.FormatUint(num.GetNationalNumber(), 10),
+		Extension:      num.GetExtension(),
+		Region:         phonenumbers.GetRegionCodeForNumber(num),
+		Type:           numberTypes[phonenumbers.GetNumberType(num)],
+		Valid:          phonenumbers.IsValidNumber(num),
+		Possible:       phonenumbers.IsPossibleNumber(num),
+	}
+}
+
+func handleFormat(w http.ResponseWriter, r *http.Request) {
+	name := strings.ToLower(r.FormValue("format"))
+	if name == "" {
+		name = "e164"
+	}
+	format, valid := formats[name]
+	if !valid {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown format '%s', must be one of e164, national, international or rfc3966", name))
+		return
+	}
+
+	num := parseRequest(w, r)
+	if num == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &formatResponse{Format: name, Number: phonenumbers.Format(num, format)})
+}
+
+func handleValidate(w http.ResponseWriter, r *http.Request) {
+	num := parseRequest(w, r)
+	if num == nil {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &validateResponse{
+		Valid:    phonenumbers.IsValidNumber(num),
+		Possible: phonenumbers.IsPossibleNumber(num),
+		Reason:   validationResults[phonenumbers.IsPossibleNumberWithReason(num)],
+		Region:   phonenumbers.GetRegionCodeForNumber(num),
+	})
+}
+
+func handleGeocode(w http.ResponseWriter, r *http.Request) {
+	num := parseRequest(w, r)
+	if num == nil {
+		return
+	}
+
+	description, err := lookups.GetGeocodingForNumber(num, requestLang(r))
+	if err != nil {
+		writeLookupError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &geocodeResponse{Description: description})
+}
+
+func handleCarrier(w http.ResponseWriter, r *http.Request) {
+	num := parseRequest(w, r)
+	if num == nil {
+		return
+	}
+
+	carrier, err := lookups.GetCarrierForNumber(num, requestLang(r))
+	if err != nil {
+		writeLookupError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &carrierResponse{Carrier: carrier})
+}
+
+func handleTimezones(w http.ResponseWriter, r *http.Request) {
+	num := parseRequest(w, r)
+	if num == nil {
+		return
+	}
+
+	timezones, err := lookups.GetTimezonesForNumber(num)
+	if err != nil {
+		writeLookupError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &timezonesResponse{Timezones: timezones})
+}
+
+// parseRequest parses the number and optional region in the passed in request, which can be given as query
+// parameters or form values. If that fails an error response is written and nil is returned.
+func parseRequest(w http.ResponseWriter, r *http.Request) *phonenumbers.PhoneNumber {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return nil
+	}
+
+	number := r.FormValue("number")
+	if number == "" {
+		writeError(w, http.StatusBadRequest, "missing number")
+		return nil
+	}
+
+	region := strings.ToUpper(r.FormValue("region"))
+	num, err := phonenumbers.Parse(number, region)
+	recordParse(region, err)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	return num
+}
+
+// requestLang returns the language to use for geocoding and carrier names, defaulting to English
+func requestLang(r *http.Request) string {
+	if lang := r.FormValue("lang"); lang != "" {
+		return lang
+	}
+	return "en"
+}
+
+// writeLookupError writes the error from a data lookup, which can only fail if we weren't built with its data
+func writeLookupError(w http.ResponseWriter, err error) {
+	log.Printf("Error looking up data: %s", err)
+	writeError(w, http.StatusInternalServerError, err.Error())
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, &apiError{Error: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("Error writing response: %s", err)
+	}
+}
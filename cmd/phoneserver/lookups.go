@@ -0,0 +1,29 @@
+package main
+
+import "github.com/nyaruka/phonenumbers"
+
+// lookupService does the geocoding, carrier and timezone lookups of our endpoints, which is either the library
+// itself or a phonenumbers.LookupCache in front of it
+type lookupService interface {
+	GetGeocodingForNumber(number *phonenumbers.PhoneNumber, lang string) (string, error)
+	GetCarrierForNumber(number *phonenumbers.PhoneNumber, lang string) (string, error)
+	GetTimezonesForNumber(number *phonenumbers.PhoneNumber) ([]string, error)
+}
+
+// uncachedLookups looks up every number with the library
+type uncachedLookups struct{}
+
+func (uncachedLookups) GetGeocodingForNumber(number *phonenumbers.PhoneNumber, lang string) (string, error) {
+	return phonenumbers.GetGeocodingForNumber(number, lang)
+}
+
+func (uncachedLookups) GetCarrierForNumber(number *phonenumbers.PhoneNumber, lang string) (string, error) {
+	return phonenumbers.GetCarrierForNumber(number, lang)
+}
+
+func (uncachedLookups) GetTimezonesForNumber(number *phonenumbers.PhoneNumber) ([]string, error) {
+	return phonenumbers.GetTimezonesForNumber(number)
+}
+
+// lookups is replaced with a cache at startup if one is configured
+var lookups lookupService = uncachedLookups{}
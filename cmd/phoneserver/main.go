@@ -1,74 +1,143 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
+	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
-	"github.com/aws/aws-lambda-go/events"
-	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/nyaruka/phonenumbers"
+	_ "github.com/nyaruka/phonenumbers/carrierdata"
+	_ "github.com/nyaruka/phonenumbers/geocodingdata"
+	_ "github.com/nyaruka/phonenumbers/timezonedata"
 )
 
-var Version = "dev"
-
-type errorResponse struct {
-	Message string `json:"message"`
-	Error   string `json:"error"`
+func newMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/parse", instrument("parse", handleParse))
+	mux.HandleFunc("/format", instrument("format", handleFormat))
+	mux.HandleFunc("/validate", instrument("validate", handleValidate))
+	mux.HandleFunc("/geocode", instrument("geocode", handleGeocode))
+	mux.HandleFunc("/carrier", instrument("carrier", handleCarrier))
+	mux.HandleFunc("/timezones", instrument("timezones", handleTimezones))
+	mux.HandleFunc("/bulk", instrument("bulk", handleBulk))
+	mux.HandleFunc("/healthz", handleHealthz)
+	mux.HandleFunc("/readyz", handleReadyz)
+	mux.Handle("/metrics", handleMetrics())
+	return mux
 }
 
-type successResponse struct {
-	NationalNumber         uint64 `json:"national_number"`
-	CountryCode            int32  `json:"country_code"`
-	IsPossible             bool   `json:"is_possible"`
-	IsValid                bool   `json:"is_valid"`
-	InternationalFormatted string `json:"international_formatted"`
-	NationalFormatted      string `json:"national_formatted"`
-	Version                string `json:"version"`
-}
+func main() {
+	address := flag.String("address", envOrDefault("PHONESERVER_ADDRESS", ":8080"), "address to listen on")
+	preload := flag.String("preload", envOrDefault("PHONESERVER_PRELOAD", ""), "comma separated regions to preload metadata for at startup, or 'all'")
+	bulkWorkers := flag.Int("bulk-workers", envIntOrDefault("PHONESERVER_BULK_WORKERS", runtime.NumCPU()), "how many numbers can be processed at once across all /bulk requests")
+	bulkRequests := flag.Int("bulk-requests", envIntOrDefault("PHONESERVER_BULK_REQUESTS", 4), "how many /bulk requests can be in progress at once, each holding up to 64MB in memory")
+	flag.DurationVar(&bulkTimeout, "bulk-timeout", envDurationOrDefault("PHONESERVER_BULK_TIMEOUT", bulkTimeout), "how long a /bulk request has to send its body and read back its results")
+	lookupCacheTTL := flag.Duration("lookup-cache-ttl", envDurationOrDefault("PHONESERVER_LOOKUP_CACHE_TTL", 0), "how long to cache geocoding, carrier and timezone lookups for, zero to not cache them")
+	lookupCacheSize := flag.Int("lookup-cache-size", envIntOrDefault("PHONESERVER_LOOKUP_CACHE_SIZE", 10000), "how many geocoding, carrier and timezone lookups to cache")
+	flag.Parse()
 
-func writeResponse(status int, body interface{}) (events.APIGatewayProxyResponse, error) {
-	js, err := json.MarshalIndent(body, "", "    ")
-	if err != nil {
-		return events.APIGatewayProxyResponse{
-			StatusCode: 500,
-			Body:       err.Error(),
-		}, nil
+	if *bulkWorkers < 1 {
+		log.Fatalf("Invalid number of bulk workers: %d", *bulkWorkers)
 	}
+	bulkSlots = make(chan struct{}, *bulkWorkers)
 
-	return events.APIGatewayProxyResponse{
-		StatusCode: 200,
-		Body:       string(js),
-		Headers:    map[string]string{"Content-Type": "application/json"},
-	}, nil
-}
+	if *bulkRequests < 1 || bulkTimeout <= 0 {
+		log.Fatalf("Invalid number of bulk requests or bulk timeout: %d, %s", *bulkRequests, bulkTimeout)
+	}
+	bulkBodies = make(chan struct{}, *bulkRequests)
 
-func parse(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
-	phone := request.QueryStringParameters["phone"]
+	if *lookupCacheTTL < 0 || *lookupCacheSize < 1 {
+		log.Fatalf("Invalid lookup cache TTL or size: %s, %d", *lookupCacheTTL, *lookupCacheSize)
+	}
+	if *lookupCacheTTL > 0 {
+		lookups = phonenumbers.NewLookupCache(phonenumbers.LookupCacheOptions{TTL: *lookupCacheTTL, MaxEntries: *lookupCacheSize})
+	}
+
+	// when deployed as a Lambda function behind API Gateway we only support the original parse endpoint
+	if runningInLambda() {
+		startLambda()
+		return
+	}
 
-	// required phone number
-	if phone == "" {
-		return writeResponse(http.StatusBadRequest, errorResponse{"missing body", "missing 'phone' parameter"})
+	server := &http.Server{
+		Addr:              *address,
+		Handler:           newMux(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
 	}
 
-	// optional country code
-	country := request.QueryStringParameters["country"]
+	// shut down gracefully when we're asked to stop, letting in flight requests finish
+	stopped := make(chan struct{})
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		<-signals
 
-	metadata, err := phonenumbers.Parse(phone, country)
-	if err != nil {
-		return writeResponse(http.StatusBadRequest, errorResponse{"error parsing phone", err.Error()})
+		log.Println("Shutting down")
+		stopping.Store(true)
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("Error shutting down: %s", err)
+		}
+		close(stopped)
+	}()
+
+	// we don't report ourselves as ready until any preloading is done
+	go func() {
+		if *preload == "all" {
+			phonenumbers.PreloadAll()
+		} else if *preload != "" {
+			phonenumbers.Preload(strings.Split(strings.ToUpper(*preload), ",")...)
+		}
+		ready.Store(true)
+	}()
+
+	log.Printf("Listening on %s", *address)
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		log.Fatalf("Error listening: %s", err)
 	}
+	<-stopped
+}
 
-	return writeResponse(http.StatusOK, successResponse{
-		NationalNumber:         metadata.NationalNumber,
-		CountryCode:            metadata.CountryCode,
-		IsPossible:             phonenumbers.IsPossibleNumber(metadata),
-		IsValid:                phonenumbers.IsValidNumber(metadata),
-		NationalFormatted:      phonenumbers.Format(metadata, phonenumbers.NATIONAL),
-		InternationalFormatted: phonenumbers.Format(metadata, phonenumbers.INTERNATIONAL),
-		Version:                Version,
-	})
+// envOrDefault returns the value of the passed in environment variable, or def if it isn't set
+func envOrDefault(key string, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return def
 }
 
-func main() {
-	lambda.Start(parse)
+// envIntOrDefault returns the integer value of the passed in environment variable, or def if it isn't set
+func envIntOrDefault(key string, def int) int {
+	if value := os.Getenv(key); value != "" {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			log.Fatalf("Invalid value for %s: %s", key, value)
+		}
+		return i
+	}
+	return def
+}
+
+// envDurationOrDefault returns the duration value of the passed in environment variable, e.g. "5m", or def if it isn't set
+func envDurationOrDefault(key string, def time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid value for %s: %s", key, value)
+		}
+		return d
+	}
+	return def
 }
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// request makes a request to our mux, returning the recorded response
+func request(method, target string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if method == http.MethodPost && !strings.HasPrefix(target, "/bulk") {
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	w := httptest.NewRecorder()
+	newMux().ServeHTTP(w, r)
+	return w
+}
+
+func TestParse(t *testing.T) {
+	w := request(http.MethodGet, "/parse?number=6502530000&region=us", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	response := &parseResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), response))
+	assert.Equal(t, &parseResponse{
+		E164:           "+16502530000",
+		National:       "(650) 253-0000",
+		International:  "+1 650-253-0000",
+		RFC3966:        "tel:+1-650-253-0000",
+		CountryCode:    1,
+		NationalNumber: "6502530000",
+		Region:         "US",
+		Type:           "FIXED_LINE_OR_MOBILE",
+		Valid:          true,
+		Possible:       true,
+	}, response)
+
+	// numbers can also be posted as form values
+	w = request(http.MethodPost, "/parse", url.Values{"number": {"+44 20 7031 3000 ext. 12"}}.Encode())
+	assert.Equal(t, http.StatusOK, w.Code)
+	response = &parseResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), response))
+	assert.Equal(t, "+442070313000", response.E164)
+	assert.Equal(t, "12", response.Extension)
+	assert.Equal(t, "GB", response.Region)
+
+	tests := []struct {
+		method string
+		target string
+		status int
+		error  string
+	}{
+		{http.MethodGet, "/parse", http.StatusBadRequest, "missing number"},
+		{http.MethodGet, "/parse?number=6502530000", http.StatusBadRequest, "invalid country code"},
+		{http.MethodGet, "/parse?number=abc&region=US", http.StatusBadRequest, "the phone number supplied is not a number"},
+		{http.MethodDelete, "/parse?number=6502530000&region=US", http.StatusMethodNotAllowed, "method not allowed"},
+	}
+	for _, tc := range tests {
+		w := request(tc.method, tc.target, "")
+		assert.Equal(t, tc.status, w.Code, "status mismatch for %s %s", tc.method, tc.target)
+
+		apiErr := &apiError{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), apiErr))
+		assert.Equal(t, tc.error, apiErr.Error, "error mismatch for %s %s", tc.method, tc.target)
+	}
+}
+
+func TestBulk(t *testing.T) {
+	// enough lines that the scanner refills its buffer while earlier lines are still being processed
+	var body strings.Builder
+	expected := make([]string, 0, 5003)
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&body, `{"number": "650253%04d", "region": "US"}`+"\n", i)
+		expected = append(expected, fmt.Sprintf("+1650253%04d", i))
+	}
+	body.WriteString("\n  \n")
+	body.WriteString(`{"number": "020 7031 3000", "region": "gb"}` + "\n")
+	body.WriteString(`{"region": "GB"}` + "\n")
+	body.WriteString(`{"number": "abc", "region": "GB"}` + "\n")
+	body.WriteString("not json")
+	expected = append(expected, "+442070313000", "", "", "")
+
+	w := request(http.MethodPost, "/bulk", body.String())
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+	// the fields of each result we check, bulkResult itself can't be unmarshalled into
+	type bulkLine struct {
+		Number string `json:"number"`
+		E164   string `json:"e164"`
+		Error  string `json:"error"`
+	}
+
+	var results []*bulkLine
+	scanner := bufio.NewScanner(w.Body)
+	for scanner.Scan() {
+		result := &bulkLine{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), result))
+		results = append(results, result)
+	}
+	require.Len(t, results, len(expected))
+
+	for i, result := range results[:5001] {
+		require.Empty(t, result.Error, "unexpected error for line %d", i)
+		assert.Equal(t, expected[i], result.E164, "e164 mismatch for line %d", i)
+		if i < 5000 {
+			assert.Equal(t, fmt.Sprintf("650253%04d", i), result.Number, "number mismatch for line %d", i)
+		}
+	}
+	assert.Equal(t, "020 7031 3000", results[5000].Number)
+	assert.Equal(t, "missing number", results[5001].Error)
+	assert.Equal(t, "abc", results[5002].Number)
+	assert.Equal(t, "the phone number supplied is not a number", results[5002].Error)
+	assert.True(t, strings.HasPrefix(results[5003].Error, "invalid JSON: "))
+
+	w = request(http.MethodGet, "/bulk", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "POST", w.Header().Get("Allow"))
+}
+
+func TestBulkOutlivesServerTimeouts(t *testing.T) {
+	// a server whose timeouts have passed before any handler gets to write
+	server := httptest.NewUnstartedServer(newMux())
+	server.Config.WriteTimeout = time.Nanosecond
+	server.Start()
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/bulk", "application/x-ndjson", strings.NewReader(`{"number": "6502530000", "region": "US"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), `"e164":"+16502530000"`)
+}
+
+func TestMetrics(t *testing.T) {
+	request(http.MethodGet, "/parse?number=6502530000&region=US", "")
+	request(http.MethodGet, "/parse?number=6502530000&region=XX", "")
+
+	w := request(http.MethodGet, "/metrics", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	metrics := w.Body.String()
+	assert.Contains(t, metrics, `phoneserver_requests_total{code="200",endpoint="parse"}`)
+	assert.Contains(t, metrics, `phoneserver_requests_total{code="400",endpoint="parse"}`)
+	assert.Contains(t, metrics, `phoneserver_parses_total{region="US",result="ok"}`)
+	assert.Contains(t, metrics, `phoneserver_parses_total{region="unknown",result="error"}`)
+	assert.Contains(t, metrics, `phoneserver_request_duration_seconds_bucket{endpoint="parse"`)
+}
+
+func TestHealthzAndReadyz(t *testing.T) {
+	defer func() {
+		ready.Store(false)
+		stopping.Store(false)
+	}()
+
+	w := request(http.MethodGet, "/healthz", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ok\n", w.Body.String())
+
+	// not ready until we've started up
+	w = request(http.MethodGet, "/readyz", "")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "not ready\n", w.Body.String())
+
+	ready.Store(true)
+	w = request(http.MethodGet, "/readyz", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ok\n", w.Body.String())
+
+	// or once we've started shutting down
+	stopping.Store(true)
+	w = request(http.MethodGet, "/readyz", "")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "phoneserver_requests_total",
+		Help: "Number of requests handled, by endpoint and status code.",
+	}, []string{"endpoint", "code"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "phoneserver_request_duration_seconds",
+		Help:    "Time taken to handle requests, by endpoint.",
+		Buckets: []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"endpoint"})
+
+	parsesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "phoneserver_parses_total",
+		Help: "Number of numbers parsed, by requested region and whether parsing failed.",
+	}, []string{"region", "result"})
+)
+
+// ready is whether we've finished starting up, and stopping whether we've started shutting down, in which case we
+// stop reporting ourselves as ready so that load balancers stop sending us requests
+var ready, stopping atomic.Bool
+
+func init() {
+	registry.MustRegister(
+		requestsTotal,
+		requestDuration,
+		parsesTotal,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets handlers get at the deadlines and flushing of the underlying writer with http.ResponseController
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// instrument wraps the passed in handler to record request counts and latencies for the given endpoint
+func instrument(endpoint string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		handler(recorder, r)
+
+		requestsTotal.WithLabelValues(endpoint, strconv.Itoa(recorder.status)).Inc()
+		requestDuration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+	}
+}
+
+// recordParse records the outcome of parsing a number for the passed in region. Regions we don't support are
+// grouped together so that arbitrary input can't blow up the number of series we export.
+func recordParse(region string, err error) {
+	if region == "" {
+		region = "none"
+	} else if !phonenumbers.GetSupportedRegions()[region] {
+		region = "unknown"
+	}
+
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	parsesTotal.WithLabelValues(region, result).Inc()
+}
+
+func handleMetrics() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// handleHealthz reports that we are alive, which is true as long as we can respond at all
+func handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte("ok\n"))
+}
+
+// handleReadyz reports whether we are ready to receive traffic
+func handleReadyz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	if !ready.Load() || stopping.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("not ready\n"))
+		return
+	}
+	w.Write([]byte("ok\n"))
+}
@@ -0,0 +1,92 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"strings"
+	"syscall/js"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// main registers a global phonenumbers object with our functions and then waits forever, as the
+// functions can't be called once we've returned
+func main() {
+	js.Global().Set("phonenumbers", js.ValueOf(map[string]interface{}{
+		"parse":     js.FuncOf(parse),
+		"format":    js.FuncOf(formatNumber),
+		"isValid":   js.FuncOf(isValid),
+		"asYouType": js.FuncOf(asYouType),
+	}))
+	select {}
+}
+
+// arg returns the string argument at index i, or an empty string if it wasn't passed
+func arg(args []js.Value, i int) string {
+	if i >= len(args) || args[i].Type() != js.TypeString {
+		return ""
+	}
+	return args[i].String()
+}
+
+func errorResult(err error) map[string]interface{} {
+	return map[string]interface{}{"error": err.Error()}
+}
+
+// parse(number, region) returns an object describing the number, or one with an error
+func parse(this js.Value, args []js.Value) interface{} {
+	num, err := phonenumbers.Parse(arg(args, 0), strings.ToUpper(arg(args, 1)))
+	if err != nil {
+		return errorResult(err)
+	}
+	return describe(num)
+}
+
+// format(number, region, format) returns an object with the formatted number, or one with an error
+func formatNumber(this js.Value, args []js.Value) interface{} {
+	formatted, err := format(arg(args, 0), arg(args, 1), arg(args, 2))
+	if err != nil {
+		return errorResult(err)
+	}
+	return map[string]interface{}{"number": formatted}
+}
+
+// isValid(number, region) returns whether the number can be parsed and is valid
+func isValid(this js.Value, args []js.Value) interface{} {
+	num, err := phonenumbers.Parse(arg(args, 0), strings.ToUpper(arg(args, 1)))
+	return err == nil && phonenumbers.IsValidNumber(num)
+}
+
+// asYouType(region) returns a formatter object whose inputDigit(digit) method returns the number
+// formatted so far, which can be reset with clear() and must be released with release() once done.
+// If more than one character is passed to inputDigit they are each input in turn.
+func asYouType(this js.Value, args []js.Value) interface{} {
+	formatter := phonenumbers.GetAsYouTypeFormatter(strings.ToUpper(arg(args, 0)))
+
+	var funcs []js.Func
+	method := func(fn func(args []js.Value) interface{}) js.Func {
+		f := js.FuncOf(func(this js.Value, args []js.Value) interface{} { return fn(args) })
+		funcs = append(funcs, f)
+		return f
+	}
+
+	return map[string]interface{}{
+		"inputDigit": method(func(args []js.Value) interface{} {
+			formatted := ""
+			for _, c := range arg(args, 0) {
+				formatted = formatter.InputDigit(c)
+			}
+			return formatted
+		}),
+		"clear": method(func(args []js.Value) interface{} {
+			formatter.Clear()
+			return nil
+		}),
+		"release": method(func(args []js.Value) interface{} {
+			for _, f := range funcs {
+				f.Release()
+			}
+			return nil
+		}),
+	}
+}
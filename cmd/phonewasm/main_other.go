@@ -0,0 +1,13 @@
+//go:build !(js && wasm)
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Fprintln(os.Stderr, "phonewasm must be built for WebAssembly with GOOS=js GOARCH=wasm, see README.md")
+	os.Exit(1)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+var numberTypes = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.FIXED_LINE:           "FIXED_LINE",
+	phonenumbers.MOBILE:               "MOBILE",
+	phonenumbers.FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	phonenumbers.TOLL_FREE:            "TOLL_FREE",
+	phonenumbers.PREMIUM_RATE:         "PREMIUM_RATE",
+	phonenumbers.SHARED_COST:          "SHARED_COST",
+	phonenumbers.VOIP:                 "VOIP",
+	phonenumbers.PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	phonenumbers.PAGER:                "PAGER",
+	phonenumbers.UAN:                  "UAN",
+	phonenumbers.VOICEMAIL:            "VOICEMAIL",
+	phonenumbers.UNKNOWN:              "UNKNOWN",
+}
+
+var formats = map[string]phonenumbers.PhoneNumberFormat{
+	"e164":          phonenumbers.E164,
+	"national":      phonenumbers.NATIONAL,
+	"international": phonenumbers.INTERNATIONAL,
+	"rfc3966":       phonenumbers.RFC3966,
+}
+
+// describe returns the passed in number in each format along with its details, using the same
+// names as the /parse endpoint of phoneserver
+func describe(num *phonenumbers.PhoneNumber) map[string]interface{} {
+	return map[string]interface{}{
+		"e164":            phonenumbers.Format(num, phonenumbers.E164),
+		"national":        phonenumbers.Format(num, phonenumbers.NATIONAL),
+		"international":   phonenumbers.Format(num, phonenumbers.INTERNATIONAL),
+		"rfc3966":         phonenumbers.Format(num, phonenumbers.RFC3966),
+		"country_code":    int(num.GetCountryCode()),
+		"national_number": strconv.FormatUint(num.GetNationalNumber(), 10),
+		"extension":       num.GetExtension(),
+		"region":          phonenumbers.GetRegionCodeForNumber(num),
+		"type":            numberTypes[phonenumbers.GetNumberType(num)],
+		"valid":           phonenumbers.IsValidNumber(num),
+		"possible":        phonenumbers.IsPossibleNumber(num),
+	}
+}
+
+// format parses the passed in number and formats it in the named format, defaulting to E164
+func format(number, region, name string) (string, error) {
+	name = strings.ToLower(name)
+	if name == "" {
+		name = "e164"
+	}
+	numberFormat, valid := formats[name]
+	if !valid {
+		return "", fmt.Errorf("unknown format '%s', must be one of e164, national, international or rfc3966", name)
+	}
+
+	num, err := phonenumbers.Parse(number, strings.ToUpper(region))
+	if err != nil {
+		return "", err
+	}
+	return phonenumbers.Format(num, numberFormat), nil
+}
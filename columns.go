@@ -0,0 +1,131 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ParseStatus is the outcome of parsing one row with ParseColumns, standing in for the error Parse
+// would return so that failures don't need to be allocated or boxed per row
+type ParseStatus uint8
+
+const (
+	ParseOK ParseStatus = iota
+	ParseNotANumber
+	ParseInvalidCountryCode
+	ParseTooShortAfterIDD
+	ParseTooShortNSN
+	ParseTooLong
+)
+
+var parseStatusErrors = []error{
+	ParseOK:                 nil,
+	ParseNotANumber:         ErrNotANumber,
+	ParseInvalidCountryCode: ErrInvalidCountryCode,
+	ParseTooShortAfterIDD:   ErrTooShortAfterIDD,
+	ParseTooShortNSN:        ErrTooShortNSN,
+	ParseTooLong:            ErrTooLong,
+}
+
+// Err returns the error Parse returns for this status, without the position of any invalid
+// character, or nil for ParseOK
+func (s ParseStatus) Err() error {
+	if int(s) < len(parseStatusErrors) {
+		return parseStatusErrors[s]
+	}
+	return ErrNotANumber
+}
+
+// parseStatusFor returns the status for the passed in error returned by Parse
+func parseStatusFor(err error) ParseStatus {
+	if err == nil {
+		return ParseOK
+	}
+	for status, statusErr := range parseStatusErrors {
+		if statusErr != nil && errors.Is(err, statusErr) {
+			return ParseStatus(status)
+		}
+	}
+	return ParseNotANumber
+}
+
+// NumberColumns are the output columns filled in by ParseColumns. Each column which isn't nil must
+// be the same length as the input, and columns which are nil are skipped, so callers only pay for
+// what they use. Columns can be reused between batches.
+type NumberColumns struct {
+	E164   []string
+	Valid  []bool
+	Type   []PhoneNumberType
+	Region []string
+	Status []ParseStatus
+}
+
+// ParseColumns parses each of numbers with the region at the same index of regions, filling in the
+// same index of each of the output columns, for columnar pipelines such as those built on Arrow or
+// Parquet. regions can be nil if all numbers are in international format. The results are the same
+// as calling Parse, Format with E164, IsValidNumber, GetNumberType and GetRegionCodeForNumber for
+// each row, with rows which can't be parsed left empty, invalid and UNKNOWN, and their status set.
+//
+// Results are written straight into the columns, numbers are parsed into a PhoneNumber reused for
+// every row and failures are reported as a ParseStatus, so unlike ValidateBatch nothing is
+// allocated, boxed or kept per row beyond what parsing and validating it needs. Like ValidateBatch,
+// if workers is greater than one the rows are split between that many goroutines. An error is only
+// returned if the columns aren't the same length, in which case nothing is filled in.
+func ParseColumns(numbers []string, regions []string, out *NumberColumns, workers int) error {
+	size := len(numbers)
+	if regions != nil && len(regions) != size {
+		return fmt.Errorf("regions has %d rows, numbers has %d", len(regions), size)
+	}
+	for _, column := range []struct {
+		name string
+		size int
+		used bool
+	}{
+		{"E164", len(out.E164), out.E164 != nil},
+		{"Valid", len(out.Valid), out.Valid != nil},
+		{"Type", len(out.Type), out.Type != nil},
+		{"Region", len(out.Region), out.Region != nil},
+		{"Status", len(out.Status), out.Status != nil},
+	} {
+		if column.used && column.size != size {
+			return fmt.Errorf("%s column has %d rows, numbers has %d", column.name, column.size, size)
+		}
+	}
+
+	// we only need to work out region, type and validity if one of them is wanted
+	validate := out.Valid != nil || out.Type != nil || out.Region != nil
+
+	processBatch(size, workers, func(v *batchValidator, i int) {
+		region := UNKNOWN_REGION
+		if regions != nil {
+			region = regions[i]
+		}
+
+		result := BatchResult{Type: UNKNOWN}
+		err := ParseInto(numbers[i], region, v.number)
+		if err == nil {
+			if validate {
+				v.validate(v.number, &result)
+			}
+			if out.E164 != nil {
+				out.E164[i] = Format(v.number, E164)
+			}
+		} else if out.E164 != nil {
+			out.E164[i] = ""
+		}
+
+		if out.Valid != nil {
+			out.Valid[i] = result.Valid
+		}
+		if out.Type != nil {
+			out.Type[i] = result.Type
+		}
+		if out.Region != nil {
+			out.Region[i] = result.Region
+		}
+		if out.Status != nil {
+			out.Status[i] = parseStatusFor(err)
+		}
+	})
+	return nil
+}
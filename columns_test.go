@@ -0,0 +1,74 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseColumns(t *testing.T) {
+	numbers := append([]string{"6502530000", "020 7031 3000", "hello", "+1 650"}, batchNumbers...)
+	regions := make([]string, len(numbers))
+	regions[0], regions[1], regions[2], regions[3] = "US", "GB", "US", "US"
+
+	for _, workers := range []int{1, 4} {
+		out := &NumberColumns{
+			E164:   make([]string, len(numbers)),
+			Valid:  make([]bool, len(numbers)),
+			Type:   make([]PhoneNumberType, len(numbers)),
+			Region: make([]string, len(numbers)),
+			Status: make([]ParseStatus, len(numbers)),
+		}
+		assert.NoError(t, ParseColumns(numbers, regions, out, workers))
+
+		for i, input := range numbers {
+			number, err := Parse(input, regions[i])
+			assert.Equal(t, parseStatusFor(err), out.Status[i], "status mismatch for %s", input)
+			if err != nil {
+				assert.ErrorIs(t, err, out.Status[i].Err(), "error mismatch for %s", input)
+				assert.Equal(t, "", out.E164[i], "e164 mismatch for %s", input)
+				assert.False(t, out.Valid[i], "validity mismatch for %s", input)
+				assert.Equal(t, UNKNOWN, out.Type[i], "type mismatch for %s", input)
+				assert.Equal(t, "", out.Region[i], "region mismatch for %s", input)
+				continue
+			}
+
+			assert.Equal(t, Format(number, E164), out.E164[i], "e164 mismatch for %s", input)
+			assert.Equal(t, IsValidNumber(number), out.Valid[i], "validity mismatch for %s", input)
+			assert.Equal(t, GetNumberType(number), out.Type[i], "type mismatch for %s", input)
+			assert.Equal(t, GetRegionCodeForNumber(number), out.Region[i], "region mismatch for %s", input)
+		}
+	}
+
+	// columns which aren't wanted can be left out, and without regions numbers must be international
+	out := &NumberColumns{Status: make([]ParseStatus, 3)}
+	assert.NoError(t, ParseColumns([]string{"+16502530000", "6502530000", "+49 0"}, nil, out, 1))
+	assert.Equal(t, []ParseStatus{ParseOK, ParseInvalidCountryCode, ParseTooShortNSN}, out.Status)
+
+	// columns must all be the same length
+	assert.EqualError(t, ParseColumns([]string{"+16502530000"}, []string{}, out, 1), "regions has 0 rows, numbers has 1")
+	assert.EqualError(t, ParseColumns([]string{"+16502530000"}, nil, out, 1), "Status column has 3 rows, numbers has 1")
+
+	assert.Nil(t, ParseOK.Err())
+	assert.Equal(t, ErrTooLong, ParseTooLong.Err())
+}
+
+func BenchmarkParseColumns(b *testing.B) {
+	numbers := make([]string, 1000)
+	for i := range numbers {
+		numbers[i] = batchNumbers[i%len(batchNumbers)]
+	}
+	out := &NumberColumns{
+		Valid:  make([]bool, len(numbers)),
+		Type:   make([]PhoneNumberType, len(numbers)),
+		Region: make([]string, len(numbers)),
+		Status: make([]ParseStatus, len(numbers)),
+	}
+	ParseColumns(numbers, nil, out, 1)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParseColumns(numbers, nil, out, 1)
+	}
+}
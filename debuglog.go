@@ -0,0 +1,32 @@
+package phonenumbers
+
+import "sync/atomic"
+
+// debugLogger records notable decisions made while parsing, such as stripping an international or
+// national prefix, to help work out why a number was parsed the way it was. It's set by SetLogger,
+// which is only available from Go 1.21 as it takes a *slog.Logger, and there is none by default.
+type debugLogger struct {
+	enabled func() bool
+	log     func(msg string, args ...interface{})
+}
+
+// the current debugLogger, as a *debugLogger which is nil if logging is off
+var currentDebugLogger atomic.Value
+
+func setDebugLogger(logger *debugLogger) {
+	currentDebugLogger.Store(logger)
+}
+
+// debugLogging returns whether debug logging is on, which should be checked before calling logDebug
+// so that we don't pay for building its arguments when it isn't
+func debugLogging() bool {
+	logger, _ := currentDebugLogger.Load().(*debugLogger)
+	return logger != nil && logger.enabled()
+}
+
+// logDebug logs the passed in message with alternating keys and values, like slog.Logger.Debug
+func logDebug(msg string, args ...interface{}) {
+	if logger, _ := currentDebugLogger.Load().(*debugLogger); logger != nil {
+		logger.log(msg, args...)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build go1.21
+
+package phonenumbers
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SetLogger sets a logger to record notable decisions made while parsing at debug level, to help
+// investigate why a number was parsed the way it was. This includes stripping international and
+// national prefixes, metadata being loaded on first use and regular expressions being compiled.
+// Messages include the numbers being parsed, so think twice before turning this on in production.
+// Passing nil, the default, turns logging off. It's safe to call at any time.
+func SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		setDebugLogger(nil)
+		return
+	}
+	setDebugLogger(&debugLogger{
+		enabled: func() bool { return logger.Enabled(context.Background(), slog.LevelDebug) },
+		log:     func(msg string, args ...interface{}) { logger.Debug(msg, args...) },
+	})
+}
@@ -0,0 +1,60 @@
+//go:build go1.21
+
+package phonenumbers
+
+import (
+	"bytes"
+	"log/slog"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	SetLogger(slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+	defer SetLogger(nil)
+
+	// reset our metadata so that regions are loaded again
+	require.NoError(t, ResetMetadata())
+
+	_, err := Parse("011 44 20 7031 3000", "US")
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), `level=DEBUG msg="loaded metadata" region=US country_code=1`)
+	assert.Contains(t, out.String(), `level=DEBUG msg="loaded metadata" region=GB country_code=44`)
+	assert.Contains(t, out.String(), `level=DEBUG msg="stripped international prefix" number="011 44 20 7031 3000" prefix=011`)
+
+	out.Reset()
+	_, err = Parse("020 7031 3000", "GB")
+	require.NoError(t, err)
+	assert.Equal(t, "level=DEBUG msg=\"stripped national prefix\" number=02070313000 region=GB national_number=2070313000 carrier_code=\"\"\n", out.String())
+
+	out.Reset()
+	_, err = Parse("1 650 253 0000", "US")
+	require.NoError(t, err)
+	assert.Equal(t, "level=DEBUG msg=\"stripped country code without plus sign\" number=\"1 650 253 0000\" country_code=1\n", out.String())
+
+	out.Reset()
+	pattern := "^" + regexp.QuoteMeta(t.Name()) + "$"
+	regexFor(pattern)
+	regexFor(pattern)
+	assert.Equal(t, "level=DEBUG msg=\"compiled regex\" pattern=^TestSetLogger$\n", out.String())
+
+	// nothing is logged once the logger is removed, or if it isn't logging debug messages
+	SetLogger(slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	out.Reset()
+	Parse("020 7031 3000", "GB")
+	SetLogger(nil)
+	Parse("020 7031 3000", "GB")
+	assert.Equal(t, "", out.String())
+}
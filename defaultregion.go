@@ -0,0 +1,29 @@
+package phonenumbers
+
+import "sync/atomic"
+
+// the region used when parsing numbers without one, empty if there is none
+var defaultRegion atomic.Value
+
+// SetDefaultRegion sets a process wide default region, e.g. "US", used by Parse, ParseInto,
+// ParseAndKeepRawInput and their variants when they're called with an empty region, so that
+// applications which only deal with numbers from one region don't need to pass it everywhere.
+// Passing UNKNOWN_REGION explicitly still requires numbers to be in international format. It's
+// safe to call at any time, and calling it with an empty region removes the default.
+func SetDefaultRegion(region string) {
+	defaultRegion.Store(region)
+}
+
+// DefaultRegion returns the region set with SetDefaultRegion, or an empty string if none has been
+func DefaultRegion() string {
+	region, _ := defaultRegion.Load().(string)
+	return region
+}
+
+// regionOrDefault returns the passed in region, or the default region if it's empty
+func regionOrDefault(region string) string {
+	if region == "" {
+		return DefaultRegion()
+	}
+	return region
+}
@@ -0,0 +1,61 @@
+package phonenumbers
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRegion(t *testing.T) {
+	defer SetDefaultRegion("")
+
+	assert.Equal(t, "", DefaultRegion())
+	_, err := Parse("(650) 253-0000", "")
+	assert.Equal(t, ErrInvalidCountryCode, err)
+
+	SetDefaultRegion("US")
+	assert.Equal(t, "US", DefaultRegion())
+
+	number, err := Parse("(650) 253-0000", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "+16502530000", number.E164())
+
+	number, err = ParseAndKeepRawInput("(650) 253-0000", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "+16502530000", number.E164())
+
+	number = &PhoneNumber{}
+	assert.NoError(t, ParseInto("(650) 253-0000", "", number))
+	assert.Equal(t, "+16502530000", number.E164())
+
+	// regions passed in take precedence, including an explicit unknown region
+	number, err = Parse("020 7031 3000", "GB")
+	assert.NoError(t, err)
+	assert.Equal(t, "+442070313000", number.E164())
+	_, err = Parse("(650) 253-0000", UNKNOWN_REGION)
+	assert.Equal(t, ErrInvalidCountryCode, err)
+
+	// as do numbers in international format
+	number, err = Parse("+44 20 7031 3000", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "+442070313000", number.E164())
+
+	// and it can be changed while other goroutines are parsing
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				Parse("(650) 253-0000", "")
+			}
+		}()
+	}
+	SetDefaultRegion("CA")
+	SetDefaultRegion("")
+	wg.Wait()
+
+	_, err = Parse("(650) 253-0000", "")
+	assert.Equal(t, ErrInvalidCountryCode, err)
+}
@@ -0,0 +1,135 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// digitPrefixes is the set of two digit prefixes, 00 to 99, that a number matching a national
+// number pattern can start with. Bit n is set if the prefix with value n is possible.
+type digitPrefixes [2]uint64
+
+func (p *digitPrefixes) set(prefix int) {
+	p[prefix/64] |= 1 << (prefix % 64)
+}
+
+// allows returns whether the passed in national number could match the pattern these prefixes
+// were built from, numbers shorter than two digits or containing non digits are always allowed
+func (p *digitPrefixes) allows(nationalNumber string) bool {
+	if len(nationalNumber) < 2 || !isASCIIDigit(nationalNumber[0]) || !isASCIIDigit(nationalNumber[1]) {
+		return true
+	}
+	prefix := int(nationalNumber[0]-'0')*10 + int(nationalNumber[1]-'0')
+	return p[prefix/64]&(1<<(prefix%64)) != 0
+}
+
+// buildDigitPrefixes works out which two digit prefixes a string strictly matching the passed in
+// pattern can start with, by running each prefix through the compiled pattern's program. Empty
+// width assertions are always treated as satisfied, so the result may include prefixes that can't
+// actually match but never excludes one that can.
+func buildDigitPrefixes(pattern string) (digitPrefixes, error) {
+	var prefixes digitPrefixes
+
+	prog, err := compilePattern(pattern)
+	if err != nil {
+		return prefixes, err
+	}
+
+	start := prog.start()
+	for prefix := 0; prefix < 100; prefix++ {
+		states := prog.step(start, rune('0'+prefix/10))
+		states = prog.step(states, rune('0'+prefix%10))
+
+		// anything still running, or that matched on exactly these two digits, means the prefix is possible
+		if len(states) > 0 {
+			prefixes.set(prefix)
+		}
+	}
+	return prefixes, nil
+}
+
+// BuildDigitPrefixData builds the table of which two digit prefixes are possible for each
+// national number pattern in the passed in metadata, used by buildmetadata to generate
+// digitprefixes_bin.go. The table is a sequence of entries sorted by pattern, each made up of
+// the length of the pattern as a uvarint, the pattern and then the prefix bits as two little
+// endian uint64s.
+func BuildDigitPrefixData(metadataCollection *PhoneMetadataCollection) ([]byte, error) {
+	patterns := make(map[string]bool)
+	for _, metadata := range metadataCollection.GetMetadata() {
+		for _, desc := range metadata.descs() {
+			if pattern := desc.GetNationalNumberPattern(); pattern != "" {
+				patterns[pattern] = true
+			}
+		}
+	}
+
+	sorted := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		sorted = append(sorted, pattern)
+	}
+	sort.Strings(sorted)
+
+	data := &bytes.Buffer{}
+	length := make([]byte, binary.MaxVarintLen64)
+	for _, pattern := range sorted {
+		prefixes, err := buildDigitPrefixes(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error building prefixes for pattern %s: %w", pattern, err)
+		}
+
+		data.Write(length[:binary.PutUvarint(length, uint64(len(pattern)))])
+		data.WriteString(pattern)
+		binary.Write(data, binary.LittleEndian, prefixes)
+	}
+	return data.Bytes(), nil
+}
+
+func loadDigitPrefixMap(data string) (map[string]*digitPrefixes, error) {
+	rawBytes, err := decodeUnzipString(data)
+	if err != nil {
+		return nil, err
+	}
+	return readDigitPrefixMap(rawBytes)
+}
+
+// readDigitPrefixMap reads the table written by BuildDigitPrefixData
+func readDigitPrefixMap(rawBytes []byte) (map[string]*digitPrefixes, error) {
+	reader := bytes.NewReader(rawBytes)
+
+	prefixMap := make(map[string]*digitPrefixes)
+	for reader.Len() > 0 {
+		length, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(reader.Len()) {
+			return nil, fmt.Errorf("unable to read pattern: need %d bytes, have %d", length, reader.Len())
+		}
+		pattern := make([]byte, length)
+		reader.Read(pattern)
+		prefixes := &digitPrefixes{}
+		if err := binary.Read(reader, binary.LittleEndian, prefixes); err != nil {
+			return nil, err
+		}
+		prefixMap[string(pattern)] = prefixes
+	}
+	return prefixMap, nil
+}
+
+var (
+	digitPrefixMap     map[string]*digitPrefixes
+	digitPrefixMapOnce sync.Once
+)
+
+// digitPrefixesFor returns the possible two digit prefixes for the passed in national number
+// pattern, or nil if the pattern isn't part of our built in metadata
+func digitPrefixesFor(pattern string) *digitPrefixes {
+	digitPrefixMapOnce.Do(func() {
+		// without the table every number is checked against its patterns, so it's safe to ignore errors
+		digitPrefixMap, _ = loadDigitPrefixMap(digitPrefixData)
+	})
+	return digitPrefixMap[pattern]
+}
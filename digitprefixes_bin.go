@@ -0,0 +1,3 @@
+package phonenumbers
+
+var digitPrefixData = "H4sIAAAAAAAA/+y9fZBm2VkfdnpmtDOzM9oZSWhXrFY7I2kl7QvT6Hzfe5eVuoURyC7HFpgArjtvWOJJhYCJlLJjq5jTTEvCZSexrbVdFQyWWYEx/yQFTiqhcOwwjUmBEyclOeWqgMsRg6EAB9A2XiU76232Tf2e83vOvbe7h49UykmqsrO70/2+5557Pp+P3/M1PL3zTP3X+xLCypaUUnl655muK32/unlrdfNWiWPu1qubt26nvTKmvL5563beM2bXnDk42uxcN1vGGPN07cZaG0tk496ONsmTca9Em+QHab05a0x9znyNPji67WFdennn7bhXRrcdsjyd94q1N2/ddlY+HTCC/ob85utv3d7W0ab9c8UY8352O/rtbl26MdY5lCwf2u24Ls6W0ct8Vjo7NJYXHplDdiaDNI/V7pwt3q360q9kQN2eOXcdX7fZ/Am2k0UMYxrWJY79sC755q3SjR1mONpehoLFtzdv4a3DGkMo/umdZ+LNW6XXAT298wwW/+YtNLdcV7Nv3vAXzNnDzWbrf8dUc52qG1Lx6CWMTl7r8PY0hm5d8uhkFcKwLn1acQfv391/xzveceETdQpfx25Gu+3TusROR+RHvx3zuoTRbffo2G9n6Rmrha5l30a33a1XaOTXngP99MH3bu1zfYwxz2/pK3wv3fXrMvBwlTxal9FTh0nbDj+OTnan9PKRC9sdTwhPBWaNlRntdsID08d+DNsyZI+/MOS4LmPa7taj307rVezrCI8+9ee/5YnDw83m1Q2W8j0coOyBrHpnrUW33V4ZQ+552szRlYONMYd3zEaeO+DMsFzZl65b2RJixIaViDsTo/QSZEtDLHEMqVuvOjlG9VM8O9rtsL55qySZUY+Ns25der8qAZPFrPp1GX3APHLdjZByv16ViOfnX9S2CWdcevIJ29eNPsoX/Sh/86gZc/gD54+2dKvMp89OM8KJwNbI2bLoJI4WhxbjdCFh5WV8GH6SNSpxmoHc606+k5ad7qbsGQ6ZdLriAmGzk8PdwH+jDXh8dD52HIG+eXvqvR+tnD48NlpZEWnsRitnVUbTcxixk2F4UgKcqdH169GFhJ/96CLeEEa7HTHtgoVCb53c5AGfr1cr7Fmuoxi7Hue0jh/Xui5P/R1jko+2E1rJltY1Q5cchJdXkrit2Ed9PY9DBxqx4iIZkCecuxfvgZKav3GRu2WtHBgcslCSLX2/uB0ySKGBjnvrpf3Qr0rAoYvrkkCTssMZ411069GCfkQMJZVhlNHXFURXrnS1Y3yA++/wUhcw5JLqZ1lPjywdPnRos5ZOfFeG0ee1rGmwJQ3scSVjRRMnL8VRCblbl9CX6EryJdv6kJBWTDXIuuIypFz6oMet43HAPAJ+xQmNHe6HTSBjHQizw4kd0NeqPohX+4hDBzIa6yC60eEq1Wl0mDCexcGvD2EBxzCs8Zq6l/ikG23td2C/bnRe5iLrkGoTHC1MKIEu6rrKIuCUZJK/+az0jLnaD9raETNz0kXOq9IJ4cAC4em65PgPEy1DqsTF18nhSGMnUqfHUxYO6+ZHL8OVpYht5TJEBfZBBpLXxbsSxpi5XAP3NpRBbhNmlXj2ceZGuXoDRtcpIemUkIB3lyyz6UfbgVQItZN5DBiHl4HglsjrtkFbZXdAAKIsmh7b2Zysj9g0HKptz43A3MaYZe/Qs0OHsjBD3T/Zz260Tj4ERbh5S0+087K22G0/enwdh3UUpggajDn7sTE5XNWEA8djiRvX6bEUXgU2BNqi756ugwdBTMrZViUMOPRudFhGTHwMsZEOr7Pme5zwGO9LjLjPWWQ9nkt0DObMExfrGSypwyJnjtyPVmbPowB6YUs3etnEHpcZJ78Sq7hnzFVzxpx77gt3zW8/AuFPmaUr0a9E+impx2VYk9Piy1GEkNE6IYIlQRxMKnAIH3PYWIgIZXC1G7D/G3wepw28kRJLxL32cmtjGgOYSUkiF+BYZTtG8MTOjz7gklHsARuRyy/7FPFcj9MKDsOjAOkICzZ60Fh5V8ZZDLJaQ+W6qza+dAOS1713mpdfffmF1333xy4aY65xPUJJcZVK8IOMEa/gAu6breu7yprNDbYXdoHDBblIb1QIGF/qJsZ+7+5d8xl91vw7+nDgsFWM2KZMJ6P3YFbY0oiZk7P1YM3ei1wUPXdXFrv0sgpkMFWc2PmWo5d+6k3fWWWKZ+tbwVO9bAguLPYBS1TfUHIkM8eFrrxP528Otn74ObNlrpuHjDGxzj8KfQd3qyOO2C07jSqmomqMjMgY85w5SNcvyIj+Bk8hNhVDcXG9Em5Osue6QGlHJVAHdQbvUCUCIyyuXmg/6B2TfYfMZ/UCFBIiWWdwCxwgWWbIzLLKOHM4jBRhzdF5Y5782C9+6rHPY6zmMxysXIJMsogVl6eGSZ0YKS1tDzO1pqMwBZ68TcnIq9CBNuFG1beE4t7Oe0KrwdfkRQ70CAsk1zuPDkcC9w80uqdmaET/+u3NZnMkEvHPUX5EL6PDnQpyomXg0CbyaIOqe2WwsvXoKt6gYE8KFCoZgMIGAcUrBxb5nuSXaoqQ/4hZZiwNqHeQkVonKwX2CBEBl6OEifs4XY/6KowScmJl69tBHvKBIjaINa+OdRgcVgYXEO/Z9nJs8ZxDN3gbFnzVmB0G7dIaowBDxdcUNUlIIfAmGYALdQCUbNy6kn+Hha/PRkri9TLZ7UAuGOYT8Ng+DGSiWY7v9VUmg0aEjtAthcAx92syP8xSxXOhXvdfxFZvdsxmc36iXqPHRFViCb6koHrm4c6WuX6omvlXLGCCYa+AlVGvwsaPlXH1cpMTFPsd4gVHOFjvrI/31qr2L2/Eww593HD+BBjwqDzjemhbBX/hgby3Zc4pVZQ/72nN0DUWdcScjvU/PKB76R0c2+rIjVlgA9vaDKf+dtijtoqlpoLpVCW6nU+85E3ydLvap7b5UrRJHP1sbcLedSgMm82VA7Z8s7SMg8oZdSwVCDhqqIesWy6eOqY22zUHj+rU8OettRmkQ3AAuZW6xrvmcAdtDjZbstjyYlkqjLJXerelcIvstLFsJecBp7ru2aTfC5cVwkHy0zqor7nB10jbRNZUhmFgL0GwI3x8O84e3py9oEuNAybj1BHqbIV7mH9X2nQ9OSNuFW8TLiXVNxHjuA28WWAJN28pgYsRkkLEvZQuQg9RGBRkIMZhPtBefAb/v473NlmIWjBEYx3oF9DKHL2se/gWPiBsIbXjHPdek4OAO1WPxJcTLhvWpZ/Ub5xHHPxVSRg49c+js4tB1V1taFw92nFvc7atq7zhs+c57bZYiTQc9KjpbVV0zr7RsLAqedJasC/g2ZUc14OU14vWyclYJyUBv5YIqhxI+r3l2zpbH8mrEuXDFDtbKa0lolJ6sCnZMx8FAvXUd2PnZ73hOXTpRxtBwmLnq1CAnQVlgDSbKrcWUHWSENI0UiGyJULgrsdTuHHAq3Q+rvQYLt6XII/JaGXwUTVe/gYaXqUXDhG9UnDVMa/4Fo7Uxs7WlUs6KRmd9XhjGTJPQaxqJ/q9HW+kvdlq4pO9FaWmxaywhlAVrOM5DHurspg45uptiQOvXuD8p23EtCNQLFyA6TQlpweoXo8ZDAXRYgUZMVCilC6dEhEeuqgCsaqA+LqkEfyYOIYX/HApN8tjkOgohYLp1sFB4ejItYHJYloAMNAtVHyc2SgAuWq9cc3+REbA0wXAPJW8XgcNRku9Ywxp7acd8Wm2jmhCaRVwjlw2HzmvgDfJ/NC5DF1kJV8pC+aQZAr6ZjwgzTFkCuaH/9OOKYebzZXvFjLwd89NFzyA3mJHRkyogUW8VoCdINHJRuAXcNvgSh/lITyW8hr3z+EqQUCzukhU2UOdvFIlOX5VNxH4vB5OwWH0OAbiVT7IW6EyD4Cq6ws77hJtHNTpbcGKi9rnc4G+y2tIwh94O4Q6FijrqyY1FCLzo4M86l3xPQAAIUK1eRi4uKODvi7fB8Xp0D8UFPyKjWgCcpLPSQu4ptCEwUegvYha0fA4L+BkGfyqpF4pL1cDlLMMvGWJ1oe0AAo5BWgLeYZ0liD2ASiMVTasTE3eCA1IyB3FEL4LcnPiN0HuBUh/T0Tz3oZS5TP/ErziHTxJNhfXYeHCABwFJF4XxzxrDhpzJANzk5jtmowNoCDg+OjC5j3zbH3mgFzy75zVhyEhjgIP+DHMQKEoJhRgXrjniXoFwIOeMMMwutArsiwo9HbXUGjVTKgd+NmyTFA1rnsddQhojudFboCekUeb61u3Fe63FsPbFr0sEj/kiqe64qrqdVRJS9f0VKvIV6Y2E2SIuJWEd/JMFxJ8uqdsYuuhtUPx2MISSGlBUXqaBQScaczG1iedahN4c8jEkJLMM1q8OMr4AZJT0bN8c31saKROFM7v+/TZ3c37fhsH5vsvcQcduYUC7liXINvBVcMXlabkYZS3dbQDrHivklo85LxDbhua8RC/YVWTMM6VHnqZoxWNugBbqTOrExtdXZYxQanynF+1Q2CG9VRgaiRbGA3eAbOGp6khtMNZRT6ftC9Mrp4BAbGa2aKjmAhpUjSCdmyaUSVAbiyjr+NKRLCsGvgoXIp81sB8Sp/NPIetrPMGfiqWl7l9KHCSfAZTwihnZgmFU4IAOE0br0acMgYMri4RACIBgxPtOnl0rX/2ScRKDzkVctV0Q6b5KtB8xbXK+orFSOu0Br2ucuZxXNGhKmtUoMEgms0rQaXFd5m2UmDpvPUYpCCpejGweT1tUFxNxR31FQ2d411Ht+30cMbcY6HFK8VEADFXzBJ7w50BHevbzLEPngjfXKwjo46wsOEudzKGVUkRUCxOW5eVm1REFi0VYSBKDMkCElXi6lFoaVY43GtIV2RH6DAA0QIe0UGkqo9FJQMZw1d5To5yxJkA3YSwDkW1YkpNfoc1icp8A44xhlVxUclY8pgeUBUyfXAx9I6hY6YQeUuXtV+fif3lPhOTt5nMsxsjbtyq+AGaHBiIwJygkLIosWS3KgmLlOAJQMNGF+vCpUTUvU6fwjx6n591cE+8MPqSxU+jJEpVwHBg7OmhEWXK4L6rMk0J6A08O+EI+Cp+REwz9Uqp+R9Xq0/QMTDzkPog9DPzq4GiG/RFLAMolAdoigOzKkPKTs2V919wyqVVO3e0gAFYKBAt4XsCSBRSDPZQ9VTzc8rkKzbw2lZ72isvobGfRELkdiG22GkRbTsRbfH1ILow5U4cadj11wo/0oouxBJ6FVFWuVuNrWK1hGc32zYaUTz11DzCGBs1bVKezwsjNu2JqRmxMQFaf/ESNB3xiDRQROD+Z+9ujl56cWdz9BIY33dyOfQmqgQC+04lZr3qvF4pH/VUqBZi7sHJBbDLGXpf0qh82FWmruYEu7v1upvPN7nrL5/h60McGoYaB0D4EFpSxGQ8LiE0UShuIa6lqWtmBaEw+FltN0CSSuxgDolDmlwksOs2DmrOEYCaPDir+VTNBupJUB/G1thebppLffGUZII7BtILe4C0ScINRpYo0wjvWMXBzwbkAXqktcPH0o354kf++gub73h58/JPyvr8KV2e0QF/xYkSZHhm2XXko8la+R1cHvuChYTPEPah71dwlRnW+E8VIcJtjjb4XqdirstFuf4r9E3623pjEuHjVBVBvL+t5aSpQlLCTeBnHV5SjxT6EGMFbflhdnwp9raHbTt2YzP+e8pDlso7DjwMVTxa+7+kp8qoSxne2AF16DvZZhxycMOueTNgzc2/2jFH+pgxL+p8R1tH40A61RulKtgwuigb7HTwNkRKNLQ/wS6EEz2IRqACO8HQAqs6jgvkbFkuFbCkCV+8HUDgOpAj6GiJ5ER8TVRMD7zqjjd9Jl1EDJ0DpyweKODoAuQ985//L7/6Lb8OdBfTN+a/fwgLYOmI0g1V2oWAMQcDt2m69rJUp35ZxvY4NwmbEGHKWZcuCYLf3ejrVbOChnZ7Ar/Jx7JlztJEmFauzB6VrywmUYZEI5TCASqLBh5tsWtzbV1aw7XAeRKrKi9WVQ2XJkn7QLlXaJpclzCsHdBVkQSskuTOlaFfrbhUisQ3/BPDe3rnGQxvb7Wj63I77a3az3nvuL/O7bwHtPR22JNH5X+3/d5qZ7UzyfKO4mBIJQ7q/QFsR0a93Vy6tJ2XNRCfCrXUQjIZKQeKgAmJQY5UoIAbwdtKakoHxGc5RCD6vcVBJMWUqw/2UTVND8PZACJEddEV6L+y1D5WfZH8TXgvpBZxjwNFCbk/JjWDVefKqqtMROhAmGedX1/payNFA09j7dVR5hyDeoXAOgbKk9bL3cun7p6f716c7V46uXuJu3cj7a1W5tzh3/vRL7zyqV+++2MvPmyMwSVxjk/T0xADDMNqws8JhMuVCbgei30UPRmLIEpL8wyIN4a9me1F/gVVco5joj8cFt+WnGncJq8DXHo73+j3DCwY1bDVDC7O4SKnbrJlmQtqDxILgLnOVj0vOzxsYSLBVoqVTh6AKY/WgqsE0ugJm/fMVR10lQ2+TRrwlFoP2gd2LcKNXFlfhglLpM3XgfKBJ0zOpoIMggxH/AC/ChwPmQ49kd/ymU/d+aGf+6uPmIfeckEnjM6db06h3Wx45q8/ovhSIHcdLNZ2FHOuIgx5z6hrL1cSBhIXewfYkWa6ZsQD5ct701kzu0aWVu1tb5BnSwAbINPaNeZD2j89jF0So1PnWtdhzzQH2q1mpHTJpjL6jmMoyarbg1isBGs3+zC8nTMHmy3Z4f9SmCLQBkruPPSKd1KOUjdOeM3ORKuero/gneq3GEq0auBqCKw87qOw3dEFOqZAfElq5OFQRZzBTDN9JuWogW4ARKPPxhmd/KEaWl3V1Cb2F1szZX8wg9KKC3bbFhMtv7hTDZxVlxBFpLeENVuzspTy1e/afHGD7fwp7ukVeXjmC97MyXWnYKl2g5zkjhZ1kI0CcWYa+oX9HWPu3THVHAdrnRsKQX4sNHfWmPt4+UY9jr+UZnzfbPvtpD/BURjz9y+qfiBrj9sH9KHa2sjMvfLKMBKai+Pkc9Vcrqh6OkIjrqEHjtcRZwiLdPSDP/Zd//N/9rfu3v/8q5doyXVALhsIQ80HYrP6G3VAytQUfO63dzbPHm6Z98sUDPVEvB/yqvhU01MgYNsgJOe8ah633Z75tSvmuavGvI6rcHeLXQAmwGzBngL8WThZOnHg4wxZHPOEVAiaFfADSDs4auqapT6vJwYP68IOULRhfZzrh8r1ywBWbi1RV4zHgyZjWWDnKsES2pZL5Jwr0ZIQxT3z9A997x/2H3rX98R/Kk5gX6FKgyveaXSBKjyObhuQBfj4hbfjqcMd84npeowqVFCHqpt3+JETDhEQcqsGKno6ZF/+Vp/yoDX3fv2FO+b+r14zr71yXq9VBULwiCoEEM4EZs6gMB3VNHPw6mZz/qnDC3ypkErR1LqOR8LQ3EyCLBS+GfE7PffNGUIamR/YUk7jVnPHQNi9cAKxIaIEiOyl2GZM1R+op7MNyJgPXdPL8R14UFOIQSt8oGi6LQ6vAkDBIgjVHSLaNs8U7ouNbbwv7pjNzot3z5nfwI3+IHv3xP3pf05cUZhg42cMDXEVWAJr1Tgbc7R5cbP55CPGvHvabJribg9T/Mx8vSa/HJmdV1oCcQJc4Ha+MeyB4EImAKfrq6iyY2aPf6g9LjbSodpIO5pIKbESvKwyXbMPQRDY1nfumaPP7ux88aV/FDd//6WL9C5xJTcvHCFTFNSEw5qjhQOMeqPTo4YBPdNssSrGvE1bTm1Jw6C4HG/9XWSYdPFxdDrvqC5B4VH/FNF/2BTHo84ev8QbnRqeLWmuWJ0J9irsi5sS1ys8kbDOv3H7z26u6eUw5ht0KA6WSSFp1leJOjckesiqegNXC0HduISm2UjxPPMQgw0dPvWr+gZjzL+hLwnBlhgsbIfe9t1qBn2I9AqCuh26SlCxhzgvoKZBjq6e9Je/74XN7fPa+89uafcRl2udyxj79VCSLTnQMy+XCHdYmNrQOyJgogwIDpdCifIaMI3Dt8kKfppoL4QHWYl96QZQ8KjAho2iAocSBoBL6pxha5QWHNZK16vRCqR0tHndFTcUn0oXVvBS9GkS2OHa9rlvuvniH/7xP/4nf1ZY/+faxCBT4a4xbkSkV1AGyLGERXDXvHoSY25NwYJ9pWuWeX0m8ca3RkJrsNTgpD1R10w61NH7GweiftsUYbp/k+9zqnhH3TmQxD6qDGwOX9j6kVd+5e+dzSLUaEiQA3RIW53E1uHmJ5VwBloChPJp/JQxn9kxW+eaHP6d2lWeOw7RAidmAXKZ0jv4BJRsoXelmQhJC782XUnEAxlD68Bl3Fm4Qd957ZXHVFb8Nn073XcbmoVzDKZQbXgKK3qi+oGu6fguqIsRbixWLWHXV4DuYbhRgmzuPXLWTszrH+oZqb4KSdWzROk363FFMAEIN6wHaj2Bq3IYSvY0mATpIzYh3bemSU60OIKXPoPCJnIwEA0KYlEEMZADuHUIaYZsyFlJ31nHF1Ug9atGNHYf/ZkP63Ya8yM6M6wHOs1clwGBIooGugk3TGJbrCsMdb539c3VNbhjeAzEWwWmgWtEEkn5G0sN/1bGOUVFMoQsUa6Te2czPeIxbvO37x/823/kjccoaoK/+KqEmErse5EHAM7EAuwxExaPzYIOE33H1ZY4ii6DRDR53Tx8znzrVz/80A+fUzATayNGoaUZAh1FHmrQFPOOM5Oyav4gHwRZwrZl0STsSs59v56+4cfdrK1nWx3S577h6z745tft/rEPyl2+2XquxLo5IZAcwVSfaZMUva+kqFcTm+ZW4MpQDlW5dX7GUf7O28xHPnC4Yx4WjqLRQdjAFtWWNUwHwrWcSi6LLsXzO8b88iVdin+sB0zknfKg8I7mriKHDDEbDWlVv0kN/CBjELt5IYDb0esISFfIjT4ipOn3FhHi6LP1gHgQLPDKCarTQkFe0lCQ724zpMLjaZgGAaT/0uggoDWnjDhNlpQqU+lG2wg3ge0WSSroRvUDouziWvix7Nq9u0eEO/DvJ6YVR2BpBt+FWa2uF/r2mf6n+JBXvDbG5xhMay6uFPXngewcehS+RlOBtdk24l0r5Rv3Xti81gxOopR4umYmkg8cX0R6lGD9dJGuTiEzetwt3fd4RuhjB9e2du4tzwbEq9hOqK2/yIc4QV5PkGIDVw/Onp007yflZbijDXQDSmSpiKA53ZWPVO33ttBQ3MMNTTcEDaH+nDmjYqt39HCKRH/cRHSuG7Np90VUKQ+qlaZFwZo0SEmEfu+bO0H0ogD1nmY/7NTYsAVMc5eno/JxwBW++gBBF1dpOvI9gGQq0PIIzY2uDWMaA6EU7xUW4XvkmwpPQuPzvrGLphdKq8Od67UVMBEvsm7sWmwIW9bX7T416Qk+ehFZGFzfpBR4eqM7CW4wT6DPmIcuow0FlWmWBDu5KLXb/Hvo9q3SbYc4LxFgfV+yT7Ner0+Ht/aqDqZpez4tef8uoZI62OpVEKDVVleNtnfmzAUKIa1b8H0g/kBgYHKoWC+FJFU6kNvBJ6gokDSBPNNNXN40ANNo4/kHWN5zm63XXdBX5Hj6epybr0dt2f8eWr5JWi4jM4jQNnBaeusf8N6r894AY/t+KH32bfHJfHcbiOd7zldlIY2uIAQwPfOTZ9u1Q8hpsm7eayOrVeQYYBWvAhC4EIQeW2i192sedvKjShi6KaI4y0UlINtegu39+Xe925iLRJT9UImz84w4l8sgsuhzP/kKhmLM2zEYGoy4x3SqEdcSGf7zmyuvTdDmSiUssEJ4o0CMiSX36hI6odzm3sHO5qUm9Uc+CTlWA8iOxXbGrMFULTrE3Pv4d5if+KXfvDPp7ugkrenwABSPsXOgHfc+uXmS71PJA2pNpllBpF5AJxgq9LQM00QRU4DTY/LcXzr/+PPfum/O4Byb72Af6wjfgAwnHc8ZqgOgV9wmY0k8JImQE6FjajU18UXMzTdXqOwaTgRhHVTluvrF83PO9RRBWhi/sEgim0QHnS7yymKJPrN/7syHrn/83NdvNcIMsr4mU0EzfwOrc/BTpMny7+fPsiVuSgkdvHCIIGpEHv321ahGC7HfjnhkBZlRIL65b7K4FjXdBDGR6+J8iQNvDPVUiQIATrNo5ZEegzQFHVYZm8vcXE0hTQk2AFsY1pow02An+vy79atbp7ZCtqdH0gDohA4Cs9jD3BJMTMZcdEvxOdI1i7HXkc6enmda1Vd1NSUeDkWdGhNdNvuRYB+H5We+wtybwojaqNyXYYKVMBFdNQfXNi+89PKPbT4tm56n5Cg4GCPDiBiP6mkfPhaQimOzf76/Z3/qdW+uMg7shfT9HQQUQiAbko/0DRox9kAPmV58uo0Q0sQFtJgylwbCokycSYMw+MPNnWvnrt77m4Jgm3e3Tmjbw0VupgoeVxD5w7v/4h/8+hV991vbuwF54OWEEeCaZg7vvv/9xySRhYwBMxpJpiJjGIA42iFKhHCBdDm6ZRT6g9pB+Pc38PZ7d88+ef+eShHC3CoqydC22EZwsBEmV0UpBs7e7qYB0j3u23kkISFb8I6Q4J9X+sG6VQnTvdD/rEAFGVqtZTSDBXmxljkwrLW+OG/hTZhgGY/NmmZ23z0TJj+gr8YpJi9RhZy+z5E+ApZJaOCKEDULDeZqdiF6KPn+4a3WJUh0nDyahWesS06gg3CVk7MPc7LcFkFHob9CUq9GHKFoRMo6YlT95KDgJleewOhi0JnKniD2TO9GR9UkoFtgdv/Zn8fqVx751Rw0TCcJAxAZK3AK6vsEFbajHgQ4AzYCwRr04Jn9905+dubXzrBX8Bl1ncdPvE0I8xCKU/2bshIuwCgMSm6pkmahdpau6VRUZHVI3eQoeM2cgzDFXjNKNOwFBBfMS1EXAv8uNGhQH2nOS/i8RxYZpNBoCrfkjpCrjfgozkkoojpja/qgnrj2FIhFQ6LID3Sm7ujDKR4N9FxmHAKUuADArfrYjwGTmTIQgdYZszl66bM7DGW/wYUPA7wKNHKtDIFufWtbBj9MJ5hmtkoxvokPV+GAPv1qx5FFCwzkCDhiwBBidPTGoZt+A8kEnGvvgbl7X42cf1VvCgInOs4ZUHhSIxz5Y9RMCaDvkR744lMpLanrIuIB8QHsB5ZK/Fhlswi8ZGbR6egsOWjX00IebMxrjQ5/vQ6xZl0DHa5j0rw3cYLF1Qek45DFh86HyeTcbol53w80LwHzLF5hkWqu5GTtzMtUsDfLAPlYA+QDqAHRFaH1ot7+y58+e/+set/AMBvoZhGVrDIcLbTgyRJo7gEpEOGbf74WXbind56BtEb3JgYoRJeZaYqhCiJ0YYRQHQaJvZmxcbMPpWz3nnRrcAmCg60CUTt4AWhqWMNfQIi2E9kIBA23w57oaJ+DBCMP3hH1msJO4vw2gArtU/j+hxAYQ8jeyzPqazUz88IxaxBLjlt+gb1MQ3MUxavwK0M7cfXVP5RkAt9GXvdKGxzjNsBny9DRXbl5PjdT6UCu4xmsA0RFHO87r8iYwuDqyoBHkzw6d3bl4CcwMTf/anmrrTci4xXi3a2Gt/bi0Nyt+bppbmn+ClAgWThHYrqcVayz6mRW6gUHDdJPRpypK9kDxvlSYJJjh0+bvy31/H0ymWttY8XGu0fVhb4xQ2uvmM5TxK5hlMJmMd0KLujiTpkzj3/CmOvmX1whRBFieiCeYs4dw1MAx5+qx5tzMz3+m9Ey6fno6Yc32JG+RI5eeI1D0d0PICbPPKOwSAjxkIf5FRCWOMq9+vLDVKyC7PY2gv3mkBV0/P7yHLIKEvcDnjPpaSpJbjUHv9BTJkZGPhX51Q9WBhsnZRw3+HM7perh8r//WCh/Pyb67pW8kGcgyCBESc+4qzQca5Wom4B2d4iqt1lj7ORuM8GXWMg0CQ56xHPAi8jP4Cs64367xtzbuV/OqjwbQM7GuDgRu7SuX9FjVI2/dAGlhxdtAW2/9825/U+fNfc1G4YYg2AnFdysPyGOfgnbIGCxBZOrhKlH7D2U0vvJ1Rp729FVPM0GsH/nX73yJ9656FwiEErOuZ2B/a3pDAseB0ejrjoaMeDat3XYv/VnFBipyo0YFnq61fZqfnSMAGjn7B/d3zq8u6ln4Bv5GJ7plyl0gqMDMDZObNcIElDwsExQDMaWWro5zVH18bNfOGsONh9vqUBClWdjS01VT/0j36ZTMOZ7t6hVuEmh0/jT0NEUAQLF1GuJmWgy2Y4cACohCQkrWoRhYhQbo12BV3XUG+WrJiGKjBZUtu8mXM+Y1xApWvWV1y5yoMExnxV0H+/9anLhEk/O0ofgeQWqxy/ouw2CKngfSdthFIY6Euj0W4EhNAWmjlPqh9L7QPXEdyX5VDIm6pFPdlVS9iX71DW2WjMPEJ1CEj3o5GCfXu56yjDwRZ+7VJKnWNUPa2Y+XAH46dY9sv+JeFCtqV2SPgYf4DHR4zkvDhDBr1IWhmFxCtcpF4/he7wH3g/BybbARVf2LmXNRtkWBQZqaFFenPwDABdPDcd74JAliFFNsxJ46Afi1wTxPGEHks9CwKyHW19KKyivBGwUr+n0fGUGTHt60Gx70rhJP8RZkGMijiKD0yhOsSDCnwC7qEGFkxOLo77HdIKQE4VGDMyOyNBNpCdhikE/IipEgtDqnECzYXQLzTQHIg4CDFJINxeS+USPL4grq+J6mBBl2wM9REqvJ816AUw81zog2QjEFh9k3XCwIqLxslqsNSGIH5PaeHB0e9mkVd/WAxS6YHnl9eA9win6FZOWyYy8esvHdc116EvwvWwSJWHuEcxOgKiZLIBHmu7/8n/kW0ml1wNiQyh0P+sQ4BGYZwF2N6rLqmTicKUMLwgZvIej0AqWKt8cBbBKothhIcHicfKovuNCAu1i1EtdIR9L7zPtwT60xMdJM4DgRqEPsfdG/IBFl6vdeuLwmCYDmwzhid3I+DL1XeuLl9nCiI/AFN5yP3PhcB0jWmS5HALAfFy7BaKMIyMsS13p4DshXSLYpuppGJrKcmlYOxLu3VfvbDa/gIRtkLV+/AzpYWRQIoOycYqZ0bPlUpmrUGD9JXW4fFxTRMcyZgntgA100BDaRcJ1WZVe0vHIq3Ci/NCEe02w284TERIkN8VtUAaB/ob1PIR7W+ZLMHEKa1HIuuWfqOqszqbT6PZeYOcyzI3jlvovXst4eMAqc9uPMR++/+odcxU5+8yf5HhBQht2i27kMjVZqs4gzQBtGJolFJje9Q53DKQJp13iBkoEm5hyh0EUhJCxf1axP0jw0Vo7cwxniqtZe5EaJMGgiGeRIVgzxxVtc27qsygfBfB4O5E/wru5td+qY4A8Eh3OXOldk+CquGHbmjPlKVIHQJaMa2gylnnvyM47yqIif9298vW75uz3mIPnPgkmbv6KnFfXfKiw5DZNPiwAjnJLggI6CAZdLx8dfXrIzDwiLQNBk43p/bVigKW1xTmxNICyBYhVcNuJTXknVCNHo/TwR5Ih5YZqtdy/LfsGHOcUE2qQkGs+3ANVZWvhCEaGISaJoFTOIlJpBgkZc+bTL+9QA0G+uRiYSn0YaE9s1KNn7Es/aVQ1dmj/7GXqXjG6B+he5uqke8Ech5WA4JEITKtMzFFd3TfneEahQMUsFHjU0MKp4a5ARs2aH7vwoAHsTwN4O33OhFFi22kJWWqh5iNHmx1Tfa3fTIrScopMrX5pq3kdQDNBsCpzT6L3zvZ0qWkO12j+tu/ZUoudzI9Y8Zphz1PDL1xms5pvH2hwosah0dZ6Em4nGhEQAprRCNb2aqdmpkSJmq8LTa8SY+4c3aE9wPwxkhjIEGASA7LMCQWSOJCZll5IiNKoBJRpLJvQ03x5ZYOef/3uYQ1YeR/fESl9CfLGnMN5lgyI6CXjmRcrbg62voKw1h9nb4kqg0APZPv15Itxuko8Am0BclAc2mqaaWRs7jW56IxOm/2f+cqPTQ4pyc7MPCR4NPM8jkGoD2jOi3wEhsooD/RubRqV/9EWCJ2bEb86KrnpmteWyQinDp8/3HxO0g++CNKW0qTLT6H6UU3dtJmDRObY4v1Do6/RtUh+SKTQP8CDRLnKU7orYVKlG1s6EnE/0GwekA8prmBEI1OaICUz7QqY88C4Ibq2Q4whDWij0lz/NODQfqOO0AS66FA33RxxohXQbo6eJbXANK8+Ie6OAa6Q9XhguGSpa6p9myND1uc83Ow/94VfMM1+kwYLNB6wrjyUB0nxYQdx/u8CzcwNrFFNPc4uCv69f/dra/jKH8USDpO/ZCvtAepfJbZZ8nDEx6jdw0OORfKrwGTjs1MDiv1I8zhLagxWrsLE0K15AZolgPxfOsPkCIlhQ9xli7C5oKhcS7oArY15IJcgkLq3TSln2EkLZOvWC4M3j43s+bwKwsxAUgkTIU11oQcdlW6Y2RxCzWrJ/n0zTzGjNIZOGUjS3GcSKt6fpKYKSkZC9Z88o2ZgIWVhTh+OPsVVN1/PbDXSIbMgoF816sxiIDtxI1BzId15OqYn9Eg8udhQc75m8DQDX2FHUV0jXHnRfaciLQ42vDehgoDEd+vFeTZXtwguvZc9qXFwMsMPI6sWcMvnkzWHO9Wcaf7A9LxuEXrqWjp9EeZ4br2QiG1FZOaGGvS5+Q/kb/Mu9GmZkYc2J0lLUaHt5VNXd/9Wfeqd8pTs+uAsnQkLrUaB3msSEyQeWRcUg8xAN0ELlpfzqjEZf5vfQGbS7Hw3yGD6XqPSsQnBhdIhpT3mS/wAvws5ROuIBPTyRK9bULhkDr4927GT1ANOUg8Aj+jtivmYFOzx/F293T29kLG0SgjSFIEAgitys34/sHwOjWgqwSKVKt00YOpqZl2YtPVRz6nUZGiqMiFDE84XUrpoM7ptyWmPPDlIYIUX9P1o8fegC1EXCusAraa0jzvb5gQRDMStDI4oDve9EOURBFMCeNCZyy2bH4wtdTEhlEHLRhZX+KXVddR0Cv16uR+To47I3ugvaPxVIkIuIxI42goyDqe70o/donqEbwn+cJdkO2oCO7i3C/sk7DPVp8E8ZY24NNgjvMo1ro1bzCAUqywT4iZjuxhv0xGk6ptLUsu1IPxlEhBJocQToqcjQqc4E1zTldXSrz0z33+9LEzJS0FBS+pgz/pWH8UxUIA0VoTLFdLr5O15FQk1rLTjCaCrHk4R9EiOKLv3DLhR/q7mgCpBZvp0Yek80/hg/kxjBB6IEjFy0Kq/Tw90Ss/FRB9bRvLPArTOWjtHQtsdAYCGJWGZnW95y3wDteMsbUpL7JiYz34GkObQxC56/jEMQn0wFCfTSOZC7ZkBEtu+pcHDS6kxUPBpsMKClRxoQPz3yQRT74dU+k5riHDQJ0MNQFioc6VTcsLgeRY1w83mnZBb0tGDJCpN2hb0U0FDtx5wBSDCHRuqOUPDDXS5DIdcUICKIlhgwyMFq+mJq2b/442+M2XB5LAqfw7PEcp4XA3k9dh03TGlz5jPZTqRP8rcZwBcho52o8Yx7r2JRlCoxxSkLZ32luM7pL28ct9JgeimGNx5wggJaGRMK41jPJ0fIC30zk4aMyO51OInYWPNkQkMZoB1/Ngamx80V19P54FO88VBigjgABCTkY68euF13KaFP6X2o0EA6KOzdq6dByJE1F3l0k/Bu7x0mzszs21nWy0KRPqpwkwmYEfWrCj9ApZr0nz7CIrDLGhERypghrhkd54pcpaLsnW5BZV3qF0ThkWEJ//sVrMttHukOUIsqHWMiwg3phea3cl0DaF1cQ0WXR7sm2fVlMmE2DOfP7bhQj9F/JQhvFhYoWxM3bsQ+8z+V94/o4e+amkdo0g5hlnT9/0fZzX5SNci09IUuasNf8LsK2DIO+7nJm38e7B5aEtxDeyVk/S57I1blepW6XaTATGnF/aYwd0aHndsFOaM6C5fxjdAkUu19JAwrcwTYSdzhD5ozC+/Dit5i4/+X3aAHHDmbc5iM3O9s9W8ZjuYoU+89Hm803T60nobOqLiShPJ8ZivodsrfUwxx5u3Wjfm+cvneG9/t378dmzr9sCOcBuQH0VzrE8HwphaP+Fd2kJlk4l4BaKWdesPNpLlv+aYAf7ct+wmzLeQ4Qo0aHv0vq+1PBB9gSUcLFEtHYQxZ9BQsJUeqUslEEkw1CbOaSpmS1nIVdp+CvBnvufqU+Y7hJgC9oHzoTv+vusX9Nb2WRNFTsmmp1NuzO4TSgj6jhm0pgWar+T+R6GZv1EazjC0eV/7AsNDbO2BwTCpPI2L4okKCiLbmW84O3ty993K+yQ1T38MHaUqqcEafaMtvSKHmhinBGtb4JEUtASBPq9UgUIqNNmo9aiEL7ECzBVCDxJgC6FFlbKplurm0uGJ1AvgUkmT6TOJPhfn8Gev3fnib5035J8f1sQaaoGnODRFkfLnlSatIndFsgtWo5SPJYRmkSdE5mHeyhdISyUoCgtK209eNdenSi+Cv2m9AW6LNJvi7h7XZkiPRJXZro69vV4BMBTI84w/mfHaY8P8oAxTxOKbt06Kis1/VZNRIWXHoAMEciMm9OOTf69MXnrVPPDcPq5iNzmA3XDhxKierKOqaxccF461cjKa38WC6Pr92amuFDzH4nTzVSViDap0Y5a9Y2AIiFPPY11VfXQqXYXHqGwR21KZaqcGVU9BZOqQqd2I+Deb2lfJWGlB1WQ5zKXsWp5nFmsShHNY46412bLbM/c/8n0fee3X79390dde1aLEv+8uhWmc2t2TTBoxDBJZSgNdHpoLj9A0c50OfqHlmJjENboBQcjW2FQtd1qzyR1d46JsNbGGka9a7IgG6SraCS9o8tTop2vFCyWtPmm06MyjbLVelk5iZ80SeW2ZlZd3CQt9u7/hnMqV1zTd2mNsPyz6jewXb57dPqxvyb1aG2YD2Fz5HH0dV4pF8kBxrXk1pipO7cmWYgyQFWkhDqpvabNagTtdFfz5mdtaikee0aPU7xXfGJ8DYxZFpxMHxS/y2Tr1x+vU+0mc7PdKOPaaegveQs2ejvBaPY8N5arXXX+MDckxltsk92arBdow7ZFSZrqkadtyxAd4PhQIpRGLrYz57zibJ9lqNh2cUB9miy2yZ53RIDOKdE2kQzEfwhWZ6IoSt6yXpRG2ejLeOuOSoLYx6ZWRU7RzbraO+1tzH2W52ICPiB3AIOI83cVGBluktaWjtq/RPsFZW36HJyrMIJKtpPtbQeRZRDFhMGcu7TQjpEQ4nHIBmACvTvMxNsQ04fWkSyztzhy1KT46ue1P3aXW3Q7v6RunZqW5q2qTepPezGgPQcWp6+F9V0XXUSsqQo5hsCcuBMAD8DpgsO1jKXgOf+H8taOXNaLxy/kgtiJM9R8pi4oYw/R38uzOmc2m1bDn6CcSo+FfIIJTgNYIRzr0T1EH4WZGT+LUZnZkWxgZIpTlyPxvW0yMjFS2AD5h+fJx7v7aTwXwVZsGKIxcHHA0q38BnwOWKwpTIjzsehxDIAIC8cH455prQlTHVWE1JEUKtTXZBiCUphNiEJsko3YtYkftKFgsZV5wLqRq3qd+ciV97u7mtVc/8x/d+feNCvWY+s1bp+Qlg6TmmPyQWEnkb1mJ4HEhBJ6zzIZZYgLdgS6AFaRHx+ywXv+LrzvY/PxrE++pj8HJYLFVU+LMJxgsQEAQY4ZlUO/TPZzuK/sbI2VFH2uRBYD9+q7Lx4P49lu2z5EJBacLpRoV3M23JklSgg5AUL3wqEXTNx3eIS+To1l93mZ4C7o72wLj38ZYcgeteSar6AJJbMhV9cB4Y2vdHWvSUm1qkwWLxzs/qTdGMq2Kx3Dpush6VxDIa9aOaZjmaLPz0dkNUqIfKtHX/J4Hm807NXUnEAb6G3CXW3VACU6ugS9aL31z/vBFMuU3MyF0XXzfiKPO65ysKK4bshjSn0RRWhzLmmcVAQJFw6I4SmWxs02BIaXvGbFJW+MGrtWmxWoD3oRZISN0YTqwVQC6SuqKWB34yi7BRLy+Xx9n8Gcb1W5xAV1P4bwZO3endAnNyybH4bgueXRli2Rr2TKd0DqPrpw5tWWOJ1ueO7Vl5062vHBqy/6UPq+e1rI7bZzmwmktc3dKy6untTzt7Wa3tnzzvOXJVhzjW9oYWQOom287Mhu+vx7WbclOHGyvAno39BT+xOfC2pD7pmBOEaP1p/dalibVvOaZCZtLz1g2yTwtIuKwt1pttbypTJtjbdfRyCvkr7k8yLC6Djnzek/rKsbj/FQ5s2kl0jh0kre6p+Ic9rauLgf7ZbOxNgABzE/jym80SXhrOutyiGG+IQ/JNxxkc0/gCmkvF8VTH1Q+9bmpC9EqtAoyk7nrhQMgPXWrSf50/SHd5fXMY93aUvMm9u2Fd6a9gULW29BEFNd1MwELU7fW1QYoWKuRjvX5D1qfqRcnNTnqelPDJZsFKRP7ZGJwsPoj3E57f4Z96p8LliU3ri8+NuZxW8t59M1fnupJ3Ls+b2eMuWxzllPqbqAG7PKfF87i26alo0mxkzFRfXSF5cL51anTU2ZId89SR8XPoBA+N2UXged07NGJljSn8bSjGXL2rkD2Toc8OP8nZpereenS8XckFoFkspRFotdtGlHhBU03uAaj0juiOU60Hj0DXjVEV6c7K3cNkTA115jpWRa3CCwPDi9jmXdL+adNxRFQvqBTP0v5YMy0nfgb3d5rZ5cbdpG3MszK4DLCqX2Dw+t76sGUBLbEC+NQRX/tJp7o5sn2DbOHdOuJdLI88CwFgnbUnejofKWSp3xOBPrM8vNLFuXMcV37vR/SD/nP47MREcbohgpjCGHG//Yr5fg6NE0NaevlJ4Z9g8KjLLWteFyriwEvZNXP507V/gbSSYsMaPbNLtfug9MbwrLTlB7UR3OMkVHjxdqrOox/csvRHu2lsGUsw7CqNyGnpcQz3U+eV5qiU7s5Gn+VFZGdatsRoYPkLGef+hvj9o6R4a/DmCxhXvoVVNM/gmIWqWa03CNjOd1kUp9sADDOPdFi0OXff3LGsbDClHoUBF/2JbhcYpYSoQke30j9KuaJIMtNNczBeVswQcYMVkUL92nS4ponRcfA7ykJJjUmhFqJrhI1E4sLrFOzHUhDWikQkDY8k4Qg4Kkawk/lTb9GeYL1rG6/Z+WboJ6RLmDc4t8TNel7QqwIVbvpPfiaCQ41O/pELsowavmGiKUx9z/yk//W//jKt56vkK95TQ6X6/u+ehXHCNwKjAg+u75br9RlDvd+FkiOBWFURGaZTTXIYCkcYz8oB06GRTJvJa1NlYVpXrZ29FWRgt7Q6H1QL0ScuOBwSTXYFWVLxErJdAUWbtiYQC7qDqSDA09sQ6Qcd7Q8dcnxPk61hjXhvmxy5VFTDV7Svo8tifHj6MWnVHqYiDRtrvzfXNXAU6375DQyj6GyuD64G/R8SixKigUHX4qJzt/zUkVWiUBUtMxZ3pQIF8lBw07piWdF2AilTxq0IAAF7Fusys2upWDz7X7PPPXx5z/9z2s+Atji/6iWqoLvsFgJtNgJqBPZKbwq8c3oMlM/9gyPCIxEH1pKjpYkg+njHZ07exYTqeu8v1y8P+XoQGVxXVIqYWD4PRnqGpjKsA6rACIS1ymJds7XgtoW11xTZYMlhgTqLOQWDPLmrWHAeQuq4+4v9/oxGUO2VtLxqOAKDWE5oze45pZABmXMw/MG5mvoCqt66onCRPTpVKPPqrDqsVBomo86kuqzLa3j+xbd9sp99k7rz8V5b0l6u0JGVHv7hTMOFMCR7w65VYWKqhTUanBdi+anWKXHTMQGJO0dlkWURvVPdd2CaWn5pFnpJBBENob/Qg2grmSdJZPc77VeElPaTBWTHDOwiDAn/kjzDETLEknENhJzhMzLI/W/z/JIbSGlmNGLr3zql+/+qBQzuixubgoeLM+/uSD74OyJL65r8lrGmHddzbJDMoDiQnTmul69b37kdc7lTB8XiorOUaBADUgJdGMmVgxZ9ZTqRsh0HKgsiV9LGhmjwsxAyNuvfrEgwkEjgiHitiTRKGGq3odZg21VbsEOpxZ5ztwpMapTN1MqyKcelx7utI1jU/kS/1xcI6FuTCLip3JRzWcwINIGdUtFgshZD2piW/G8LIGhna1hwlFgezGxSKJxCBcDCKDVlOC1F60doW4yK2aCgDMFC+1GZJ2SDuGMqEoRPsWDSAMIO4pGcTKtuWYHlxVBavxZPStuHkYs2SHqkOlaChdsiIGRN4TlnjzFzdCSs0UqO3iYlSflYuIhj/Dm6vghlBH2ZrxC3Y2xfJFLrWVtAlMMYGJM8ADrjKp4gDaVUVzYPfPQgXlID/q7nXd+VhIXHjMUOGHTGWhvN1cvHLs43vML/aj++Wtb+k1R09JtZyF4qpYWGvpg9zRD5KTAj3ZK012jIYSyTgY/3K3BkyTT4Kh6Pl/aQHZF2M3VJY5yzfmgCBHoxiBjUTG5P9H+jbJEwD1cxT0mW5rGod1wOJjsdMYiYqKQBgmmuMDilXyKfx51ITBrHchrzNBz4p55bqnifZMLtApSDEkldcfqvkCXD7jwlHhxjHKrwNEz9/QwE7+6PfOZg5/a/OKf+6rOfMN/ASb1bhcpnTjISzDEILcWfkKmBcVDpvzF9c/HXGQlJITzwusc7CSwliTGJIp7wt+QZ9ghHuGDjszBg8pMsWAd8yExvhhhrSP5doZYOA3keVmvCy5Scz42wisobabphW+nE99fE2HGVaIk/7ezWl15z+zPWxtzU9oznX8VxTXTAKRBMmSYv0CzozrDw3gjSI+VwucgM4FZbCDgNJFOX1P/vB8V0Zq23s2dUvKgeTfLcNKZmfIUx77/WyKSmIcdKrrUMjfHpvUGlwJL3ffrxcP650m0aOcQytjMEtijuVaGExjYuhT4ruIHjouWft/Mny3ZMRZafSPqhb3o0kBw49hI3u1a1lIKXuoG3VK9QJI6LnVedKl9cwztcdgPpB13lSr0BPCgOysoqf9cdIo0H//mcZc9DoYPFg5SU97wcKLpk2j6Oyym2Vos5sMObva3/Y2TL33S5SG0lR09oYJA2NaY37ozjwm45DKyW1PcXnZ10XVtZks86atdF4FHJBIiCDhjoDYLrRxuozOgBCZ6OnKq022/Z+4t9cbHXc+CV0l4xUSLV3QPbn++xDVQP+wV5rI53ugx11s7FZua5ZpftrvmCMoBn3WMGAjr4tXJ/pi6eVHbn+hJv8knvrnEb2DMeNBT88p6yhP0m+Kgc7XOG+uWfy876Mh+feqYLrj+9MG+09FTBGstnKsja1OnBjCp5TMXXZ9PH+pl10tqD2ZQW355CRn8HPnqsQcfcb3K5uHGyYW77HqcpdNX9bzreXCXnz/BzwvdNHB7S2xuDF/cMWeONpo+4FFHYUfIMzOYdc2rTP951A3z0zQdpmWzS25oh+n4dxf53YO/OfnaRxw88Ld9Xj/owS5wDZbfXHYgsoHCz7Evz7uBG3X8Ibwrcac+rR/XP98OFaZbNyIuPczEHG6/mO763IgZZAGt8pjVm6uoX0CLs2zpALLWh+UfEInXw8l+Ov/Xl+f/YVdpz+1w4sA+7Ei7sbKXFyv717ZAasIN5+tcsvwUWXzEqneIhj5wxgLHIkdst57yhAjlGFrpM7CVKL3hy05+ogufJ33PjAYgdkBs0Z2Y+LOKIdTa0WQPBFqJB7cSdmA1jEm/ncDwjpWuW7mZZBqQcXynjGoVKM03ePLevaJ+hqIIq3wpWpLqQOJAo8IZ3PR5AszRZv/K3/xKXWyT5z3AixTCHYABwR4kpWermejpuCTd/NgfCK9/6sO6q29VeUUzOYy6fGh7hcVGqsDwhGti/XE/wzbDjbrpvckt/XOW1fFqh32L+3ZSHclRuIjqp++QxFZDh0XtVpKDwliXDzebJ8QjZ60deTiHTUn5WMhJA/yJQOOHigbPinjWNswtjQAdrZEc+bbpn2/Rt8VB8Hs8mra747G0UVJYFM3wMCrLdlNZITrAWH7b3nd1IVi9U97nEf8CZAl5qbDZGGqckL3DhazxNfIMBIRefAKzRpmpOxhSc2NkvQ4XVJFpIkIz8crU36CdGvMWz5aQSboxsY4A1mipzH5jbRg0bodRlxp4CvoyFXTtqYmoEShociW9lHTSmSctr39+4wze44ZeE1YGJ2oaicxkdcF/2ZYh+5Y7ohmASXzKVGHM02dLyYnG2ZQM78scM1joCki6OgaUYcrXTINtszFYv9Ac1K6rfh70x+/UIR8jQADRsm8Qff7q5FfPapYzolf88WB7BjjwYBHUYeprpCBjrrpZUhC5Nqz8J7YTJFxsq3/v3JWv+Ikf+U8ubV7CtXvUU7MDHqIeWjg01xdn4VfrJjU4wqsTcwFG2q4rzVjbseXOG9XfncYvtaB3DYqFeyFsE7JaLVotM9mzHm19sF/U8WCOxGpPUzfIxOZwpprKjdDszfQPWYE9MQMi/AZiHrr2caqBkqbax3FZSFGHwzIk6glA3JWx/tU1U+R8QlTTU4zjjRw2CQfI4r3mcIp/f/yMQomWiTeIKE65g4GBAjXtGMS9ZsVPIhuC+U1BTUopCGhKWjTMIaghNzMNqsTPMCQOsAhzd9HNAKjFdmo5f1yLHMVCWbXUiNNwcR3IRmawaUfctWt1wWhrclxby2DEPDZLspYcCnHK4ygVPqE8Md4Yq6iAF4jZ7mIdr2hCmpbUY16hwphOT/UUkR7VUxZOzS0da2gHge85WLznaU0eKP2AhYvfI+xSzIXQ6Q07WKh6T2pe6cRceQ5zIrvEhdz1J4hzRyk9xG5OnkrPz5loVT8nPfX0XBLK03ZAX3NvQZxXJEdeHVhUCCmkB6VL+uRHn9Wn8Oc7/YKSVvetrHSOvSBRKTNMgxxsq5VI/agILTYfYKw/ODIzGsJ6SQ3vmFjy+PLtJU/plY/zn69hVUH14GchXvG2EVFQzUXy7p4eDbl6NOBotIu76PZdLJ03VUHwPO84VUPQVfvM4qlv8LSZ1q3q2t3HSXL06G5FJXCwYFFS528pgIuRiXMSY8CxqdcXFsEP+eNJCjUzOX7VYrr1tgE55QyEVNOHqh3+hZTz7VKbD2OOFmXNq8QitMASOQ92yvBIxyG7yCKDYSC5LfMZZU2iBYLZ7N1pVhyPQrCnIioHQ0vo33Y4ZK1EF/8kaUs2kkfLRM+xwu5W8sUzSLGFA+CNh4+0LuDDqQNvTJ1pEo+/7rqnjsaCn1NcYEu/d9WcEcHaSH7DD3rbeXAvDsqSMkSwe6QkhbADWi+GIKRyhSWM+PWMhS+HcdFrIerj33xii1/1eyUDpF3Te7+SY0EYRcdLKsFI2PuqeDsLtAK+aDMpOIi7g18pLRXqHTCAuGLUll4cK77YnLv63D42lSf1vAypPzHWP+TVeChyP+9sUn/ASUhiMEDoWpwwfCvk+rZlv27M9RtvfOqnK0b5NIorklrxLCDxyjYFS01niZPw0QXpftYTkOCtVd2CWakE5V4Vp1WqPKP69LR81Mw7u+ydYj8gKkuLyIe8m5k1uAp07gMlqllkWOOy1npG6mCteNaU0T1z/xc/8dDjvfZrzns3rcnsn2vet5xVdrJ3Evmfaj3qP1c8/LQkpRmXavn9W7xawYgnqOvs5qw23CI7nDWEUpvSFKWGI7xLZ7YapfZeGlgnGZkXk3SjNDRctxBo7wttG99QC2GuFV89ObPzngEop37enfj8pscm+CktXxDdvlethgyK3JCKTM2kAqsmvQdw/jS/dZxLN0setr8lb4saUVkQQAZ/G1HvZhfDB+bgbligxBTN/Fn5OIrN4Ziu6MXiA5P4QRZiSdE0hdCtUCTs2hOHB2e5pL2PdhimFOEsF4Py7LLGIF+i/UgFmQnogqv81t2jswfvf9Ecoq+/sOWjp2JmkVGr6hsUVuvyaCb5DimaNc0wS9KIbTu7kqynLgfCIzXhcETgJ5zWSCMBmtpDHKJXk+ZqnmEIS7PCD85GlpDPVLTptWSb0zTAOtJW+UMTLIrGLO7emq2RkACouXgfqIKV4dGE/MAhrjPDBkvoV8eSosiiesZyNvenY+aLSz5679Op373XR1p/IyOyLV2wUmpeWMv0KHz+Ffn7oz4y55Rn1E/z5GOlIWxh0t2HWY8gS4aT/QqZbKYUJzh7WB3JqV9z3MO+D+zEUng4PoE/VAfgB19C7ujuwdTu1c5YxeTcUYcb25qBRONchwes28M+5qDJs5dffWLLx5w8gJ1Mj7zCgWAp5EAtahwJT8n6XuaMWtQATpGaxqwYDHL5g9N3AjYIglvyg4Z7wUdaBY99cd3nBXBiCZmoK6dm6Wj/nPdZ+Orxz3ufMRkPfysw/kEqoENXhKMOZWR19/BD6fwDXnCdHQ2e5d9FOsaqPeCBSz7HzsdTv3unz5rgJUPFhDUd4ix+8evT+8t8JkceCohzUuqaiVxhrMeXRMAeMKxHfO6jhX/Qg79Wg8/Jr9/lM1W2qeIkZFMqaHZ16lP/9KLvXKc4LnAoJvOA/OO7htl1hNZWGlbDWPIOha3Xxaujol+TSouJVaAxuAux2CT6DFW37yUpIssLy75BEgTo41mIPSWIAepfiecd8vqDGlSAQIfCWomoQtjF0vX9lMdPmsyyiJJhMWPito/KGEExRRqUHPcEgsWVoGNxE6Ig6k4mMcjYXBemmhFNVnRI/4XIJpTdYNkuUi+fm3td0LfQIU30JHmmQVaAxpq3NbLc4jWOSTJ985JXdJKoHZCQKQEeKHuCU1MF2lC5IuJwQjPt14IRaHGEB+ebrCcbHI8JFDF41SYwLCAThFUenGjRMrAFrNWt2g5ye1mdgb7NWlaLBT9kXwh3zjk/vdF6bEhDP5GyMGqt/cgkMXS0T5qrkOXoULu6oycgav3Tg0e5jZSC7DTZhqwd/X/c1FUgqs+4JukKDje6izQ7eOYdDBQrxCogqwyW3YWqg3EIDTpDuDCc+AhJ0/cbE8RgCJvBAR8jENkL76cq11DK33cSx06zNjY4VXkexWLmsKzzYWW/BnCASRPdRQHpBr/BrW6ZyBJ7Nc9f2S/yV84kNCjSWnNQYwh6QmsiqAkH0jOV9sz9zebO5tUXX6CSf8mrD6lQzwU4bX2PnfJ+YLkZRicg6FjOdPIAgNKceC+0yn+THcSkUvh2aPXkcl8GMGa1CEFwQcqQAcS+XtJ5y/zAt1xmUS8KL59bSPCX/MyHztw7r5/j3//6rBxfNcGVLJbqnhgcbkyUvBDFN4Ak7BWxLia1geIoVrydmRSmUA5JEEXXYkwfVc4TMX2AJJnYfphAMIo0gJp6TSxgqffBBuOISLJeKas3se5rDLh/2y3WhRlvIqH/BuE3oRmObxyM1rEkPE0xWWPZCDszBQRRuki4n+I8zhzFclBUkVDxbOmWGSsFB6lz0WpqkVk3PQMpPV1xheZPPKaZms29u3c2r/ziT++YT2M/X+/5KOXI556cy5EP41vd/+fM8a80QeQxY/4lzyzZcmwOFsfmkpb2vt2dgKnf7m/eInqFaqrMF5wWucyWKubX+RYtnBQPmjIyM2hVjP5d86CZ8Imp6F5iQzUln7+q+Q6+0atdHzIczSods6nOXjltm4AzM4y219gkFT2gmoqq/9r9zWbz3VLa8yHV15fzC1qTiQVUIOj43gtBaIY0kM5Vya7lPTK7b9IOkDaq1XWKDEJd5LSvFwjHdlTeDXfNVXGThm6OGSb+oHQpIQV+HSkIFlXKU7MeynxbfFmgVzpZtW7o5q9sxL1H/ncVjX3L2CevNh+fvfo6baGtAKdo8FAGo4Iwy1N1LVj1GsKEqt+Yc9TYgLwvMZKngp17ZTIXEVJSQwucKiQsHnq0eShDqLCauWqWmVT+dyE056Hl8z+xFazIw4k4n+6ZRp7iHiNM0eVuTVEzU1W3dOyXp9tHjD7YDtNHrK9cWS3kzOkrLaysGXMtKz5hoaePQIEg5ll80jawpd6Qfx8JWnuG13850fNhVpb2xOcnF+brAyRrK6h98bYvwQ4t3j8wOq9bc7uy9aW3SISQRD3ocZJIi5hURDT0/VfRtzl3uPV/2yu6B70Ct/tCYAiR0Wp//HMryHFMwu26QCZJ1gjFC3dGau0kjWqAzIIIfgadQTNHTTokO6/Z4Z0UhU6Ky4HWfHjfmH/2pt2nzUWIKPe3+NZm0cdj1MtYGWXC9aZqeqrjRPJOWY/YNVivVdcgU8osIkXxJ1UdHKlgtEoGC4mixqzmcZaGlThljZyUTqNyfFz3oWUPOlYjpPlBoC4GdAsR4ib2Yfb/2xm2/UTwQ6NdmiYqNtvh4c7W9aMWNPDzZwOI9CBMJA4qGcFrKSgsHAcFfEPznUNJHAShDwzZnW8wDXuTBVHL7ja3A3THoMV5E7J4JtKd6QVaHkzzzVAy1F5ZWIz2c3xGSRxKfVC7N3BDrQC29DSBxI+mUUtnZvpRdFOPzReg02TqmuVqKsOQNC+qeiHgWbr0dBP+RfZM+baV021JAlp/1CZwGuzI2Ovq4z9C/KMoPK0CdCUWxnQeW31nbqh9cwh0ZcL2zVnR3Lv6YyE0/jzDrDBVRpYuro/n4Z2qZil6Pi8doaI+0GDmYg+s2sTofCGSB19OUA3/2w6ts5u3oLVNPCQE4uKTDQlPzC2v/+lWiHZOcnDR4BqBtYrAxgXqld4Qka+MxGsKLujtPYtHOyyYUCS5zCANUJTBhTrsuEQ1Ks6pOppWei09ktgM6tKsXJP/PBMiY58wktCVyNDStsAlduBM0DYKM4a0BTPvmcKozNeGGC1mZvNii2zvsoeBEMsXU/aspoYfvEcgbU1uEPfaEBcFfv/DEBNXUmgWlwhF2gJwJvyM70MJfYmEQIItUStB5np2u65FD6NgPswBPQqn4ni7CBoRGn0/uVC36igCnc0i62TgMweyWQM68c1I4VdwUuRZ6uuODWxKuyAOLQtwDfYBL70UYurjcOp3l0Ps6b6qhbKnfy6GlBpzXHg5v719Q/LMXPMtGQ3u7c4F8Satya0+0CpuU5oHX7ndUzvwrIGrGv+cxEzVtZfBZO8NOaU4DQIVmIgX4lVJq3HU0lmdhKZefcNfvLp7vd7Nd4Q8cwcG3COTKBJ8yEeONjtXJVVoNbSfD7ktx1xVPx+6lo1pbg/9vosBGQ3avFO9+Ti4TuC9niCal7Q1wAkGRgavSCxodMO2Jx5X9qCVCXMSbI1qojKwqVgvDDJ+giNh3+0C/QuRyMMjv14JscSMsNDguxUO9rK3PhYa1HxYlZy6MniKsiA5kqKhDH5VUnSl7/oJ7ps6YYJvv0IdWFsi4u7xvBerjfQVCMkAakRTSPUl5SjdzdaD4Zsgg1lIbwugtRh7AQnLWmCXPNrmdaTLYolDve42Fo1SA1qq/jFQ//va08CI8Gr1xGWvH9eeSXtzJIsnAg3gRNr3UWmvby6wsVUmqV69INvIozDU9/dtEvJazgMPFWQA43tJXttOO5JyODTPkhHBqgZ2jiyUBGsyNE0JbRVchSVoBnoFxS7p0DJDOYBgAMIhC5d8IMTlAnMsRZFwx5TXwk96v1rFxdbjv9RJciQMAsWesxwX6dIVFCSOXE2sZPSlUztnKmAo1VKLD+X29BgV5ISVun4tX4aaufR7iRg++HRk3itMlwNxOGjFJzHqAsUqQ8b9wD6jwHYZAq9OEMwZq0ZerIZ2oFJw2YOw3AMpw10vqB8RcJVnRcoB2MBWHlBQFusUCQADyG2AK+B0kAbUgk/q6grAV6Qqv03nHcpmTAAQRt8xOQh8LEeFyprTZFeGiMViuua0Zy4cpO+eUfWbgQVpGD0i5BmRVlLUo1JsVOeXLJ1Y6W5O75tIrW5xLcliajnPhX5X74vzQWOOljpl/bw75fOhfT5X0h4OjLuDJmV25488FujFKDxGrdDSxf5SKZ6S8vMNv3r8a01pXnPq31kOoJ/JbnMk5iHNo7xUwr8DxxvLN2BHRHZE0YLtQBeZtMd0Y9uBCoVGhqgffdY0MixaNWXPgBS3HRauEZouQ/68kWfXNVRNJmTerg2Mebw2YZjzAKo3uXrCbDxJ2RKW6ZzgNVoKn2OPdFqncrCsXvnY1MVjpJpi5fBaNIDpD2fxSe9Gu3HyK2xLhYt5LIPpRyec7k1RoZ8pBefkMgSAwZjzsaEe+hE/VwenpWpxLUZH8KdpcYhdGPxslWaywTvZPjIoEKJepJQx87pYPHMhxnZ4jn8RT33i/O/4eXfi82+NyaaZBxqhFCSCw9G6eYuBGtHNEES2AAni13S86Labk84MfLp3d3OE1PM1Qdy1yAKSOF+yHUUtb1PatusMmYJraOwCaXlgpcCBzEN8GfpMpxmb1z3LQ+K2IGAIzu2gPwVyDuzPNXAG5DX1YuFHVvQhzLSZOVnZra+OVH3RkWPZOyJ5qVeKjvsbmLtvsO70Dv/cVuwmr8YqbwD+aKlFWKkVg0wtx0BuARoIJ9juFpnRIMXMijq0+GFme0t7rNAwhRauVubwZ99z/vP/+De//9nX/gcM69+Lfdt9Rq5iYdXzmY4jnvNu2CRgx3YXFaVXb4d59TrNbsUFMVtmf3OIE2GuxyFmxl4X2sP6BdQ/lV/gwR+G6XrN7uNVgXWAvs3I8LzBZSZInJ6+qV8ZcymylqXetfsTsbwURwbD8/rcm757aHbbZvT1S+M8rR4iA3uNgmstWSKAcer43rkW0ui3T8kEq2HmdTneEOnxTu7WnXBl//RZZpLC+U0pMQdat+6QDwXKAuZVuoT70I20YbNkaoG7l9dcaxVoTZq/FZcW5F5TbU1VlXskkyrqaYuysK5ZARCmEVuFWOjZ/aqRQs0OKh94S70b/gTtUxYvh5aatKTmPLmgjLWkftTCX8h3hur3OL92sGVyVgd1Kt28SpkMAhCL7WxJAI6ZJtCtbWGxJgA1jjl6ECSxtlC905qSIeIpJEF9SOtQ52hFC8Ue2pK896spd0nL7Ev68JVtAOBlXYm1C4keAUJuHURvLB7Uwpu3bIsl0b6e0ONnbswq8LmOBeDQ1EN2gfTYr62WeeH1eNh8gOkAEl3HmAdmdpum2P2PaJ4OABhB8yUBZOzhD8GwEMZyUjzVLFY9wyMYpkYBY8pvqg7/IDVTLCL+fO6RXV7oy8naZJlFtNXh5p8vm39Z+gYIz5L5dS0XBISEc1LCCHfmQpqsP4s+LyTb3PhO++LkE9//umRTpgjXwvVaPSSsTOwApdvUcLahD5rJOy8g6KBF0JQKB3GVhgoHPlf1rsmmbenhbzFdWMcDtT70pQFZdqLo0IuEokf16FBnMt9covrRJvW8tGpQl16QsgxalsYhR8YyV6YlyhDKL2M8iawhNoBb6z6iJwnkKRLHa3VeTubVkQ9GZr4Q5cfCGqDw93yNlEXXPGeseEtwOqvZoy4RvLWkCL2ePU3FOTG8yBxjnhFo8PZKoi9ioQPTHzKRF1RM0iZ6QGBAdATI6s8gy4Cu6gqhsCwlesZTWt/07FHD8GY7xr1HCi50HXFVkgaO+8iIOtElMD9xFlodK4DxLZvffvkX7myOIF5/SZoShUsii6y8Sk56FYevpJY1dWKUswN/mQVMT70NFwUm7G8MJ765kJgMir/q5+Z8ml+sCXX8la2k3tPqbqTiD1kJP50LJsxAxgSFx9qHuHCanx5qZwskyzNIDjW2a5wx2Q6O+7CiiFOSlwOH1tyxBSW0YBpw5xjWxxJVrkr2frFJmO2f/lqd9+f/vznv32XSJ+bbhL1nk09uYpi6gOIUR+nIJ0j1dL5wk9qhDOubH9POLiffM3uF0vBJDfrLW8kPobmJFTUQgErQuaDV2opc5mrUoh/doJWoiTDCcXPKeVHypKCzXjekzRUlXpLasLCBIq2QDs98V0pRE1y27KDix6pwokjhyPvbtay01s0MA/AxiNgf4gdULJiYF06lrVz1vIymDuXo9TqUK5LijxWY2jpPOs55ZpY+/vkzKbvMKDsSflk5J4ljfZzCbWr85HAMBDBHFxUEeFvKU10rRte21B1sbX7rLpfvfJqg+rlmXz/vT3x+IXVMjHJMg7iRuoFHhHMA1Z0b5IPKPfrwbzCmxZiUBrq4gOvALzhU4ytrgQfNCokSQEg6OR9zI31/RHupnAXdNMjHM5SFNv/EhDCSW12lrE7EhIXfzrz7d9Tu/UhMl4Ny/sEjemsaiKzApQsl5dQtSPfNXLjOWjOPJkkziDA1ODhld3qXb67NoAq2eNeTrbbTEJXmtWqGcryVk+LI9/b0p78iDVmeDgzSA1FWBajCOOCaw/r0x9+ahvx7nfWXp5afTS1MiuhxozSImhrca5t2amwa5glx0iz9Cmng7PEaoLk0jT2CrDuAEWbqq/knLQ/ww4mugypDv0W/MZcA9k+QKuKpGsvGY4seL8y/knvcKMfkkPhwYpCDPmXajX4ozT6eEmA/jj2yLaKXCd1nQzJX38Ef3pPpLBMS5TsG8U1VVuOk7Bjzfv79GAV03GJNb0evMGNmnn43MyEmOZuhOr8h8pwfQPWTzwh/0jSVMRKYXlYDLW0OJceFd8XeNhorAtbzDxnzMfz09vqyyf7p6XrrpxBd+fO8Bs6/K6tuM7rJUAOdDPPqW7IutDW7y8l3mo2FR7i1Mxrl2WeShX49A9i8nl51+NZy/ouQmQWjfX229ji/n769LN/S4Hj8y8daBRoE5a4HH8Ls3E5Z5R7OTA3Xn+jiQ9k6m7QSbKv0JBiss/OP5ynmc9V1+vWDB/7ubOmPQJAyTWn6+pEZVGTTkLnhw4+Y69hl865siTlXcSsULcURYzuL+rJDvRWXc4sYPrnAj+BL2q9vdye+vpx/h+U/n+2pD13MvkWwslg5f3iD8GFcl9Ixea22IDu9kEOLGVzIA8/moHFLhFUldl6L5Emot5SeEXkT8EBUQ9LJzs7nMB95+/yfn8kxerqP5OYdxxQbCNup8hw2u/gUC5KGwplTRGbfobYhQkegq3rNtJ09YymZGpbOFje4iyyNYZkmsQWjQCWLI2FuJDapKUZd1NiVrqTm847vaFSXFCiOSHBA7npmpwbgDDNq1EY4FFpuQGOS6juoa9dWkq5/+iLziyTuLn72SKePwKUN+m9gADcbLPaiZZ+0War9eBYMpBs9tg4+OyB7TGBzvKS+CmDX2AEUBLhJ0kyS7Or09o/W9ghac+sy5Hx6s2cyIxvLAH6cKL4yfRsgWlaBR5LuuFpmUWxd3YKUcjHHlg9h+ZKvykJdXEfWDFgFVjpOtbD6HLMj1pIKKBcyasVTuUEH5tLD5iV2+TMP5dQ1B/8WMEXkrW7MtlaEaEmHFIKnzgJhutn9aTuAh01P9/fAFDWzXEiMYCJYBFuE+sozx1rfEJeBQUSMW2Ihz35Kw5RpP4B4KrdGJR/NRJ/bi5q/JJQiWB0VFBRoS4eg6ZVm+VTUR5H5lQgzTimWPBOxOa92ChrF4WUDV1h6bmkEWqsIpcoe3glnAAgxdRSyLeraSdfam7f+dW1IOr4h8eatf/2bEqZNYQqi/8c2Jp/clSaRdXvm8LN/98XXPvmbdzef/29Aq/6rs8fvFVF9ty7//y7+v3YXzecWu/hMziwlQHqu+fWY8jkzOLb5RJAB304nJPx3ZIknx15qIRDsl+zAMValsuajOUd6dEPZHJYKbYM4z+c8F0/a59+eO/XpVV07i9UV10s8QwPdjAJiRHHIGSmP2gwVMIT+GUtnS+9XpUNtGmw6gKBh3ZdhFguz0GD/163ctTI/gNvBiYAPMU5n9A3ptxhVMwkrxFgHgpAe2C7WbTCBBdfhhUZMEaCTBEVrfG0nW4RDIQIJwmWYkqpngaR+5k8A60FUMFySG5XMnBlOIPyGWnXHzMzTdN+TOzeziGPLIEkJCRpblvSW3v7eFbUG5aVc2zpc595Sdp2kSxY7n1I/YmGo+08mAVx7KT4xJa1CUdkpuVUb/sGj/AEpAHhQIvzdvPqAqa9YFc7qKViugErxT+U+MnSDJtuk9tpxlst7+dATGS7BiugT6xiiWs4o8FEheGdNRNCSVM6wx3R8X9Q19pvzsMgqAeEVyjhDQZk0lAAmTiiVs0TQktEXPVOFdsODXvQueRFpwxwjlxX03elPvSkruDUyX5zGUk0RJ09k4lACx9LlTa2fxzt8Sx6wXdHjTsE37+at0xs+kqELpBbTTFqlacReX79mpjv9Vv2kHgfVg5jf9GWnpUXZwr6TPzys+epbL1d189+WFbZStyAc59wAtcNFtY6HMyNN211p4aavZ9D9HGcyBwooP7SgjY0aX6NTuCaEwUBKHBheod50r23UbhtYHQYokFyDoQwB2yoczlrJeF2G6uWwgLaNMb/Gd7KPrnelD6CcZUBtmGWJXdAH+b95H9u3CiuepfAVfsfNslCt4O4bEHOC/Tz29t1XmP+xmb6r7NhpHCbt6aVPygP54J+uD7681VErsExdAlpXI4yZqQLXFj9lKpvQcnuipsCLulHSNKp/NPKfKKSdNTVCbQD/pbJUcuy25pHA++FF25I3CD0kwfc0+M8pfGjguWvh06NlMX1c8JgXAdbCRE5QeXXjlPcEOLrgjnVR5jysu1VHUI4V/hhKrTTHMQwLWi1YV+V7kIOynX04EHflpBHqgweOD0Z9PL8VLws9oYAkgIYWnGvV5gSYo25Ib2bgDNi3CkPgEPikCZ8QNKg51CeetP8ynSPkHMTUtcRhHXgUlGIHQ6TCUJT5tudZHI8RxSZxWsaaMW6h+NFu/5/sXTuMZUdaPt09TD9ut7vtsWfGO7vzwKxxw8z6vKsKe+mREBIIYSNYhNCdK62QY4RI5zTuDRAhSAQkBJsgEiJSpN0rbQBIBCQkJLtkBIhtIgwa+6Lvr++vqlPn3LFWshAS23fUM3P/ej//+h/fn9zi4T7TwEGi7oxhzzXkLFHJ0DWGY+RMk0B94dCkUDlJD3/IcD1oOXwBAjx0FNEPGrpYCqxH/8NJyxVmcIwZcjKBFXzxrkC7X2w+lWv/3nihqIho1KY1/3XXJJuhCRYzSeN/h6eyUWGr2u9O5K16sHxV+ELYYcP4wfW0sgY7bFRJGFeciuMOTTmyXYmUY1kIY8FqIL5nYgBrzxslM4uTBKYLtTALvRh3uWcf5Q1f/4vHfT8xUb47beCBKcvZJpDQTwgLQ2FuVW3LZCeEJyZIc2vix2NdkVdQCfpg5nMvTFl1VTXbgfdMWVNgy8O6ChjnTQAcQjUNkYmfBGOe/Hz4mimxybsVrZRkyqlUx6kNOx6898rz2aYcmyAFno7nGYh41EU0yHGChaGv/TbalrWCfL35PNq0zH0zP/H7pnxJ+ulC3t8yaYemqsxonFQG/DOmqgOfrqdsUA4xytH4Qr7ihXxuakavKmt9FyMCl6GZZKt6t8sikwM+Yk6iD+HSklu0JObvNMcD5AiSiOWM8Sc1Yzve+PPA0Js5L+iOEuYs4vHaZbqfM7XyhXoj+IsOh2c5ND0PPWFCOkU2nF3J+6ae7dOZaUO4vdEA6wP4xFCLNO7JiNraeeqBGRkHxafZ+6bDpVPDjQ1DUCqCkCp3a1xtuCNrHw6wARB1agCevPN+k4XhUY5Hqm2JNQcBLr7Ue9LD6tWl2JRPIQKfwPzOVvV8LcemS4Fbx8TfMj1B5vqOngWDUWYLcF61vE3EbQ/rGmZpHdxmZeaEfcPRDVMBCDWafBb1sVebvkZoPXVMJi/RCzIaUPOGvpYl0XMhJPPJ0DaN6RV2GoIB2uXXKu0miurQEch5PBZshotlgNlCZQhcjZfQ+dBUMOdfDT09/gxuqX5LSftmLHXR74+NUf//ZBT48AIx3IVF9ip7x5ioPRuIOUg8MkrE0t1afPM7n2lTzGxt7xtLRH25Qyp6v8D7rCHT31qBbhBGtFdZ2Li/PAR/23ghQd8LW6teympkS7a2sYmayZIZoyiHm8LBr7Oxg7HtfE0/72tqo7LTu9RZF8x4UWLtW9BQv2bqcr64U2PbrproNAP90FjjZikfGqtT2V4OlYkHaCWIhL3GSsd7CoyfJeIZxIyN/NdATNXrgr7+2zu/8v5/fOvWSo8gSEDbfDmw9reNy8PbozHn/DvBGRldSR8Y14zztIrs65+TDbwj+XBlqmDOICZs6hisrcLBXqz/cZdrLQB4jqo9wUsGj+DRpliTOQW1JgxppD7904Ta5nvm6dcjtV/lBhZPud0WhsxxkvOKRiEnhg+q53WUTRQFDQAXRj3uYk71uTsmJPeoucX3QkY6c0ROoeBWPDZL2mKnGf87ZGQvk9bQxOLI0Bc6aQxDYC3MMrOJjcZ7t7AoAUayVGmO5ZTBw4kBHKJPEMYDqSJitSJOI3z2jlyNN82oZ3oN+7MEXGTVRptN7FGV9+Uml2EB8ePReB9aDbvKgN/P68eiH2XYqvOQvhAPluINRUEobQbnkKZ6i6m2tiYdPDbllHki3L3+7Aj9F4XOIPpdYneCoVR5K/ywq2iagqPIURacD4CPB3nX54Tjxsu7dNeOnsBzQ+qdfO4xYY9oTGL219HFKS30L/GreGAJeA9TUTkYxEljsHX0lKXkDL+KM6bXSGVJgl0ZpKeWGoqWjyEatooZUgAY1mBIPJNwc+QK96Lw4sf7LLCi8wC4D2CCRMkcf27uhfWE5DE12D7kyTPclga3PgO2FB6eUKIBzIHXjFq2dwFu9nmdaOKL4ju7YVEK9mlk8dOq/k3a9hpSebME2+fDd7ATkrRlVOOnpfwafhXvSRIFKxkMUEhSYBkhdxjQAWj/0Hec9xGImJ8/kc39ZztI3VXqC1QNTQkoJYiO8GVl4DBvuojkBFcop+hYGLOKCGCVyEst3ZUcQJ+FMwCAuS8CN3jXD84grJWKSmWmKjLKrrMRM5ufhzLAf5g1tB6air6sIv6vrACW1ECJcNUYIa9mGrQS/i8S/w2trMqhb3Uxwp2zrhGEoBYtEF5gAB6Gpq1zW5r1T7uWjseO5woWtFqk4C3DqC5i3ZlqETxOvVaeeOz4r/3pj6wULdA6D2a2VJ1iiYjKWIVj+syEQlstPgNQKuwZxgraxKszxCYvFQIWMwL96LJug6jJMPSxeIP0tEbG/oA6lgKIKGx7EhzxqMMTThBlUM6JtwIVPsiK4VPjfh3kongDv4p7PmIsutl04FcGO3Z6TR6Ct9LosoRPy9PcljTEE8XWzjejT3bHqmCnM5EXnqa7x3QVn7NkdGerfuDj2kbdFQT4Qcw6TX/Hp/e4U/0Mn8d0J7YsK+DOzA7KfVuWNdY/wRNQPR6q7nw2+SGSZ/eFr+ahLUujBmjYkbgvvbgvLSp68dy1ZWnVihxH7LYOHIuKU0OBzvSOMuSZ8T/1VKvhYHI6c1Mok1Mld/05uesQwGlmPhlnRncQwg+XiSSEH28GfaTp3aSoA5K21aFjjgGVUdd7ZidLzzramc4EktlW/bSLrynB82ft5flFnuS+JtH2YTMoSMe0gT+bJq9jOs09qGfJc3O5E2zMfd4vp3mX1IL2re7M6wsgT+zQGp596rb1SZ2mm5k+LRgPOn1mZMOYmG7nw9hfbhkjWv9UQ98knrIhos2VAldx0vnCrKHaEyyamP5snfLpCzsVXmddkWi2Oe3I5hbMU9LWtTrt/qEtI7BlniV1aZ0QpiP2huUpNNggfp4tV6MoTwjtbLkkzM5dt60oM5tjYQUgbrZhx7a0PHaefZQTD2xpt1UVwWgyQiaXGBOmOY7jsE0HwRO7eeJtG81NAozJtK2+DDs/LydWpf5zB9EiUKfFLkJI9JfRtpQZombP0Ox8vi8HmtG++k4DZSZPjD7DJHC2dcchTOs2Yr2a3wQf2ngJaehtsWkEByoUIm11mZ2ZqRJOro6Kwxd//+Dj4xff/Zti8/va/3pLq19B3Wp5M718Ty2C64KPnG86Ot3kuhglot4mF4AkGaeiDBJfgWPw9sE8tNuuuP0QM3/u+2lJ/vtt5Uxb/SVb0bcOkj1OWs7T7HJnVnkZgZDpE5jjvq1qRRKgwLOOnkIoZ41WrDehHJs1fpejByU3DLdmyUdWLDHGB9MuJwzhG/NB3+WQ5Ae15pFHfJbnhuT5hm1Uf9D0meyCAZyC7zsRsYYYjEziWlOonKL2hJ/flZf1myIi5iZuLge1okmn5Iyn0qyeh9R9m98YZ7wxunwiH3IJd2bZTU7AQNQjNh2Xh6yqy6p6yMdE1MwhfgKEa8oLFYg2IR+f9m3LqBvQGNZ4u0dTu2VDBK20iqfsTV++jJBe90+5XnoN6JeTllbiEkXQDQRTpKFHwz3SUzyI1taloCCooQ6fBWqSwVf05B3o63rm66o1YgrDAShi2ZM6WAYFjAccnHRdwCEWnGfx6BssXOMnuiF2bGF721f5letpje31kV+7oQGYa6mBoUoJBCryl46x1mfLeMeX0XohewcsbJiVi4exeHBvy3ho+y1z8c97Vsa0LL3oh3tAzc176vw1nkqZ2M6qP7C85KUpkFHQjDmxv8EDnvJCAlWUGom2rPjIFyAOoCoGROsQkRlZaor3VdYGY2YZJ6nxfOiIbiPfV7DhQUNqYl60jnWgD4i6ynjgMagCDxouNzkXEDmvVtwchItTXBG87dG2mg3DcUPUa40ME7zXS4+BYijaral4AhuDjNLEpk1Pr1aUEdLenkVoRCEIaagihhAFAhAoSwFow02yLJHXJWBtxzvFw/UeVSwrSVhC1iDNqlS8UjOsYBNDgeKcNRo6K7jAUSzT6NZr/dbLTqsryn29xYj1zuLptXHFCwfGOERPSNfsFdesmXD/V7QNt6bvyz5een2nm5zMJSPzM7wuGWqMFCYYOBCwFOVS8w65RHdJTocSsyA+AzWnMQAbArEEM9AmhmaMU0REd4LRaLge4qQ8IdoK1FzKBzCYo52IsnbjQJh+dogOrMnvciVMOBfP6nxgbV0FhpOXKXGjO0ySGsQ1YhAXhPEVJYjhsM3FWv8ql+qZtUEFP1v/CUCG6QvRzFGXmjftlKc+sjawupZbGwaUiiKZHm4Xp1AFHFo7OfZ8Wfs2H7j0+2n6UwuZp4JKjPIJP3PX01VTqEiko4Ri/fyKJOzMlnL2bf4u8+zXu1bXMzsE0aLIZKiUg8fMSBOkIpDvi8HgiY3q07TWX5XSXwU1CqRH7XqDtzk5pZTkdVyvBmcg1DwWffy7rIpjRMNaTd74N7Vk9XHKSQvAQrX5Q+BLZJTQ6ckr4cUuM05VqMUP9kibmcUrob1uGYqBMWFZwLXoQddELHjNqsKUo2WT0fZJfDlgKhvFaxUGidIXn+imzfaAV8XdzBdm8nXaG//1LcsI83XTjVpDqD1U9LamkWBvUZPb8PgJWlxpGv5AyVh8a8dR6qz6BuKDialGjMKMW1aRYVSQHyBEcdmW/kyRWYY6lYchcokBOg6ZStx6jVpfZxboUHLDFOnXtUU8jKq6U9+YYI7QK767XrRodAu9soNfmR6yg5scAH+OI+OnWEXjusH0quIcWkMz8mwNF9/EgnsUMvUDjmqfo39Jjvshx9Cac68gNj3VBdPkt+j6Gz0yRi2/gf3yOodVTPmqOKn6EVuAN5jIMyimnxaFDXRbrys6vk87EQurSnEbmOMDir9bFEXxNaRqHG13WkNwNDER68laNxpIY9r1d312R4Qg45LsNTTVwk82W/NLjyHtC0DRVZmaCHiog99zY3shxC/UKDMl11aIHemZH+wE4dAQ1ydZu7Lavb8asUfpCu8VV9NmrjF7d6UBRMUAYHiCyaAfwWyWBSou2eK7TstxhgbrJs+34gpHwB3JJGIgYVeNmabb0zUEcZHRkDdZol1tAeFrYJUD86QQPiUAxWgWXGq6RbABcY8RxAabd05FVhRo808L1A90UDCDqhjxAvNyPvQ94MpXGVvpTS8/cAEAlNi6mDj/L7ihadQKWMbJEwZ/BwZbm8Y32IhHkeLfkuLhYIpAglJErWU5HkV5nq+4UtWC5H3MJZ4yz6vH7WgtSg0LV5Yq0clph66kxUdO+clAIUfgHZrA0fXBrBPX1N71ZvNUKnrFlQzKk0+AkI89+SXEqaTaE0/cDIpKoJ4KtXFT7ZnP/VXQeZs1l4F1dqkn5GgD3cBpdOCo5MmLe00Jc7oon4R5u8sthH4bwcxNkJufOuPKgLPUxW7RG0qjP4YAowxEP6r6HzjycxL0MPLQso8d3QJVslbd1hmFiv1zidNiF04F89PxkIyJa+IMMfBZ4aOrU0JNTHSPSaXbV65y4/OtreqX0La3Z1667LPuu/kltE8Pzfnvp9UcuHqS4YyEXPznRZBvOqq8sO8h3KClFcJQakjEjcRy/ItDNwnqgjtqUHHcJLaLp2pcGLJlsnZ/hDgvQe71xQR6cV9IpBf3xYd66U2nWOSVWvT9fwv2Qq28+z8f7sX9r8Z7cT8O+PIjBHzhzwEB3t52XQJ9iqEEKqAMYK+AVeOT9wo8/YHrctW8l5v9kqPoDzoQhJGwjKdnAXyw6rBTVsE9BHYz53So7RnQAaejW6Wcm4iJHrNggTcWcan0vyGUqQwnGfvRMS6Za+dVB9TlEp6rId9aBhTl4MYBje13f/jx871QROv6VtX3XHSN80GEVR7d9GNGatSOb+O6+Ksd17tSFXBpJEYV9vKQ6/mWJMPCq/F8MM1IDhugWqPVM7hwzPWwrEOwyJb4LjQGrLy4AauQKYhGaQjsnXngFsVn/wWxxb7LnGo4NHecqUIb+nZwIT5nSCOPm4Wj5cKY5ZC7eeEs9ZyjURPagZve8+Ihte8mEj35/kihWkdZwMkWxxDtt1Nm6xuY4+MIDjYq9BMUeuIIATShAs1gEcKNjmk7Spsy7J+ckmZWk+4dYLxuTnoHdqO46UYuAKOvU12h/3r3AyL7x8+JrlUpB0KpDaVsp6R2ZkTdbE5VsnaEkcVs95e7B6NSi2MoZ7wHhbnc7AHNIbo5gMhClRjFfod8CrlA0c8vBKNZOqbhYVcGPP9KA+6OwgQmcTEkoHoWGeOBlMnGQHYnqncEiKLYCuklZqFv3W2k53Cg4I6zHKWAMtC/jNu310IZAIWmXEMQqNoo2zSXqj6BrV3AWukmDT5KlhZJOkivex1gbD5Cref5v0IXfQgnrYq2oxQ9psYmL4507MykoECaDuojJWkEMEVZYFDddpLjD8AE1/qW0HhilGbiRhxqg7uBinMKivjW7yj7wT2HK6SqrZ6DzN4EK50nFPtE70VR1n7yg72iePgTa47kbT5cYF43JCLuFxuuY9/Px0yGRFWUCw8EQMGcVtHzGvmSzAsczmEAT9eb+IgojiF1ibWertM9eQhugGvjVH1+5M/XSen5BIeG5PyCsl99uNS0XmcAdqaEkWVs2wtOcHDXIhKvyNUhvsI5xR7Wow76j9yUby2BcE6of2Da+XWLRV81432zuSB4y+2QB6me9FnRmuyQXepCy7TRSrETymteVONWSYuL9YOPX+ysH3xcXGO5n6jTFcEe1duKzl2B2k5oUvgqVR0hyaf/+f0YCehEYax4jl+PjtJjomUlpQtxrxgT+5cRR8VKzneoLcUs+APTQXEUZs1cDuo9PtasvDXKiPrD2VRHsxlF/tHLAs8f64J46OxpsXPjjE6Spzj2VvowRD/Wpxcvrr93yuPrSEmh/+G+CKRUAxRH1a2i3bRW6Q1vj/R47SYdDKR+O8lsJ6Ut8aRXleS8yHFa7p1lHV3/hu0nqxh5QfCppwjfM+BaB751EPisevZRkIN++4/u60KDCIWWicGEdL0XNgNkxOSuAI2Mc1ZCMYnQLwnVy23sB/KQOdhtLQhvBlLYawI1oaNYYEMbAKevN5vdF+FwONGYZjrMF0WykhZLxfGLNB2dI+AqhjnVHPK5B5cYt4qwBxXUUyqi+bAobuAcuyHCnL/e0RDFz7vHFQJIuxD/vXcU7Tz76MJ/j+eno3dMIkyvHjfMGCJnNNw4BFlE5RWHufLPPlrW0AtFIsxLHZQY4qXUrOBDBZmQ3J1IWM3c5afyqtd1hwW3xmZaX3BzPBQ6z2mZ3Yl8VnivEITzFh6V5B+iRt+nuaCG/s4y8m4BDmh8/Os8RbWxsnj+KC6hTmLxjPbwvMO/Bb3ZT12HKYgG9bKT/liPtd8A+J/ez13qLUAP1WDO48szutiVK0IN8GKV2lnHAerYv/6M7T9kHRoZKt4kR+LpxYYFvlX+lES11C1O2JnnJjKLYO8E4JL3l46ZrvymjSd9UGTLYX7IoYoU/VkEn2Iz8SleLFMVO13EWdu9KOyDZLG0g6V3DB8gm82pIngfLnk1khIvvyNIxRKdQBJK8wyekH4wEuvNzV4ylv6gCG+YeEQcKeM1IrHdXb9yDJgHd7GXRF04XI79gyJgn1LMhPIgMODq4+Fx0Fqruiocgp+G2/toyRs4VBJu4DdpgurXXCPesVEgfUNWlDgbZyl7M06JMg8Y8O9k2ZsVX88cUrlstVu66UkJB/ajZW9X1KfQRQe9gqS45CmJfb7ZP9Nzf7FkwIjogoIE0rP7pIUj32RmDtf6lJRGnwngXjCJCq0r9LI+hIyKWamH5RguwuEVehtuJk+bWL8L7XWG+XHxcUWf+2TH3cThWunbdrPZbDanRVH8zwC1AlHD20wBAA=="
@@ -0,0 +1,70 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDigitPrefixes(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		allowed    []string
+		disallowed []string
+	}{
+		{`[2-9]\d{9}`, []string{"20", "99", "2015550123"}, []string{"00", "10", "1015550123"}},
+		{`1(?:2|3)\d|4`, []string{"12", "13"}, []string{"11", "14", "40"}},
+		{`(?:6[0-2]|7)\d{5}`, []string{"60", "62", "70", "79"}, []string{"63", "80"}},
+		{`\d`, []string{"1", ""}, []string{"12"}},
+		{`\d{2,4}`, []string{"00", "99"}, nil},
+	}
+
+	for _, tc := range tests {
+		prefixes, err := buildDigitPrefixes(tc.pattern)
+		require.NoError(t, err)
+
+		for _, number := range tc.allowed {
+			assert.True(t, prefixes.allows(number), "expected %s to be allowed by %s", number, tc.pattern)
+		}
+		for _, number := range tc.disallowed {
+			assert.False(t, prefixes.allows(number), "expected %s to be disallowed by %s", number, tc.pattern)
+		}
+	}
+
+	_, err := buildDigitPrefixes(`[`)
+	assert.Error(t, err)
+}
+
+func TestDigitPrefixData(t *testing.T) {
+	collection, err := MetadataCollection()
+	require.NoError(t, err)
+
+	// our generated table should be current for our metadata
+	data, err := BuildDigitPrefixData(collection)
+	require.NoError(t, err)
+	expected, err := readDigitPrefixMap(data)
+	require.NoError(t, err)
+	actual, err := loadDigitPrefixMap(digitPrefixData)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual, "digitprefixes_bin.go is out of date, run buildmetadata")
+
+	for _, metadata := range collection.GetMetadata() {
+		for _, desc := range metadata.descs() {
+			pattern := desc.GetNationalNumberPattern()
+			if pattern == "" {
+				continue
+			}
+
+			prefixes := digitPrefixesFor(pattern)
+			if !assert.NotNil(t, prefixes, "missing prefixes for %s pattern %s", metadata.GetId(), pattern) {
+				continue
+			}
+
+			// and never reject an example number
+			if example := desc.GetExampleNumber(); example != "" {
+				assert.True(t, prefixes.allows(example), "prefixes for %s reject example number %s", metadata.GetId(), example)
+			}
+		}
+	}
+}
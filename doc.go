@@ -0,0 +1,20 @@
+// Package phonenumbers is a port of Google's libphonenumber for parsing, formatting and validating
+// international phone numbers.
+//
+// # Concurrency
+//
+// All functions in this package are safe to call from multiple goroutines at once, with the
+// exception of the Register functions, which are only meant to be called from the init functions
+// of the data packages. SwapMetadata, LoadMetadataCollection and ResetMetadata replace the
+// metadata every other function reads all at once, so no lookup ever sees part of one and part of
+// another, but a call already in progress can use the old metadata for some steps and the new for
+// later ones.
+//
+// Metadata for each region, carrier and geocoding data for each language, and timezone data are
+// all decoded the first time they are used. This is done exactly once, however many goroutines
+// ask for it at the same time, and afterwards they are only ever read.
+//
+// An AsYouTypeFormatter or PhoneNumberMatcher holds the state of a single input and is not safe
+// for concurrent use, so each goroutine should create its own. The same is true of a PhoneNumber
+// being parsed into with ParseInto or ParseToNumber.
+package phonenumbers
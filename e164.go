@@ -0,0 +1,116 @@
+package phonenumbers
+
+import "fmt"
+
+// PhoneE164 is a valid phone number in E164 format, e.g. "+16502530000", for use in API models where
+// a full PhoneNumber is more than is needed. Values should be created with NewPhoneE164 or unmarshaled,
+// which both check the number is valid, so that code receiving one doesn't need to. The zero value
+// represents no number, and encodes as an empty string.
+type PhoneE164 string
+
+// NewPhoneE164 parses the passed in number, using the region for numbers not in international format,
+// and returns it as a PhoneE164 if it's valid. As E164 has no room for extensions, they are dropped.
+func NewPhoneE164(number, region string) (PhoneE164, error) {
+	num, err := Parse(number, region)
+	if err != nil {
+		return "", err
+	}
+	if !IsValidNumber(num) {
+		return "", ErrInvalidNumber
+	}
+	return PhoneE164(Format(num, E164)), nil
+}
+
+// NormalizeE164 parses the passed in number, using the region for numbers not in international format,
+// checks it's valid and returns it in E164 format, e.g. "+16502530000" for "(650) 253-0000" in the US.
+// Errors from parsing are returned as is, so can be checked for with errors.Is, and numbers which parse
+// but aren't valid give an *InvalidNumberError saying why, which wraps ErrInvalidNumber. As E164 has no
+// room for extensions, they are dropped.
+func NormalizeE164(raw, region string) (string, error) {
+	num, err := Parse(raw, region)
+	if err != nil {
+		return "", err
+	}
+	if !IsValidNumber(num) {
+		return "", &InvalidNumberError{Err: ErrInvalidNumber, Reason: IsPossibleNumberWithReason(num)}
+	}
+	return Format(num, E164), nil
+}
+
+// InvalidNumberError is returned by NormalizeE164 for numbers which can be parsed but aren't valid. It
+// wraps ErrInvalidNumber, so errors.Is(err, ErrInvalidNumber) still works.
+type InvalidNumberError struct {
+	Err error
+
+	// why the number isn't valid, which is IS_POSSIBLE for numbers of the right length which aren't
+	// in any range the metadata knows of
+	Reason ValidationResult
+}
+
+var invalidNumberReasons = map[ValidationResult]string{
+	IS_POSSIBLE:            "not in an assigned range",
+	INVALID_COUNTRY_CODE:   "invalid country code",
+	TOO_SHORT:              "too short",
+	TOO_LONG:               "too long",
+	IS_POSSIBLE_LOCAL_ONLY: "only diallable locally",
+	INVALID_LENGTH:         "invalid length",
+}
+
+func (e *InvalidNumberError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, invalidNumberReasons[e.Reason])
+}
+
+func (e *InvalidNumberError) Unwrap() error {
+	return e.Err
+}
+
+// String returns the number in E164 format
+func (p PhoneE164) String() string {
+	return string(p)
+}
+
+// Number parses and returns the full phone number, or nil for the zero value
+func (p PhoneE164) Number() *PhoneNumber {
+	if p == "" {
+		return nil
+	}
+	num, err := Parse(string(p), UNKNOWN_REGION)
+	if err != nil {
+		return nil
+	}
+	return num
+}
+
+// Region returns the region code of the number, e.g. "US", or ZZ for the zero value
+func (p PhoneE164) Region() string {
+	num := p.Number()
+	if num == nil {
+		return UNKNOWN_REGION
+	}
+	return GetRegionCodeForNumber(num)
+}
+
+// CountryCode returns the country calling code of the number, e.g. 1, or 0 for the zero value
+func (p PhoneE164) CountryCode() int32 {
+	return p.Number().GetCountryCode()
+}
+
+// MarshalText implements encoding.TextMarshaler so that numbers are encoded as JSON strings
+func (p PhoneE164) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting valid numbers in international format,
+// which are normalized to E164, or an empty string for the zero value.
+func (p *PhoneE164) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = ""
+		return nil
+	}
+	parsed, err := NewPhoneE164(string(text), UNKNOWN_REGION)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,110 @@
+package phonenumbers
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ encoding.TextMarshaler = PhoneE164("")
+var _ encoding.TextUnmarshaler = new(PhoneE164)
+var _ fmt.Stringer = PhoneE164("")
+
+func TestNewPhoneE164(t *testing.T) {
+	tests := []struct {
+		input       string
+		region      string
+		expected    PhoneE164
+		err         error
+		regionCode  string
+		countryCode int32
+	}{
+		{"(650) 253-0000", "US", "+16502530000", nil, "US", 1},
+		{"+1 650 253 0000 ext. 123", "", "+16502530000", nil, "US", 1},
+		{"0788 383 383", "RW", "+250788383383", nil, "RW", 250},
+		{"+800 1234 5678", "", "+80012345678", nil, "001", 800},
+		{"(650) 253-0000", "", "", ErrInvalidCountryCode, "ZZ", 0},
+		{"+1 555 555 5555", "", "", ErrInvalidNumber, "ZZ", 0},
+	}
+	for _, tc := range tests {
+		number, err := NewPhoneE164(tc.input, tc.region)
+		assert.Equal(t, tc.err, err, "error mismatch for %s", tc.input)
+		assert.Equal(t, tc.expected, number, "number mismatch for %s", tc.input)
+		assert.Equal(t, string(tc.expected), number.String())
+		assert.Equal(t, tc.regionCode, number.Region(), "region mismatch for %s", tc.input)
+		assert.Equal(t, tc.countryCode, number.CountryCode(), "country code mismatch for %s", tc.input)
+	}
+
+	assert.Nil(t, PhoneE164("").Number())
+	assert.Equal(t, uint64(6502530000), PhoneE164("+16502530000").Number().GetNationalNumber())
+}
+
+func TestPhoneE164JSON(t *testing.T) {
+	type contact struct {
+		Phone PhoneE164 `json:"phone"`
+		Fax   PhoneE164 `json:"fax"`
+	}
+
+	encoded, err := json.Marshal(contact{Phone: "+16502530000"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"phone":"+16502530000","fax":""}`, string(encoded))
+
+	// numbers are normalized when unmarshaled
+	var decoded contact
+	require.NoError(t, json.Unmarshal([]byte(`{"phone":"+1 (650) 253-0000","fax":""}`), &decoded))
+	assert.Equal(t, contact{Phone: "+16502530000"}, decoded)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"phone":"6502530000"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"phone":"+1 555 555 5555"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"phone":16502530000}`), &decoded))
+
+	// and can be used as map keys
+	encoded, err = json.Marshal(map[PhoneE164]int{"+16502530000": 1})
+	require.NoError(t, err)
+	assert.Equal(t, `{"+16502530000":1}`, string(encoded))
+}
+
+func TestNormalizeE164(t *testing.T) {
+	tests := []struct {
+		input    string
+		region   string
+		expected string
+		err      error
+		reason   ValidationResult
+	}{
+		{"(650) 253-0000", "US", "+16502530000", nil, IS_POSSIBLE},
+		{"+1 650 253 0000 ext. 123", "", "+16502530000", nil, IS_POSSIBLE},
+		{"020 7031 3000", "GB", "+442070313000", nil, IS_POSSIBLE},
+		{"+800 1234 5678", "", "+80012345678", nil, IS_POSSIBLE},
+		{"", "US", "", ErrNotANumber, IS_POSSIBLE},
+		{"(650) 253-0000", "", "", ErrInvalidCountryCode, IS_POSSIBLE},
+		{"+1 555 555 5555", "", "", ErrInvalidNumber, IS_POSSIBLE},
+		{"+1 650 253", "", "", ErrInvalidNumber, TOO_SHORT},
+		{"+1 650 253 0000 0000", "", "", ErrInvalidNumber, TOO_LONG},
+		{"253 0000", "US", "", ErrInvalidNumber, IS_POSSIBLE_LOCAL_ONLY},
+	}
+	for _, tc := range tests {
+		number, err := NormalizeE164(tc.input, tc.region)
+		assert.Equal(t, tc.expected, number, "number mismatch for %s", tc.input)
+		if tc.err == nil {
+			assert.NoError(t, err, "unexpected error for %s", tc.input)
+			continue
+		}
+		assert.ErrorIs(t, err, tc.err, "error mismatch for %s", tc.input)
+
+		var invalidErr *InvalidNumberError
+		if errors.As(err, &invalidErr) {
+			assert.Equal(t, tc.reason, invalidErr.Reason, "reason mismatch for %s", tc.input)
+		} else {
+			assert.NotEqual(t, ErrInvalidNumber, tc.err, "expected InvalidNumberError for %s", tc.input)
+		}
+	}
+
+	_, err := NormalizeE164("+1 650 253", "")
+	assert.EqualError(t, err, "the phone number supplied is not a valid number: too short")
+}
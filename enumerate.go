@@ -0,0 +1,146 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// NumberEnumerator iterates over the valid numbers of a type in a region which start with a prefix,
+// created with EnumerateNumbers. It's not safe for concurrent use.
+type NumberEnumerator struct {
+	regionCode  string
+	typ         PhoneNumberType
+	countryCode int32
+	prog        *patternProg
+	lengths     map[int]bool
+	maxLength   int
+	remaining   int // how many more numbers can be returned, negative meaning there's no limit
+
+	// the national significant numbers still to be explored, deepest last
+	stack []enumerateFrame
+}
+
+// enumerateFrame is a national significant number, or the start of one, which we are exploring
+type enumerateFrame struct {
+	digits  string
+	states  []uint32 // the instructions of prog we could be at after its digits
+	next    byte     // the next digit to try after it
+	visited bool     // whether it's been checked as a number in its own right
+}
+
+// EnumerateNumbers returns an enumerator of the valid numbers of the passed in type in the passed in
+// region whose national significant numbers start with the passed in prefix, e.g. all the valid
+// fixed line numbers in a block of numbers allocated to a business, for test labs and DID
+// provisioning. Numbers are found lazily, in the lexicographic order of their national significant
+// numbers, by walking the region's pattern for that type one digit at a time, so only digits which
+// can lead to a valid number are tried however large the range. At most limit numbers are returned,
+// zero meaning there's no limit.
+//
+// ErrInvalidCountryCode is returned if the region isn't supported, and ErrInvalidPrefix if the prefix
+// isn't made up of digits. As with GenerateExample, FIXED_LINE and MOBILE numbers may be ones which
+// could be either.
+func EnumerateNumbers(regionCode string, typ PhoneNumberType, prefix string, limit int) (*NumberEnumerator, error) {
+	if !isValidRegionCode(regionCode) {
+		return nil, ErrInvalidCountryCode
+	}
+	for i := 0; i < len(prefix); i++ {
+		if prefix[i] < '0' || prefix[i] > '9' {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPrefix, prefix)
+		}
+	}
+
+	metadata := getMetadataForRegion(regionCode)
+	e := &NumberEnumerator{
+		regionCode:  regionCode,
+		typ:         typ,
+		countryCode: metadata.GetCountryCode(),
+		lengths:     make(map[int]bool),
+		remaining:   limit,
+	}
+	if limit <= 0 {
+		e.remaining = -1
+	}
+
+	desc := getNumberDescByType(metadata, typ)
+	pattern := desc.GetNationalNumberPattern()
+	if pattern == "" || pattern == "NA" {
+		return e, nil
+	}
+
+	// types which don't have their own possible lengths have those of the region
+	lengths := desc.GetPossibleLength()
+	if len(lengths) == 0 {
+		lengths = metadata.GetGeneralDesc().GetPossibleLength()
+	}
+	for _, length := range lengths {
+		if length > 0 {
+			e.lengths[int(length)] = true
+			if int(length) > e.maxLength {
+				e.maxLength = int(length)
+			}
+		}
+	}
+
+	var err error
+	e.prog, err = compilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	states := e.prog.start()
+	for i := 0; i < len(prefix) && len(states) > 0; i++ {
+		states = e.prog.step(states, rune(prefix[i]))
+	}
+	if len(states) > 0 {
+		e.stack = append(e.stack, enumerateFrame{digits: prefix, states: states})
+	}
+	return e, nil
+}
+
+// Next returns the next number, or false if there are no more
+func (e *NumberEnumerator) Next() (*PhoneNumber, bool) {
+	for e.remaining != 0 && len(e.stack) > 0 {
+		top := &e.stack[len(e.stack)-1]
+
+		if !top.visited {
+			top.visited = true
+			if number := e.numberFor(top); number != nil {
+				if e.remaining > 0 {
+					e.remaining--
+				}
+				return number, true
+			}
+		}
+
+		if len(top.digits) >= e.maxLength || top.next > 9 {
+			e.stack = e.stack[:len(e.stack)-1]
+			continue
+		}
+
+		digit := '0' + top.next
+		top.next++
+		if states := e.prog.step(top.states, rune(digit)); len(states) > 0 {
+			e.stack = append(e.stack, enumerateFrame{digits: top.digits + string(digit), states: states})
+		}
+	}
+	return nil, false
+}
+
+// numberFor returns the number for the passed in frame if it's a valid number of our type
+func (e *NumberEnumerator) numberFor(frame *enumerateFrame) *PhoneNumber {
+	if !e.lengths[len(frame.digits)] || !e.prog.matches(frame.states) {
+		return nil
+	}
+
+	nsn, err := strconv.ParseUint(frame.digits, 10, 64)
+	if err != nil {
+		return nil
+	}
+	number := &PhoneNumber{CountryCode: e.countryCode, NationalNumber: nsn}
+	setItalianLeadingZerosForPhoneNumber(frame.digits, number)
+
+	if !isNumberOfType(number, e.regionCode, e.typ) {
+		return nil
+	}
+	return number
+}
@@ -0,0 +1,75 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumerateNumbers(t *testing.T) {
+	tests := []struct {
+		region   string
+		typ      PhoneNumberType
+		prefix   string
+		limit    int
+		expected []string
+	}{
+		{"US", FIXED_LINE, "65025300", 3, []string{"+16502530000", "+16502530001", "+16502530002"}},
+		{"US", FIXED_LINE, "6502530000", 0, []string{"+16502530000"}},
+		{"US", FIXED_LINE, "", 2, []string{"+12012000000", "+12012000001"}},
+		{"US", TOLL_FREE, "8002530", 2, []string{"+18002530000", "+18002530001"}},
+		{"US", FIXED_LINE, "0", 0, nil},           // no US numbers start with 0
+		{"US", FIXED_LINE, "65025300000", 0, nil}, // too long
+		{"US", PAGER, "", 0, nil},                 // no numbers of this type
+		{"GB", MOBILE, "740012345", 0, []string{
+			"+447400123450", "+447400123451", "+447400123452", "+447400123453", "+447400123454",
+			"+447400123455", "+447400123456", "+447400123457", "+447400123458", "+447400123459",
+		}},
+		{"RW", MOBILE, "", 2, []string{"+250720000000", "+250720000001"}},
+
+		// numbers of different lengths come in lexicographic order
+		{"DE", FIXED_LINE, "30", 4, []string{"+4930000", "+49300000", "+493000000", "+4930000000"}},
+	}
+
+	for _, tc := range tests {
+		enumerator, err := EnumerateNumbers(tc.region, tc.typ, tc.prefix, tc.limit)
+		require.NoError(t, err)
+
+		var actual []string
+		for {
+			number, ok := enumerator.Next()
+			if !ok {
+				break
+			}
+			assert.True(t, IsValidNumberForRegion(number, tc.region), "invalid number %s enumerated", Format(number, E164))
+			actual = append(actual, Format(number, E164))
+		}
+		assert.Equal(t, tc.expected, actual, "numbers mismatch for %s %d %s", tc.region, tc.typ, tc.prefix)
+	}
+
+	_, err := EnumerateNumbers("XX", FIXED_LINE, "", 0)
+	assert.Equal(t, ErrInvalidCountryCode, err)
+	_, err = EnumerateNumbers("US", FIXED_LINE, "+1650", 0)
+	assert.ErrorIs(t, err, ErrInvalidPrefix)
+}
+
+func TestEnumerateNumbersBlock(t *testing.T) {
+	// a whole block of numbers is enumerated, each exactly once and in order
+	enumerator, err := EnumerateNumbers("GB", FIXED_LINE, "2070313", 0)
+	require.NoError(t, err)
+
+	previous := uint64(0)
+	count := 0
+	for {
+		number, ok := enumerator.Next()
+		if !ok {
+			break
+		}
+		assert.Greater(t, number.GetNationalNumber(), previous)
+		assert.Equal(t, FIXED_LINE, GetNumberType(number))
+		previous = number.GetNationalNumber()
+		count++
+	}
+	assert.Equal(t, 1000, count)
+}
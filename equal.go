@@ -0,0 +1,55 @@
+package phonenumbers
+
+import "google.golang.org/protobuf/proto"
+
+// EqualOption is an option for Equal, naming a field which shouldn't be compared
+type EqualOption int
+
+const (
+	// IgnoreRawInput ignores the raw input, which differs for numbers parsed from different strings
+	IgnoreRawInput EqualOption = iota
+
+	// IgnoreCountryCodeSource ignores how the country code was found when the number was parsed
+	IgnoreCountryCodeSource
+
+	// IgnorePreferredDomesticCarrierCode ignores the carrier code found when the number was parsed
+	IgnorePreferredDomesticCarrierCode
+)
+
+// Clone returns a deep copy of the passed in number, or nil if it's nil
+func Clone(number *PhoneNumber) *PhoneNumber {
+	if number == nil {
+		return nil
+	}
+	return proto.Clone(number).(*PhoneNumber)
+}
+
+// Equal returns whether the passed in numbers are the same. Unlike proto.Equal, an empty extension
+// is the same as no extension, and the italian leading zero flag without a number of leading zeros
+// is the same as it with a single leading zero. The number of leading zeros is ignored for numbers
+// without the italian leading zero flag. Fields which are only set when a number is parsed, such as
+// the raw input, are compared unless ignored by the passed in options.
+func Equal(a, b *PhoneNumber, options ...EqualOption) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Key() != b.Key() {
+		return false
+	}
+
+	var ignoreRawInput, ignoreCountryCodeSource, ignoreCarrierCode bool
+	for _, option := range options {
+		switch option {
+		case IgnoreRawInput:
+			ignoreRawInput = true
+		case IgnoreCountryCodeSource:
+			ignoreCountryCodeSource = true
+		case IgnorePreferredDomesticCarrierCode:
+			ignoreCarrierCode = true
+		}
+	}
+
+	return (ignoreRawInput || a.GetRawInput() == b.GetRawInput()) &&
+		(ignoreCountryCodeSource || a.GetCountryCodeSource() == b.GetCountryCodeSource()) &&
+		(ignoreCarrierCode || a.GetPreferredDomesticCarrierCode() == b.GetPreferredDomesticCarrierCode())
+}
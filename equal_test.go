@@ -0,0 +1,86 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClone(t *testing.T) {
+	number, err := ParseAndKeepRawInput("(650) 253-0000 ext. 123", "US")
+	require.NoError(t, err)
+
+	clone := Clone(number)
+	assert.True(t, proto.Equal(number, clone))
+	assert.True(t, Equal(number, clone))
+
+	// changing the clone doesn't change the original
+	clone.Extension = proto.String("456")
+	assert.Equal(t, "123", number.GetExtension())
+
+	assert.Nil(t, Clone(nil))
+}
+
+func TestEqual(t *testing.T) {
+	parsed, err := ParseAndKeepRawInput("(650) 253-0000", "US")
+	require.NoError(t, err)
+	parsedInternational, err := ParseAndKeepRawInput("+1 650 253 0000", "US")
+	require.NoError(t, err)
+
+	tests := []struct {
+		a, b     *PhoneNumber
+		options  []EqualOption
+		expected bool
+	}{
+		{&PhoneNumber{CountryCode: 1, NationalNumber: 6502530000}, &PhoneNumber{CountryCode: 1, NationalNumber: 6502530000}, nil, true},
+		{&PhoneNumber{CountryCode: 1, NationalNumber: 6502530000}, &PhoneNumber{CountryCode: 1, NationalNumber: 6502530001}, nil, false},
+		{&PhoneNumber{CountryCode: 1, NationalNumber: 6502530000}, &PhoneNumber{CountryCode: 7, NationalNumber: 6502530000}, nil, false},
+
+		// empty extensions are the same as none
+		{&PhoneNumber{CountryCode: 1, NationalNumber: 6502530000}, &PhoneNumber{CountryCode: 1, NationalNumber: 6502530000, Extension: proto.String("")}, nil, true},
+		{&PhoneNumber{CountryCode: 1, NationalNumber: 6502530000}, &PhoneNumber{CountryCode: 1, NationalNumber: 6502530000, Extension: proto.String("1")}, nil, false},
+
+		// the italian leading zero flag on its own means a single leading zero
+		{
+			&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true)},
+			&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true), NumberOfLeadingZeros: proto.Int32(1)},
+			nil, true,
+		},
+		{
+			&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true)},
+			&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true), NumberOfLeadingZeros: proto.Int32(2)},
+			nil, false,
+		},
+		{
+			&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(false)},
+			&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, NumberOfLeadingZeros: proto.Int32(1)},
+			nil, true,
+		},
+
+		// fields set by parsing are compared unless ignored
+		{parsed, parsedInternational, nil, false},
+		{parsed, parsedInternational, []EqualOption{IgnoreRawInput}, false},
+		{parsed, parsedInternational, []EqualOption{IgnoreRawInput, IgnoreCountryCodeSource}, true},
+		{
+			&PhoneNumber{CountryCode: 55, NationalNumber: 1123456789, PreferredDomesticCarrierCode: proto.String("15")},
+			&PhoneNumber{CountryCode: 55, NationalNumber: 1123456789},
+			nil, false,
+		},
+		{
+			&PhoneNumber{CountryCode: 55, NationalNumber: 1123456789, PreferredDomesticCarrierCode: proto.String("15")},
+			&PhoneNumber{CountryCode: 55, NationalNumber: 1123456789},
+			[]EqualOption{IgnorePreferredDomesticCarrierCode}, true,
+		},
+
+		{nil, nil, nil, true},
+		{nil, &PhoneNumber{}, nil, false},
+		{&PhoneNumber{}, nil, nil, false},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expected, Equal(tc.a, tc.b, tc.options...), "equal mismatch for test %d", i)
+		assert.Equal(t, tc.expected, Equal(tc.b, tc.a, tc.options...), "equal mismatch for reversed test %d", i)
+	}
+}
@@ -0,0 +1,192 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// inputs seen in the wild which have caused trouble for phone number libraries, used to seed our fuzz targets
+var fuzzNumberSeeds = []string{
+	"",
+	"+",
+	"++44 20 7031 3000",
+	"+1 (650) 253-0000 ext. 123",
+	"+1 650 253 0000 x 1234 # 5",
+	"1-800-FLOWERS",
+	"+44 (0) 20 7031 3000",
+	"011 44 20 7031 3000",
+	"0049 30 123456",
+	"+49 (0)30 12345-67 Durchwahl 89",
+	"tel:+1-650-253-0000;phone-context=+1",
+	"tel:253-0000;phone-context=www.google.com",
+	"tel:;phone-context=",
+	"tel:+1-650-253-0000;isub=12345;ext=99",
+	"０６５０−２５３−００００",
+	"+٤٤٢٠٧٠٣١٣٠٠٠",
+	"+۹۸ ۲۱ ۸۸۷۷ ۶۶۵۵",
+	"+800 1234 5678",
+	"+1 650 253 0000 ext. 99999999999999999999",
+	"ext. 1234",
+	"0800 123 456;ext=",
+	"\xff\xfe6502530000",
+	"+1 650\x00253 0000",
+	"+1 650 253 ‮0000",
+	"(((((((((6502530000",
+	"9999999999999999999999999",
+}
+
+var fuzzRegionSeeds = []string{"US", "GB", "DE", "BR", "IN", "ZZ", "001", ""}
+
+func FuzzParse(f *testing.F) {
+	for _, number := range fuzzNumberSeeds {
+		for _, region := range fuzzRegionSeeds {
+			f.Add(number, region)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, number string, region string) {
+		num, err := ParseAndKeepRawInput(number, region)
+		if err != nil {
+			return
+		}
+
+		for _, format := range []PhoneNumberFormat{E164, INTERNATIONAL, NATIONAL, RFC3966} {
+			Format(num, format)
+		}
+		FormatInOriginalFormat(num, region)
+		FormatOutOfCountryCallingNumber(num, "US")
+		IsValidNumber(num)
+		IsPossibleNumberWithReason(num)
+		GetNumberType(num)
+		GetRegionCodeForNumber(num)
+
+		// whatever we format should parse again, apart from numbers which are all zeros as those are formatted
+		// as they were input
+		if num.GetNationalNumber() == 0 {
+			return
+		}
+		if _, err := Parse(Format(num, E164), ""); err != nil && IsValidNumber(num) {
+			t.Errorf("unable to parse E164 format of valid number %q: %s", number, err)
+		}
+	})
+}
+
+func FuzzPhoneNumberMatcher(f *testing.F) {
+	for _, number := range fuzzNumberSeeds {
+		f.Add("Call me on "+number+" or "+number+" tomorrow", "US")
+	}
+	f.Add("Numbers: 650-253-0000, (650) 253-0001 and +44 20 7031 3000.", "US")
+	f.Add("1/12/2011 12:34 650 253 0000", "US")
+	f.Add("[(650) 253-0000]", "GB")
+
+	f.Fuzz(func(t *testing.T, text string, region string) {
+		matcher := NewPhoneNumberMatcher(text, region)
+		for {
+			match, err := matcher.Next()
+			if err != nil {
+				if err != io.EOF && err != ErrInputTooLong {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if match.Start() < 0 || match.End() > len(text) || match.Start() > match.End() {
+				t.Fatalf("match %d-%d out of range for text of length %d", match.Start(), match.End(), len(text))
+			}
+			if text[match.Start():match.End()] != match.RawString() {
+				t.Fatalf("match %q doesn't match text %q", match.RawString(), text[match.Start():match.End()])
+			}
+		}
+	})
+}
+
+// encodeFuzzPrefixMap encodes the passed in values and mappings as read by readPrefixMap
+func encodeFuzzPrefixMap(values []string, prefixes []uint64, indexes []uint16) []byte {
+	data := &bytes.Buffer{}
+	joined := strings.Join(values, "\n")
+	binary.Write(data, binary.LittleEndian, uint32(len(joined)))
+	data.WriteString(joined)
+	binary.Write(data, binary.LittleEndian, uint32(len(prefixes)))
+
+	varint := make([]byte, binary.MaxVarintLen64)
+	for i, prefix := range prefixes {
+		data.Write(varint[:binary.PutUvarint(varint, prefix)])
+		binary.Write(data, binary.LittleEndian, indexes[i])
+	}
+	return data.Bytes()
+}
+
+func FuzzReadPrefixMap(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff})
+	f.Add(encodeFuzzPrefixMap([]string{"Vodafone", "O2"}, []uint64{447700, 1}, []uint16{0, 1}))
+	f.Add(encodeFuzzPrefixMap([]string{"Airtel"}, []uint64{250788}, []uint16{3}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		prefixMap, err := readPrefixMap(data)
+		if err == nil && prefixMap == nil {
+			t.Fatal("no map and no error")
+		}
+	})
+}
+
+func FuzzReadIntStringArrayMap(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff})
+	f.Add([]byte{
+		3, 0, 0, 0, 'U', 'S', '\n', // values
+		1, 0, 0, 0, // mapping count
+		1, 2, 0, 0, 1, 0, // prefix 1 with values 0 and 1
+	})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		prefixMap, err := readIntStringArrayMap(data)
+		if err == nil && prefixMap == nil {
+			t.Fatal("no map and no error")
+		}
+	})
+}
+
+func FuzzReadDigitPrefixMap(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0x0f})
+	f.Add(append([]byte{4, '[', '2', ']', '1'}, make([]byte, 16)...))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		readDigitPrefixMap(data)
+	})
+}
+
+func FuzzUnmarshalBinary(f *testing.F) {
+	for _, number := range fuzzNumberSeeds {
+		if num, err := ParseAndKeepRawInput(number, "US"); err == nil {
+			data, _ := num.MarshalBinary()
+			f.Add(data)
+		}
+	}
+	f.Add([]byte{0x40, 0xff, 0xff, 0xff, 0xff, 0x7f})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		num := &PhoneNumber{}
+		if err := num.UnmarshalBinary(data); err != nil {
+			return
+		}
+
+		// anything we can read, we must be able to write back out and read again
+		encoded, err := num.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal decoded number: %s", err)
+		}
+		decoded := &PhoneNumber{}
+		if err := decoded.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("unable to unmarshal re-encoded number: %s", err)
+		}
+		if !proto.Equal(num, decoded) {
+			t.Fatalf("number changed after round trip: %v != %v", num, decoded)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package phonenumbers
+
+import (
+	"math/rand"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+)
+
+// the number of candidates GenerateExample tries before giving up
+const generateAttempts = 100
+
+// GenerateExample returns a random valid number of the passed in type for the passed in region,
+// using the passed in source of randomness. Unlike GetExampleNumberForType, which always returns
+// the same number, this generates numbers matching any of the region's patterns for that type,
+// which makes it useful for load and property based tests. It returns nil if the region isn't
+// supported, has no numbers of that type, or no valid number could be generated.
+//
+// As with GetNumberType, a generated FIXED_LINE or MOBILE number may be one which could be either.
+func GenerateExample(regionCode string, typ PhoneNumberType, rnd *rand.Rand) *PhoneNumber {
+	if !isValidRegionCode(regionCode) {
+		return nil
+	}
+	metadata := getMetadataForRegion(regionCode)
+	desc := getNumberDescByType(metadata, typ)
+	if desc.GetNationalNumberPattern() == "" || desc.GetNationalNumberPattern() == "NA" {
+		return nil
+	}
+
+	re, err := parsePattern(desc.GetNationalNumberPattern())
+	if err != nil {
+		return nil
+	}
+
+	for i := 0; i < generateAttempts; i++ {
+		sb := &strings.Builder{}
+		generateMatch(re, rnd, sb)
+		nationalNumber := sb.String()
+
+		nsn, err := strconv.ParseUint(nationalNumber, 10, 64)
+		if err != nil {
+			continue
+		}
+		number := &PhoneNumber{CountryCode: metadata.GetCountryCode(), NationalNumber: nsn}
+		setItalianLeadingZerosForPhoneNumber(nationalNumber, number)
+
+		if isNumberOfType(number, regionCode, typ) {
+			return number
+		}
+	}
+	return nil
+}
+
+// the most times an unbounded repeat is repeated when generating a match
+const generateMaxRepeat = 3
+
+// generateMatch writes a random string of digits matching the passed in regular expression to sb,
+// it only handles the constructs used by our national number patterns
+func generateMatch(re *syntax.Regexp, rnd *rand.Rand, sb *strings.Builder) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			sb.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		// pick a random digit from the class, which is made up of pairs of rune ranges
+		var digits []rune
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			for r := re.Rune[i]; r <= re.Rune[i+1] && r <= '9'; r++ {
+				if r >= '0' {
+					digits = append(digits, r)
+				}
+			}
+		}
+		if len(digits) > 0 {
+			sb.WriteRune(digits[rnd.Intn(len(digits))])
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteByte(byte('0' + rnd.Intn(10)))
+	case syntax.OpCapture:
+		generateMatch(re.Sub[0], rnd, sb)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			generateMatch(sub, rnd, sb)
+		}
+	case syntax.OpAlternate:
+		generateMatch(re.Sub[rnd.Intn(len(re.Sub))], rnd, sb)
+	case syntax.OpQuest:
+		if rnd.Intn(2) == 0 {
+			generateMatch(re.Sub[0], rnd, sb)
+		}
+	case syntax.OpStar, syntax.OpPlus, syntax.OpRepeat:
+		min, max := re.Min, re.Max
+		if re.Op == syntax.OpStar {
+			min, max = 0, -1
+		} else if re.Op == syntax.OpPlus {
+			min, max = 1, -1
+		}
+		if max < 0 {
+			max = min + generateMaxRepeat
+		}
+		for n := min + rnd.Intn(max-min+1); n > 0; n-- {
+			generateMatch(re.Sub[0], rnd, sb)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package phonenumbers
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateExample(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		region string
+		typ    PhoneNumberType
+	}{
+		{"US", FIXED_LINE},
+		{"US", TOLL_FREE},
+		{"GB", MOBILE},
+		{"GB", FIXED_LINE},
+		{"RW", MOBILE},
+		{"DE", MOBILE},
+		{"BR", MOBILE},
+		{"IN", MOBILE},
+		{"FR", PREMIUM_RATE},
+	}
+
+	for _, tc := range tests {
+		seen := make(map[uint64]bool)
+		for i := 0; i < 50; i++ {
+			number := GenerateExample(tc.region, tc.typ, rnd)
+			if assert.NotNil(t, number, "no number generated for %s %d", tc.region, tc.typ) {
+				assert.True(t, IsValidNumberForRegion(number, tc.region), "invalid number %s generated for %s", Format(number, E164), tc.region)
+				assert.Contains(t, []PhoneNumberType{tc.typ, FIXED_LINE_OR_MOBILE}, GetNumberType(number), "wrong type for %s", Format(number, E164))
+				seen[number.GetNationalNumber()] = true
+			}
+		}
+
+		// we should get lots of different numbers, not just the example number
+		assert.Greater(t, len(seen), 40, "too few distinct numbers generated for %s %d", tc.region, tc.typ)
+	}
+
+	assert.Nil(t, GenerateExample("XX", MOBILE, rnd))
+	assert.Nil(t, GenerateExample("US", PAGER, rnd))
+}
+
+func TestGenerateExampleForAllRegions(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for region := range GetSupportedRegions() {
+		if GetExampleNumberForType(region, MOBILE) == nil {
+			continue
+		}
+		number := GenerateExample(region, MOBILE, rnd)
+		if number != nil {
+			assert.True(t, IsValidNumberForRegion(number, region), "invalid number generated for %s", region)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// Package geocodingdata contains the prefix to geocoding data used by phonenumbers.GetGeocodingForNumber.
+// It is kept in its own module so that users who don't need geocoding don't have to download it.
+// Import it for its side effects:
+//
+//	import _ "github.com/nyaruka/phonenumbers/geocodingdata"
+package geocodingdata
+
+import "github.com/nyaruka/phonenumbers"
+
+func init() {
+	phonenumbers.RegisterGeocodingData(geocodingMapData)
+}
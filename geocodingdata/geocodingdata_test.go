@@ -0,0 +1,139 @@
+package geocodingdata_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	_ "github.com/nyaruka/phonenumbers/geocodingdata"
+)
+
+// this runs first so that every language is decoded while goroutines are racing to look it up, use -race
+func TestConcurrentFirstUse(t *testing.T) {
+	number, err := phonenumbers.Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatalf("Failed to parse number: %s", err)
+	}
+	expected := map[string]string{"en": "Tianjin", "zh": "天津市"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		for lang, geocoding := range expected {
+			wg.Add(1)
+			go func(lang, expected string) {
+				defer wg.Done()
+				geocoding, err := phonenumbers.GetGeocodingForNumber(number, lang)
+				if err != nil || geocoding != expected {
+					t.Errorf("Expected '%s', got '%s' (%v) for '%s'", expected, geocoding, err, lang)
+				}
+				phonenumbers.GetMemStats()
+			}(lang, geocoding)
+		}
+	}
+	wg.Wait()
+}
+
+func TestGetGeocodingForNumber(t *testing.T) {
+	tests := []struct {
+		num      string
+		lang     string
+		expected string
+	}{
+		{num: "+8613702032331", lang: "en", expected: "Tianjin"},
+		{num: "+8613702032331", lang: "zh", expected: "天津市"},
+		{num: "+863197785050", lang: "zh", expected: "河北省邢台市"},
+		{num: "+8613323241342", lang: "en", expected: "Baoding, Hebei"},
+		{num: "+917999999543", lang: "en", expected: "Ahmedabad Local, Gujarat"},
+		{num: "+17047181840", lang: "en", expected: "North Carolina"},
+		{num: "+12542462158", lang: "en", expected: "Texas"},
+		{num: "+16193165996", lang: "en", expected: "California"},
+		{num: "+12067799191", lang: "en", expected: "Washington State"},
+		{num: "+447825602614", lang: "en", expected: "United Kingdom"},
+	}
+	for _, test := range tests {
+		number, err := phonenumbers.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("Failed to parse number %s: %s", test.num, err)
+		}
+		geocoding, err := phonenumbers.GetGeocodingForNumber(number, test.lang)
+		if err != nil {
+			t.Errorf("Failed to getGeocoding for the number %s: %s", test.num, err)
+		}
+		if test.expected != geocoding {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, geocoding, test.num)
+		}
+	}
+}
+
+// values are shared between every number they apply to, so looking them up doesn't allocate
+func TestGetGeocodingForNumberAllocs(t *testing.T) {
+	number, err := phonenumbers.Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatalf("Failed to parse number: %s", err)
+	}
+	for _, lang := range []string{"en", "zh"} {
+		allocs := testing.AllocsPerRun(100, func() { phonenumbers.GetGeocodingForNumber(number, lang) })
+		if allocs != 0 {
+			t.Errorf("Expected no allocations for '%s', got %f", lang, allocs)
+		}
+	}
+}
+
+func TestPrefixDataMemoryLimit(t *testing.T) {
+	defer phonenumbers.SetPrefixDataMemoryLimit(0)
+
+	number, err := phonenumbers.Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatalf("Failed to parse number: %s", err)
+	}
+	lookup := func(lang, expected string) {
+		geocoding, err := phonenumbers.GetGeocodingForNumber(number, lang)
+		if err != nil || geocoding != expected {
+			t.Errorf("Expected '%s', got '%s' (%v) for '%s'", expected, geocoding, err, lang)
+		}
+	}
+	assertLoaded := func(langs ...string) {
+		stats := phonenumbers.GetMemStats()
+		if len(stats.GeocodingBytes) != len(langs) {
+			t.Errorf("Expected %d languages loaded, got %v", len(langs), stats.GeocodingBytes)
+		}
+		for _, lang := range langs {
+			if stats.GeocodingBytes[lang] == 0 {
+				t.Errorf("Expected '%s' to be loaded, got %v", lang, stats.GeocodingBytes)
+			}
+		}
+	}
+
+	lookup("en", "Tianjin")
+	lookup("zh", "天津市")
+	before := phonenumbers.GetMemStats()
+
+	// a tiny limit evicts everything, but each language is kept while it's being used
+	phonenumbers.SetPrefixDataMemoryLimit(1)
+	assertLoaded()
+	lookup("zh", "天津市")
+	assertLoaded("zh")
+	lookup("en", "Tianjin")
+	assertLoaded("en")
+	lookup("zh", "天津市")
+	assertLoaded("zh")
+
+	if evictions := phonenumbers.GetMemStats().PrefixDataEvictions - before.PrefixDataEvictions; evictions != 4 {
+		t.Errorf("Expected 4 evictions, got %d", evictions)
+	}
+
+	// with room for both, both are kept
+	phonenumbers.SetPrefixDataMemoryLimit(before.GeocodingBytes["en"] + before.GeocodingBytes["zh"])
+	lookup("en", "Tianjin")
+	assertLoaded("en", "zh")
+
+	// and racing lookups with a limit in place are safe, use -race
+	phonenumbers.SetPrefixDataMemoryLimit(before.GeocodingBytes["zh"])
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() { defer wg.Done(); lookup("en", "Tianjin") }()
+		go func() { defer wg.Done(); lookup("zh", "天津市") }()
+	}
+	wg.Wait()
+}
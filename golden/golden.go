@@ -0,0 +1,164 @@
+// Package golden checks that this library agrees with Google's Java libphonenumber, by comparing
+// how both parse and validate the same inputs.
+//
+// Inputs are tab separated lines of a region and a number, e.g. "US\t650 253 0000". The Java
+// program in the java directory reads them and writes the results of the Java library as a JSON
+// file of test vectors, which Compare runs through this library to find any differences. Generate
+// writes the same file from this library, which is useful for diffing the two by hand.
+//
+// Vectors are only expected to match when both libraries use the same release of the metadata.
+package golden
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// Input is a number to parse and the region to parse it for
+type Input struct {
+	Region string
+	Number string
+}
+
+// Vector is what a library returned for an input
+type Vector struct {
+	Input    string `json:"input"`
+	Region   string `json:"region"`
+	Error    string `json:"error,omitempty"` // the kind of parse error, e.g. NOT_A_NUMBER
+	E164     string `json:"e164,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Valid    bool   `json:"valid"`
+	Possible bool   `json:"possible"`
+}
+
+// Vectors is a set of vectors produced by one library
+type Vectors struct {
+	Library string    `json:"library"` // the library and version, e.g. "libphonenumber 8.13.26"
+	Vectors []*Vector `json:"vectors"`
+}
+
+// Mismatch is a vector where this library doesn't agree with the expected result
+type Mismatch struct {
+	Expected *Vector
+	Actual   *Vector
+	Fields   []string // the names of the fields which differ
+}
+
+func (m *Mismatch) String() string {
+	return fmt.Sprintf("%q (%s): %s differ, expected %+v, got %+v", m.Expected.Input, m.Expected.Region, strings.Join(m.Fields, ", "), *m.Expected, *m.Actual)
+}
+
+// the names of number types as used by the Java library
+var numberTypeNames = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.FIXED_LINE:           "FIXED_LINE",
+	phonenumbers.MOBILE:               "MOBILE",
+	phonenumbers.FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	phonenumbers.TOLL_FREE:            "TOLL_FREE",
+	phonenumbers.PREMIUM_RATE:         "PREMIUM_RATE",
+	phonenumbers.SHARED_COST:          "SHARED_COST",
+	phonenumbers.VOIP:                 "VOIP",
+	phonenumbers.PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	phonenumbers.PAGER:                "PAGER",
+	phonenumbers.UAN:                  "UAN",
+	phonenumbers.VOICEMAIL:            "VOICEMAIL",
+	phonenumbers.UNKNOWN:              "UNKNOWN",
+}
+
+// ReadInputs reads tab separated lines of region and number, skipping blank lines and those
+// starting with #
+func ReadInputs(r io.Reader) ([]Input, error) {
+	var inputs []Input
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		parts := strings.SplitN(text, "\t", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected a region and a number separated by a tab", line)
+		}
+		inputs = append(inputs, Input{Region: parts[0], Number: parts[1]})
+	}
+	return inputs, scanner.Err()
+}
+
+// ReadVectors reads vectors written as JSON
+func ReadVectors(r io.Reader) (*Vectors, error) {
+	vectors := &Vectors{}
+	if err := json.NewDecoder(r).Decode(vectors); err != nil {
+		return nil, err
+	}
+	return vectors, nil
+}
+
+// WriteVectors writes vectors as JSON
+func WriteVectors(w io.Writer, vectors *Vectors) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(vectors)
+}
+
+// Run returns the vector for the passed in input from this library
+func Run(input Input) *Vector {
+	v := &Vector{Input: input.Number, Region: input.Region}
+
+	number, err := phonenumbers.Parse(input.Number, input.Region)
+	if err != nil {
+		v.Error = phonenumbers.ErrorKind(err)
+		return v
+	}
+
+	v.E164 = phonenumbers.Format(number, phonenumbers.E164)
+	v.Type = numberTypeNames[phonenumbers.GetNumberType(number)]
+	v.Valid = phonenumbers.IsValidNumber(number)
+	v.Possible = phonenumbers.IsPossibleNumber(number)
+	return v
+}
+
+// Generate returns the vectors for the passed in inputs from this library
+func Generate(inputs []Input) *Vectors {
+	vectors := &Vectors{Library: "github.com/nyaruka/phonenumbers", Vectors: make([]*Vector, len(inputs))}
+	for i, input := range inputs {
+		vectors.Vectors[i] = Run(input)
+	}
+	return vectors
+}
+
+// Compare runs the input of each of the passed in vectors through this library, returning those
+// where the results differ
+func Compare(expected *Vectors) []*Mismatch {
+	var mismatches []*Mismatch
+
+	for _, e := range expected.Vectors {
+		actual := Run(Input{Region: e.Region, Number: e.Input})
+
+		var fields []string
+		if actual.Error != e.Error {
+			fields = append(fields, "error")
+		}
+		if actual.E164 != e.E164 {
+			fields = append(fields, "e164")
+		}
+		if actual.Type != e.Type {
+			fields = append(fields, "type")
+		}
+		if actual.Valid != e.Valid {
+			fields = append(fields, "valid")
+		}
+		if actual.Possible != e.Possible {
+			fields = append(fields, "possible")
+		}
+
+		if len(fields) > 0 {
+			mismatches = append(mismatches, &Mismatch{Expected: e, Actual: actual, Fields: fields})
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,56 @@
+package golden_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var vectorsPath = flag.String("vectors", "testdata/expected.json", "the vectors from the Java library to compare against")
+
+func TestReadInputs(t *testing.T) {
+	inputs, err := golden.ReadInputs(strings.NewReader("# region\tnumber\nUS\t650 253 0000\n\nGB\t+44 20 7031 3000\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []golden.Input{{Region: "US", Number: "650 253 0000"}, {Region: "GB", Number: "+44 20 7031 3000"}}, inputs)
+
+	_, err = golden.ReadInputs(strings.NewReader("US 650 253 0000\n"))
+	assert.EqualError(t, err, "line 1: expected a region and a number separated by a tab")
+}
+
+func TestGenerateAndCompare(t *testing.T) {
+	vectors := golden.Generate([]golden.Input{{Region: "US", Number: "650 253 0000"}, {Region: "US", Number: "abc"}})
+	assert.Equal(t, &golden.Vector{Input: "650 253 0000", Region: "US", E164: "+16502530000", Type: "FIXED_LINE_OR_MOBILE", Valid: true, Possible: true}, vectors.Vectors[0])
+	assert.Equal(t, &golden.Vector{Input: "abc", Region: "US", Error: "NOT_A_NUMBER"}, vectors.Vectors[1])
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, golden.WriteVectors(buf, vectors))
+	read, err := golden.ReadVectors(buf)
+	require.NoError(t, err)
+	assert.Equal(t, vectors, read)
+	assert.Empty(t, golden.Compare(read))
+
+	read.Vectors[0].Type = "MOBILE"
+	read.Vectors[0].Valid = false
+	mismatches := golden.Compare(read)
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, []string{"type", "valid"}, mismatches[0].Fields)
+}
+
+func TestJavaVectors(t *testing.T) {
+	file, err := os.Open(*vectorsPath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	vectors, err := golden.ReadVectors(file)
+	require.NoError(t, err)
+
+	for _, mismatch := range golden.Compare(vectors) {
+		t.Errorf("mismatch with %s: %s", vectors.Library, mismatch)
+	}
+}
@@ -0,0 +1,73 @@
+import com.google.i18n.phonenumbers.NumberParseException;
+import com.google.i18n.phonenumbers.PhoneNumberUtil;
+import com.google.i18n.phonenumbers.Phonenumber.PhoneNumber;
+
+import java.io.BufferedReader;
+import java.io.InputStreamReader;
+import java.nio.charset.StandardCharsets;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * Reads tab separated lines of region and number from stdin and writes how libphonenumber parses and
+ * validates each of them to stdout as JSON test vectors, for the golden package to compare against.
+ *
+ * <pre>
+ * java -cp libphonenumber-8.13.26.jar GenerateVectors.java &lt; testdata/inputs.tsv &gt; testdata/expected.json
+ * </pre>
+ */
+public class GenerateVectors {
+    public static void main(String[] args) throws Exception {
+        PhoneNumberUtil util = PhoneNumberUtil.getInstance();
+        BufferedReader reader = new BufferedReader(new InputStreamReader(System.in, StandardCharsets.UTF_8));
+
+        List<String> vectors = new ArrayList<>();
+        String line;
+        while ((line = reader.readLine()) != null) {
+            if (line.trim().isEmpty() || line.startsWith("#")) {
+                continue;
+            }
+            String[] parts = line.split("\t", 2);
+            if (parts.length != 2) {
+                throw new IllegalArgumentException("expected a region and a number separated by a tab: " + line);
+            }
+            vectors.add(vector(util, parts[0], parts[1]));
+        }
+
+        String version = PhoneNumberUtil.class.getPackage().getImplementationVersion();
+        StringBuilder out = new StringBuilder();
+        out.append("{\n  \"library\": ").append(quote("libphonenumber" + (version != null ? " " + version : "")));
+        out.append(",\n  \"vectors\": [\n").append(String.join(",\n", vectors)).append("\n  ]\n}\n");
+        System.out.print(out);
+    }
+
+    private static String vector(PhoneNumberUtil util, String region, String input) {
+        StringBuilder v = new StringBuilder();
+        v.append("    {\"input\": ").append(quote(input)).append(", \"region\": ").append(quote(region));
+        try {
+            PhoneNumber number = util.parse(input, region);
+            v.append(", \"e164\": ").append(quote(util.format(number, PhoneNumberUtil.PhoneNumberFormat.E164)));
+            v.append(", \"type\": ").append(quote(util.getNumberType(number).name()));
+            v.append(", \"valid\": ").append(util.isValidNumber(number));
+            v.append(", \"possible\": ").append(util.isPossibleNumber(number));
+        } catch (NumberParseException e) {
+            v.append(", \"error\": ").append(quote(e.getErrorType().name()));
+            v.append(", \"valid\": false, \"possible\": false");
+        }
+        return v.append("}").toString();
+    }
+
+    private static String quote(String s) {
+        StringBuilder q = new StringBuilder("\"");
+        for (char c : s.toCharArray()) {
+            if (c == '"' || c == '\\') {
+                q.append('\\').append(c);
+            } else if (c < 0x20) {
+                q.append(String.format("\\u%04x", (int) c));
+            } else {
+                q.append(c);
+            }
+        }
+        return q.append('"').toString();
+    }
+}
@@ -0,0 +1,19 @@
+module github.com/nyaruka/phonenumbers/googletype
+
+go 1.19
+
+replace github.com/nyaruka/phonenumbers => ../
+
+require (
+	github.com/nyaruka/phonenumbers v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.8.4
+	google.golang.org/genproto v0.0.0-20230711160842-782d3b101e98
+	google.golang.org/protobuf v1.31.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/text v0.12.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,73 @@
+// Package googletype converts between phonenumbers.PhoneNumber and the google.type.PhoneNumber
+// message used by Google APIs. It's a separate module so that only those who need it depend on
+// the googleapis protos.
+package googletype
+
+import (
+	"errors"
+
+	"github.com/nyaruka/phonenumbers"
+	"google.golang.org/genproto/googleapis/type/phone_number"
+)
+
+var ErrNoNumber = errors.New("google.type.PhoneNumber has neither an E164 number nor a short code")
+
+// FromNumber converts the passed in number to a google.type.PhoneNumber with its extension. Numbers
+// which aren't valid but are valid short codes in a region of their country code, e.g. 611 in the US,
+// are converted to the short code form with that region, and all others to the E164 form. Other
+// fields, such as the raw input, have no equivalent and aren't included.
+func FromNumber(number *phonenumbers.PhoneNumber) *phone_number.PhoneNumber {
+	if number == nil {
+		return nil
+	}
+	if !phonenumbers.IsValidNumber(number) {
+		for _, regionCode := range phonenumbers.GetRegionCodesForCountryCode(number.GetCountryCode()) {
+			if phonenumbers.IsValidShortNumberForRegion(number, regionCode) {
+				return FromShortCode(number, regionCode)
+			}
+		}
+	}
+	return &phone_number.PhoneNumber{
+		Kind:      &phone_number.PhoneNumber_E164Number{E164Number: phonenumbers.Format(number, phonenumbers.E164)},
+		Extension: number.GetExtension(),
+	}
+}
+
+// FromShortCode converts the passed in number to a google.type.PhoneNumber in the short code form,
+// with the passed in region and the number's national significant number, along with its extension.
+func FromShortCode(number *phonenumbers.PhoneNumber, regionCode string) *phone_number.PhoneNumber {
+	if number == nil {
+		return nil
+	}
+	return &phone_number.PhoneNumber{
+		Kind: &phone_number.PhoneNumber_ShortCode_{ShortCode: &phone_number.PhoneNumber_ShortCode{
+			RegionCode: regionCode,
+			Number:     phonenumbers.GetNationalSignificantNumber(number),
+		}},
+		Extension: number.GetExtension(),
+	}
+}
+
+// ToNumber converts the passed in google.type.PhoneNumber to a number, parsing its E164 number, or
+// its short code in the short code's region, and adding its extension.
+func ToNumber(number *phone_number.PhoneNumber) (*phonenumbers.PhoneNumber, error) {
+	var parsed *phonenumbers.PhoneNumber
+	var err error
+
+	switch kind := number.GetKind().(type) {
+	case *phone_number.PhoneNumber_E164Number:
+		parsed, err = phonenumbers.Parse(kind.E164Number, phonenumbers.UNKNOWN_REGION)
+	case *phone_number.PhoneNumber_ShortCode_:
+		parsed, err = phonenumbers.Parse(kind.ShortCode.GetNumber(), kind.ShortCode.GetRegionCode())
+	default:
+		return nil, ErrNoNumber
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if extension := number.GetExtension(); extension != "" {
+		parsed.Extension = &extension
+	}
+	return parsed, nil
+}
@@ -0,0 +1,104 @@
+package googletype_test
+
+import (
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/googletype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/phone_number"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFromNumber(t *testing.T) {
+	number, err := phonenumbers.Parse("(650) 253-0000 ext. 123", "US")
+	require.NoError(t, err)
+
+	converted := googletype.FromNumber(number)
+	assert.Equal(t, "+16502530000", converted.GetE164Number())
+	assert.Equal(t, "123", converted.GetExtension())
+
+	number, err = phonenumbers.Parse("020 7031 3000", "GB")
+	require.NoError(t, err)
+	converted = googletype.FromNumber(number)
+	assert.Equal(t, "+442070313000", converted.GetE164Number())
+	assert.Equal(t, "", converted.GetExtension())
+
+	// short codes are converted to the short code form
+	number, err = phonenumbers.Parse("611", "US")
+	require.NoError(t, err)
+	converted = googletype.FromNumber(number)
+	assert.Equal(t, "", converted.GetE164Number())
+	assert.Equal(t, "US", converted.GetShortCode().GetRegionCode())
+	assert.Equal(t, "611", converted.GetShortCode().GetNumber())
+
+	// numbers which are neither valid numbers nor short codes still use the E164 form
+	number, err = phonenumbers.Parse("+1 555 555 5555", "")
+	require.NoError(t, err)
+	converted = googletype.FromNumber(number)
+	assert.Equal(t, "+15555555555", converted.GetE164Number())
+
+	assert.Nil(t, googletype.FromNumber(nil))
+}
+
+func TestFromShortCode(t *testing.T) {
+	number, err := phonenumbers.Parse("999", "GB")
+	require.NoError(t, err)
+
+	converted := googletype.FromShortCode(number, "GB")
+	assert.Equal(t, "GB", converted.GetShortCode().GetRegionCode())
+	assert.Equal(t, "999", converted.GetShortCode().GetNumber())
+	assert.Equal(t, "", converted.GetExtension())
+
+	assert.Nil(t, googletype.FromShortCode(nil, "GB"))
+}
+
+func TestToNumber(t *testing.T) {
+	number, err := googletype.ToNumber(&phone_number.PhoneNumber{
+		Kind:      &phone_number.PhoneNumber_E164Number{E164Number: "+16502530000"},
+		Extension: "123",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), number.GetCountryCode())
+	assert.Equal(t, uint64(6502530000), number.GetNationalNumber())
+	assert.Equal(t, "123", number.GetExtension())
+
+	number, err = googletype.ToNumber(&phone_number.PhoneNumber{
+		Kind: &phone_number.PhoneNumber_ShortCode_{ShortCode: &phone_number.PhoneNumber_ShortCode{RegionCode: "US", Number: "611"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), number.GetCountryCode())
+	assert.Equal(t, uint64(611), number.GetNationalNumber())
+	assert.Nil(t, number.Extension)
+
+	_, err = googletype.ToNumber(&phone_number.PhoneNumber{Kind: &phone_number.PhoneNumber_E164Number{E164Number: "6502530000"}})
+	assert.Equal(t, phonenumbers.ErrInvalidCountryCode, err)
+
+	_, err = googletype.ToNumber(&phone_number.PhoneNumber{})
+	assert.Equal(t, googletype.ErrNoNumber, err)
+	_, err = googletype.ToNumber(nil)
+	assert.Equal(t, googletype.ErrNoNumber, err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		input  string
+		region string
+	}{
+		{"+16502530000", ""},
+		{"+44 20 7031 3000 ext. 42", ""},
+		{"+800 1234 5678", ""},
+		{"611", "US"},
+		{"999", "GB"},
+		{"3333", "RW"},
+	}
+	for _, tc := range tests {
+		number, err := phonenumbers.Parse(tc.input, tc.region)
+		require.NoError(t, err)
+
+		converted, err := googletype.ToNumber(googletype.FromNumber(number))
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(number, converted), "mismatch for %s, got %s", number, converted)
+	}
+}
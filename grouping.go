@@ -0,0 +1,46 @@
+package phonenumbers
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// matches the references to groups in the format rule of a number format, e.g. $2
+var groupReferencePattern = regexp.MustCompile(`\$(\d)`)
+
+// GetDigitGrouping returns the sizes of the groups the digits of the national significant number of
+// the passed in number are formatted in, e.g. [3 3 4] for +1 650-253-0000 or [2 4 4] for +44 20 7031 3000,
+// so that input masking and partial display can work on digits rather than formatted strings. Groups
+// are those of the international format, which unlike the national format of some regions includes all
+// the digits of the number. Numbers without a matching format are a single group, and nil is returned
+// for numbers with an invalid country code.
+func GetDigitGrouping(number *PhoneNumber) []int {
+	countryCode := number.GetCountryCode()
+	metadata := getMetadataForRegionOrCallingCode(countryCode, GetRegionCodeForCountryCode(countryCode))
+	if metadata == nil {
+		return nil
+	}
+	nsn := GetNationalSignificantNumber(number)
+
+	// as in formatNsn, use the international formats if there are any, unless the number can't be
+	// formatted internationally
+	formattingPattern := chooseFormattingPatternForNumber(metadata.GetIntlNumberFormat(), nsn)
+	if formattingPattern == nil || formattingPattern.GetFormat() == "NA" {
+		formattingPattern = chooseFormattingPatternForNumber(metadata.GetNumberFormat(), nsn)
+	}
+	if formattingPattern == nil {
+		return []int{len(nsn)}
+	}
+
+	groups := regexFor(formattingPattern.GetPattern()).FindStringSubmatch(nsn)
+	references := groupReferencePattern.FindAllStringSubmatch(formattingPattern.GetFormat(), -1)
+
+	sizes := make([]int, 0, len(references))
+	for _, reference := range references {
+		index, _ := strconv.Atoi(reference[1])
+		if index < len(groups) && len(groups[index]) > 0 {
+			sizes = append(sizes, len(groups[index]))
+		}
+	}
+	return sizes
+}
@@ -0,0 +1,33 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDigitGrouping(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []int
+	}{
+		{"+16502530000", []int{3, 3, 4}},
+		{"+442070313000", []int{2, 4, 4}},
+		{"+447400123456", []int{4, 6}},
+		{"+33612345678", []int{1, 2, 2, 2, 2}},
+		{"+5491187654321", []int{1, 2, 4, 4}},
+		{"+250788383383", []int{3, 3, 3}},
+		{"+80012345678", []int{4, 4}},
+		{"+4412", []int{2}},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.input, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.expected, GetDigitGrouping(number), "grouping mismatch for %s", tc.input)
+	}
+
+	assert.Nil(t, GetDigitGrouping(&PhoneNumber{CountryCode: 999, NationalNumber: 1234}))
+}
@@ -0,0 +1,87 @@
+package phonenumbers
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// Hooks are optional callbacks made when parsing and validating numbers, so that applications can
+// export metrics about the quality of the numbers they handle without wrapping every call. They're
+// only made for calls made by applications, and not when the library parses or validates numbers
+// internally, e.g. to find numbers in text. Callbacks are made synchronously from the goroutine
+// making the call, so they should be quick and safe to call concurrently. Any may be nil.
+type Hooks struct {
+	// ParseFailed is called when Parse, ParseInto, ParseAndKeepRawInput or their variants fail, with
+	// the error and the region the number was parsed with. Use ErrorKind to turn the error into a label.
+	ParseFailed func(err error, region string)
+
+	// InvalidNumber is called when IsValidNumber finds a number isn't valid, with the region code of
+	// the number's calling code, e.g. "US", which is "ZZ" for calling codes that don't exist
+	InvalidNumber func(number *PhoneNumber, region string)
+
+	// RegionFallback is called when a number is parsed with an empty region and the region set with
+	// SetDefaultRegion is used instead
+	RegionFallback func(region string)
+}
+
+// the hooks set with SetHooks, as a *Hooks
+var hooks atomic.Value
+
+// SetHooks sets the callbacks to be made when parsing and validating numbers, replacing any set
+// previously. Passing an empty Hooks removes them all. It's safe to call at any time.
+func SetHooks(h Hooks) {
+	hooks.Store(&h)
+}
+
+// currentHooks returns the hooks set with SetHooks, or nil if there are none
+func currentHooks() *Hooks {
+	h, _ := hooks.Load().(*Hooks)
+	return h
+}
+
+// ErrorKind returns a short name for the kind of a parse error, for use as a metric label. The names
+// are those of the error types of libphonenumber's NumberParseException. Errors which aren't parse
+// errors are returned as "OTHER".
+func ErrorKind(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrInvalidCountryCode):
+		return "INVALID_COUNTRY_CODE"
+	case errors.Is(err, ErrNotANumber):
+		return "NOT_A_NUMBER"
+	case errors.Is(err, ErrTooShortAfterIDD):
+		return "TOO_SHORT_AFTER_IDD"
+	case errors.Is(err, ErrTooShortNSN):
+		return "TOO_SHORT_NSN"
+	case errors.Is(err, ErrTooLong):
+		return "TOO_LONG"
+	}
+	return "OTHER"
+}
+
+// parseWithHooks parses a number for one of our public parse functions, using the default region
+// if none is given, and making any callbacks
+func parseWithHooks(numberToParse, region string, keepRawInput bool, number *PhoneNumber) error {
+	h := currentHooks()
+
+	if region == "" {
+		region = DefaultRegion()
+		if region != "" && h != nil && h.RegionFallback != nil {
+			h.RegionFallback(region)
+		}
+	}
+
+	err := parseWithCache(numberToParse, region, keepRawInput, number)
+	if err != nil && h != nil && h.ParseFailed != nil {
+		h.ParseFailed(err, region)
+	}
+	return err
+}
+
+// parseQuietly parses a number for internal use, where failures are expected and aren't reported
+// to any hooks. The default region isn't used.
+func parseQuietly(numberToParse, region string) (*PhoneNumber, error) {
+	number := &PhoneNumber{}
+	return number, parseHelper(numberToParse, region, false, true, number)
+}
@@ -0,0 +1,71 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooks(t *testing.T) {
+	var calls []string
+	SetHooks(Hooks{
+		ParseFailed: func(err error, region string) {
+			calls = append(calls, fmt.Sprintf("parse failed: %s %s", ErrorKind(err), region))
+		},
+		InvalidNumber: func(number *PhoneNumber, region string) {
+			calls = append(calls, fmt.Sprintf("invalid: %s %s", number.E164(), region))
+		},
+		RegionFallback: func(region string) {
+			calls = append(calls, fmt.Sprintf("fallback: %s", region))
+		},
+	})
+	defer SetHooks(Hooks{})
+	defer SetDefaultRegion("")
+
+	number, _ := Parse("6502530000", "US")
+	IsValidNumber(number)
+	assert.Nil(t, calls)
+
+	Parse("6502530000", "")
+	ParseInto("hello", "GB", &PhoneNumber{})
+	ParseAndKeepRawInput("+1 650 253 0000 1234 5678 9", "US")
+	number, _ = Parse("+1 555 555 5555", "")
+	IsValidNumber(number)
+	IsValidNumber(&PhoneNumber{CountryCode: 999, NationalNumber: 12345678})
+
+	SetDefaultRegion("US")
+	Parse("6502530000", "")
+	Parse("123", "")
+
+	assert.Equal(t, []string{
+		"parse failed: INVALID_COUNTRY_CODE ",
+		"parse failed: NOT_A_NUMBER GB",
+		"parse failed: TOO_LONG US",
+		"invalid: +15555555555 US",
+		"invalid: +99912345678 ZZ",
+		"fallback: US",
+		"fallback: US",
+	}, calls)
+
+	// internal parsing and validation doesn't call hooks
+	calls = nil
+	IsNumberMatch("6502530000", "+1 650 253 0000")
+	matcher := NewPhoneNumberMatcher("call 650 253 0000 or 555 555 5555 or 12 hello", "US")
+	for _, err := matcher.Next(); err == nil; _, err = matcher.Next() {
+	}
+	TruncateTooLongNumber(&PhoneNumber{CountryCode: 1, NationalNumber: 65025300001})
+	GetInvalidExampleNumber("GB")
+	assert.Nil(t, calls)
+}
+
+func TestErrorKind(t *testing.T) {
+	assert.Equal(t, "", ErrorKind(nil))
+	assert.Equal(t, "INVALID_COUNTRY_CODE", ErrorKind(ErrInvalidCountryCode))
+	assert.Equal(t, "NOT_A_NUMBER", ErrorKind(ErrNotANumber))
+	assert.Equal(t, "NOT_A_NUMBER", ErrorKind(&ParseError{Err: ErrNotANumber, Char: '_'}))
+	assert.Equal(t, "TOO_SHORT_AFTER_IDD", ErrorKind(ErrTooShortAfterIDD))
+	assert.Equal(t, "TOO_SHORT_NSN", ErrorKind(ErrTooShortNSN))
+	assert.Equal(t, "TOO_LONG", ErrorKind(ErrTooLong))
+	assert.Equal(t, "OTHER", ErrorKind(ErrInvalidNumber))
+}
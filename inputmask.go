@@ -0,0 +1,56 @@
+package phonenumbers
+
+import (
+	"strings"
+)
+
+// the character used in place of digits the user enters in masks returned by GetInputMask
+const inputMaskChar = '#'
+
+// GetInputMask returns an input mask for entering numbers of the passed in type for the passed in
+// region in national format, e.g. "(###) ###-####" for FIXED_LINE in US or "0#### ######" for MOBILE
+// in GB, where each # is a digit to be entered and everything else is entered for the user. Masks are
+// built from the format the region uses for its example number for the type, so numbers of the type
+// which are formatted differently to it, such as those of a different length, won't fit. Returns an
+// empty string if the region has no example number of the type.
+func GetInputMask(regionCode string, typ PhoneNumberType) string {
+	example := GetExampleNumberForType(regionCode, typ)
+	if example == nil {
+		return ""
+	}
+	nsn := GetNationalSignificantNumber(example)
+	mask := strings.Repeat(string(inputMaskChar), len(nsn))
+
+	numberFormat := chooseFormattingPatternForNumber(getMetadataForRegion(regionCode).GetNumberFormat(), nsn)
+	if numberFormat == nil {
+		return mask
+	}
+
+	// the national prefix formatting rule takes the place of the first group, as it does in Format
+	rule := numberFormat.GetFormat()
+	if prefixRule := numberFormat.GetNationalPrefixFormattingRule(); prefixRule != "" {
+		if loc := FIRST_GROUP_PATTERN.FindStringIndex(rule); loc != nil {
+			rule = rule[:loc[0]] + prefixRule + rule[loc[1]:]
+		}
+	}
+
+	// the groups of the number are matched against its digits but filled in from the mask, so that
+	// only its digits are masked and any digits the format adds, such as a national prefix, aren't
+	pattern := regexFor(numberFormat.GetPattern())
+	match := pattern.FindStringSubmatchIndex(nsn)
+	if match == nil {
+		return mask
+	}
+	return mask[:match[0]] + string(pattern.ExpandString(nil, rule, mask, match)) + mask[match[1]:]
+}
+
+// GetFormattedExample returns the region's example number for the passed in type formatted in the
+// passed in format, e.g. "+44 7400 123456" for MOBILE in GB in INTERNATIONAL format, for use as a
+// placeholder in input fields. Returns an empty string if the region has no example number of the type.
+func GetFormattedExample(regionCode string, typ PhoneNumberType, format PhoneNumberFormat) string {
+	example := GetExampleNumberForType(regionCode, typ)
+	if example == nil {
+		return ""
+	}
+	return Format(example, format)
+}
@@ -0,0 +1,52 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInputMask(t *testing.T) {
+	tests := []struct {
+		region   string
+		typ      PhoneNumberType
+		expected string
+	}{
+		{"US", FIXED_LINE, "(###) ###-####"},
+		{"US", TOLL_FREE, "(###) ###-####"},
+		{"GB", MOBILE, "0#### ######"},
+		{"GB", FIXED_LINE, "0### ### ####"},
+		{"RW", MOBILE, "0### ### ###"},
+		{"DE", MOBILE, "0#### #######"},
+		{"FR", MOBILE, "0# ## ## ## ##"},
+		{"AR", MOBILE, "0# 15-####-####"},
+		{"BR", MOBILE, "(##) #####-####"},
+		{"US", PAGER, ""},
+		{"XX", MOBILE, ""},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, GetInputMask(tc.region, tc.typ), "mask mismatch for %s %d", tc.region, tc.typ)
+	}
+}
+
+func TestGetFormattedExample(t *testing.T) {
+	tests := []struct {
+		region   string
+		typ      PhoneNumberType
+		format   PhoneNumberFormat
+		expected string
+	}{
+		{"GB", MOBILE, INTERNATIONAL, "+44 7400 123456"},
+		{"GB", MOBILE, NATIONAL, "07400 123456"},
+		{"GB", MOBILE, E164, "+447400123456"},
+		{"US", FIXED_LINE, NATIONAL, "(201) 555-0123"},
+		{"RW", MOBILE, RFC3966, "tel:+250-720-123-456"},
+		{"US", PAGER, INTERNATIONAL, ""},
+		{"XX", MOBILE, INTERNATIONAL, ""},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, GetFormattedExample(tc.region, tc.typ, tc.format), "example mismatch for %s %d", tc.region, tc.typ)
+	}
+}
@@ -0,0 +1,299 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Instance validates and formats numbers using our metadata with additions of its own layered over
+// it, such as the private numbering plan or test ranges of one tenant of a multi-tenant platform,
+// without changing the metadata used by the rest of the process. It's created with NewInstance, is
+// immutable and is safe for concurrent use.
+type Instance struct {
+	regions map[string]*PhoneMetadata // the merged metadata of each region which has additions
+
+	// the compiled patterns of our merged metadata which aren't in the metadata they were merged
+	// with, keyed as regexFor keys them, kept here rather than in our process wide regex cache
+	// which would hold on to them long after we're gone
+	regexes map[string]*regexp.Regexp
+}
+
+// NewInstance returns an Instance whose regions have the passed in additions layered over them.
+// Each PhoneMetadata in the collection is identified by the id of an existing region, and its number
+// descriptions, such as FixedLine or Mobile, are patterns which are valid numbers of that type in
+// that region as well as those the region already has, with their possible lengths, which default
+// to those of the region if not given. Its number formats are tried before those of the region when
+// formatting. Other fields are ignored, as is its country code unless it differs from the region's.
+//
+// The additions are merged with the metadata in use when the Instance is created, so an Instance
+// doesn't see changes made by SwapMetadata afterwards. An error is returned if a region isn't
+// supported, appears more than once, or has patterns which don't compile.
+func NewInstance(additions *PhoneMetadataCollection) (*Instance, error) {
+	instance := &Instance{regions: make(map[string]*PhoneMetadata), regexes: make(map[string]*regexp.Regexp)}
+
+	for _, added := range additions.GetMetadata() {
+		id := added.GetId()
+		base := getMetadataForRegion(id)
+		if base == nil {
+			return nil, fmt.Errorf("%w: no region %q to add to", ErrInvalidCountryCode, id)
+		}
+		if instance.regions[id] != nil {
+			return nil, fmt.Errorf("additions for %s given more than once", id)
+		}
+		if added.GetCountryCode() != 0 && added.GetCountryCode() != base.GetCountryCode() {
+			return nil, fmt.Errorf("additions for %s have country code %d, not %d", id, added.GetCountryCode(), base.GetCountryCode())
+		}
+
+		merged := mergeMetadata(base, added)
+		if err := checkMetadata(merged); err != nil {
+			return nil, err
+		}
+		if err := instance.compile(base, merged); err != nil {
+			return nil, err
+		}
+		instance.regions[id] = merged
+	}
+	return instance, nil
+}
+
+// mergeMetadata returns a copy of base with the number descriptions and formats of added layered over it
+func mergeMetadata(base, added *PhoneMetadata) *PhoneMetadata {
+	merged := proto.Clone(base).(*PhoneMetadata)
+	baseGeneral := base.GetGeneralDesc()
+
+	descs := []struct {
+		merged **PhoneNumberDesc
+		added  *PhoneNumberDesc
+	}{
+		{&merged.FixedLine, added.GetFixedLine()},
+		{&merged.Mobile, added.GetMobile()},
+		{&merged.TollFree, added.GetTollFree()},
+		{&merged.PremiumRate, added.GetPremiumRate()},
+		{&merged.SharedCost, added.GetSharedCost()},
+		{&merged.PersonalNumber, added.GetPersonalNumber()},
+		{&merged.Voip, added.GetVoip()},
+		{&merged.Pager, added.GetPager()},
+		{&merged.Uan, added.GetUan()},
+		{&merged.Voicemail, added.GetVoicemail()},
+		{&merged.NoInternationalDialling, added.GetNoInternationalDialling()},
+	}
+
+	general := &PhoneNumberDesc{
+		NationalNumberPattern: proto.String(baseGeneral.GetNationalNumberPattern()),
+		PossibleLength:        baseGeneral.GetPossibleLength(),
+	}
+	for _, d := range descs {
+		if d.added.GetNationalNumberPattern() == "" {
+			continue
+		}
+		addedLengths := d.added.GetPossibleLength()
+		if len(addedLengths) == 0 {
+			addedLengths = baseGeneral.GetPossibleLength()
+		}
+
+		// a description without possible lengths of its own has those of the region
+		baseLengths := (*d.merged).GetPossibleLength()
+		if len(baseLengths) == 0 && !isEmptyPattern((*d.merged).GetNationalNumberPattern()) {
+			baseLengths = baseGeneral.GetPossibleLength()
+		}
+
+		*d.merged = &PhoneNumberDesc{
+			NationalNumberPattern: proto.String(unionPatterns((*d.merged).GetNationalNumberPattern(), d.added.GetNationalNumberPattern())),
+			PossibleLength:        unionLengths(baseLengths, addedLengths),
+			ExampleNumber:         (*d.merged).ExampleNumber,
+		}
+		general.NationalNumberPattern = proto.String(unionPatterns(general.GetNationalNumberPattern(), d.added.GetNationalNumberPattern()))
+		general.PossibleLength = unionLengths(general.PossibleLength, addedLengths)
+	}
+	merged.GeneralDesc = general
+	merged.SameMobileAndFixedLinePattern = proto.Bool(merged.GetFixedLine().GetNationalNumberPattern() == merged.GetMobile().GetNationalNumberPattern())
+
+	// formats which are only for national numbers are also used for international ones unless the
+	// additions have international formats of their own
+	merged.NumberFormat = append(append([]*NumberFormat(nil), added.GetNumberFormat()...), merged.NumberFormat...)
+	if len(merged.IntlNumberFormat) > 0 {
+		intlFormats := added.GetIntlNumberFormat()
+		if len(intlFormats) == 0 {
+			intlFormats = added.GetNumberFormat()
+		}
+		merged.IntlNumberFormat = append(append([]*NumberFormat(nil), intlFormats...), merged.IntlNumberFormat...)
+	}
+	return merged
+}
+
+// isEmptyPattern returns whether the passed in pattern is one which matches no numbers
+func isEmptyPattern(pattern string) bool {
+	return pattern == "" || pattern == "NA"
+}
+
+// unionPatterns returns a pattern which matches what either of the passed in patterns does
+func unionPatterns(pattern, added string) string {
+	if isEmptyPattern(pattern) {
+		return added
+	}
+	return "(?:" + pattern + ")|(?:" + added + ")"
+}
+
+// unionLengths returns the sorted union of the passed in possible lengths, without the -1 used to
+// mark descriptions which have no numbers
+func unionLengths(lengths, added []int32) []int32 {
+	seen := make(map[int32]bool, len(lengths)+len(added))
+	var union []int32
+	for _, l := range append(append([]int32(nil), lengths...), added...) {
+		if l > 0 && !seen[l] {
+			seen[l] = true
+			union = append(union, l)
+		}
+	}
+	sort.Slice(union, func(i, j int) bool { return union[i] < union[j] })
+	return union
+}
+
+// compile compiles the patterns of merged which aren't in base into our regexes
+func (i *Instance) compile(base, merged *PhoneMetadata) error {
+	baseKeys := make(map[string]bool)
+	for _, key := range regexKeys(base) {
+		baseKeys[key] = true
+	}
+
+	for _, key := range regexKeys(merged) {
+		if baseKeys[key] || i.regexes[key] != nil {
+			continue
+		}
+		regex, err := regexp.Compile(key)
+		if err != nil {
+			return fmt.Errorf("metadata for %s has invalid pattern: %w", merged.GetId(), err)
+		}
+		i.regexes[key] = regex
+	}
+	return nil
+}
+
+// regexKeys returns the keys of the regexes we look up when validating and formatting numbers with
+// the passed in metadata
+func regexKeys(metadata *PhoneMetadata) []string {
+	var keys []string
+	for _, desc := range metadata.descs() {
+		if pattern := desc.GetNationalNumberPattern(); pattern != "" {
+			keys = append(keys, strictKey(pattern))
+		}
+	}
+	for _, formats := range [][]*NumberFormat{metadata.GetNumberFormat(), metadata.GetIntlNumberFormat()} {
+		for _, format := range formats {
+			keys = append(keys, format.GetPattern(), strictKey(format.GetPattern()))
+			if leadingDigits := format.GetLeadingDigitsPattern(); len(leadingDigits) > 0 {
+				keys = append(keys, leadingDigits[len(leadingDigits)-1])
+			}
+		}
+	}
+	return keys
+}
+
+// strictKey returns the key of the regex which matches the passed in pattern against an entire string
+func strictKey(pattern string) string {
+	return "^(?:" + pattern + ")$"
+}
+
+// regex returns the regex for the passed in key, from our own regexes if it's one of them
+func (i *Instance) regex(key string) *regexp.Regexp {
+	if regex := i.regexes[key]; regex != nil {
+		return regex
+	}
+	return regexFor(key)
+}
+
+// strict returns the regex which matches the passed in pattern against an entire string
+func (i *Instance) strict(pattern string) *regexp.Regexp {
+	if regex := i.regexes[strictKey(pattern)]; regex != nil {
+		return regex
+	}
+	return strictRegexFor(pattern)
+}
+
+// metadataFor returns the metadata of the passed in region, with our additions if it has any
+func (i *Instance) metadataFor(countryCode int32, regionCode string) *PhoneMetadata {
+	if merged := i.regions[regionCode]; merged != nil {
+		return merged
+	}
+	return getMetadataForRegionOrCallingCode(countryCode, regionCode)
+}
+
+// Parse parses the passed in number as Parse does, using the metadata of the process rather than our
+// additions. Parsing mostly depends on the prefixes of a region rather than its ranges, but whether
+// a national prefix is stripped depends on whether the number without it matches the region's
+// general pattern and possible lengths, so a number which only our additions make valid may keep
+// a leading digit that looks like a national prefix.
+func (i *Instance) Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
+	return Parse(numberToParse, defaultRegion)
+}
+
+// GetRegionCodeForNumber returns the region the passed in number is from as GetRegionCodeForNumber
+// does, taking our additions into account
+func (i *Instance) GetRegionCodeForNumber(number *PhoneNumber) string {
+	regions := regionCodesForCountryCode(number.GetCountryCode())
+	if len(regions) == 0 {
+		return ""
+	}
+	if len(regions) == 1 {
+		return regions[0]
+	}
+
+	nationalNumber := GetNationalSignificantNumber(number)
+	for _, regionCode := range regions {
+		metadata := i.metadataFor(number.GetCountryCode(), regionCode)
+		if len(metadata.GetLeadingDigits()) > 0 {
+			if regexFor("^(?:" + metadata.GetLeadingDigits() + ")").MatchString(nationalNumber) {
+				return regionCode
+			}
+		} else if getNumberTypeWithRegexes(nationalNumber, metadata, i) != UNKNOWN {
+			return regionCode
+		}
+	}
+	return ""
+}
+
+// IsValidNumber returns whether the passed in number is valid, as IsValidNumber does, including the
+// numbers in our additions. The InvalidNumber hook isn't called.
+func (i *Instance) IsValidNumber(number *PhoneNumber) bool {
+	return i.IsValidNumberForRegion(number, i.GetRegionCodeForNumber(number))
+}
+
+// IsValidNumberForRegion returns whether the passed in number is valid for the passed in region, as
+// IsValidNumberForRegion does, including the numbers in our additions
+func (i *Instance) IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
+	metadata := i.metadataFor(number.GetCountryCode(), regionCode)
+	if metadata == nil || (REGION_CODE_FOR_NON_GEO_ENTITY != regionCode && number.GetCountryCode() != metadata.GetCountryCode()) {
+		return false
+	}
+	return getNumberTypeWithRegexes(GetNationalSignificantNumber(number), metadata, i) != UNKNOWN
+}
+
+// GetNumberType returns the type of the passed in number, as GetNumberType does, with numbers in our
+// additions having the type they were added as
+func (i *Instance) GetNumberType(number *PhoneNumber) PhoneNumberType {
+	metadata := i.metadataFor(number.GetCountryCode(), i.GetRegionCodeForNumber(number))
+	if metadata == nil {
+		return UNKNOWN
+	}
+	return getNumberTypeWithRegexes(GetNationalSignificantNumber(number), metadata, i)
+}
+
+// Format formats the passed in number as Format does, using the number formats of our additions
+// before those of the region. As with Format, numbers are formatted using the formats of the main
+// region for their country calling code, e.g. US for all NANPA numbers.
+func (i *Instance) Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
+	regionCode := GetRegionCodeForCountryCode(number.GetCountryCode())
+	metadata := i.regions[regionCode]
+	if metadata == nil || numberFormat == E164 || (number.GetNationalNumber() == 0 && len(number.GetRawInput()) > 0) {
+		return Format(number, numberFormat)
+	}
+
+	formattedNumber := NewBuilder(nil)
+	_, _ = formattedNumber.WriteString(formatNsnWithRegexes(GetNationalSignificantNumber(number), metadata, numberFormat, "", i))
+	maybeAppendFormattedExtension(number, metadata, numberFormat, formattedNumber)
+	prefixNumberWithCountryCallingCode(number.GetCountryCode(), numberFormat, formattedNumber)
+	return formattedNumber.String()
+}
@@ -0,0 +1,110 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestInstance(t *testing.T) {
+	instance, err := NewInstance(&PhoneMetadataCollection{Metadata: []*PhoneMetadata{
+		// a test range in an area code which doesn't exist
+		{Id: "US", FixedLine: &PhoneNumberDesc{NationalNumberPattern: proto.String(`9995550\d{3}`)}},
+
+		// a private plan of six digit VoIP numbers, with a format of their own
+		{
+			Id:           "GB",
+			CountryCode:  proto.Int32(44),
+			Voip:         &PhoneNumberDesc{NationalNumberPattern: proto.String(`9\d{5}`), PossibleLength: []int32{6}},
+			NumberFormat: []*NumberFormat{{Pattern: `(\d{3})(\d{3})`, Format: "$1-$2", LeadingDigitsPattern: []string{"9"}}},
+		},
+	}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		input         string
+		region        string
+		valid         bool
+		instanceValid bool
+		instanceType  PhoneNumberType
+		international string
+		national      string
+	}{
+		{"+1 999 555 0123", "US", false, true, FIXED_LINE, "+1 999-555-0123", "(999) 555-0123"},
+		{"+1 650 253 0000", "US", true, true, FIXED_LINE_OR_MOBILE, "+1 650-253-0000", "(650) 253-0000"},
+		{"+44 912345", "GB", false, true, VOIP, "+44 912-345", "912-345"},
+		{"0912345", "GB", false, true, VOIP, "+44 912-345", "912-345"},
+		{"+44 20 7031 3000", "GB", true, true, FIXED_LINE, "+44 20 7031 3000", "020 7031 3000"},
+		{"+44 7912 345678", "GB", true, true, MOBILE, "+44 7912 345678", "07912 345678"},
+		{"+44 9123456", "GB", false, false, UNKNOWN, "+44 9123456", "9123456"},
+		{"+33 1 23 45 67 89", "FR", true, true, FIXED_LINE, "+33 1 23 45 67 89", "01 23 45 67 89"},
+	}
+
+	for _, tc := range tests {
+		number, err := instance.Parse(tc.input, tc.region)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.valid, IsValidNumber(number), "valid mismatch for %s", tc.input)
+		assert.Equal(t, tc.instanceValid, instance.IsValidNumber(number), "instance valid mismatch for %s", tc.input)
+		assert.Equal(t, tc.instanceValid, instance.IsValidNumberForRegion(number, tc.region), "instance valid for region mismatch for %s", tc.input)
+		assert.Equal(t, tc.instanceType, instance.GetNumberType(number), "type mismatch for %s", tc.input)
+		assert.Equal(t, tc.international, instance.Format(number, INTERNATIONAL), "international mismatch for %s", tc.input)
+		assert.Equal(t, tc.national, instance.Format(number, NATIONAL), "national mismatch for %s", tc.input)
+		assert.Equal(t, Format(number, E164), instance.Format(number, E164), "E164 mismatch for %s", tc.input)
+	}
+
+	// the additions don't change the metadata used by everything else
+	number, _ := Parse("+44 912345", "GB")
+	assert.Equal(t, UNKNOWN, GetNumberType(number))
+	assert.Equal(t, "912345", Format(number, NATIONAL))
+
+	// and the patterns we merged are compiled by the instance, not kept in our process wide caches
+	for _, region := range []string{"US", "GB"} {
+		pattern := instance.regions[region].GetGeneralDesc().GetNationalNumberPattern()
+		assert.NotNil(t, instance.regexes[strictKey(pattern)], "%s general pattern not compiled", region)
+
+		_, cached := readFromRegexCache(strictKey(pattern))
+		assert.False(t, cached, "%s general pattern is in the regex cache", region)
+		_, cached = strictRegexCache.read(pattern)
+		assert.False(t, cached, "%s general pattern is in the strict regex cache", region)
+	}
+}
+
+func TestInstanceRegionForNumber(t *testing.T) {
+	// numbers added to a region which shares its country code are from that region
+	instance, err := NewInstance(&PhoneMetadataCollection{Metadata: []*PhoneMetadata{
+		{Id: "CA", Mobile: &PhoneNumberDesc{NationalNumberPattern: proto.String(`9995551\d{3}`)}},
+	}})
+	require.NoError(t, err)
+
+	number, _ := Parse("+1 999 555 1000", "CA")
+	assert.Equal(t, "", GetRegionCodeForNumber(number))
+	assert.Equal(t, "CA", instance.GetRegionCodeForNumber(number))
+	assert.True(t, instance.IsValidNumberForRegion(number, "CA"))
+	assert.False(t, instance.IsValidNumberForRegion(number, "US"))
+	assert.Equal(t, MOBILE, instance.GetNumberType(number))
+}
+
+func TestNewInstanceErrors(t *testing.T) {
+	_, err := NewInstance(&PhoneMetadataCollection{Metadata: []*PhoneMetadata{{Id: "XX"}}})
+	assert.ErrorIs(t, err, ErrInvalidCountryCode)
+
+	_, err = NewInstance(&PhoneMetadataCollection{Metadata: []*PhoneMetadata{{Id: "GB"}, {Id: "GB"}}})
+	assert.EqualError(t, err, "additions for GB given more than once")
+
+	_, err = NewInstance(&PhoneMetadataCollection{Metadata: []*PhoneMetadata{{Id: "GB", CountryCode: proto.Int32(1)}}})
+	assert.EqualError(t, err, "additions for GB have country code 1, not 44")
+
+	_, err = NewInstance(&PhoneMetadataCollection{Metadata: []*PhoneMetadata{
+		{Id: "GB", Mobile: &PhoneNumberDesc{NationalNumberPattern: proto.String(`9(\d`)}},
+	}})
+	assert.Error(t, err)
+
+	// an empty collection is just the metadata we have
+	instance, err := NewInstance(nil)
+	require.NoError(t, err)
+	number, _ := Parse("+44 20 7031 3000", "GB")
+	assert.True(t, instance.IsValidNumber(number))
+}
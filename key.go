@@ -0,0 +1,45 @@
+package phonenumbers
+
+import "google.golang.org/protobuf/proto"
+
+// Key is a comparable value identifying a phone number, for use as a map key or in sets without
+// formatting numbers to strings. Numbers have the same key when they are an EXACT_MATCH, i.e. they
+// have the same country code, national number, leading zeros and extension.
+type Key struct {
+	CountryCode    int32
+	NationalNumber uint64
+	LeadingZeros   int32 // the number of leading zeros of the national number, if any
+	Extension      string
+}
+
+// Key returns the comparable key for this number
+func (x *PhoneNumber) Key() Key {
+	var leadingZeros int32
+	if x.GetItalianLeadingZero() {
+		leadingZeros = x.GetNumberOfLeadingZeros()
+		if leadingZeros < 1 {
+			leadingZeros = 1
+		}
+	}
+	return Key{
+		CountryCode:    x.GetCountryCode(),
+		NationalNumber: x.GetNationalNumber(),
+		LeadingZeros:   leadingZeros,
+		Extension:      x.GetExtension(),
+	}
+}
+
+// Number returns a new phone number with the fields of this key
+func (k Key) Number() *PhoneNumber {
+	number := &PhoneNumber{CountryCode: k.CountryCode, NationalNumber: k.NationalNumber}
+	if k.LeadingZeros > 0 {
+		number.ItalianLeadingZero = proto.Bool(true)
+		if k.LeadingZeros > 1 {
+			number.NumberOfLeadingZeros = proto.Int32(k.LeadingZeros)
+		}
+	}
+	if k.Extension != "" {
+		number.Extension = proto.String(k.Extension)
+	}
+	return number
+}
@@ -0,0 +1,43 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestKey(t *testing.T) {
+	seen := make(map[Key]bool)
+	for _, input := range []string{"(650) 253-0000", "+1 650 253 0000", "tel:+1-650-253-0000", "1 650 253 0000"} {
+		number, err := ParseAndKeepRawInput(input, "US")
+		require.NoError(t, err)
+		seen[number.Key()] = true
+	}
+	assert.Equal(t, map[Key]bool{{CountryCode: 1, NationalNumber: 6502530000}: true}, seen)
+
+	// extensions and leading zeros make numbers different
+	number := &PhoneNumber{CountryCode: 39, NationalNumber: 236618300}
+	withExtension := &PhoneNumber{CountryCode: 39, NationalNumber: 236618300, Extension: proto.String("12")}
+	withLeadingZero := &PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true)}
+	withLeadingZeros := &PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true), NumberOfLeadingZeros: proto.Int32(2)}
+
+	assert.Equal(t, Key{CountryCode: 39, NationalNumber: 236618300, Extension: "12"}, withExtension.Key())
+	assert.Equal(t, Key{CountryCode: 39, NationalNumber: 236618300, LeadingZeros: 1}, withLeadingZero.Key())
+	assert.Equal(t, Key{CountryCode: 39, NationalNumber: 236618300, LeadingZeros: 2}, withLeadingZeros.Key())
+	assert.NotEqual(t, number.Key(), withExtension.Key())
+	assert.NotEqual(t, number.Key(), withLeadingZero.Key())
+	assert.NotEqual(t, withLeadingZero.Key(), withLeadingZeros.Key())
+
+	// the number of leading zeros means nothing without the italian leading zero flag
+	assert.Equal(t, number.Key(), (&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, NumberOfLeadingZeros: proto.Int32(2)}).Key())
+
+	// and we can get the numbers back
+	for _, n := range []*PhoneNumber{number, withExtension, withLeadingZero, withLeadingZeros} {
+		assert.True(t, proto.Equal(n, n.Key().Number()), "mismatch for %s", n)
+	}
+
+	var nilNumber *PhoneNumber
+	assert.Equal(t, Key{}, nilNumber.Key())
+}
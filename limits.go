@@ -0,0 +1,67 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrInputTooLong is returned by Parse and its variants for input longer than the limit set with
+// SetInputLimits, and by PhoneNumberMatcher for text longer than its limit, without doing any work
+// on it. It wraps ErrTooLong, so errors.Is(err, ErrTooLong) is also true. Input longer than the
+// default limit of MAX_INPUT_STRING_LENGTH is still rejected with ErrTooLong itself, as it always
+// has been.
+var ErrInputTooLong = fmt.Errorf("%w: input is longer than the limit", ErrTooLong)
+
+// the default maximum number of candidates a PhoneNumberMatcher tries
+const defaultMaxMatcherTries = 65535
+
+// InputLimits bounds the work done on untrusted input, such as numbers submitted to a public
+// endpoint. Zero values mean the defaults.
+type InputLimits struct {
+	// the maximum length in bytes of strings passed to Parse and its variants, defaults to
+	// MAX_INPUT_STRING_LENGTH
+	MaxParseLength int
+
+	// the maximum length in bytes of text searched by PhoneNumberMatcher, by default there is no limit
+	MaxMatchTextLength int
+
+	// the maximum number of candidates a PhoneNumberMatcher tries to parse before giving up on the
+	// rest of the text, defaults to 65535
+	MaxMatchTries int
+}
+
+var inputLimits atomic.Value
+
+// SetInputLimits sets process wide limits on the input to parsing and matching, above which
+// ErrInputTooLong is returned. It's safe to call at any time, though matchers which have already been
+// created keep the limits in place when they were. Passing the zero InputLimits restores the defaults.
+func SetInputLimits(limits InputLimits) {
+	inputLimits.Store(limits)
+}
+
+// checkParseLength returns the error for input of the passed in length to Parse and its variants if
+// it's longer than our limit, ErrTooLong for the default limit and ErrInputTooLong for one set with
+// SetInputLimits, or nil if it isn't
+func checkParseLength(length int) error {
+	limits, _ := inputLimits.Load().(InputLimits)
+	if limits.MaxParseLength > 0 {
+		if length > limits.MaxParseLength {
+			return ErrInputTooLong
+		}
+	} else if length > MAX_INPUT_STRING_LENGTH {
+		return ErrTooLong
+	}
+	return nil
+}
+
+// GetInputLimits returns the limits set with SetInputLimits, with any defaults filled in
+func GetInputLimits() InputLimits {
+	limits, _ := inputLimits.Load().(InputLimits)
+	if limits.MaxParseLength <= 0 {
+		limits.MaxParseLength = MAX_INPUT_STRING_LENGTH
+	}
+	if limits.MaxMatchTries <= 0 {
+		limits.MaxMatchTries = defaultMaxMatcherTries
+	}
+	return limits
+}
@@ -0,0 +1,53 @@
+package phonenumbers
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInputLimits(t *testing.T) {
+	defer SetInputLimits(InputLimits{})
+
+	assert.Equal(t, InputLimits{MaxParseLength: MAX_INPUT_STRING_LENGTH, MaxMatchTries: 65535}, GetInputLimits())
+
+	// by default input is limited to MAX_INPUT_STRING_LENGTH, which is rejected with ErrTooLong itself
+	_, err := Parse(strings.Repeat(" ", MAX_INPUT_STRING_LENGTH)+"6502530000", "US")
+	assert.Equal(t, ErrTooLong, err)
+	assert.Equal(t, ErrNumTooLong, err)
+	assert.Equal(t, "TOO_LONG", ErrorKind(err))
+
+	// whereas too many digits isn't too long input
+	_, err = Parse("+1 650 253 0000 1234 5678 9", "")
+	assert.ErrorIs(t, err, ErrTooLong)
+	assert.NotErrorIs(t, err, ErrInputTooLong)
+
+	SetInputLimits(InputLimits{MaxParseLength: 16, MaxMatchTextLength: 40})
+	assert.Equal(t, InputLimits{MaxParseLength: 16, MaxMatchTextLength: 40, MaxMatchTries: 65535}, GetInputLimits())
+
+	_, err = Parse("+1 650-253-0000", "")
+	assert.NoError(t, err)
+	_, err = Parse("+1 (650) 253-0000", "")
+	assert.ErrorIs(t, err, ErrInputTooLong)
+	assert.ErrorIs(t, err, ErrTooLong)
+	_, err = ParseAndKeepRawInput("+1 (650) 253-0000", "")
+	assert.ErrorIs(t, err, ErrInputTooLong)
+
+	matcher := NewPhoneNumberMatcher("Call me on 650-253-0000", "US")
+	match, err := matcher.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "650-253-0000", match.RawString())
+
+	matcher = NewPhoneNumberMatcher("Call me on 650-253-0000 or at +44 20 7031 3000 tomorrow", "US")
+	_, err = matcher.Next()
+	assert.Equal(t, ErrInputTooLong, err)
+
+	// matchers give up after trying the maximum number of candidates
+	SetInputLimits(InputLimits{MaxMatchTries: 1})
+	matcher = NewPhoneNumberMatcher("Call 12 or 650-253-0000", "US")
+	_, err = matcher.Next()
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,52 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// the locations we've loaded, by name, as time.LoadLocation reads the timezone database every time
+var locations sync.Map
+
+// GetLocalTimeForNumber returns the passed in time in each of the timezones GetTimezonesForNumber
+// returns for the number, e.g. to check that it's a reasonable hour to call. Numbers which span
+// several timezones, like mobiles in countries which have more than one, get a time for each, in
+// the same order, so callers can decide whether all or any of them need to be within their rules.
+// Returns nil if we don't know the number's timezone.
+//
+// Timezones are loaded with time.LoadLocation, so this returns an error if one isn't in the
+// timezone database of the system, which can be avoided by importing time/tzdata.
+func GetLocalTimeForNumber(number *PhoneNumber, at time.Time) ([]time.Time, error) {
+	timezones, err := GetTimezonesForNumber(number)
+	if err != nil {
+		return nil, err
+	}
+
+	var times []time.Time
+	for _, timezone := range timezones {
+		if timezone == UNKNOWN_TIMEZONE {
+			continue
+		}
+		location, err := loadLocation(timezone)
+		if err != nil {
+			return nil, err
+		}
+		times = append(times, at.In(location))
+	}
+	return times, nil
+}
+
+// loadLocation returns the location with the passed in name, loading it the first time it's used
+func loadLocation(name string) (*time.Location, error) {
+	if location, found := locations.Load(name); found {
+		return location.(*time.Location), nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load timezone %s: %w", name, err)
+	}
+	locations.Store(name, location)
+	return location, nil
+}
@@ -0,0 +1,188 @@
+package phonenumbers
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// incremented whenever data which lookups depend on is replaced, i.e. our metadata or a carrier
+// overlay, so that caches can tell their entries are stale
+var lookupDataGeneration uint64
+
+// LookupCacheOptions controls how the results of a LookupCache are cached
+type LookupCacheOptions struct {
+	TTL        time.Duration // how long results are kept, zero meaning until they are evicted
+	MaxEntries int           // how many results are kept, zero meaning 10,000
+}
+
+const defaultLookupCacheEntries = 10000
+
+// LookupCache caches the results of geocoding, carrier and timezone lookups, for servers which
+// enrich live traffic and see the same numbers over and over. Its methods return the same as the
+// package functions of the same names, which are only called for numbers which aren't cached, or
+// whose results have expired or were looked up before our metadata was replaced with SwapMetadata or
+// a carrier overlay was set with SetCarrierOverlay. Errors aren't cached. It's safe to use from
+// multiple goroutines.
+type LookupCache struct {
+	ttl time.Duration
+	max int
+
+	mutex   sync.Mutex
+	entries map[lookupCacheKey]*list.Element
+	lru     *list.List // of *lookupCacheEntry, most recently used first
+
+	// replaced in tests
+	now func() time.Time
+}
+
+type lookupKind uint8
+
+const (
+	lookupGeocoding lookupKind = iota
+	lookupCarrier
+	lookupTimezones
+)
+
+type lookupCacheKey struct {
+	kind   lookupKind
+	number Key
+	lang   string
+}
+
+type lookupCacheEntry struct {
+	key        lookupCacheKey
+	value      string
+	values     []string
+	generation uint64
+	expires    time.Time
+}
+
+// NewLookupCache returns a new empty cache with the passed in options
+func NewLookupCache(opts LookupCacheOptions) *LookupCache {
+	max := opts.MaxEntries
+	if max <= 0 {
+		max = defaultLookupCacheEntries
+	}
+	return &LookupCache{
+		ttl:     opts.TTL,
+		max:     max,
+		entries: make(map[lookupCacheKey]*list.Element),
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// GetGeocodingForNumber is GetGeocodingForNumber with its results cached
+func (c *LookupCache) GetGeocodingForNumber(number *PhoneNumber, lang string) (string, error) {
+	key := newLookupCacheKey(lookupGeocoding, number, lang)
+	generation := atomic.LoadUint64(&lookupDataGeneration)
+	if entry := c.get(key, generation); entry != nil {
+		return entry.value, nil
+	}
+
+	geocoding, err := GetGeocodingForNumber(number, lang)
+	if err != nil {
+		return "", err
+	}
+	c.put(&lookupCacheEntry{key: key, value: geocoding, generation: generation})
+	return geocoding, nil
+}
+
+// GetCarrierForNumber is GetCarrierForNumber with its results cached
+func (c *LookupCache) GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
+	key := newLookupCacheKey(lookupCarrier, number, lang)
+	generation := atomic.LoadUint64(&lookupDataGeneration)
+	if entry := c.get(key, generation); entry != nil {
+		return entry.value, nil
+	}
+
+	carrier, err := GetCarrierForNumber(number, lang)
+	if err != nil {
+		return "", err
+	}
+	c.put(&lookupCacheEntry{key: key, value: carrier, generation: generation})
+	return carrier, nil
+}
+
+// GetTimezonesForNumber is GetTimezonesForNumber with its results cached. The returned slice is
+// shared and must not be modified.
+func (c *LookupCache) GetTimezonesForNumber(number *PhoneNumber) ([]string, error) {
+	key := newLookupCacheKey(lookupTimezones, number, "")
+	generation := atomic.LoadUint64(&lookupDataGeneration)
+	if entry := c.get(key, generation); entry != nil {
+		return entry.values, nil
+	}
+
+	timezones, err := GetTimezonesForNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	c.put(&lookupCacheEntry{key: key, values: timezones, generation: generation})
+	return timezones, nil
+}
+
+// Len returns the number of results currently cached, including any which have expired or are
+// stale but not yet been dropped
+func (c *LookupCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.lru.Len()
+}
+
+// Flush drops all cached results
+func (c *LookupCache) Flush() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = make(map[lookupCacheKey]*list.Element)
+	c.lru.Init()
+}
+
+// newLookupCacheKey returns the key for a lookup of the passed in kind, extensions don't change the
+// results of any lookup so aren't part of it
+func newLookupCacheKey(kind lookupKind, number *PhoneNumber, lang string) lookupCacheKey {
+	key := lookupCacheKey{kind: kind, number: number.Key(), lang: lang}
+	key.number.Extension = ""
+	return key
+}
+
+// get returns the cached entry for the passed in key if there is one which hasn't expired and was
+// looked up with the passed in generation of our data, dropping it if not
+func (c *LookupCache) get(key lookupCacheKey, generation uint64) *lookupCacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, found := c.entries[key]
+	if !found {
+		return nil
+	}
+	entry := element.Value.(*lookupCacheEntry)
+	if entry.generation != generation || (c.ttl > 0 && !c.now().Before(entry.expires)) {
+		c.lru.Remove(element)
+		delete(c.entries, key)
+		return nil
+	}
+	c.lru.MoveToFront(element)
+	return entry
+}
+
+func (c *LookupCache) put(entry *lookupCacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.ttl > 0 {
+		entry.expires = c.now().Add(c.ttl)
+	}
+	if existing, found := c.entries[entry.key]; found {
+		c.lru.Remove(existing)
+	}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.max {
+		evicted := c.lru.Remove(c.lru.Back()).(*lookupCacheEntry)
+		delete(c.entries, evicted.key)
+	}
+}
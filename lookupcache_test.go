@@ -0,0 +1,97 @@
+package phonenumbers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupCache(t *testing.T) {
+	cache := NewLookupCache(LookupCacheOptions{TTL: time.Hour, MaxEntries: 2})
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	number, err := Parse("+447700900123", "")
+	require.NoError(t, err)
+
+	// errors aren't cached
+	_, err = cache.GetCarrierForNumber(number, "en")
+	assert.ErrorIs(t, err, ErrCarrierDataNotLoaded)
+	_, err = cache.GetGeocodingForNumber(number, "en")
+	assert.ErrorIs(t, err, ErrGeocodingDataNotLoaded)
+	_, err = cache.GetTimezonesForNumber(number)
+	assert.ErrorIs(t, err, ErrTimezoneDataNotLoaded)
+	assert.Equal(t, 0, cache.Len())
+
+	overlay, err := NewPrefixSet()
+	require.NoError(t, err)
+	require.NoError(t, overlay.Add("+44 7700 9", "Sub Brand"))
+	SetCarrierOverlay("en", overlay)
+	defer SetCarrierOverlay("en", nil)
+
+	carrier, err := cache.GetCarrierForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub Brand", carrier)
+	assert.Equal(t, 1, cache.Len())
+
+	// overlays shouldn't be changed once set, which lets us see the cached result being used, including for the
+	// same number with an extension
+	require.NoError(t, overlay.Add("+44 7700 900", "Changed"))
+	carrier, err = cache.GetCarrierForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub Brand", carrier)
+
+	withExtension, err := Parse("+447700900123 ext. 12", "")
+	require.NoError(t, err)
+	carrier, err = cache.GetCarrierForNumber(withExtension, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub Brand", carrier)
+	assert.Equal(t, 1, cache.Len())
+
+	// other languages are cached separately
+	carrier, err = cache.GetCarrierForNumber(number, "fr")
+	assert.NoError(t, err)
+	assert.Equal(t, "Changed", carrier)
+	assert.Equal(t, 2, cache.Len())
+
+	// results expire after the TTL
+	now = now.Add(time.Hour)
+	carrier, err = cache.GetCarrierForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Changed", carrier)
+
+	// and are stale once the data they came from is replaced
+	replaced, err := NewPrefixSet()
+	require.NoError(t, err)
+	require.NoError(t, replaced.Add("+44 7700", "Host Network"))
+	SetCarrierOverlay("en", replaced)
+
+	carrier, err = cache.GetCarrierForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Host Network", carrier)
+
+	embedded, err := MetadataCollection()
+	require.NoError(t, err)
+	require.NoError(t, replaced.Add("+44 7700 9", "Sub Brand"))
+	require.NoError(t, SwapMetadata(embedded))
+	defer ResetMetadata()
+
+	carrier, err = cache.GetCarrierForNumber(number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sub Brand", carrier)
+
+	// the least recently used results are dropped once we're full
+	other, err := Parse("+447700800123", "")
+	require.NoError(t, err)
+	cache.GetCarrierForNumber(other, "en")
+	cache.GetCarrierForNumber(number, "en")
+	assert.Equal(t, 2, cache.Len())
+	_, found := cache.entries[newLookupCacheKey(lookupCarrier, number, "fr")]
+	assert.False(t, found)
+
+	cache.Flush()
+	assert.Equal(t, 0, cache.Len())
+}
@@ -0,0 +1,123 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// MachineToMachineRange is a range of numbers set aside for machine to machine (M2M) and IoT use,
+// such as the SIMs in smart meters, alarms and vehicles, identified by the start of the national
+// significant number and the lengths it can have
+type MachineToMachineRange struct {
+	CountryCode int32
+	Prefix      string
+	Lengths     []int
+}
+
+// the ranges we know of by default. libphonenumber doesn't have a type for M2M numbers, and leaves
+// most of these ranges out of its metadata altogether, so they are kept here.
+var defaultMachineToMachineRanges = []MachineToMachineRange{
+	// Netherlands, 097 numbers, which the metadata treats as mobile
+	{CountryCode: 31, Prefix: "97", Lengths: []int{11}},
+
+	// Sweden, 071 numbers
+	{CountryCode: 46, Prefix: "71", Lengths: []int{12}},
+
+	// Norway, 12 digit numbers in the 58 series
+	{CountryCode: 47, Prefix: "58", Lengths: []int{12}},
+
+	// Germany, mobile numbers longer than any assigned to people, up to the 15 digits E164 allows
+	{CountryCode: 49, Prefix: "15", Lengths: []int{12, 13}},
+	{CountryCode: 49, Prefix: "16", Lengths: []int{12, 13}},
+	{CountryCode: 49, Prefix: "17", Lengths: []int{12, 13}},
+}
+
+// machineToMachineTable is the ranges set with SetMachineToMachineRanges, in the order they were
+// set and by country code
+type machineToMachineTable struct {
+	ranges []MachineToMachineRange
+	byCode map[int32][]MachineToMachineRange
+}
+
+// the table in use, as a *machineToMachineTable
+var machineToMachineRanges atomic.Value
+
+func init() {
+	machineToMachineRanges.Store(newMachineToMachineTable(defaultMachineToMachineRanges))
+}
+
+// SetMachineToMachineRanges replaces the ranges IsMachineToMachineNumber checks numbers against, e.g.
+// to add ones allocated since this release or which a carrier uses for its own IoT customers. The
+// ranges in use can be gotten with GetMachineToMachineRanges and appended to, and passing nil restores
+// the defaults. It's safe to call at any time.
+func SetMachineToMachineRanges(ranges []MachineToMachineRange) error {
+	if ranges == nil {
+		ranges = defaultMachineToMachineRanges
+	}
+	for _, r := range ranges {
+		if r.Prefix == "" || strings.Trim(r.Prefix, "0123456789") != "" {
+			return fmt.Errorf("%w: %q for country code %d", ErrInvalidPrefix, r.Prefix, r.CountryCode)
+		}
+		if len(r.Lengths) == 0 {
+			return fmt.Errorf("no lengths for prefix %q for country code %d", r.Prefix, r.CountryCode)
+		}
+	}
+	machineToMachineRanges.Store(newMachineToMachineTable(ranges))
+	return nil
+}
+
+// GetMachineToMachineRanges returns a copy of the ranges IsMachineToMachineNumber checks numbers
+// against, in the order they were set
+func GetMachineToMachineRanges() []MachineToMachineRange {
+	return copyMachineToMachineRanges(machineToMachineRanges.Load().(*machineToMachineTable).ranges)
+}
+
+// IsMachineToMachineNumber returns whether the passed in number is in a range set aside for machine
+// to machine (M2M) and IoT use, e.g. +49 151 1234567890 or +31 97 012345678, so that platforms can route
+// traffic to devices differently from traffic to people. GetNumberType has no type for these numbers,
+// and as most of these ranges aren't in the metadata they will often not be valid according to
+// IsValidNumber either, so this only looks at the digits of the number and the ranges set with
+// SetMachineToMachineRanges. Countries which haven't set aside a range, or which use the same ranges
+// for people and devices, such as the UK, never have M2M numbers.
+func IsMachineToMachineNumber(number *PhoneNumber) bool {
+	ranges := machineToMachineRanges.Load().(*machineToMachineTable).byCode[number.GetCountryCode()]
+	if len(ranges) == 0 {
+		return false
+	}
+
+	nsn := GetNationalSignificantNumber(number)
+	for _, r := range ranges {
+		if len(nsn) < len(r.Prefix) || nsn[:len(r.Prefix)] != r.Prefix {
+			continue
+		}
+		for _, length := range r.Lengths {
+			if len(nsn) == length {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newMachineToMachineTable returns a table of copies of the passed in ranges, so that callers can't
+// change them after they've been set
+func newMachineToMachineTable(ranges []MachineToMachineRange) *machineToMachineTable {
+	table := &machineToMachineTable{
+		ranges: copyMachineToMachineRanges(ranges),
+		byCode: make(map[int32][]MachineToMachineRange),
+	}
+	for _, r := range table.ranges {
+		table.byCode[r.CountryCode] = append(table.byCode[r.CountryCode], r)
+	}
+	return table
+}
+
+func copyMachineToMachineRanges(ranges []MachineToMachineRange) []MachineToMachineRange {
+	copied := make([]MachineToMachineRange, len(ranges))
+	for i, r := range ranges {
+		r.Lengths = append([]int(nil), r.Lengths...)
+		copied[i] = r
+	}
+	return copied
+}
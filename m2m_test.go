@@ -0,0 +1,64 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsMachineToMachineNumber(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected bool
+	}{
+		{"+31970123456789", false}, // too long
+		{"+3197012345678", true},   // valid mobile number as far as the metadata goes
+		{"+31612345678", false},    // mobile
+		{"+46712345678901", true},
+		{"+46701234567", false},
+		{"+47581234567890", true},
+		{"+4758123456", false},
+		{"+4915112345678", false}, // mobile
+		{"+491511234567890", true},
+		{"+491701234567890", true},
+		{"+4917012345678901", false}, // longer than E164 allows
+		{"+49301234567890", false},   // fixed line prefix
+		{"+447400123456", false},     // no M2M ranges in the UK
+		{"+16502530000", false},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.number, UNKNOWN_REGION)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.expected, IsMachineToMachineNumber(number), "M2M mismatch for %s", tc.number)
+	}
+}
+
+func TestSetMachineToMachineRanges(t *testing.T) {
+	defer SetMachineToMachineRanges(nil)
+
+	ranges := append(GetMachineToMachineRanges(), MachineToMachineRange{CountryCode: 44, Prefix: "7", Lengths: []int{12}})
+	require.NoError(t, SetMachineToMachineRanges(ranges))
+
+	// changing what we set doesn't change what's in use
+	ranges[len(ranges)-1].Lengths[0] = 10
+
+	number, _ := Parse("+44740012345678", UNKNOWN_REGION)
+	assert.True(t, IsMachineToMachineNumber(number))
+	number, _ = Parse("+447400123456", UNKNOWN_REGION)
+	assert.False(t, IsMachineToMachineNumber(number))
+	assert.Equal(t, len(defaultMachineToMachineRanges)+1, len(GetMachineToMachineRanges()))
+
+	assert.ErrorIs(t, SetMachineToMachineRanges([]MachineToMachineRange{{CountryCode: 44, Prefix: "7x", Lengths: []int{12}}}), ErrInvalidPrefix)
+	assert.Error(t, SetMachineToMachineRanges([]MachineToMachineRange{{CountryCode: 44, Prefix: "7"}}))
+
+	// failing to set ranges leaves the previous ones in use
+	number, _ = Parse("+44740012345678", UNKNOWN_REGION)
+	assert.True(t, IsMachineToMachineNumber(number))
+
+	require.NoError(t, SetMachineToMachineRanges(nil))
+	assert.False(t, IsMachineToMachineNumber(number))
+	assert.Equal(t, defaultMachineToMachineRanges, GetMachineToMachineRanges())
+}
@@ -0,0 +1,224 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MarshalText implements encoding.TextMarshaler, encoding numbers in E164 format followed by any
+// extension in RFC3966 style, e.g. "+16502530000;ext=123". As encoding/json uses this, numbers are
+// encoded as JSON strings, and can be used as map keys.
+func (x *PhoneNumber) MarshalText() ([]byte, error) {
+	text := Format(x, E164)
+	if extension := x.GetExtension(); extension != "" {
+		text += RFC3966_EXTN_PREFIX + extension
+	}
+	return []byte(text), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing numbers in international format
+// such as those written by MarshalText.
+func (x *PhoneNumber) UnmarshalText(text []byte) error {
+	return ParseInto(string(text), UNKNOWN_REGION, x)
+}
+
+// Format implements fmt.Formatter so that numbers are printed by the fmt functions in the same form
+// as MarshalText, rather than with the protobuf text format used by the generated String method.
+// This means logging a number never includes its raw input. Numbers without a country code or
+// national number have no E164 form, and are printed with their fields instead. The %v and %s
+// verbs print the number as is, and %q prints it quoted.
+func (x *PhoneNumber) Format(f fmt.State, verb rune) {
+	var text string
+	if x == nil {
+		text = "<nil>"
+	} else if x.GetCountryCode() == 0 || x.GetNationalNumber() == 0 {
+		text = fmt.Sprintf("{country_code:%d national_number:%d extension:%q}", x.GetCountryCode(), x.GetNationalNumber(), x.GetExtension())
+	} else {
+		b, _ := x.MarshalText()
+		text = string(b)
+	}
+
+	switch verb {
+	case 'v', 's':
+		fmt.Fprint(f, text)
+	case 'q':
+		fmt.Fprintf(f, "%q", text)
+	default:
+		fmt.Fprintf(f, "%%!%c(PhoneNumber=%s)", verb, text)
+	}
+}
+
+// binaryVersion is the version of the encoding written by MarshalBinary, stored in the top two
+// bits of the first byte, the other six being flags for which optional fields are present
+const binaryVersion = 1
+
+const (
+	binaryHasExtension = 1 << iota
+	binaryHasItalianLeadingZero
+	binaryHasNumberOfLeadingZeros
+	binaryHasRawInput
+	binaryHasCountryCodeSource
+	binaryHasPreferredDomesticCarrierCode
+)
+
+var (
+	ErrInvalidBinaryNumber     = errors.New("invalid binary encoded phone number")
+	ErrUnsupportedBinaryNumber = errors.New("unsupported version of binary encoded phone number")
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding numbers in a compact versioned
+// format which is smaller than their protobuf encoding, for use in caches and queues. Every field
+// is encoded, so numbers decoded with UnmarshalBinary are equal to the original.
+//
+// The format is a byte made up of the version in its top two bits and flags for which optional
+// fields are present in the rest, then the country code and national number as uvarints, followed
+// by each optional field present in field number order. Strings are encoded as a uvarint length
+// followed by their bytes, booleans as a single byte and numbers as uvarints.
+func (x *PhoneNumber) MarshalBinary() ([]byte, error) {
+	var flags byte
+	if x.Extension != nil {
+		flags |= binaryHasExtension
+	}
+	if x.ItalianLeadingZero != nil {
+		flags |= binaryHasItalianLeadingZero
+	}
+	if x.NumberOfLeadingZeros != nil {
+		flags |= binaryHasNumberOfLeadingZeros
+	}
+	if x.RawInput != nil {
+		flags |= binaryHasRawInput
+	}
+	if x.CountryCodeSource != nil {
+		flags |= binaryHasCountryCodeSource
+	}
+	if x.PreferredDomesticCarrierCode != nil {
+		flags |= binaryHasPreferredDomesticCarrierCode
+	}
+
+	data := make([]byte, 0, 16)
+	data = append(data, binaryVersion<<6|flags)
+	data = appendUvarint(data, uint64(uint32(x.GetCountryCode())))
+	data = appendUvarint(data, x.GetNationalNumber())
+
+	if x.Extension != nil {
+		data = appendBinaryString(data, x.GetExtension())
+	}
+	if x.ItalianLeadingZero != nil {
+		if x.GetItalianLeadingZero() {
+			data = append(data, 1)
+		} else {
+			data = append(data, 0)
+		}
+	}
+	if x.RawInput != nil {
+		data = appendBinaryString(data, x.GetRawInput())
+	}
+	if x.CountryCodeSource != nil {
+		data = appendUvarint(data, uint64(uint32(x.GetCountryCodeSource())))
+	}
+	if x.PreferredDomesticCarrierCode != nil {
+		data = appendBinaryString(data, x.GetPreferredDomesticCarrierCode())
+	}
+	if x.NumberOfLeadingZeros != nil {
+		data = appendUvarint(data, uint64(uint32(x.GetNumberOfLeadingZeros())))
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding numbers encoded by MarshalBinary.
+// The number is reset first.
+func (x *PhoneNumber) UnmarshalBinary(data []byte) error {
+	x.Reset()
+
+	if len(data) == 0 {
+		return ErrInvalidBinaryNumber
+	}
+	if data[0]>>6 != binaryVersion {
+		return ErrUnsupportedBinaryNumber
+	}
+	flags := data[0]
+	reader := bytes.NewReader(data[1:])
+
+	countryCode, err := binary.ReadUvarint(reader)
+	if err != nil || countryCode > math.MaxUint32 {
+		return ErrInvalidBinaryNumber
+	}
+	x.CountryCode = int32(countryCode)
+	if x.NationalNumber, err = binary.ReadUvarint(reader); err != nil {
+		return ErrInvalidBinaryNumber
+	}
+
+	if flags&binaryHasExtension != 0 {
+		extension, err := readBinaryString(reader)
+		if err != nil {
+			return err
+		}
+		x.Extension = &extension
+	}
+	if flags&binaryHasItalianLeadingZero != 0 {
+		italianLeadingZero, err := reader.ReadByte()
+		if err != nil || italianLeadingZero > 1 {
+			return ErrInvalidBinaryNumber
+		}
+		x.ItalianLeadingZero = proto.Bool(italianLeadingZero == 1)
+	}
+	if flags&binaryHasRawInput != 0 {
+		rawInput, err := readBinaryString(reader)
+		if err != nil {
+			return err
+		}
+		x.RawInput = &rawInput
+	}
+	if flags&binaryHasCountryCodeSource != 0 {
+		source, err := binary.ReadUvarint(reader)
+		if err != nil || source > math.MaxUint32 {
+			return ErrInvalidBinaryNumber
+		}
+		countryCodeSource := PhoneNumber_CountryCodeSource(int32(source))
+		x.CountryCodeSource = &countryCodeSource
+	}
+	if flags&binaryHasPreferredDomesticCarrierCode != 0 {
+		carrierCode, err := readBinaryString(reader)
+		if err != nil {
+			return err
+		}
+		x.PreferredDomesticCarrierCode = &carrierCode
+	}
+	if flags&binaryHasNumberOfLeadingZeros != 0 {
+		zeros, err := binary.ReadUvarint(reader)
+		if err != nil || zeros > math.MaxUint32 {
+			return ErrInvalidBinaryNumber
+		}
+		x.NumberOfLeadingZeros = proto.Int32(int32(zeros))
+	}
+
+	if reader.Len() > 0 {
+		return ErrInvalidBinaryNumber
+	}
+	return nil
+}
+
+func appendUvarint(data []byte, value uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(data, buf[:binary.PutUvarint(buf[:], value)]...)
+}
+
+func appendBinaryString(data []byte, value string) []byte {
+	data = appendUvarint(data, uint64(len(value)))
+	return append(data, value...)
+}
+
+func readBinaryString(reader *bytes.Reader) (string, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil || length > uint64(reader.Len()) {
+		return "", ErrInvalidBinaryNumber
+	}
+	value := make([]byte, length)
+	reader.Read(value)
+	return string(value), nil
+}
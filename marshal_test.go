@@ -0,0 +1,142 @@
+package phonenumbers
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ encoding.TextMarshaler = &PhoneNumber{}
+var _ encoding.TextUnmarshaler = &PhoneNumber{}
+var _ encoding.BinaryMarshaler = &PhoneNumber{}
+var _ encoding.BinaryUnmarshaler = &PhoneNumber{}
+var _ fmt.Formatter = &PhoneNumber{}
+
+func TestMarshalText(t *testing.T) {
+	tests := []struct {
+		input    string
+		region   string
+		expected string
+	}{
+		{"(650) 253-0000", "US", "+16502530000"},
+		{"(650) 253-0000 ext. 123", "US", "+16502530000;ext=123"},
+		{"020 7031 3000", "GB", "+442070313000"},
+		{"+800 1234 5678", "", "+80012345678"},
+	}
+	for _, tc := range tests {
+		number, err := Parse(tc.input, tc.region)
+		require.NoError(t, err)
+
+		text, err := number.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, string(text))
+
+		// and we get the same number back
+		parsed := &PhoneNumber{}
+		assert.NoError(t, parsed.UnmarshalText(text))
+		assert.True(t, IsNumberMatchWithNumbers(number, parsed) == EXACT_MATCH, "mismatch for %s", tc.input)
+	}
+
+	assert.Equal(t, ErrInvalidCountryCode, (&PhoneNumber{}).UnmarshalText([]byte("6502530000")))
+}
+
+func TestMarshalTextJSON(t *testing.T) {
+	number, err := Parse("(650) 253-0000 ext. 123", "US")
+	require.NoError(t, err)
+
+	type contact struct {
+		Name  string       `json:"name"`
+		Phone *PhoneNumber `json:"phone"`
+	}
+
+	encoded, err := json.Marshal(contact{Name: "Bob", Phone: number})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"Bob","phone":"+16502530000;ext=123"}`, string(encoded))
+
+	decoded := contact{}
+	assert.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, int32(1), decoded.Phone.GetCountryCode())
+	assert.Equal(t, uint64(6502530000), decoded.Phone.GetNationalNumber())
+	assert.Equal(t, "123", decoded.Phone.GetExtension())
+
+	// numbers can be used as map keys
+	encoded, err = json.Marshal(map[*PhoneNumber]string{number: "Bob"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"+16502530000;ext=123":"Bob"}`, string(encoded))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"phone":"12"}`), &decoded))
+}
+
+func TestFormatter(t *testing.T) {
+	number, err := ParseAndKeepRawInput("(650) 253-0000 ext. 123", "US")
+	require.NoError(t, err)
+
+	assert.Equal(t, "+16502530000;ext=123", fmt.Sprint(number))
+	assert.Equal(t, "+16502530000;ext=123", fmt.Sprintf("%v", number))
+	assert.Equal(t, "+16502530000;ext=123", fmt.Sprintf("%+v", number))
+	assert.Equal(t, "number: +16502530000;ext=123", fmt.Sprintf("number: %s", number))
+	assert.Equal(t, `"+16502530000;ext=123"`, fmt.Sprintf("%q", number))
+	assert.Equal(t, "%!d(PhoneNumber=+16502530000;ext=123)", fmt.Sprintf("%d", number))
+	assert.Equal(t, "[+16502530000;ext=123]", fmt.Sprint([]*PhoneNumber{number}))
+
+	// the raw input is never included
+	assert.NotContains(t, fmt.Sprintf("%v", number), "(650)")
+
+	// incomplete numbers are printed with their fields
+	assert.Equal(t, `{country_code:1 national_number:0 extension:""}`, fmt.Sprint(&PhoneNumber{CountryCode: 1}))
+	assert.Equal(t, `{country_code:0 national_number:0 extension:""}`, fmt.Sprint(&PhoneNumber{}))
+
+	var nilNumber *PhoneNumber
+	assert.Equal(t, "<nil>", fmt.Sprint(nilNumber))
+}
+
+func TestMarshalBinary(t *testing.T) {
+	var numbers []*PhoneNumber
+	for _, input := range []string{"+16502530000", "(650) 253-0000 ext. 123", "0788 383 383", "1-800-FLOWERS", "011 44 20 7031 3000"} {
+		number, err := Parse(input, "US")
+		require.NoError(t, err)
+		numbers = append(numbers, number)
+
+		number, err = ParseAndKeepRawInput(input, "RW")
+		require.NoError(t, err)
+		numbers = append(numbers, number)
+	}
+	numbers = append(numbers,
+		&PhoneNumber{},
+		&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true), NumberOfLeadingZeros: proto.Int32(2)},
+		&PhoneNumber{CountryCode: 57, NationalNumber: 6012345678, PreferredDomesticCarrierCode: proto.String("3"), ItalianLeadingZero: proto.Bool(false)},
+		&PhoneNumber{CountryCode: -1, NationalNumber: math.MaxUint64, Extension: proto.String("")},
+	)
+
+	for _, number := range numbers {
+		data, err := number.MarshalBinary()
+		assert.NoError(t, err)
+
+		// we're always smaller than protobuf, unless that's empty
+		protoData, err := proto.Marshal(number)
+		assert.NoError(t, err)
+		if len(protoData) > 0 {
+			assert.Less(t, len(data), len(protoData), "binary not smaller than proto for %s", number)
+		}
+
+		decoded := &PhoneNumber{RawInput: proto.String("not reset")}
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.True(t, proto.Equal(number, decoded), "mismatch for %s, got %s", number, decoded)
+	}
+
+	data, err := numbers[1].MarshalBinary()
+	require.NoError(t, err)
+
+	// anything truncated, with trailing data or from another version is an error
+	for i := range data {
+		assert.Equal(t, ErrInvalidBinaryNumber, (&PhoneNumber{}).UnmarshalBinary(data[:i]), "expected error for %d bytes", i)
+	}
+	assert.Equal(t, ErrInvalidBinaryNumber, (&PhoneNumber{}).UnmarshalBinary(append(data, 0)))
+	assert.Equal(t, ErrUnsupportedBinaryNumber, (&PhoneNumber{}).UnmarshalBinary(append([]byte{2 << 6}, data[1:]...)))
+}
@@ -0,0 +1,43 @@
+package phonenumbers
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// the character used in place of hidden digits by FormatMasked
+const maskChar = '•'
+
+// FormatMasked formats the passed in number like Format, but with the digits of its subscriber
+// number hidden, except for the last visibleSuffix of them, e.g. "+1 415-•••-••23". The country
+// code, any national prefix and the national destination code, such as the area code, stay
+// visible, and the grouping of the format is kept. Extensions are not included.
+func FormatMasked(number *PhoneNumber, numberFormat PhoneNumberFormat, visibleSuffix int) string {
+	withoutExtension := number
+	if number.GetExtension() != "" {
+		withoutExtension = &PhoneNumber{}
+		proto.Merge(withoutExtension, number)
+		withoutExtension.Extension = nil
+	}
+	formatted := Format(withoutExtension, numberFormat)
+
+	subscriberLength := len(GetNationalSignificantNumber(number)) - GetLengthOfNationalDestinationCode(number)
+	if visibleSuffix >= subscriberLength {
+		return formatted
+	}
+
+	// the subscriber number is always the last digits of a formatted number, so work backwards
+	// from the end, leaving the visible digits alone and masking those before them
+	runes := []rune(formatted)
+	digits := 0
+	for i := len(runes) - 1; i >= 0 && digits < subscriberLength; i-- {
+		if runes[i] < '0' || runes[i] > '9' {
+			continue
+		}
+		if digits >= visibleSuffix {
+			runes[i] = maskChar
+		}
+		digits++
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,35 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatMasked(t *testing.T) {
+	tests := []struct {
+		number        string
+		format        PhoneNumberFormat
+		visibleSuffix int
+		expected      string
+	}{
+		{"+14152530023", INTERNATIONAL, 2, "+1 415-•••-••23"},
+		{"+14152530023", NATIONAL, 2, "(415) •••-••23"},
+		{"+14152530023", E164, 4, "+1415•••0023"},
+		{"+14152530023", RFC3966, 0, "tel:+1-415-•••-••••"},
+		{"+14152530023;ext=123", INTERNATIONAL, 2, "+1 415-•••-••23"},
+		{"+442070313000", INTERNATIONAL, 3, "+44 20 •••• •000"},
+		{"+442070313000", NATIONAL, 3, "020 •••• •000"},
+		{"+33123456789", INTERNATIONAL, 2, "+33 1 •• •• •• 89"},
+		{"+14152530023", INTERNATIONAL, 7, "+1 415-253-0023"},
+		{"+14152530023", INTERNATIONAL, 10, "+1 415-253-0023"},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.number, UNKNOWN_REGION)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.expected, FormatMasked(number, tc.format, tc.visibleSuffix), "masked format mismatch for %s", tc.number)
+	}
+}
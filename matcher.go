@@ -205,6 +205,11 @@ func AllNumberGroupsAreExactlyPresent(
 // Returns whether the given national number (a string containing only decimal digits) matches
 // the national number pattern defined in the given PhoneNumberDesc message.
 func MatchNationalNumber(number string, numberDesc PhoneNumberDesc, allowPrefixMatch bool) bool {
+	return matchNationalNumber(number, &numberDesc, allowPrefixMatch)
+}
+
+// matchNationalNumber is MatchNationalNumber for the descs in our metadata, which can't be copied
+func matchNationalNumber(number string, numberDesc *PhoneNumberDesc, allowPrefixMatch bool) bool {
 	nationalNumberPattern := numberDesc.GetNationalNumberPattern()
 	// We don't want to consider it a prefix match when matching non-empty input against an empty pattern.
 	if len(nationalNumberPattern) == 0 {
@@ -0,0 +1,179 @@
+package phonenumbers
+
+import (
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"sync/atomic"
+	"unsafe"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// the sizes of the headers of strings and slices, and pointers
+const (
+	stringHeaderSize = int64(unsafe.Sizeof(""))
+	sliceHeaderSize  = int64(unsafe.Sizeof([]byte(nil)))
+	pointerSize      = int64(unsafe.Sizeof(uintptr(0)))
+)
+
+// MemStats describes the memory retained by this package for metadata, prefix data and compiled
+// regexes. All byte counts are estimates based on the size of what is held, they don't include
+// allocator overhead and won't exactly match what the runtime reports, but are close enough to
+// see what each part of the library costs and how that grows as it is used.
+type MemStats struct {
+	// MetadataRegions is the number of regions, including non-geographical calling codes and
+	// short number metadata, whose metadata has been decoded, and MetadataBytes the memory it uses
+	MetadataRegions int
+	MetadataBytes   int64
+
+	// EncodedMetadataBytes is the memory used by metadata which hasn't been used yet and so is
+	// still encoded
+	EncodedMetadataBytes int64
+
+	// CarrierBytes and GeocodingBytes are the memory used by the prefix data for each language
+	// which has been looked up, and so decoded, and not since evicted
+	CarrierBytes   map[string]int64
+	GeocodingBytes map[string]int64
+
+	// PrefixDataEvictions is the number of times carrier or geocoding data for a language has been
+	// evicted to stay within the limit set by SetPrefixDataMemoryLimit
+	PrefixDataEvictions int64
+
+	// TimezoneBytes and MccMncBytes are the memory used by the timezone and MCC/MNC prefix data,
+	// zero until they are first used
+	TimezoneBytes int64
+	MccMncBytes   int64
+
+	// Regexes is the number of compiled regexes we have cached, and RegexBytes the memory they use
+	Regexes    int
+	RegexBytes int64
+}
+
+// GetMemStats returns an estimate of the memory currently retained by this package
+func GetMemStats() MemStats {
+	stats := MemStats{
+		CarrierBytes:   make(map[string]int64),
+		GeocodingBytes: make(map[string]int64),
+	}
+
+	addMetadata := func(l *lazyMetadata) {
+		if l.isLoaded() {
+			stats.MetadataRegions++
+			stats.MetadataBytes += messageBytes(l.metadata.ProtoReflect())
+		} else {
+			stats.EncodedMetadataBytes += int64(l.encodedSize)
+		}
+	}
+	tables := currentMetadata()
+	for _, l := range tables.regionToMetadata {
+		if l != nil {
+			addMetadata(l)
+		}
+	}
+	for _, l := range tables.countryCodeToNonGeographicalMetadata {
+		if l != nil {
+			addMetadata(l)
+		}
+	}
+	for _, l := range shortNumberRegionToMetadataMap {
+		addMetadata(l)
+	}
+
+	for lang, prefixMap := range carrierMaps {
+		if size := prefixMap.bytes(); size > 0 {
+			stats.CarrierBytes[lang] = size
+		}
+	}
+	for lang, prefixMap := range geocodingMaps {
+		if size := prefixMap.bytes(); size > 0 {
+			stats.GeocodingBytes[lang] = size
+		}
+	}
+	stats.PrefixDataEvictions = atomic.LoadInt64(&prefixDataEvictions)
+	if timezoneMap != nil {
+		stats.TimezoneBytes = timezoneMap.bytes()
+	}
+	if mccMncMap != nil {
+		stats.MccMncBytes = mccMncMap.bytes()
+	}
+
+	// our strict cache shares its regexes with our main cache, so only count each once
+	seen := make(map[*regexp.Regexp]bool)
+	for _, table := range []*regexCacheTable{&regexCache, &strictRegexCache} {
+		for i := range table {
+			regexes, _ := table[i].regexes.Load().(map[string]*regexp.Regexp)
+			for key, regex := range regexes {
+				stats.RegexBytes += stringHeaderSize + int64(len(key)) + pointerSize
+				if !seen[regex] {
+					seen[regex] = true
+					stats.RegexBytes += regexBytes(regex)
+				}
+			}
+		}
+	}
+	stats.Regexes = len(seen)
+
+	return stats
+}
+
+// messageBytes estimates the memory used by the passed in message and everything it references
+func messageBytes(m protoreflect.Message) int64 {
+	size := int64(reflect.TypeOf(m.Interface()).Elem().Size())
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				size += fieldValueBytes(fd, list.Get(i))
+				if fd.Kind() == protoreflect.MessageKind {
+					size += pointerSize
+				}
+			}
+			size += sliceHeaderSize
+		} else {
+			size += fieldValueBytes(fd, v)
+		}
+		return true
+	})
+	return size
+}
+
+// fieldValueBytes estimates the memory used by a single field value, strings and bytes include their
+// headers as optional fields are stored as pointers to them
+func fieldValueBytes(fd protoreflect.FieldDescriptor, v protoreflect.Value) int64 {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return stringHeaderSize + int64(len(v.String()))
+	case protoreflect.BytesKind:
+		return sliceHeaderSize + int64(len(v.Bytes()))
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageBytes(v.Message())
+	default:
+		return 8
+	}
+}
+
+// regexBytes estimates the memory used by a compiled regex, which is dominated by its program
+func regexBytes(regex *regexp.Regexp) int64 {
+	size := int64(unsafe.Sizeof(regexp.Regexp{})) + int64(len(regex.String()))
+
+	re, err := syntax.Parse(regex.String(), syntax.Perl)
+	if err != nil {
+		return size
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return size
+	}
+	for i := range prog.Inst {
+		size += int64(unsafe.Sizeof(prog.Inst[i])) + int64(len(prog.Inst[i].Rune))*4
+	}
+	return size
+}
+
+// mapBytes estimates the memory used by a map with the passed in number of entries whose keys
+// and values together take up entrySize bytes, allowing for the map's load factor and per entry
+// control byte
+func mapBytes(entries int, entrySize int64) int64 {
+	return int64(entries) * (entrySize + 1) * 8 / 7
+}
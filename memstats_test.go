@@ -0,0 +1,29 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMemStats(t *testing.T) {
+	require.NoError(t, ResetMetadata())
+
+	before := GetMemStats()
+	assert.Greater(t, before.EncodedMetadataBytes, int64(0))
+
+	Preload("GB", "RW")
+
+	after := GetMemStats()
+	assert.Equal(t, before.MetadataRegions+4, after.MetadataRegions)
+	assert.Greater(t, after.MetadataBytes, before.MetadataBytes)
+	assert.Less(t, after.EncodedMetadataBytes, before.EncodedMetadataBytes)
+	assert.Greater(t, after.Regexes, before.Regexes)
+	assert.Greater(t, after.RegexBytes, before.RegexBytes)
+
+	// estimates for a region are sane, decoded metadata is bigger than it is encoded but not wildly so
+	gb := messageBytes(getMetadataForRegion("GB").ProtoReflect())
+	assert.Greater(t, gb, int64(1000))
+	assert.Less(t, gb, int64(100000))
+}
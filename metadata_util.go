@@ -1,5 +1,141 @@
 package phonenumbers
 
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// lazyMetadata holds the encoded metadata for a single region, or non-geographical calling code,
+// which is only unmarshalled the first time it is used. Most programs only ever see numbers from a
+// handful of regions so there's no point paying to unmarshal all of them at startup.
+type lazyMetadata struct {
+	once     sync.Once
+	encoded  []byte
+	metadata *PhoneMetadata
+
+	// encodedSize is the size of our encoded metadata, and loaded is set to 1 once it has been
+	// unmarshalled, these let GetMemStats inspect us without racing with get
+	encodedSize int
+	loaded      uint32
+}
+
+// newLazyMetadata returns a lazyMetadata for the passed in encoded metadata
+func newLazyMetadata(encoded []byte) *lazyMetadata {
+	return &lazyMetadata{encoded: encoded, encodedSize: len(encoded)}
+}
+
+// newLoadedMetadata returns a lazyMetadata for metadata which has already been unmarshalled
+func newLoadedMetadata(metadata *PhoneMetadata) *lazyMetadata {
+	l := &lazyMetadata{metadata: metadata, loaded: 1}
+	l.once.Do(func() {})
+	return l
+}
+
+// isLoaded returns whether our metadata has been unmarshalled, in which case it can be read
+func (l *lazyMetadata) isLoaded() bool {
+	return atomic.LoadUint32(&l.loaded) == 1
+}
+
+// get returns our metadata, unmarshalling it if this is the first time we've been called
+func (l *lazyMetadata) get() *PhoneMetadata {
+	l.once.Do(func() {
+		metadata := &PhoneMetadata{}
+		if err := proto.Unmarshal(l.encoded, metadata); err != nil {
+			// our embedded metadata is checked when it's built, so this means it has been corrupted
+			panic(fmt.Sprintf("unable to unmarshal metadata: %s", err))
+		}
+		metadata.precompileParsingRegexes()
+		if debugLogging() {
+			logDebug("loaded metadata", "region", metadata.GetId(), "country_code", metadata.GetCountryCode(), "size", len(l.encoded))
+		}
+
+		l.metadata = metadata
+		l.encoded = nil
+		atomic.StoreUint32(&l.loaded, 1)
+	})
+	return l.metadata
+}
+
+var errInvalidMetadata = errors.New("invalid encoded metadata")
+
+// splitMetadataCollection walks the passed in encoded PhoneMetadataCollection calling fn with the
+// id, country code and encoded bytes of each PhoneMetadata it contains, without unmarshalling them
+func splitMetadataCollection(encoded []byte, fn func(id string, countryCode int32, metadata []byte)) error {
+	for len(encoded) > 0 {
+		num, typ, n := protowire.ConsumeTag(encoded)
+		if n < 0 {
+			return errInvalidMetadata
+		}
+		encoded = encoded[n:]
+
+		// anything other than our repeated metadata field is skipped
+		if num != 1 || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, encoded)
+			if n < 0 {
+				return errInvalidMetadata
+			}
+			encoded = encoded[n:]
+			continue
+		}
+
+		metadata, n := protowire.ConsumeBytes(encoded)
+		if n < 0 {
+			return errInvalidMetadata
+		}
+		encoded = encoded[n:]
+
+		id, countryCode, err := peekMetadataKey(metadata)
+		if err != nil {
+			return err
+		}
+		fn(id, countryCode, metadata)
+	}
+	return nil
+}
+
+// peekMetadataKey reads just the id and country code fields from an encoded PhoneMetadata
+func peekMetadataKey(encoded []byte) (string, int32, error) {
+	var id string
+	var countryCode int32
+
+	for len(encoded) > 0 {
+		num, typ, n := protowire.ConsumeTag(encoded)
+		if n < 0 {
+			return "", 0, errInvalidMetadata
+		}
+		encoded = encoded[n:]
+
+		switch {
+		case num == 9 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(encoded)
+			if n < 0 {
+				return "", 0, errInvalidMetadata
+			}
+			id = v
+			encoded = encoded[n:]
+		case num == 10 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(encoded)
+			if n < 0 {
+				return "", 0, errInvalidMetadata
+			}
+			countryCode = int32(v)
+			encoded = encoded[n:]
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, encoded)
+			if n < 0 {
+				return "", 0, errInvalidMetadata
+			}
+			encoded = encoded[n:]
+		}
+	}
+	return id, countryCode, nil
+}
+
 // merge merges two number formats
 func (nf *NumberFormat) merge(other *NumberFormat) {
 	if len(other.Pattern) > 0 {
@@ -40,3 +176,55 @@ func (pd *PhoneNumberDesc) hasPossibleLengthLocalOnly(length int32) bool {
 	}
 	return false
 }
+
+// descs returns all the number descriptions in this metadata
+func (m *PhoneMetadata) descs() []*PhoneNumberDesc {
+	return []*PhoneNumberDesc{
+		m.GetGeneralDesc(), m.GetFixedLine(), m.GetMobile(), m.GetTollFree(), m.GetPremiumRate(),
+		m.GetSharedCost(), m.GetPersonalNumber(), m.GetVoip(), m.GetPager(), m.GetUan(),
+		m.GetEmergency(), m.GetVoicemail(), m.GetShortCode(), m.GetStandardRate(),
+		m.GetCarrierSpecific(), m.GetSmsServices(), m.GetNoInternationalDialling(),
+	}
+}
+
+// precompileRegexes adds the regexes used when parsing, validating and formatting numbers for
+// this metadata to our regex cache, using the same keys as the functions that look them up
+func (m *PhoneMetadata) precompileRegexes() {
+	m.precompileParsingRegexes()
+
+	for _, desc := range m.descs() {
+		if pattern := desc.GetNationalNumberPattern(); pattern != "" {
+			regexFor(pattern)
+			strictRegexFor(pattern)
+		}
+	}
+
+	if leadingDigits := m.GetLeadingDigits(); leadingDigits != "" {
+		regexFor("^(?:" + leadingDigits + ")")
+	}
+
+	for _, formats := range [][]*NumberFormat{m.GetNumberFormat(), m.GetIntlNumberFormat()} {
+		for _, format := range formats {
+			regexFor(format.GetPattern())
+			regexFor("^(?:" + format.GetPattern() + ")$")
+
+			if leadingDigits := format.GetLeadingDigitsPattern(); len(leadingDigits) > 0 {
+				regexFor(leadingDigits[len(leadingDigits)-1])
+			}
+		}
+	}
+}
+
+// precompileParsingRegexes adds the regexes needed to parse any number for this metadata to our
+// regex cache, these are the hottest patterns so we compile them as soon as metadata is loaded
+func (m *PhoneMetadata) precompileParsingRegexes() {
+	if prefix := m.GetInternationalPrefix(); prefix != "" {
+		regexFor(prefix)
+	}
+	if prefix := m.GetNationalPrefixForParsing(); prefix != "" {
+		regexFor("^(?:" + prefix + ")")
+	}
+	if pattern := m.GetGeneralDesc().GetNationalNumberPattern(); pattern != "" {
+		regexFor("^(?:" + pattern + ")$")
+	}
+}
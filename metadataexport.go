@@ -0,0 +1,259 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// WriteMetadataXML writes the passed in metadata collection, such as that returned by
+// MetadataCollection or ShortNumberMetadataCollection, in the format of libphonenumber's
+// PhoneNumberMetadata.xml, e.g. to audit which ranges are considered valid or diff against
+// upstream. Building metadata from the written XML gives back the same collection, but as the
+// embedded metadata has already been processed, formatting rules are written per format with
+// the national prefix filled in rather than once per territory.
+func WriteMetadataXML(w io.Writer, collection *PhoneMetadataCollection) error {
+	document := exportedMetadata{}
+	for _, metadata := range collection.GetMetadata() {
+		document.Territories = append(document.Territories, newExportedTerritory(metadata))
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteMetadataJSON writes the passed in metadata collection as JSON, using the standard JSON
+// mapping of the protocol buffer messages libphonenumber stores metadata in
+func WriteMetadataJSON(w io.Writer, collection *PhoneMetadataCollection) error {
+	encoded, err := protojson.Marshal(collection)
+	if err != nil {
+		return err
+	}
+
+	// protojson deliberately varies its whitespace, which would make diffs noisy
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, encoded, "", "  "); err != nil {
+		return err
+	}
+	indented.WriteByte('\n')
+
+	_, err = indented.WriteTo(w)
+	return err
+}
+
+// the elements we write, which unlike those we parse in builder.go leave out what isn't set
+type exportedMetadata struct {
+	XMLName     xml.Name            `xml:"phoneNumberMetadata"`
+	Territories []exportedTerritory `xml:"territories>territory"`
+}
+
+type exportedTerritory struct {
+	ID                           string `xml:"id,attr"`
+	CountryCode                  int32  `xml:"countryCode,attr"`
+	MainCountryForCode           bool   `xml:"mainCountryForCode,attr,omitempty"`
+	LeadingDigits                string `xml:"leadingDigits,attr,omitempty"`
+	PreferredInternationalPrefix string `xml:"preferredInternationalPrefix,attr,omitempty"`
+	InternationalPrefix          string `xml:"internationalPrefix,attr,omitempty"`
+	NationalPrefix               string `xml:"nationalPrefix,attr,omitempty"`
+	NationalPrefixForParsing     string `xml:"nationalPrefixForParsing,attr,omitempty"`
+	NationalPrefixTransformRule  string `xml:"nationalPrefixTransformRule,attr,omitempty"`
+	PreferredExtnPrefix          string `xml:"preferredExtnPrefix,attr,omitempty"`
+	MobileNumberPortableRegion   bool   `xml:"mobileNumberPortableRegion,attr,omitempty"`
+
+	AvailableFormats *exportedAvailableFormats `xml:"availableFormats"`
+
+	GeneralDesc             *exportedNumberDesc `xml:"generalDesc"`
+	NoInternationalDialling *exportedNumberDesc `xml:"noInternationalDialling"`
+	FixedLine               *exportedNumberDesc `xml:"fixedLine"`
+	Mobile                  *exportedNumberDesc `xml:"mobile"`
+	Pager                   *exportedNumberDesc `xml:"pager"`
+	TollFree                *exportedNumberDesc `xml:"tollFree"`
+	PremiumRate             *exportedNumberDesc `xml:"premiumRate"`
+	SharedCost              *exportedNumberDesc `xml:"sharedCost"`
+	PersonalNumber          *exportedNumberDesc `xml:"personalNumber"`
+	Voip                    *exportedNumberDesc `xml:"voip"`
+	Uan                     *exportedNumberDesc `xml:"uan"`
+	Voicemail               *exportedNumberDesc `xml:"voicemail"`
+	StandardRate            *exportedNumberDesc `xml:"standardRate"`
+	ShortCode               *exportedNumberDesc `xml:"shortCode"`
+	Emergency               *exportedNumberDesc `xml:"emergency"`
+	CarrierSpecific         *exportedNumberDesc `xml:"carrierSpecific"`
+	SmsServices             *exportedNumberDesc `xml:"smsServices"`
+}
+
+// a struct rather than a path so that it can be left out when there are no formats
+type exportedAvailableFormats struct {
+	NumberFormats []exportedNumberFormat `xml:"numberFormat"`
+}
+
+type exportedNumberFormat struct {
+	Pattern                              string   `xml:"pattern,attr"`
+	NationalPrefixFormattingRule         string   `xml:"nationalPrefixFormattingRule,attr,omitempty"`
+	NationalPrefixOptionalWhenFormatting *bool    `xml:"nationalPrefixOptionalWhenFormatting,attr,omitempty"`
+	CarrierCodeFormattingRule            string   `xml:"carrierCodeFormattingRule,attr,omitempty"`
+	LeadingDigits                        []string `xml:"leadingDigits"`
+	Format                               string   `xml:"format"`
+	IntlFormat                           string   `xml:"intlFormat,omitempty"`
+}
+
+type exportedNumberDesc struct {
+	PossibleLengths       *exportedPossibleLengths `xml:"possibleLengths"`
+	ExampleNumber         string                   `xml:"exampleNumber,omitempty"`
+	NationalNumberPattern string                   `xml:"nationalNumberPattern"`
+}
+
+type exportedPossibleLengths struct {
+	National  string `xml:"national,attr"`
+	LocalOnly string `xml:"localOnly,attr,omitempty"`
+}
+
+func newExportedTerritory(metadata *PhoneMetadata) exportedTerritory {
+	general := metadata.GetGeneralDesc()
+	territory := exportedTerritory{
+		ID:                           metadata.GetId(),
+		CountryCode:                  metadata.GetCountryCode(),
+		MainCountryForCode:           metadata.GetMainCountryForCode(),
+		LeadingDigits:                metadata.GetLeadingDigits(),
+		PreferredInternationalPrefix: metadata.GetPreferredInternationalPrefix(),
+		InternationalPrefix:          metadata.GetInternationalPrefix(),
+		NationalPrefix:               metadata.GetNationalPrefix(),
+		NationalPrefixForParsing:     metadata.GetNationalPrefixForParsing(),
+		NationalPrefixTransformRule:  metadata.GetNationalPrefixTransformRule(),
+		PreferredExtnPrefix:          metadata.GetPreferredExtnPrefix(),
+		MobileNumberPortableRegion:   metadata.GetMobileNumberPortableRegion(),
+		AvailableFormats:             newExportedNumberFormats(metadata),
+		NoInternationalDialling:      newExportedNumberDesc(metadata.GetNoInternationalDialling(), general),
+		FixedLine:                    newExportedNumberDesc(metadata.GetFixedLine(), general),
+		Mobile:                       newExportedNumberDesc(metadata.GetMobile(), general),
+		Pager:                        newExportedNumberDesc(metadata.GetPager(), general),
+		TollFree:                     newExportedNumberDesc(metadata.GetTollFree(), general),
+		PremiumRate:                  newExportedNumberDesc(metadata.GetPremiumRate(), general),
+		SharedCost:                   newExportedNumberDesc(metadata.GetSharedCost(), general),
+		PersonalNumber:               newExportedNumberDesc(metadata.GetPersonalNumber(), general),
+		Voip:                         newExportedNumberDesc(metadata.GetVoip(), general),
+		Uan:                          newExportedNumberDesc(metadata.GetUan(), general),
+		Voicemail:                    newExportedNumberDesc(metadata.GetVoicemail(), general),
+		StandardRate:                 newExportedNumberDesc(metadata.GetStandardRate(), general),
+		ShortCode:                    newExportedNumberDesc(metadata.GetShortCode(), general),
+		Emergency:                    newExportedNumberDesc(metadata.GetEmergency(), general),
+		CarrierSpecific:              newExportedNumberDesc(metadata.GetCarrierSpecific(), general),
+		SmsServices:                  newExportedNumberDesc(metadata.GetSmsServices(), general),
+	}
+
+	// possible lengths of the general description are worked out from those of the other types
+	if general != nil {
+		territory.GeneralDesc = &exportedNumberDesc{NationalNumberPattern: general.GetNationalNumberPattern()}
+	}
+	return territory
+}
+
+// newExportedNumberFormats returns the formats of the passed in metadata. International formats
+// are only kept when they differ from the national ones, and those which are missing have no
+// international format at all.
+func newExportedNumberFormats(metadata *PhoneMetadata) *exportedAvailableFormats {
+	if len(metadata.GetNumberFormat()) == 0 {
+		return nil
+	}
+
+	intlFormats := metadata.GetIntlNumberFormat()
+	formats := make([]exportedNumberFormat, 0, len(metadata.GetNumberFormat()))
+	i := 0
+
+	for _, f := range metadata.GetNumberFormat() {
+		format := exportedNumberFormat{
+			Pattern:                              f.GetPattern(),
+			NationalPrefixFormattingRule:         f.GetNationalPrefixFormattingRule(),
+			NationalPrefixOptionalWhenFormatting: f.NationalPrefixOptionalWhenFormatting,
+			CarrierCodeFormattingRule:            f.GetDomesticCarrierCodeFormattingRule(),
+			LeadingDigits:                        f.GetLeadingDigitsPattern(),
+			Format:                               f.GetFormat(),
+		}
+
+		if len(intlFormats) > 0 {
+			if i < len(intlFormats) && isSameFormatRule(intlFormats[i], f) {
+				if !proto.Equal(intlFormats[i], f) {
+					format.IntlFormat = intlFormats[i].GetFormat()
+				}
+				i++
+			} else {
+				format.IntlFormat = "NA"
+			}
+		}
+		formats = append(formats, format)
+	}
+	return &exportedAvailableFormats{NumberFormats: formats}
+}
+
+// isSameFormatRule returns whether the passed in formats apply to the same numbers
+func isSameFormatRule(a, b *NumberFormat) bool {
+	if a.GetPattern() != b.GetPattern() || len(a.GetLeadingDigitsPattern()) != len(b.GetLeadingDigitsPattern()) {
+		return false
+	}
+	for i, pattern := range a.GetLeadingDigitsPattern() {
+		if pattern != b.GetLeadingDigitsPattern()[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// newExportedNumberDesc returns the passed in description, or nil if there are no numbers of its
+// type. Possible lengths left out because they're the same as those of general are filled back in.
+func newExportedNumberDesc(desc *PhoneNumberDesc, general *PhoneNumberDesc) *exportedNumberDesc {
+	pattern := desc.GetNationalNumberPattern()
+	if pattern == "" || pattern == "NA" {
+		return nil
+	}
+
+	exported := &exportedNumberDesc{
+		ExampleNumber:         desc.GetExampleNumber(),
+		NationalNumberPattern: pattern,
+	}
+
+	lengths := desc.GetPossibleLength()
+	if len(lengths) == 0 {
+		lengths = general.GetPossibleLength()
+	}
+	if len(lengths) > 0 {
+		exported.PossibleLengths = &exportedPossibleLengths{
+			National:  formatPossibleLengths(lengths),
+			LocalOnly: formatPossibleLengths(desc.GetPossibleLengthLocalOnly()),
+		}
+	}
+	return exported
+}
+
+// formatPossibleLengths formats the passed in sorted lengths as libphonenumber does, with runs of
+// three or more written as ranges, e.g. "[4-6],8"
+func formatPossibleLengths(lengths []int32) string {
+	parts := make([]string, 0, len(lengths))
+	for i := 0; i < len(lengths); {
+		j := i
+		for j+1 < len(lengths) && lengths[j+1] == lengths[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			parts = append(parts, "["+strconv.Itoa(int(lengths[i]))+"-"+strconv.Itoa(int(lengths[j]))+"]")
+		} else {
+			for k := i; k <= j; k++ {
+				parts = append(parts, strconv.Itoa(int(lengths[k])))
+			}
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
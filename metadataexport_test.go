@@ -0,0 +1,63 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWriteMetadataXML(t *testing.T) {
+	collection, err := MetadataCollection()
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, WriteMetadataXML(out, collection))
+
+	exported := out.String()
+	assert.True(t, strings.HasPrefix(exported, `<?xml version="1.0" encoding="UTF-8"?>`+"\n<phoneNumberMetadata>"))
+	assert.Contains(t, exported, `<territory id="GB" countryCode="44" mainCountryForCode="true" internationalPrefix="00" nationalPrefix="0" nationalPrefixForParsing="0" mobileNumberPortableRegion="true">`)
+	assert.NotContains(t, exported, "<availableFormats></availableFormats>")
+
+	// building metadata from what we wrote gives us back what we started with
+	rebuilt, err := BuildPhoneMetadataCollection(out.Bytes(), false, false, false)
+	require.NoError(t, err)
+	require.Equal(t, len(collection.GetMetadata()), len(rebuilt.GetMetadata()))
+	for i, metadata := range collection.GetMetadata() {
+		assert.True(t, proto.Equal(metadata, rebuilt.GetMetadata()[i]), "metadata mismatch for %s", metadata.GetId())
+	}
+}
+
+func TestWriteMetadataJSON(t *testing.T) {
+	collection, err := MetadataCollection()
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, WriteMetadataJSON(out, collection))
+	assert.True(t, strings.HasPrefix(out.String(), "{\n  \"metadata\": [\n"))
+
+	decoded := &PhoneMetadataCollection{}
+	require.NoError(t, protojson.Unmarshal(out.Bytes(), decoded))
+	assert.True(t, proto.Equal(collection, decoded))
+}
+
+func TestFormatPossibleLengths(t *testing.T) {
+	tests := []struct {
+		lengths  []int32
+		expected string
+	}{
+		{[]int32{}, ""},
+		{[]int32{9}, "9"},
+		{[]int32{9, 10}, "9,10"},
+		{[]int32{4, 5, 6, 8}, "[4-6],8"},
+		{[]int32{3, 5, 6, 7, 8, 10, 11}, "3,[5-8],10,11"},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, formatPossibleLengths(tc.lengths), "lengths mismatch for %v", tc.lengths)
+	}
+}
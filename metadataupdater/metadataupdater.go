@@ -0,0 +1,264 @@
+// Package metadataupdater keeps the metadata used by the phonenumbers package up to date without
+// redeploying, by periodically fetching a signed metadata bundle from a URL and swapping it in.
+//
+// A bundle is an ed25519 signature followed by a version number and the gzipped protobuf encoding
+// of a PhoneMetadataCollection, and is created with Sign using the private key matching the public
+// key the updater is configured with. Each bundle must have a higher version than the last, e.g. the
+// time it was built, so that old bundles can't be replayed to roll back the metadata:
+//
+//	bundle, err := metadataupdater.Sign(collection, uint64(time.Now().Unix()), privateKey)
+//
+//	u := metadataupdater.New("https://example.com/metadata.bundle", publicKey)
+//	u.OnError = func(err error) { log.Printf("metadata update failed: %s", err) }
+//	u.Start()
+//	defer u.Stop()
+package metadataupdater
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultInterval is how often bundles are fetched if the updater's interval isn't set
+	DefaultInterval = 24 * time.Hour
+
+	// MaxBundleSize is the largest bundle we will download
+	MaxBundleSize = 16 * 1024 * 1024
+
+	// the size of the version number which follows the signature of a bundle
+	versionSize = 8
+)
+
+var (
+	ErrBundleTooShort   = errors.New("metadata bundle is too short to be signed")
+	ErrBundleTooLarge   = errors.New("metadata bundle is too large")
+	ErrInvalidSignature = errors.New("metadata bundle signature is invalid")
+	ErrStaleBundle      = errors.New("metadata bundle is not newer than the current one")
+)
+
+// Sign returns a bundle of the passed in metadata with the passed in version, signed with the passed
+// in private key
+func Sign(collection *phonenumbers.PhoneMetadataCollection, version uint64, privateKey ed25519.PrivateKey) ([]byte, error) {
+	encoded, err := proto.Marshal(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, versionSize)
+	binary.BigEndian.PutUint64(header, version)
+
+	payload := bytes.NewBuffer(header)
+	writer := gzip.NewWriter(payload)
+	if _, err := writer.Write(encoded); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	signature := ed25519.Sign(privateKey, payload.Bytes())
+	return append(signature, payload.Bytes()...), nil
+}
+
+// Open verifies the passed in bundle was signed with the private key matching the passed in
+// public key and returns the metadata it contains and its version
+func Open(bundle []byte, publicKey ed25519.PublicKey) (*phonenumbers.PhoneMetadataCollection, uint64, error) {
+	if len(bundle) < ed25519.SignatureSize+versionSize {
+		return nil, 0, ErrBundleTooShort
+	}
+	signature, payload := bundle[:ed25519.SignatureSize], bundle[ed25519.SignatureSize:]
+	if !ed25519.Verify(publicKey, payload, signature) {
+		return nil, 0, ErrInvalidSignature
+	}
+	version := binary.BigEndian.Uint64(payload[:versionSize])
+
+	reader, err := gzip.NewReader(bytes.NewReader(payload[versionSize:]))
+	if err != nil {
+		return nil, 0, fmt.Errorf("error decompressing metadata bundle: %w", err)
+	}
+	encoded, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		return nil, 0, fmt.Errorf("error decompressing metadata bundle: %w", err)
+	}
+
+	collection := &phonenumbers.PhoneMetadataCollection{}
+	if err := proto.Unmarshal(encoded, collection); err != nil {
+		return nil, 0, fmt.Errorf("error unmarshalling metadata bundle: %w", err)
+	}
+	return collection, version, nil
+}
+
+// Updater periodically fetches a signed metadata bundle and swaps it in with
+// phonenumbers.SwapMetadata. Its fields can be changed after New but not once it has been started.
+type Updater struct {
+	URL       string
+	PublicKey ed25519.PublicKey
+
+	// how often to fetch the bundle, defaults to DefaultInterval
+	Interval time.Duration
+
+	// the client used to fetch the bundle, defaults to http.DefaultClient
+	Client *http.Client
+
+	// bundles are only swapped in if their version is higher than this and than that of the last
+	// bundle swapped in, set it to the version of a bundle already in use, e.g. one saved to disk
+	MinVersion uint64
+
+	// called after new metadata has been swapped in, with the SHA-256 digest of its bundle
+	OnUpdate func(digest string)
+
+	// called when fetching, verifying or swapping in a bundle fails, the current metadata is kept
+	OnError func(err error)
+
+	// the ETag, digest and version of the last bundle we swapped in
+	mutex   sync.Mutex
+	etag    string
+	digest  string
+	version uint64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// New returns a new updater for bundles at the passed in URL signed with the private key matching
+// the passed in public key
+func New(url string, publicKey ed25519.PublicKey) *Updater {
+	return &Updater{URL: url, PublicKey: publicKey}
+}
+
+// Update fetches the bundle once and swaps it in if it has changed since the last update, returning
+// whether it did so. Bundles which aren't newer than the last one swapped in, or than MinVersion,
+// are rejected with ErrStaleBundle. Hooks aren't called, errors are returned instead.
+func (u *Updater) Update(ctx context.Context) (bool, error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.URL, nil)
+	if err != nil {
+		return false, err
+	}
+	if u.etag != "" {
+		request.Header.Set("If-None-Match", u.etag)
+	}
+
+	client := u.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return false, fmt.Errorf("error fetching metadata bundle: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotModified {
+		return false, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("error fetching metadata bundle: unexpected status %d", response.StatusCode)
+	}
+
+	bundle, err := io.ReadAll(io.LimitReader(response.Body, MaxBundleSize+1))
+	if err != nil {
+		return false, fmt.Errorf("error fetching metadata bundle: %w", err)
+	}
+	if len(bundle) > MaxBundleSize {
+		return false, ErrBundleTooLarge
+	}
+
+	digest := fmt.Sprintf("%x", sha256.Sum256(bundle))
+	if digest == u.digest {
+		u.etag = response.Header.Get("ETag")
+		return false, nil
+	}
+
+	collection, version, err := Open(bundle, u.PublicKey)
+	if err != nil {
+		return false, err
+	}
+	if version <= u.MinVersion || version <= u.version {
+		return false, ErrStaleBundle
+	}
+	if err := phonenumbers.SwapMetadata(collection); err != nil {
+		return false, fmt.Errorf("error swapping in metadata bundle: %w", err)
+	}
+
+	u.etag = response.Header.Get("ETag")
+	u.digest = digest
+	u.version = version
+	return true, nil
+}
+
+// Start fetches the bundle straight away and then at every interval in a new goroutine, until Stop
+// is called, calling the hooks with the result of each fetch
+func (u *Updater) Start() {
+	interval := u.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	u.stop = make(chan struct{})
+	u.done = make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-u.stop
+		cancel()
+	}()
+
+	go func() {
+		defer close(u.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			u.updateAndNotify(ctx)
+
+			select {
+			case <-ticker.C:
+			case <-u.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the updater, waiting for any fetch in progress to be cancelled
+func (u *Updater) Stop() {
+	close(u.stop)
+	<-u.done
+}
+
+func (u *Updater) updateAndNotify(ctx context.Context) {
+	updated, err := u.Update(ctx)
+	if err != nil {
+		if u.OnError != nil && ctx.Err() == nil {
+			u.OnError(err)
+		}
+	} else if updated && u.OnUpdate != nil {
+		u.OnUpdate(u.currentDigest())
+	}
+}
+
+func (u *Updater) currentDigest() string {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	return u.digest
+}
@@ -0,0 +1,195 @@
+package metadataupdater_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/metadataupdater"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// updatedCollection returns a copy of our metadata where Rwandan mobile numbers can also start with 74
+func updatedCollection(t *testing.T) *phonenumbers.PhoneMetadataCollection {
+	embedded, err := phonenumbers.MetadataCollection()
+	require.NoError(t, err)
+
+	updated := proto.Clone(embedded).(*phonenumbers.PhoneMetadataCollection)
+	for _, metadata := range updated.GetMetadata() {
+		if metadata.GetId() == "RW" {
+			metadata.GeneralDesc.NationalNumberPattern = proto.String("(?:74\\d|" + metadata.GetGeneralDesc().GetNationalNumberPattern() + ")\\d*")
+			metadata.Mobile.NationalNumberPattern = proto.String("74\\d{7}|" + metadata.GetMobile().GetNationalNumberPattern())
+		}
+	}
+	return updated
+}
+
+func TestSignAndOpen(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	collection := updatedCollection(t)
+	bundle, err := metadataupdater.Sign(collection, 1234, privateKey)
+	require.NoError(t, err)
+
+	opened, version, err := metadataupdater.Open(bundle, publicKey)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(collection, opened))
+	assert.Equal(t, uint64(1234), version)
+
+	_, _, err = metadataupdater.Open(bundle, otherKey)
+	assert.Equal(t, metadataupdater.ErrInvalidSignature, err)
+
+	// the version is signed too
+	tampered := append([]byte{}, bundle...)
+	tampered[ed25519.SignatureSize+7]++
+	_, _, err = metadataupdater.Open(tampered, publicKey)
+	assert.Equal(t, metadataupdater.ErrInvalidSignature, err)
+
+	bundle[len(bundle)-1]++
+	_, _, err = metadataupdater.Open(bundle, publicKey)
+	assert.Equal(t, metadataupdater.ErrInvalidSignature, err)
+
+	_, _, err = metadataupdater.Open([]byte("short"), publicKey)
+	assert.Equal(t, metadataupdater.ErrBundleTooShort, err)
+
+	_, _, err = metadataupdater.Open(bundle[:ed25519.SignatureSize+4], publicKey)
+	assert.Equal(t, metadataupdater.ErrBundleTooShort, err)
+}
+
+func TestUpdater(t *testing.T) {
+	defer phonenumbers.ResetMetadata()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	bundle, err := metadataupdater.Sign(updatedCollection(t), 1, privateKey)
+	require.NoError(t, err)
+
+	var mutex sync.Mutex
+	served := bundle
+	etag := `"v1"`
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write(served)
+	}))
+	defer server.Close()
+
+	num, err := phonenumbers.Parse("0748383383", "RW")
+	require.NoError(t, err)
+	assert.False(t, phonenumbers.IsValidNumber(num))
+
+	u := metadataupdater.New(server.URL, publicKey)
+
+	updated, err := u.Update(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.True(t, phonenumbers.IsValidNumber(num))
+
+	// nothing has changed so nothing is swapped in
+	updated, err = u.Update(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, updated)
+	assert.Equal(t, 2, requests)
+
+	// newer bundles are swapped in, but older ones can't be replayed over them
+	newer, err := metadataupdater.Sign(updatedCollection(t), 2, privateKey)
+	require.NoError(t, err)
+	mutex.Lock()
+	served, etag = newer, `"v2"`
+	mutex.Unlock()
+
+	updated, err = u.Update(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, updated)
+
+	mutex.Lock()
+	served, etag = bundle, `"v1"`
+	mutex.Unlock()
+
+	updated, err = u.Update(context.Background())
+	assert.Equal(t, metadataupdater.ErrStaleBundle, err)
+	assert.False(t, updated)
+
+	// as can't bundles older than the minimum version
+	u = metadataupdater.New(server.URL, publicKey)
+	u.MinVersion = 1
+	updated, err = u.Update(context.Background())
+	assert.Equal(t, metadataupdater.ErrStaleBundle, err)
+	assert.False(t, updated)
+
+	// bundles with invalid signatures are rejected and the current metadata kept
+	u = metadataupdater.New(server.URL, publicKey)
+	mutex.Lock()
+	served = append([]byte{}, bundle...)
+	served[0]++
+	mutex.Unlock()
+
+	updated, err = u.Update(context.Background())
+	assert.Equal(t, metadataupdater.ErrInvalidSignature, err)
+	assert.False(t, updated)
+	assert.True(t, phonenumbers.IsValidNumber(num))
+
+	// requests which fail return an error
+	u = metadataupdater.New(server.URL+"/missing\x7f", publicKey)
+	_, err = u.Update(context.Background())
+	assert.Error(t, err)
+
+	// started updaters call their hooks
+	require.NoError(t, phonenumbers.ResetMetadata())
+	mutex.Lock()
+	served = bundle
+	mutex.Unlock()
+
+	digests := make(chan string, 1)
+	u = metadataupdater.New(server.URL, publicKey)
+	u.Interval = time.Hour
+	u.OnUpdate = func(digest string) { digests <- digest }
+	u.Start()
+
+	select {
+	case digest := <-digests:
+		assert.Len(t, digest, 64)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "updater didn't call OnUpdate")
+	}
+	u.Stop()
+	assert.True(t, phonenumbers.IsValidNumber(num))
+
+	errs := make(chan error, 1)
+	mutex.Lock()
+	served = []byte("not a bundle")
+	mutex.Unlock()
+
+	u = metadataupdater.New(server.URL, publicKey)
+	u.OnError = func(err error) { errs <- err }
+	u.Start()
+
+	select {
+	case err := <-errs:
+		assert.Equal(t, metadataupdater.ErrBundleTooShort, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "updater didn't call OnError")
+	}
+	u.Stop()
+}
@@ -0,0 +1,140 @@
+package phonenumbers
+
+// RegionMetadata is a read-only view of the metadata for a region or non-geographical entity, for
+// tooling which would otherwise parse PhoneNumberMetadata.xml itself. It is a copy, so changing it
+// has no effect on the metadata used by this package.
+type RegionMetadata struct {
+	Region      string // the region code, or "001" for non-geographical entities
+	CountryCode int32
+
+	// whether this is the main region for its country code, and for the others, the leading digits
+	// which identify numbers as belonging to it, if that's all it takes
+	MainCountryForCode bool
+	LeadingDigits      string
+
+	InternationalPrefix          string
+	PreferredInternationalPrefix string
+	NationalPrefix               string
+	NationalPrefixForParsing     string
+	NationalPrefixTransformRule  string
+	PreferredExtnPrefix          string
+
+	MobileNumberPortable bool
+
+	// the description of every valid number, and of the numbers of each type which exists here
+	General NumberDescription
+	Types   map[PhoneNumberType]NumberDescription
+
+	// the formats used for national and international formatting, the latter being nil when they're
+	// the same as the national ones
+	NumberFormats     []NumberFormatRule
+	IntlNumberFormats []NumberFormatRule
+}
+
+// NumberDescription describes the numbers of one type in a region
+type NumberDescription struct {
+	NationalNumberPattern    string
+	PossibleLengths          []int32
+	PossibleLengthsLocalOnly []int32
+	ExampleNumber            string
+}
+
+// NumberFormatRule is one of the rules used to format numbers in a region
+type NumberFormatRule struct {
+	Pattern                              string
+	Format                               string
+	LeadingDigitsPatterns                []string
+	NationalPrefixFormattingRule         string
+	NationalPrefixOptionalWhenFormatting bool
+	DomesticCarrierCodeFormattingRule    string
+}
+
+// the types which can appear in RegionMetadata.Types
+var metadataViewTypes = []PhoneNumberType{
+	FIXED_LINE, MOBILE, TOLL_FREE, PREMIUM_RATE, SHARED_COST, VOIP, PERSONAL_NUMBER, PAGER, UAN, VOICEMAIL,
+}
+
+// GetRegionMetadata returns a read-only view of the metadata for the passed in region, or nil if
+// we have no metadata for it. Use GetNonGeoEntityMetadata for non-geographical entities.
+func GetRegionMetadata(regionCode string) *RegionMetadata {
+	metadata := getMetadataForRegion(regionCode)
+	if metadata == nil {
+		return nil
+	}
+	return newRegionMetadata(metadata)
+}
+
+// GetNonGeoEntityMetadata returns a read-only view of the metadata for the non-geographical entity
+// with the passed in country calling code, e.g. 800, or nil if there isn't one
+func GetNonGeoEntityMetadata(countryCallingCode int32) *RegionMetadata {
+	metadata := getMetadataForNonGeographicalRegion(countryCallingCode)
+	if metadata == nil {
+		return nil
+	}
+	return newRegionMetadata(metadata)
+}
+
+func newRegionMetadata(metadata *PhoneMetadata) *RegionMetadata {
+	general := newNumberDescription(metadata.GetGeneralDesc(), nil)
+
+	view := &RegionMetadata{
+		Region:                       metadata.GetId(),
+		CountryCode:                  metadata.GetCountryCode(),
+		MainCountryForCode:           metadata.GetMainCountryForCode(),
+		LeadingDigits:                metadata.GetLeadingDigits(),
+		InternationalPrefix:          metadata.GetInternationalPrefix(),
+		PreferredInternationalPrefix: metadata.GetPreferredInternationalPrefix(),
+		NationalPrefix:               metadata.GetNationalPrefix(),
+		NationalPrefixForParsing:     metadata.GetNationalPrefixForParsing(),
+		NationalPrefixTransformRule:  metadata.GetNationalPrefixTransformRule(),
+		PreferredExtnPrefix:          metadata.GetPreferredExtnPrefix(),
+		MobileNumberPortable:         metadata.GetMobileNumberPortableRegion(),
+		General:                      general,
+		Types:                        make(map[PhoneNumberType]NumberDescription),
+		NumberFormats:                newNumberFormatRules(metadata.GetNumberFormat()),
+		IntlNumberFormats:            newNumberFormatRules(metadata.GetIntlNumberFormat()),
+	}
+
+	for _, typ := range metadataViewTypes {
+		desc := getNumberDescByType(metadata, typ)
+		if desc == nil || desc.GetNationalNumberPattern() == "" || desc.GetNationalNumberPattern() == "NA" {
+			continue
+		}
+		view.Types[typ] = newNumberDescription(desc, &general)
+	}
+
+	return view
+}
+
+// newNumberDescription copies the passed in description, possible lengths which are left out because
+// they're the same as those of the general description being taken from general, if passed in
+func newNumberDescription(desc *PhoneNumberDesc, general *NumberDescription) NumberDescription {
+	d := NumberDescription{
+		NationalNumberPattern:    desc.GetNationalNumberPattern(),
+		PossibleLengths:          append([]int32(nil), desc.GetPossibleLength()...),
+		PossibleLengthsLocalOnly: append([]int32(nil), desc.GetPossibleLengthLocalOnly()...),
+		ExampleNumber:            desc.GetExampleNumber(),
+	}
+	if general != nil && len(d.PossibleLengths) == 0 {
+		d.PossibleLengths = append([]int32(nil), general.PossibleLengths...)
+	}
+	return d
+}
+
+func newNumberFormatRules(formats []*NumberFormat) []NumberFormatRule {
+	if len(formats) == 0 {
+		return nil
+	}
+	rules := make([]NumberFormatRule, len(formats))
+	for i, f := range formats {
+		rules[i] = NumberFormatRule{
+			Pattern:                              f.GetPattern(),
+			Format:                               f.GetFormat(),
+			LeadingDigitsPatterns:                append([]string(nil), f.GetLeadingDigitsPattern()...),
+			NationalPrefixFormattingRule:         f.GetNationalPrefixFormattingRule(),
+			NationalPrefixOptionalWhenFormatting: f.GetNationalPrefixOptionalWhenFormatting(),
+			DomesticCarrierCodeFormattingRule:    f.GetDomesticCarrierCodeFormattingRule(),
+		}
+	}
+	return rules
+}
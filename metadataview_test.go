@@ -0,0 +1,76 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRegionMetadata(t *testing.T) {
+	tests := []struct {
+		region             string
+		countryCode        int32
+		mainCountryForCode bool
+		nationalPrefix     string
+		generalLengths     []int32
+		mobileLengths      []int32
+		hasPager           bool
+	}{
+		{"US", 1, true, "1", []int32{10}, []int32{10}, false},
+		{"GB", 44, true, "0", []int32{7, 9, 10}, []int32{10}, true},
+		{"AR", 54, false, "0", []int32{10, 11}, []int32{10, 11}, false},
+		{"RW", 250, false, "0", []int32{8, 9}, []int32{9}, false},
+	}
+
+	for _, tc := range tests {
+		metadata := GetRegionMetadata(tc.region)
+		require.NotNil(t, metadata, "metadata missing for %s", tc.region)
+
+		assert.Equal(t, tc.region, metadata.Region, "region mismatch for %s", tc.region)
+		assert.Equal(t, tc.countryCode, metadata.CountryCode, "country code mismatch for %s", tc.region)
+		assert.Equal(t, tc.mainCountryForCode, metadata.MainCountryForCode, "main country mismatch for %s", tc.region)
+		assert.Equal(t, tc.nationalPrefix, metadata.NationalPrefix, "national prefix mismatch for %s", tc.region)
+		assert.Equal(t, tc.generalLengths, metadata.General.PossibleLengths, "general lengths mismatch for %s", tc.region)
+		assert.Equal(t, tc.mobileLengths, metadata.Types[MOBILE].PossibleLengths, "mobile lengths mismatch for %s", tc.region)
+		_, hasPager := metadata.Types[PAGER]
+		assert.Equal(t, tc.hasPager, hasPager, "pager mismatch for %s", tc.region)
+		assert.NotEmpty(t, metadata.NumberFormats, "formats missing for %s", tc.region)
+
+		// example numbers of each type are valid numbers of that type
+		for typ, desc := range metadata.Types {
+			if desc.ExampleNumber == "" {
+				continue
+			}
+			number, err := Parse(desc.ExampleNumber, tc.region)
+			require.NoError(t, err)
+			assert.True(t, IsValidNumberForRegion(number, tc.region), "example number invalid for %s", tc.region)
+			if typ != FIXED_LINE && typ != MOBILE {
+				assert.Equal(t, typ, GetNumberType(number), "example number type mismatch for %s", tc.region)
+			}
+		}
+	}
+
+	assert.Nil(t, GetRegionMetadata("ZZ"))
+	assert.Nil(t, GetRegionMetadata("001"))
+
+	// the view is a copy, so changing it doesn't affect us
+	metadata := GetRegionMetadata("GB")
+	metadata.NumberFormats[0].Format = "$1-$2"
+	metadata.General.PossibleLengths[0] = 3
+	assert.Equal(t, "$1 $2", GetRegionMetadata("GB").NumberFormats[0].Format)
+	assert.Equal(t, int32(7), GetRegionMetadata("GB").General.PossibleLengths[0])
+}
+
+func TestGetNonGeoEntityMetadata(t *testing.T) {
+	metadata := GetNonGeoEntityMetadata(800)
+	require.NotNil(t, metadata)
+	assert.Equal(t, "001", metadata.Region)
+	assert.Equal(t, int32(800), metadata.CountryCode)
+	assert.Equal(t, "12345678", metadata.Types[TOLL_FREE].ExampleNumber)
+	assert.Equal(t, []int32{8}, metadata.Types[TOLL_FREE].PossibleLengths)
+	assert.Equal(t, "$1 $2", metadata.NumberFormats[0].Format)
+
+	assert.Nil(t, GetNonGeoEntityMetadata(44))
+	assert.Nil(t, GetNonGeoEntityMetadata(999))
+}
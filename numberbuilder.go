@@ -0,0 +1,84 @@
+package phonenumbers
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// NumberBuilder builds valid phone numbers from their parts, such as a country picked from a
+// dropdown and a number typed into a separate field, rather than from a single string:
+//
+//	num, err := phonenumbers.NewNumberBuilder().SetRegion("GB").SetNationalNumber("07911 123456").Build()
+//
+// The national number is parsed as it would be by Parse, so it can include punctuation and a
+// national prefix. Nothing is checked until Build is called.
+type NumberBuilder struct {
+	countryCode    int32
+	region         string
+	nationalNumber string
+	extension      string
+}
+
+// NewNumberBuilder returns a new empty number builder
+func NewNumberBuilder() *NumberBuilder {
+	return &NumberBuilder{}
+}
+
+// SetCountryCode sets the country calling code of the number, e.g. 44
+func (b *NumberBuilder) SetCountryCode(countryCode int32) *NumberBuilder {
+	b.countryCode = countryCode
+	return b
+}
+
+// SetRegion sets the region of the number, e.g. "GB", which is used to parse the national number.
+// If a country code is also set it must be the country code of this region.
+func (b *NumberBuilder) SetRegion(region string) *NumberBuilder {
+	b.region = region
+	return b
+}
+
+// SetNationalNumber sets the national number as it was entered, e.g. "07911 123456"
+func (b *NumberBuilder) SetNationalNumber(nationalNumber string) *NumberBuilder {
+	b.nationalNumber = nationalNumber
+	return b
+}
+
+// SetExtension sets the extension of the number, replacing any included in the national number
+func (b *NumberBuilder) SetExtension(extension string) *NumberBuilder {
+	b.extension = extension
+	return b
+}
+
+// Build returns the number built from the parts set, or an error if there's no country code or
+// region, they don't agree, the national number can't be parsed, or the result isn't a valid number.
+func (b *NumberBuilder) Build() (*PhoneNumber, error) {
+	var number *PhoneNumber
+	var err error
+
+	if b.region != "" {
+		if b.countryCode != 0 && GetCountryCodeForRegion(b.region) != b.countryCode {
+			return nil, ErrInvalidCountryCode
+		}
+		number, err = Parse(b.nationalNumber, b.region)
+	} else if b.countryCode != 0 {
+		// the national prefix is still stripped from numbers parsed this way
+		number, err = Parse("+"+strconv.Itoa(int(b.countryCode))+" "+b.nationalNumber, UNKNOWN_REGION)
+	} else {
+		return nil, ErrInvalidCountryCode
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if b.countryCode != 0 && number.GetCountryCode() != b.countryCode {
+		return nil, ErrInvalidCountryCode
+	}
+	if b.extension != "" {
+		number.Extension = proto.String(b.extension)
+	}
+	if !IsValidNumber(number) {
+		return nil, ErrInvalidNumber
+	}
+	return number, nil
+}
@@ -0,0 +1,52 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberBuilder(t *testing.T) {
+	tests := []struct {
+		countryCode    int32
+		region         string
+		nationalNumber string
+		extension      string
+		expected       string
+		err            error
+	}{
+		{region: "US", nationalNumber: "(650) 253-0000", expected: "+16502530000"},
+		{countryCode: 1, nationalNumber: "650 253 0000", expected: "+16502530000"},
+		{countryCode: 1, region: "US", nationalNumber: "6502530000", expected: "+16502530000"},
+		{countryCode: 44, nationalNumber: "07911 123456", expected: "+447911123456"},
+		{region: "GB", nationalNumber: "07911 123456", expected: "+447911123456"},
+		{countryCode: 800, nationalNumber: "1234 5678", expected: "+80012345678"},
+		{region: "US", nationalNumber: "6502530000", extension: "123", expected: "+16502530000;ext=123"},
+		{region: "US", nationalNumber: "6502530000 ext. 456", extension: "123", expected: "+16502530000;ext=123"},
+		{region: "US", nationalNumber: "6502530000 ext. 456", expected: "+16502530000;ext=456"},
+
+		{nationalNumber: "6502530000", err: ErrInvalidCountryCode},
+		{countryCode: 44, region: "US", nationalNumber: "6502530000", err: ErrInvalidCountryCode},
+		{countryCode: 1, region: "US", nationalNumber: "+44 7911 123456", err: ErrInvalidCountryCode},
+		{countryCode: 999, nationalNumber: "6502530000", err: ErrInvalidCountryCode},
+		{region: "US", nationalNumber: "", err: ErrNotANumber},
+		{region: "US", nationalNumber: "555 555 5555", err: ErrInvalidNumber},
+	}
+
+	for _, tc := range tests {
+		number, err := NewNumberBuilder().
+			SetCountryCode(tc.countryCode).
+			SetRegion(tc.region).
+			SetNationalNumber(tc.nationalNumber).
+			SetExtension(tc.extension).
+			Build()
+
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, "error mismatch for %+v", tc)
+			assert.Nil(t, number)
+		} else if assert.NoError(t, err, "unexpected error for %+v", tc) {
+			text, _ := number.MarshalText()
+			assert.Equal(t, tc.expected, string(text), "number mismatch for %+v", tc)
+		}
+	}
+}
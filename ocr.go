@@ -0,0 +1,175 @@
+package phonenumbers
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// the characters OCR and transcription most often mistake digits for, and the digits they stand in for
+var ocrConfusions = map[rune]rune{
+	'O': '0',
+	'o': '0',
+	'I': '1',
+	'l': '1',
+	'|': '1',
+	'S': '5',
+	's': '5',
+	'B': '8',
+}
+
+// OCRSubstitution is a correction made by ParseOCR, of the character From at the byte offset Offset
+// of its input to the digit To
+type OCRSubstitution struct {
+	Offset int
+	From   rune
+	To     rune
+}
+
+// ErrAmbiguousOCR is returned by ParseOCR when a number still contains a run of letters which look
+// like digits after correction, such as the OOOO of "020 7031 OOOO", which would otherwise be parsed
+// as a vanity number and give a different number to the one written
+var ErrAmbiguousOCR = errors.New("the phone number supplied contains letters which may be misread digits")
+
+// ParseOCR is like Parse for text from document scanning and transcription, which often has letters
+// in place of the digits they look like, i.e. O for 0, I, l and | for 1, S for 5 and B for 8. These
+// are corrected to digits before parsing, and the corrections made are returned whether or not parsing
+// succeeds, so callers can flag numbers for review.
+//
+// Only runs of letters made up entirely of these characters and touching a digit, directly or through
+// one of the punctuation characters used to group digits such as a dash, are corrected, e.g. the O and
+// l of "O2O 7O3l-OOOO" but not those of "Tel: 020 7031 3000" or "SOS 112", so vanity numbers such as
+// "1-800-FLOWERS" are parsed as they would be by Parse. Digits which were corrected count too, so the
+// OOOO above is corrected because it touches the l. If a run which can't be corrected is left within
+// the number, e.g. "020 7031 OOOO" where only a space separates it from a digit, ErrAmbiguousOCR is
+// returned rather than a vanity number. Parse is always the better choice for numbers typed by people.
+func ParseOCR(numberToParse, defaultRegion string) (*PhoneNumber, []OCRSubstitution, error) {
+	corrected, substitutions := correctOCRConfusions(numberToParse)
+	if hasConfusableOCRRun(extractPossibleNumber(corrected)) {
+		return nil, substitutions, ErrAmbiguousOCR
+	}
+	number, err := Parse(corrected, defaultRegion)
+	return number, substitutions, err
+}
+
+// correctOCRConfusions returns the passed in text with runs of confusable characters which touch a
+// digit replaced by the digits they stand in for, along with the substitutions made. As correcting a
+// run can give a digit which another run touches, this is repeated until nothing more is corrected.
+// Every confusable character is a single byte, as are the digits, so offsets don't change as we go.
+func correctOCRConfusions(text string) (string, []OCRSubstitution) {
+	var substitutions []OCRSubstitution
+	for {
+		corrected, made := correctOCRRuns(text)
+		if len(made) == 0 {
+			break
+		}
+		text = corrected
+		substitutions = append(substitutions, made...)
+	}
+
+	sort.Slice(substitutions, func(i, j int) bool { return substitutions[i].Offset < substitutions[j].Offset })
+	return text, substitutions
+}
+
+// correctOCRRuns makes one pass over the passed in text, correcting the runs of confusable characters
+// which touch a digit in it
+func correctOCRRuns(text string) (string, []OCRSubstitution) {
+	var substitutions []OCRSubstitution
+	var corrected strings.Builder
+	copied := 0 // how much of text has been written to corrected
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isOCRRunRune(r) {
+			i += size
+			continue
+		}
+
+		end, confusable := endOfOCRRun(text, i)
+		if confusable && (digitBeforeOCRRun(text[:i]) || digitAfterOCRRun(text[end:])) {
+			corrected.WriteString(text[copied:i])
+			for offset, r := range text[i:end] {
+				digit := ocrConfusions[r]
+				substitutions = append(substitutions, OCRSubstitution{Offset: i + offset, From: r, To: digit})
+				corrected.WriteRune(digit)
+			}
+			copied = end
+		}
+		i = end
+	}
+
+	if substitutions == nil {
+		return text, nil
+	}
+	corrected.WriteString(text[copied:])
+	return corrected.String(), substitutions
+}
+
+// endOfOCRRun returns the end of the run of letters starting at start, and whether it's made up only
+// of confusable characters
+func endOfOCRRun(text string, start int) (int, bool) {
+	end := start
+	confusable := true
+	for end < len(text) {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		if !isOCRRunRune(r) {
+			break
+		}
+		if _, found := ocrConfusions[r]; !found {
+			confusable = false
+		}
+		end += size
+	}
+	return end, confusable
+}
+
+// hasConfusableOCRRun returns whether the passed in text contains a run of letters made up only of
+// confusable characters
+func hasConfusableOCRRun(text string) bool {
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isOCRRunRune(r) {
+			i += size
+			continue
+		}
+
+		end, confusable := endOfOCRRun(text, i)
+		if confusable {
+			return true
+		}
+		i = end
+	}
+	return false
+}
+
+// the punctuation used to group digits, which may come between a run of letters and the digit it touches.
+// Spaces don't count, as they are as likely to separate a word from a number.
+const ocrGroupingChars = "-./()"
+
+// digitBeforeOCRRun returns whether the passed in text before a run of letters ends with a digit, or
+// with a digit followed by one of ocrGroupingChars
+func digitBeforeOCRRun(text string) bool {
+	r, size := utf8.DecodeLastRuneInString(text)
+	if strings.ContainsRune(ocrGroupingChars, r) {
+		r, _ = utf8.DecodeLastRuneInString(text[:len(text)-size])
+	}
+	return unicode.IsDigit(r)
+}
+
+// digitAfterOCRRun returns whether the passed in text after a run of letters starts with a digit, or
+// with one of ocrGroupingChars followed by a digit
+func digitAfterOCRRun(text string) bool {
+	r, size := utf8.DecodeRuneInString(text)
+	if strings.ContainsRune(ocrGroupingChars, r) {
+		r, _ = utf8.DecodeRuneInString(text[size:])
+	}
+	return unicode.IsDigit(r)
+}
+
+// isOCRRunRune returns whether the passed in rune can be part of a run of letters which might be
+// corrected, which apart from letters includes the | often read in place of a 1
+func isOCRRunRune(r rune) bool {
+	return unicode.IsLetter(r) || r == '|'
+}
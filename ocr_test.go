@@ -0,0 +1,57 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOCR(t *testing.T) {
+	tests := []struct {
+		input         string
+		region        string
+		expected      string
+		substitutions []OCRSubstitution
+		err           error
+	}{
+		{"020 7031 3000", "GB", "+442070313000", nil, nil},
+		{"O2O 7O3l 3OOO", "GB", "+442070313000", []OCRSubstitution{
+			{0, 'O', '0'}, {2, 'O', '0'}, {5, 'O', '0'}, {7, 'l', '1'}, {10, 'O', '0'}, {11, 'O', '0'}, {12, 'O', '0'},
+		}, nil},
+		{"Tel: (65O) 253-OOOO", "US", "+16502530000", []OCRSubstitution{
+			{8, 'O', '0'}, {15, 'O', '0'}, {16, 'O', '0'}, {17, 'O', '0'}, {18, 'O', '0'},
+		}, nil},
+		{"+44 2O8 366 ||77", "", "+442083661177", []OCRSubstitution{{5, 'O', '0'}, {12, '|', '1'}, {13, '|', '1'}}, nil},
+		{"O78B 3B3 3S3", "RW", "+250788383353", []OCRSubstitution{
+			{0, 'O', '0'}, {3, 'B', '8'}, {6, 'B', '8'}, {10, 'S', '5'},
+		}, nil},
+		{"１２O", "US", "+1120", []OCRSubstitution{{6, 'O', '0'}}, nil}, // digits of any script count
+
+		// vanity numbers and words aren't touched
+		{"1-800-FLOWERS", "US", "+18003569377", nil, nil},
+		{"SOS 112", "FR", "+33112", nil, nil},
+
+		// runs touching corrected digits are corrected too
+		{"O2O 7O3l-OOOO", "GB", "+442070310000", []OCRSubstitution{
+			{0, 'O', '0'}, {2, 'O', '0'}, {5, 'O', '0'}, {7, 'l', '1'}, {9, 'O', '0'}, {10, 'O', '0'}, {11, 'O', '0'}, {12, 'O', '0'},
+		}, nil},
+
+		// but runs which can't be corrected aren't parsed as vanity numbers
+		{"2O7O 3l3 OOOO", "GB", "", []OCRSubstitution{{1, 'O', '0'}, {3, 'O', '0'}, {6, 'l', '1'}}, ErrAmbiguousOCR}, // spaces don't count
+		{"020 7031 3OOO", "GB", "+442070313000", []OCRSubstitution{{10, 'O', '0'}, {11, 'O', '0'}, {12, 'O', '0'}}, nil},
+		{"Office: 020 7031 3000", "GB", "+442070313000", nil, nil},
+		{"OOO", "GB", "", nil, ErrNotANumber},
+	}
+
+	for _, tc := range tests {
+		number, substitutions, err := ParseOCR(tc.input, tc.region)
+		assert.Equal(t, tc.substitutions, substitutions, "substitutions mismatch for %s", tc.input)
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, "error mismatch for %s", tc.input)
+			continue
+		}
+		if assert.NoError(t, err, "unexpected error for %s", tc.input) {
+			assert.Equal(t, tc.expected, Format(number, E164), "number mismatch for %s", tc.input)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package phonenumbers
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// parseCacheShards is the number of shards our parse cache is split into, must be a power of two
+const parseCacheShards = 16
+
+// the estimated memory used by each entry on top of its strings and number, for its list element,
+// map entry and the entry itself
+const parseCacheEntryOverhead = 160
+
+// ParseCacheOptions controls the cache of parse results enabled with SetParseCache
+type ParseCacheOptions struct {
+	// MaxEntries is how many results are kept, the least recently used being dropped once there
+	// are more, zero disabling the cache
+	MaxEntries int
+}
+
+// ParseCacheStats describes how well the cache enabled with SetParseCache is doing
+type ParseCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+
+	// Entries is the number of results currently cached, and Bytes an estimate of the memory
+	// they use
+	Entries int
+	Bytes   int64
+}
+
+// HitRate returns the fraction of lookups which were answered from the cache, or zero if there
+// haven't been any
+func (s ParseCacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// the cache set with SetParseCache, as a *parseCache
+var parseCacheValue atomic.Value
+
+// SetParseCache enables a process wide cache of the results of Parse, ParseInto,
+// ParseAndKeepRawInput and their variants, keyed on the input and region, for workloads which see
+// the same numbers over and over. Failures are cached too, and hooks set with SetHooks are still
+// called for them. Results are never shared, each caller gets its own copy of the number.
+//
+// Memory is bounded by MaxEntries, as inputs longer than the limit set with SetInputLimits are
+// never parsed, and so never cached. Results parsed with metadata which has since been replaced,
+// e.g. with SwapMetadata, are never returned. Calling this again replaces the cache, and its stats,
+// with an empty one, and passing zero MaxEntries disables it.
+func SetParseCache(opts ParseCacheOptions) {
+	if opts.MaxEntries <= 0 {
+		parseCacheValue.Store((*parseCache)(nil))
+		return
+	}
+	parseCacheValue.Store(newParseCache(opts.MaxEntries))
+}
+
+// GetParseCacheStats returns the stats of the cache enabled with SetParseCache, which are all zero
+// if it isn't enabled
+func GetParseCacheStats() ParseCacheStats {
+	cache, _ := parseCacheValue.Load().(*parseCache)
+	if cache == nil {
+		return ParseCacheStats{}
+	}
+	return cache.stats()
+}
+
+type parseCacheKey struct {
+	input        string
+	region       string
+	keepRawInput bool
+}
+
+type parseCacheEntry struct {
+	key    parseCacheKey
+	number *PhoneNumber // nil if parsing failed
+	err    error
+	tables *metadataTables // the metadata the number was parsed with
+	bytes  int64
+}
+
+// parseCacheShard is one shard of our cache, each of which is an LRU list of its own
+type parseCacheShard struct {
+	mutex   sync.Mutex
+	entries map[parseCacheKey]*list.Element
+	lru     *list.List // of *parseCacheEntry, most recently used first
+	bytes   int64
+}
+
+type parseCache struct {
+	// first so they are 64 bit aligned on 32 bit platforms
+	hits      uint64
+	misses    uint64
+	evictions uint64
+
+	maxPerShard int
+	shards      [parseCacheShards]parseCacheShard
+}
+
+func newParseCache(maxEntries int) *parseCache {
+	c := &parseCache{maxPerShard: (maxEntries + parseCacheShards - 1) / parseCacheShards}
+	for i := range c.shards {
+		c.shards[i].entries = make(map[parseCacheKey]*list.Element)
+		c.shards[i].lru = list.New()
+	}
+	return c
+}
+
+// shardFor returns the shard the passed in key lives in, using FNV-1a to spread keys
+func (c *parseCache) shardFor(key parseCacheKey) *parseCacheShard {
+	hash := uint32(2166136261)
+	for i := 0; i < len(key.input); i++ {
+		hash ^= uint32(key.input[i])
+		hash *= 16777619
+	}
+	for i := 0; i < len(key.region); i++ {
+		hash ^= uint32(key.region[i])
+		hash *= 16777619
+	}
+	return &c.shards[hash&(parseCacheShards-1)]
+}
+
+// get copies the cached result for the passed in key into number, returning whether there was one
+// and the error parsing returned
+func (c *parseCache) get(key parseCacheKey, tables *metadataTables, number *PhoneNumber) (bool, error) {
+	shard := c.shardFor(key)
+	shard.mutex.Lock()
+
+	element, found := shard.entries[key]
+	if !found || element.Value.(*parseCacheEntry).tables != tables {
+		shard.mutex.Unlock()
+		atomic.AddUint64(&c.misses, 1)
+		return false, nil
+	}
+	shard.lru.MoveToFront(element)
+	entry := element.Value.(*parseCacheEntry)
+	shard.mutex.Unlock()
+
+	// entries are never changed once added, so can be copied from outside the lock
+	number.Reset()
+	if entry.number != nil {
+		proto.Merge(number, entry.number)
+	}
+	atomic.AddUint64(&c.hits, 1)
+	return true, entry.err
+}
+
+// put caches the result of parsing the passed in key, which was parsed into number
+func (c *parseCache) put(key parseCacheKey, tables *metadataTables, number *PhoneNumber, err error) {
+	// the input may be a slice of something much bigger, so keep our own copy
+	key.input = string([]byte(key.input))
+
+	entry := &parseCacheEntry{key: key, err: err, tables: tables}
+	entry.bytes = parseCacheEntryOverhead + int64(len(key.input)+len(key.region))
+	if err == nil {
+		entry.number = proto.Clone(number).(*PhoneNumber)
+		entry.bytes += messageBytes(entry.number.ProtoReflect())
+	}
+
+	shard := c.shardFor(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	// replace any existing entry, which will have been parsed with different metadata
+	if existing, found := shard.entries[key]; found {
+		shard.bytes -= existing.Value.(*parseCacheEntry).bytes
+		shard.lru.Remove(existing)
+	}
+	shard.entries[key] = shard.lru.PushFront(entry)
+	shard.bytes += entry.bytes
+
+	for shard.lru.Len() > c.maxPerShard {
+		oldest := shard.lru.Back()
+		evicted := shard.lru.Remove(oldest).(*parseCacheEntry)
+		delete(shard.entries, evicted.key)
+		shard.bytes -= evicted.bytes
+		atomic.AddUint64(&c.evictions, 1)
+	}
+}
+
+func (c *parseCache) stats() ParseCacheStats {
+	stats := ParseCacheStats{
+		Hits:      atomic.LoadUint64(&c.hits),
+		Misses:    atomic.LoadUint64(&c.misses),
+		Evictions: atomic.LoadUint64(&c.evictions),
+	}
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mutex.Lock()
+		stats.Entries += shard.lru.Len()
+		stats.Bytes += shard.bytes
+		shard.mutex.Unlock()
+	}
+	return stats
+}
+
+// parseWithCache parses a number for one of our public parse functions, using the cache set with
+// SetParseCache if there is one
+func parseWithCache(numberToParse, region string, keepRawInput bool, number *PhoneNumber) error {
+	cache, _ := parseCacheValue.Load().(*parseCache)
+
+	// inputs which are too long are rejected without being parsed, so there's nothing to cache
+	if cache == nil || len(numberToParse) > GetInputLimits().MaxParseLength {
+		return parseHelper(numberToParse, region, keepRawInput, true, number)
+	}
+
+	key := parseCacheKey{input: numberToParse, region: region, keepRawInput: keepRawInput}
+	tables := currentMetadata()
+	if found, err := cache.get(key, tables, number); found {
+		return err
+	}
+
+	err := parseHelper(numberToParse, region, keepRawInput, true, number)
+	cache.put(key, tables, number, err)
+	return err
+}
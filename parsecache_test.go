@@ -0,0 +1,98 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseCache(t *testing.T) {
+	SetParseCache(ParseCacheOptions{MaxEntries: 32})
+	defer SetParseCache(ParseCacheOptions{})
+
+	var failures int
+	SetHooks(Hooks{ParseFailed: func(err error, region string) { failures++ }})
+	defer SetHooks(Hooks{})
+
+	tests := []struct {
+		input  string
+		region string
+		e164   string
+		err    error
+		hits   uint64
+		misses uint64
+	}{
+		{"6502530000", "US", "+16502530000", nil, 0, 1},
+		{"6502530000", "US", "+16502530000", nil, 1, 1},
+		{"6502530000", "GB", "+446502530000", nil, 1, 2}, // region is part of the key
+		{"hello", "US", "", ErrNotANumber, 1, 3},
+		{"hello", "US", "", ErrNotANumber, 2, 3}, // so are failures
+	}
+	for _, tc := range tests {
+		number, err := Parse(tc.input, tc.region)
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, "error mismatch for %s", tc.input)
+		} else {
+			assert.NoError(t, err, "unexpected error for %s", tc.input)
+			assert.Equal(t, tc.e164, Format(number, E164), "e164 mismatch for %s", tc.input)
+		}
+		stats := GetParseCacheStats()
+		assert.Equal(t, tc.hits, stats.Hits, "hits mismatch for %s", tc.input)
+		assert.Equal(t, tc.misses, stats.Misses, "misses mismatch for %s", tc.input)
+	}
+	assert.Equal(t, 2, failures, "hooks are still called for cached failures")
+	assert.Equal(t, 3, GetParseCacheStats().Entries)
+	assert.Equal(t, 0.4, GetParseCacheStats().HitRate())
+
+	// callers get their own copy which they are free to change
+	number, err := Parse("6502530000", "US")
+	require.NoError(t, err)
+	number.NationalNumber = 1234
+	number, err = Parse("6502530000", "US")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6502530000), number.GetNationalNumber())
+
+	// keeping raw input is cached separately, and numbers parsed into are reset first
+	number = &PhoneNumber{Extension: proto.String("123")}
+	require.NoError(t, ParseAndKeepRawInputToNumber("6502530000", "US", number))
+	assert.Equal(t, "6502530000", number.GetRawInput())
+	require.NoError(t, ParseInto("6502530000", "US", number))
+	assert.Equal(t, "", number.GetRawInput())
+	assert.Equal(t, "", number.GetExtension())
+
+	// results parsed with replaced metadata aren't used
+	before := GetParseCacheStats()
+	require.NoError(t, ResetMetadata())
+	_, err = Parse("6502530000", "US")
+	require.NoError(t, err)
+	assert.Equal(t, before.Misses+1, GetParseCacheStats().Misses)
+	assert.Equal(t, before.Entries, GetParseCacheStats().Entries)
+
+	// we never keep more than our limit
+	for i := 0; i < 200; i++ {
+		Parse(fmt.Sprintf("650253%04d", i), "US")
+	}
+	stats := GetParseCacheStats()
+	assert.LessOrEqual(t, stats.Entries, 32)
+	assert.Greater(t, stats.Evictions, uint64(0))
+	assert.Greater(t, stats.Bytes, int64(0))
+
+	// disabling the cache drops everything
+	SetParseCache(ParseCacheOptions{})
+	assert.Equal(t, ParseCacheStats{}, GetParseCacheStats())
+	assert.Equal(t, 0.0, GetParseCacheStats().HitRate())
+}
+
+func BenchmarkParseCache(b *testing.B) {
+	SetParseCache(ParseCacheOptions{MaxEntries: 1000})
+	defer SetParseCache(ParseCacheOptions{})
+
+	number := &PhoneNumber{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ParseInto("+1 650 253 0000", "US", number)
+	}
+}
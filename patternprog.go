@@ -0,0 +1,102 @@
+package phonenumbers
+
+import (
+	"regexp/syntax"
+)
+
+// parsePattern parses the passed in national number pattern into a simplified regular expression,
+// for the things which walk our patterns rather than matching numbers against them
+func parsePattern(pattern string) (*syntax.Regexp, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	return re.Simplify(), nil
+}
+
+// patternProg is the compiled program of a national number pattern, which can be run one digit at
+// a time to find out which numbers, or starts of numbers, can match the pattern. Our patterns are
+// matched against whole numbers so empty width assertions are always treated as satisfied.
+type patternProg struct {
+	prog *syntax.Prog
+}
+
+// compilePattern compiles the passed in national number pattern into a patternProg
+func compilePattern(pattern string) (*patternProg, error) {
+	re, err := parsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := syntax.Compile(re)
+	if err != nil {
+		return nil, err
+	}
+	return &patternProg{prog: prog}, nil
+}
+
+// start returns the states the program is in before consuming any digits
+func (p *patternProg) start() []uint32 {
+	return p.addState(nil, make([]bool, len(p.prog.Inst)), uint32(p.prog.Start))
+}
+
+// step returns the states we could be at after consuming the passed in digit from the passed in
+// states, which is empty if no number starting with the digits consumed so far can match
+func (p *patternProg) step(states []uint32, digit rune) []uint32 {
+	var next []uint32
+	added := make([]bool, len(p.prog.Inst))
+	for _, pc := range states {
+		inst := &p.prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			next = p.addState(next, added, inst.Out)
+		case syntax.InstRune, syntax.InstRune1:
+			if inst.MatchRune(digit) {
+				next = p.addState(next, added, inst.Out)
+			}
+		}
+	}
+	return next
+}
+
+// matches returns whether the passed in states include a match, i.e. the digits consumed to get to
+// them match the pattern
+func (p *patternProg) matches(states []uint32) bool {
+	for _, pc := range states {
+		if p.prog.Inst[pc].Op == syntax.InstMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// addState adds the instruction at pc to states, along with those which can be reached from it
+// without consuming a digit, returning the new states
+func (p *patternProg) addState(states []uint32, added []bool, pc uint32) []uint32 {
+	if added[pc] {
+		return states
+	}
+	added[pc] = true
+
+	inst := &p.prog.Inst[pc]
+	switch inst.Op {
+	case syntax.InstAlt, syntax.InstAltMatch:
+		states = p.addState(states, added, inst.Out)
+		states = p.addState(states, added, inst.Arg)
+	case syntax.InstCapture, syntax.InstNop, syntax.InstEmptyWidth:
+		states = p.addState(states, added, inst.Out)
+	case syntax.InstFail:
+	default:
+		states = append(states, pc)
+	}
+	return states
+}
+
+// isNumberOfType returns whether the passed in number is a valid number of the passed in type in
+// the passed in region, numbers which could be either FIXED_LINE or MOBILE counting as both
+func isNumberOfType(number *PhoneNumber, regionCode string, typ PhoneNumberType) bool {
+	if !IsValidNumberForRegion(number, regionCode) {
+		return false
+	}
+	actual := GetNumberType(number)
+	return actual == typ || (actual == FIXED_LINE_OR_MOBILE && (typ == FIXED_LINE || typ == MOBILE))
+}
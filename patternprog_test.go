@@ -0,0 +1,42 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatternProg(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		digits   string
+		possible bool
+		matches  bool
+	}{
+		{`1\d{2}`, "", true, false},
+		{`1\d{2}`, "12", true, false},
+		{`1\d{2}`, "123", true, true},
+		{`1\d{2}`, "1234", false, false},
+		{`1\d{2}`, "2", false, false},
+		{`7(?:0|[1-3]\d)`, "70", true, true},
+		{`7(?:0|[1-3]\d)`, "71", true, false},
+		{`7(?:0|[1-3]\d)`, "715", true, true},
+		{`7(?:0|[1-3]\d)`, "74", false, false},
+		{`^(?:5.)$`, "59", true, true},
+	}
+	for _, tc := range tests {
+		prog, err := compilePattern(tc.pattern)
+		require.NoError(t, err)
+
+		states := prog.start()
+		for _, digit := range tc.digits {
+			states = prog.step(states, digit)
+		}
+		assert.Equal(t, tc.possible, len(states) > 0, "possible mismatch for %s against %s", tc.digits, tc.pattern)
+		assert.Equal(t, tc.matches, prog.matches(states), "matches mismatch for %s against %s", tc.digits, tc.pattern)
+	}
+
+	_, err := compilePattern(`1(`)
+	assert.Error(t, err)
+}
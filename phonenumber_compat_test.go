@@ -0,0 +1,122 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// upstreamPhoneNumberDescriptor returns the descriptor of the PhoneNumber message as defined by
+// libphonenumber's phonenumber.proto, which is proto2 and in the i18n.phonenumbers package
+func upstreamPhoneNumberDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	required := descriptorpb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+	field := func(name string, number int32, label *descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{Name: proto.String(name), Number: proto.Int32(number), Label: label, Type: typ.Enum()}
+	}
+	enumValue := func(name string, number int32) *descriptorpb.EnumValueDescriptorProto {
+		return &descriptorpb.EnumValueDescriptorProto{Name: proto.String(name), Number: proto.Int32(number)}
+	}
+
+	numberOfLeadingZeros := field("number_of_leading_zeros", 8, optional, descriptorpb.FieldDescriptorProto_TYPE_INT32)
+	numberOfLeadingZeros.DefaultValue = proto.String("1")
+	countryCodeSource := field("country_code_source", 6, optional, descriptorpb.FieldDescriptorProto_TYPE_ENUM)
+	countryCodeSource.TypeName = proto.String(".i18n.phonenumbers.PhoneNumber.CountryCodeSource")
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("upstream/phonenumber.proto"),
+		Package: proto.String("i18n.phonenumbers"),
+		Syntax:  proto.String("proto2"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("PhoneNumber"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("country_code", 1, required, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+				field("national_number", 2, required, descriptorpb.FieldDescriptorProto_TYPE_UINT64),
+				field("extension", 3, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				field("italian_leading_zero", 4, optional, descriptorpb.FieldDescriptorProto_TYPE_BOOL),
+				numberOfLeadingZeros,
+				field("raw_input", 5, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				countryCodeSource,
+				field("preferred_domestic_carrier_code", 7, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			},
+			EnumType: []*descriptorpb.EnumDescriptorProto{{
+				Name: proto.String("CountryCodeSource"),
+				Value: []*descriptorpb.EnumValueDescriptorProto{
+					enumValue("UNSPECIFIED", 0),
+					enumValue("FROM_NUMBER_WITH_PLUS_SIGN", 1),
+					enumValue("FROM_NUMBER_WITH_IDD", 5),
+					enumValue("FROM_NUMBER_WITHOUT_PLUS_SIGN", 10),
+					enumValue("FROM_DEFAULT_COUNTRY", 20),
+				},
+			}},
+		}},
+	}
+
+	fd, err := protodesc.NewFile(file, nil)
+	require.NoError(t, err)
+	return fd.Messages().ByName("PhoneNumber")
+}
+
+func TestUpstreamWireCompatibility(t *testing.T) {
+	upstream := upstreamPhoneNumberDescriptor(t)
+	ours := (&PhoneNumber{}).ProtoReflect().Descriptor()
+
+	// every field has the same number, kind and cardinality
+	require.Equal(t, upstream.Fields().Len(), ours.Fields().Len())
+	for i := 0; i < upstream.Fields().Len(); i++ {
+		upstreamField := upstream.Fields().Get(i)
+		field := ours.Fields().ByName(upstreamField.Name())
+		require.NotNil(t, field, "missing field %s", upstreamField.Name())
+		assert.Equal(t, upstreamField.Number(), field.Number(), "number mismatch for %s", field.Name())
+		assert.Equal(t, upstreamField.Kind(), field.Kind(), "kind mismatch for %s", field.Name())
+		assert.Equal(t, upstreamField.Cardinality() == protoreflect.Repeated, field.Cardinality() == protoreflect.Repeated)
+	}
+
+	// and every enum value the same number
+	upstreamSources := upstream.Enums().ByName("CountryCodeSource").Values()
+	sources := ours.Enums().ByName("CountryCodeSource").Values()
+	require.Equal(t, upstreamSources.Len(), sources.Len())
+	for i := 0; i < upstreamSources.Len(); i++ {
+		assert.Equal(t, upstreamSources.Get(i).Number(), sources.ByName(upstreamSources.Get(i).Name()).Number())
+	}
+
+	var numbers []*PhoneNumber
+	for _, input := range []string{"+16502530000", "(650) 253-0000 ext. 123", "1-800-FLOWERS", "011 44 20 7031 3000"} {
+		number, err := ParseAndKeepRawInput(input, "US")
+		require.NoError(t, err)
+		numbers = append(numbers, number)
+	}
+	numbers = append(numbers,
+		&PhoneNumber{CountryCode: 39, NationalNumber: 236618300, ItalianLeadingZero: proto.Bool(true), NumberOfLeadingZeros: proto.Int32(2)},
+		&PhoneNumber{CountryCode: 57, NationalNumber: 6012345678, PreferredDomesticCarrierCode: proto.String("3")},
+	)
+
+	for _, number := range numbers {
+		// our encoding is read by upstream with every field intact, and is initialized, i.e. has its required fields
+		data, err := proto.Marshal(number)
+		require.NoError(t, err)
+		decoded := dynamicpb.NewMessage(upstream)
+		require.NoError(t, proto.Unmarshal(data, decoded), "upstream can't read %s", number)
+		assert.Equal(t, number.GetCountryCode(), int32(decoded.Get(upstream.Fields().ByName("country_code")).Int()))
+		assert.Equal(t, number.GetNationalNumber(), decoded.Get(upstream.Fields().ByName("national_number")).Uint())
+
+		// and upstream's encoding is read by us the same
+		upstreamData, err := proto.Marshal(decoded)
+		require.NoError(t, err)
+		roundTripped := &PhoneNumber{}
+		require.NoError(t, proto.Unmarshal(upstreamData, roundTripped))
+		assert.True(t, proto.Equal(number, roundTripped), "mismatch for %s, got %s", number, roundTripped)
+	}
+
+	// the one difference is that as proto3 we don't write zero country codes or national numbers,
+	// which upstream requires, so those numbers, which are never valid, can't be read by upstream
+	data, err := proto.Marshal(&PhoneNumber{CountryCode: 1})
+	require.NoError(t, err)
+	assert.Error(t, proto.Unmarshal(data, dynamicpb.NewMessage(upstream)))
+}
@@ -10,11 +10,14 @@ import (
 // A stateful class that finds and extracts telephone numbers fom text.
 //
 // Vanity numbers (phone numbers using alphabetic digits such as '1-800-SIX-FLAGS') are not found.
+//
+// A PhoneNumberMatcher is not safe for concurrent use.
 type PhoneNumberMatcher struct {
 	text            string
 	preferredRegion string
 	leniency        Leniency
 	maxTries        int
+	maxTextLength   int
 	state           int
 	lastMatch       *PhoneNumberMatch
 	searchIndex     int
@@ -40,7 +43,7 @@ var (
 	//   <li>No whitespace is allowed at the start or end.
 	//   <li>No alpha digits (vanity numbers such as 1-800-SIX-FLAGS) are currently supported.
 	// </ul>
-	PATTERN = regexp.MustCompile("(?i)(?:\\+){0,1}(?:" + LEAD_CLASS + PUNCTUATION + ")" + LEAD_LIMIT + DIGIT_SEQUENCE + "(?:" + PUNCTUATION + DIGIT_SEQUENCE + ")" + BLOCK_LIMIT + "(?:" + EXTN_PATTERNS_FOR_MATCHING + ")?")
+	PATTERN = regexp.MustCompile("(?i)(?:" + LEAD_CLASS + PUNCTUATION + ")" + LEAD_LIMIT + DIGIT_SEQUENCE + "(?:" + PUNCTUATION + DIGIT_SEQUENCE + ")" + BLOCK_LIMIT + "(?:" + EXTN_PATTERNS_FOR_MATCHING + ")?")
 
 	//  Matches strings that look like publication pages. Example:
 	//  <pre>Computing Complete Answers to Queries in the Presence of Limited Access Patterns.
@@ -92,8 +95,8 @@ var (
 	}
 
 	//  Punctuation that may be at the start of a phone number - brackets and plus signs.
-	LEAD_CLASS   = OPENING_PARENS + PLUS_CHARS
-	LEAD_PATTERN = regexp.MustCompile(LEAD_CLASS)
+	LEAD_CLASS   = "[" + OPENING_PARENS + PLUS_CHARS + "]"
+	LEAD_PATTERN = regexp.MustCompile("^" + LEAD_CLASS)
 
 	// Builds the MATCHING_BRACKETS and PATTERN regular expressions. The building blocks below exist to make the pattern more easily understood.
 	OPENING_PARENS = "\\(\\[\uFF08\uFF3B"
@@ -135,11 +138,13 @@ var (
 //	international dialing prefix of the specified region). May be
 //	"ZZ" if only numbers with a leading plus should be considered.
 func NewPhoneNumberMatcher(text string, region string) PhoneNumberMatcher {
+	limits := GetInputLimits()
 	m := PhoneNumberMatcher{
 		text:            text,
 		preferredRegion: region,
 		leniency:        Leniency(1),
-		maxTries:        65535,
+		maxTries:        limits.MaxMatchTries,
+		maxTextLength:   limits.MaxMatchTextLength,
 		state:           notReady,
 		lastMatch:       nil,
 		searchIndex:     0,
@@ -204,7 +209,9 @@ func (p *PhoneNumberMatcher) parseAndVerify(candidate string, offset int) (*Phon
 		}
 	}
 
-	number, err := ParseAndKeepRawInput(candidate, p.preferredRegion)
+	// most candidates aren't numbers, so parse without calling any hooks
+	number := &PhoneNumber{}
+	err := parseHelper(candidate, regionOrDefault(p.preferredRegion), true, true, number)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +222,9 @@ func (p *PhoneNumberMatcher) parseAndVerify(candidate string, offset int) (*Phon
 		//  TODO: stop clearing all values here and switch all users
 		//  over to using raw_input rather than the raw_string of
 		//  PhoneNumberMatch.
+		number.CountryCodeSource = nil
+		number.RawInput = nil
+		number.PreferredDomesticCarrierCode = nil
 		match := NewPhoneNumberMatch(offset, candidate, *number)
 
 		return &match, nil
@@ -354,8 +364,13 @@ func (p *PhoneNumberMatcher) hasNext() bool {
 	return p.state == ready
 }
 
-// Return the next match; raises Exception if no next match available
+// Return the next match; raises Exception if no next match available.
+// Returns ErrInputTooLong without searching if the text is longer than
+// the limit set with SetInputLimits.
 func (p *PhoneNumberMatcher) Next() (*PhoneNumberMatch, error) {
+	if p.maxTextLength > 0 && len(p.text) > p.maxTextLength {
+		return nil, ErrInputTooLong
+	}
 	if !p.hasNext() {
 		return nil, io.EOF
 	}
@@ -371,7 +386,7 @@ The immutable match of a phone number within a piece of text.
 
 Matches may be found using the find() method of PhoneNumberMatcher.
 
-A match consists of the phone number (in .number) as well as the .start and .end offsets of the corresponding subsequence of the searched text. Use .raw_string to obtain a copy of the matched subsequence.
+A match consists of the phone number (in .Number) as well as the Start() and End() offsets of the corresponding subsequence of the searched text. Use RawString() to obtain a copy of the matched subsequence.
 */
 type PhoneNumberMatch struct {
 	start, end int
@@ -387,3 +402,18 @@ func NewPhoneNumberMatch(start int, rawString string, number PhoneNumber) PhoneN
 		Number:    number,
 	}
 }
+
+// Start returns the byte offset of the match within the searched text
+func (m *PhoneNumberMatch) Start() int {
+	return m.start
+}
+
+// End returns the byte offset just after the match within the searched text
+func (m *PhoneNumberMatch) End() int {
+	return m.end
+}
+
+// RawString returns the matched subsequence of the searched text
+func (m *PhoneNumberMatch) RawString() string {
+	return m.rawString
+}
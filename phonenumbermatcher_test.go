@@ -0,0 +1,63 @@
+package phonenumbers
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneNumberMatcher(t *testing.T) {
+	text := "Call me on 650-253-0000 or at +44 20 7031 3000 tomorrow"
+	matcher := NewPhoneNumberMatcher(text, "US")
+
+	match, err := matcher.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "650-253-0000", match.RawString())
+	assert.Equal(t, 11, match.Start())
+	assert.Equal(t, 23, match.End())
+	assert.Equal(t, text[match.Start():match.End()], match.RawString())
+	assert.Equal(t, "+16502530000", Format(&match.Number, E164))
+	assert.Nil(t, match.Number.RawInput)
+	assert.Nil(t, match.Number.CountryCodeSource)
+
+	match, err = matcher.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "+44 20 7031 3000", match.RawString())
+	assert.Equal(t, 30, match.Start())
+	assert.Equal(t, "+442070313000", Format(&match.Number, E164))
+
+	_, err = matcher.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestPhoneNumberMatcherExtensions(t *testing.T) {
+	tests := []struct {
+		text      string
+		raw       string
+		extension string
+	}{
+		{"Call (510) 642-6000 ext. 123 now", "(510) 642-6000 ext. 123", "123"},
+		{"Call (510) 642-6000 x123 now", "(510) 642-6000 x123", "123"},
+		{"Call (510) 642-6000,123 now", "(510) 642-6000,123", "123"},
+		{"Call (510) 642-6000 #123 now", "(510) 642-6000 #123", "123"},
+		{"Support: 650-253-0001 extension 6789.", "650-253-0001 extension 6789", "6789"},
+		{"Call +44 20 7031 3000 ext. 1234 thanks", "+44 20 7031 3000 ext. 1234", "1234"},
+		{"Call (510) 642-6000, 123 people are waiting", "(510) 642-6000", ""},
+	}
+
+	for _, tc := range tests {
+		matcher := NewPhoneNumberMatcher(tc.text, "US")
+
+		match, err := matcher.Next()
+		require.NoError(t, err, "unexpected error for %s", tc.text)
+		assert.Equal(t, tc.raw, match.RawString(), "raw string mismatch for %s", tc.text)
+		assert.Equal(t, tc.text[match.Start():match.End()], match.RawString(), "offsets mismatch for %s", tc.text)
+		assert.Equal(t, tc.extension, match.Number.GetExtension(), "extension mismatch for %s", tc.text)
+
+		// and the extension isn't found as a number of its own
+		_, err = matcher.Next()
+		assert.Equal(t, io.EOF, err, "unexpected second match for %s", tc.text)
+	}
+}
@@ -1,6 +1,7 @@
 package phonenumbers
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
@@ -8,7 +9,9 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/language/display"
@@ -25,7 +28,7 @@ const (
 	MAX_LENGTH_COUNTRY_CODE = 3
 	// MAX_INPUT_STRING_LENGTH caps input strings for parsing at 250 chars.
 	// This prevents malicious input from overflowing the regular-expression
-	// engine.
+	// engine. It's the default for InputLimits.MaxParseLength.
 	MAX_INPUT_STRING_LENGTH = 250
 
 	// UNKNOWN_REGION is the region-code for the unknown region.
@@ -53,8 +56,10 @@ const (
 	// full stops, slashes, square brackets, parentheses and tildes. It
 	// also includes the letter 'x' as that is found as a placeholder
 	// for carrier information in some phone numbers. Full-width variants
-	// are also present.
-	VALID_PUNCTUATION = "-x\u2010-\u2015\u2212\u30FC\uFF0D-\uFF0F \u00A0\u00AD\u200B\u2060\u3000()\uFF08\uFF09\uFF3B\uFF3D.\\[\\]/~\u2053\u223C\uFF5E"
+	// are also present, as are invisible characters such as zero-width
+	// spaces and bidi controls, which are common in numbers copied from
+	// web pages and right-to-left documents.
+	VALID_PUNCTUATION = "-x\u2010-\u2015\u2212\u30FC\uFF0D-\uFF0F \u00A0\u00AD\u061C\u2000-\u200F\u202A-\u202F\u2060\u2066-\u2069\uFEFF\u3000()\uFF08\uFF09\uFF3B\uFF3D.\\[\\]/~\u2053\u223C\uFF5E"
 
 	DIGITS = "\\p{Nd}"
 
@@ -77,6 +82,16 @@ var (
 		54: "9",
 	}
 
+	// Set of country calling codes that have geographically assigned mobile
+	// numbers, i.e. mobile numbers which start with an area code like fixed
+	// line numbers do.
+	geoMobileCountries = map[int32]bool{
+		52: true, // Mexico
+		54: true, // Argentina
+		55: true, // Brazil
+		62: true, // Indonesia: some prefixes only (fixed CDMA wireless)
+	}
+
 	// A map that contains characters that are essential when dialling.
 	// That means any of the characters in this map must not be removed
 	// from a number when dialling, otherwise the call will not reach
@@ -353,8 +368,11 @@ var (
 	// seem to be an option with Android java, so we allow two options
 	// for representing the accented o - the character itself, and one in
 	// the unicode decomposed form with the combining acute accent.
-	EXTN_PATTERNS_FOR_PARSING  = RFC3966_EXTN_PREFIX + CAPTURING_EXTN_DIGITS + "|[ \u00A0\\t,]*(?:e?xt(?:ensi(?:o\u0301?|\u00F3))?n?|\uFF45?\uFF58\uFF54\uFF4E?|[;,x\uFF58#\uFF03~\uFF5E]|int|anexo|\uFF49\uFF4E\uFF54)[:\\.\uFF0E]?[ \u00A0\\t,-]*" + CAPTURING_EXTN_DIGITS + "#?|[- ]+(" + DIGITS + "{1,5})#"
-	EXTN_PATTERNS_FOR_MATCHING = RFC3966_EXTN_PREFIX + CAPTURING_EXTN_DIGITS + "|[ \u00A0\\t,]*(?:e?xt(?:ensi(?:o\u0301?|\u00F3))?n?|\uFF45?\uFF58\uFF54\uFF4E?|[x\uFF58#\uFF03~\uFF5E]|int|anexo|\uFF49\uFF4E\uFF54)[:\\.\uFF0E]?[ \u00A0\\t,-]*" + CAPTURING_EXTN_DIGITS + "#?|[- ]+(" + DIGITS + "{1,5})#"
+	EXTN_PATTERNS_FOR_PARSING = RFC3966_EXTN_PREFIX + CAPTURING_EXTN_DIGITS + "|[ \u00A0\\t,]*(?:e?xt(?:ensi(?:o\u0301?|\u00F3))?n?|\uFF45?\uFF58\uFF54\uFF4E?|[;,x\uFF58#\uFF03~\uFF5E]|int|anexo|\uFF49\uFF4E\uFF54)[:\\.\uFF0E]?[ \u00A0\\t,-]*" + CAPTURING_EXTN_DIGITS + "#?|[- ]+(" + DIGITS + "{1,5})#"
+	// When matching in text, a comma is only taken to start an extension
+	// if it's directly followed by digits, e.g. "(510) 642-6000,123", as
+	// in prose a comma followed by a space usually ends the number.
+	EXTN_PATTERNS_FOR_MATCHING = RFC3966_EXTN_PREFIX + CAPTURING_EXTN_DIGITS + "|[ \u00A0\\t,]*(?:e?xt(?:ensi(?:o\u0301?|\u00F3))?n?|\uFF45?\uFF58\uFF54\uFF4E?|[x\uFF58#\uFF03~\uFF5E]|int|anexo|\uFF49\uFF4E\uFF54)[:\\.\uFF0E]?[ \u00A0\\t,-]*" + CAPTURING_EXTN_DIGITS + "#?|[- ]+(" + DIGITS + "{1,5})#|," + CAPTURING_EXTN_DIGITS + "#?"
 
 	// Regexp of all known extension prefixes used by different regions
 	// followed by 1 or more valid digits, for use when parsing.
@@ -366,6 +384,12 @@ var (
 	VALID_PHONE_NUMBER_PATTERN = regexp.MustCompile(
 		"^(" + VALID_PHONE_NUMBER + "(?:" + EXTN_PATTERNS_FOR_PARSING + ")?)$")
 
+	// Regexp of characters which can't appear anywhere in a phone number, including its extension,
+	// used to point out why something isn't a number. Letters are allowed as extension prefixes are
+	// words, and alpha numbers are written with them.
+	INVALID_PHONE_NUMBER_CHAR_PATTERN = regexp.MustCompile(
+		"[^" + VALID_PUNCTUATION + PLUS_CHARS + "\\" + string(STAR_SIGN) + "\\p{L}\\p{Mn}\\p{N};,#\uFF03~\uFF5E:.\uFF0E=\t]")
+
 	NON_DIGITS_PATTERN = regexp.MustCompile(`(\D+)`)
 	DIGITS_PATTERN     = regexp.MustCompile(`(\d+)`)
 
@@ -383,7 +407,7 @@ var (
 	// formatting rule has the first group only, i.e., does not start
 	// with the national prefix. Note that the pattern explicitly allows
 	// for unbalanced parentheses.
-	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile(`\(?\$1\)?`)
+	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile(`^\(?\$1\)?$`)
 
 	REGION_CODE_FOR_NON_GEO_ENTITY = "001"
 )
@@ -491,13 +515,13 @@ func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
 	case POSSIBLE:
 		return IsPossibleNumber(number)
 	case VALID:
-		if !IsValidNumber(number) ||
+		if !isValidNumber(number) ||
 			!ContainsOnlyValidXChars(number, candidate) {
 			return false
 		}
 		return IsNationalPrefixPresentIfRequired(number)
 	case STRICT_GROUPING:
-		if !IsValidNumber(number) ||
+		if !isValidNumber(number) ||
 			!ContainsOnlyValidXChars(number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
 			!IsNationalPrefixPresentIfRequired(number) {
@@ -511,7 +535,7 @@ func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
 					number, normalizedCandidate, expectedNumberGroups)
 			})
 	case EXACT_GROUPING:
-		if !IsValidNumber(number) ||
+		if !isValidNumber(number) ||
 			!ContainsOnlyValidXChars(number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
 			!IsNationalPrefixPresentIfRequired(number) {
@@ -528,147 +552,196 @@ func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
 	return false
 }
 
-var (
-	// golang map is not go routine safe. Sometimes process exiting
-	// because of panic. So adding mutex to synchronize the operation.
-
+// metadataTables holds everything we derive from our metadata. It is never modified once built,
+// so that SwapMetadata can replace all of it at once while other goroutines are using it.
+type metadataTables struct {
 	// The set of regions that share country calling code 1.
 	// There are roughly 26 regions.
-	nanpaRegions = make(map[string]struct{})
-
-	// A mapping from a region code to the PhoneMetadata for that region.
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	regionToMetadataMap = make(map[string]*PhoneMetadata)
-
-	// A mapping from a country calling code for a non-geographical
-	// entity to the PhoneMetadata for that country calling code.
-	// Examples of the country calling codes include 800 (International
-	// Toll Free Service) and 808 (International Shared Cost Service).
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	countryCodeToNonGeographicalMetadataMap = make(map[int32]*PhoneMetadata)
-
-	// A cache for frequently used region-specific regular expressions.
-	// The initial capacity is set to 100 as this seems to be an optimal
-	// value for Android, based on performance measurements.
-	regexCache    = make(map[string]*regexp.Regexp)
-	regCacheMutex sync.RWMutex
+	nanpaRegions map[string]struct{}
+
+	// The PhoneMetadata for each region, indexed by regionIndex, which
+	// is only unmarshalled when the region is first used.
+	regionToMetadata [regionIndexSize]*lazyMetadata
+
+	// The PhoneMetadata for each country calling code for a non-geographical
+	// entity, indexed by the calling code. Examples of the country calling
+	// codes include 800 (International Toll Free Service) and 808
+	// (International Shared Cost Service).
+	countryCodeToNonGeographicalMetadata [countryCodeIndexSize]*lazyMetadata
 
 	// The set of regions the library supports.
 	// There are roughly 240 of them and we set the initial capacity of
 	// the HashSet to 320 to offer a load factor of roughly 0.75.
-	supportedRegions = make(map[string]bool, 320)
+	supportedRegions map[string]bool
+
+	// The same set indexed by regionIndex, for fast lookups
+	supportedRegionIndex [regionIndexSize]bool
 
 	// The set of calling codes that map to the non-geo entity
 	// region ("001"). This set currently contains < 12 elements so the
 	// default capacity of 16 (load factor=0.75) is fine.
-	countryCodesForNonGeographicalRegion = make(map[int32]bool, 16)
+	countryCodesForNonGeographicalRegion map[int32]bool
+
+	// All the calling codes we support
+	supportedCallingCodes map[int32]bool
 
-	// These are our onces and maps for our prefix to carrier maps
-	carrierOnces     = make(map[string]*sync.Once)
-	carrierPrefixMap = make(map[string]*intStringMap)
+	// Our two letter region codes for each country code, indexed by the
+	// country code, use regionCodesForCountryCode to read from it
+	countryCodeToRegion [countryCodeIndexSize][]string
+}
 
-	// These are our onces and maps for our prefix to geocoding maps
-	geocodingOnces     = make(map[string]*sync.Once)
-	geocodingPrefixMap = make(map[string]*intStringMap)
+// the metadataTables currently in use, a *metadataTables
+var activeMetadata atomic.Value
 
-	// All the calling codes we support
-	supportedCallingCodes = make(map[int32]bool, 320)
+// currentMetadata returns the metadataTables currently in use
+func currentMetadata() *metadataTables {
+	return activeMetadata.Load().(*metadataTables)
+}
 
-	// Our once and map for prefix to timezone lookups
-	timezoneOnce sync.Once
-	timezoneMap  *intStringArrayMap
+var (
+	// Our prefix to carrier maps by language, the data itself is registered by
+	// the carrierdata package and each is only decoded when first used
+	carrierMaps = make(map[string]*lazyPrefixMap)
 
-	// Our map from country code (as integer) to two letter region codes
-	countryCodeToRegion map[int32][]string
-)
+	// Our prefix to geocoding maps by language, the data itself is registered by
+	// the geocodingdata package and each is only decoded when first used
+	geocodingMaps = make(map[string]*lazyPrefixMap)
 
-var ErrEmptyMetadata = errors.New("empty metadata")
+	// Our map for prefix to timezone lookups, the data itself is registered
+	// by the timezonedata package
+	timezoneMap *lazyPrefixArrayMap
 
-func readFromRegexCache(key string) (*regexp.Regexp, bool) {
-	regCacheMutex.RLock()
-	v, ok := regexCache[key]
-	regCacheMutex.RUnlock()
-	return v, ok
-}
+	// Our map for prefix to MCC/MNC lookups, the data itself is registered
+	// by a package generated by buildmetadata
+	mccMncMap *lazyPrefixMap
+)
 
-func writeToRegexCache(key string, value *regexp.Regexp) {
-	regCacheMutex.Lock()
-	regexCache[key] = value
-	regCacheMutex.Unlock()
-}
+var ErrEmptyMetadata = errors.New("empty metadata")
 
-func regexFor(pattern string) *regexp.Regexp {
-	regex, found := readFromRegexCache(pattern)
-	if !found {
-		regex = regexp.MustCompile(pattern)
-		writeToRegexCache(pattern, regex)
-	}
-	return regex
-}
+var (
+	ErrCarrierDataNotLoaded   = errors.New("no carrier data loaded, import github.com/nyaruka/phonenumbers/carrierdata")
+	ErrGeocodingDataNotLoaded = errors.New("no geocoding data loaded, import github.com/nyaruka/phonenumbers/geocodingdata")
+	ErrTimezoneDataNotLoaded  = errors.New("no timezone data loaded, import github.com/nyaruka/phonenumbers/timezonedata")
+	ErrMccMncDataNotLoaded    = errors.New("no MCC/MNC data loaded, generate a package with buildmetadata -mccmnc-url and import it")
+)
 
 func readFromNanpaRegions(key string) (struct{}, bool) {
-	v, ok := nanpaRegions[key]
+	v, ok := currentMetadata().nanpaRegions[key]
 	return v, ok
 }
 
-func writeToNanpaRegions(key string, val struct{}) {
-	nanpaRegions[key] = val
-}
+const (
+	// Region codes are two upper case letters and country calling codes at
+	// most three digits, so rather than hashing them we index what we know
+	// about each directly.
+	regionIndexSize      = 26 * 26
+	countryCodeIndexSize = 1000
+)
 
-func readFromRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
-	v, ok := regionToMetadataMap[key]
-	return v, ok
+// regionIndex returns the index of the passed in region code in our region
+// indexes, or -1 if it isn't made up of two upper case letters.
+func regionIndex(regionCode string) int {
+	if len(regionCode) != 2 || regionCode[0] < 'A' || regionCode[0] > 'Z' || regionCode[1] < 'A' || regionCode[1] > 'Z' {
+		return -1
+	}
+	return int(regionCode[0]-'A')*26 + int(regionCode[1]-'A')
 }
 
-func writeToRegionToMetadataMap(key string, val *PhoneMetadata) {
-	regionToMetadataMap[key] = val
+// regionCodesForCountryCode returns the region codes for the passed in
+// country calling code, or nil if it isn't one we know.
+func regionCodesForCountryCode(countryCode int32) []string {
+	if countryCode < 0 || countryCode >= countryCodeIndexSize {
+		return nil
+	}
+	return currentMetadata().countryCodeToRegion[countryCode]
 }
 
-func readFromCountryCodeToNonGeographicalMetadataMap(key int32) (*PhoneMetadata, bool) {
-	v, ok := countryCodeToNonGeographicalMetadataMap[key]
-	return v, ok
+func readFromRegionToMetadata(key string) (*PhoneMetadata, bool) {
+	index := regionIndex(key)
+	if index < 0 {
+		return nil, false
+	}
+	metadata := currentMetadata().regionToMetadata[index]
+	if metadata == nil {
+		return nil, false
+	}
+	return metadata.get(), true
 }
 
-func writeToCountryCodeToNonGeographicalMetadataMap(key int32, v *PhoneMetadata) {
-	countryCodeToNonGeographicalMetadataMap[key] = v
+func readFromCountryCodeToNonGeographicalMetadata(key int32) (*PhoneMetadata, bool) {
+	if key < 0 || key >= countryCodeIndexSize {
+		return nil, false
+	}
+	metadata := currentMetadata().countryCodeToNonGeographicalMetadata[key]
+	if metadata == nil {
+		return nil, false
+	}
+	return metadata.get(), true
 }
 
-func loadMetadataFromFile(
-	regionCode string,
-	countryCallingCode int32) error {
+// loadMetadataTables builds the tables for the passed in map of country codes to regions and
+// metadata. If metadata is nil, our embedded metadata is used, split by region and leaving each
+// to be unmarshalled when first used.
+func loadMetadataTables(regionMap map[int32][]string, metadata *PhoneMetadataCollection) (*metadataTables, error) {
+	tables := newMetadataTables(regionMap)
+
+	// metadata passed in is already unmarshalled
+	if metadata != nil {
+		metadataList := metadata.GetMetadata()
+		if len(metadataList) == 0 {
+			return nil, ErrEmptyMetadata
+		}
+		for _, meta := range metadataList {
+			tables.addMetadata(meta.GetId(), meta.GetCountryCode(), newLoadedMetadata(meta))
+		}
+		return tables, nil
+	}
 
-	metadataCollection, err := MetadataCollection()
+	rawBytes, err := decodeUnzipString(metadataData)
 	if err != nil {
-		return err
-	} else if currMetadataColl == nil {
-		currMetadataColl = metadataCollection
+		return nil, err
 	}
 
-	metadataList := metadataCollection.GetMetadata()
-	if len(metadataList) == 0 {
-		return ErrEmptyMetadata
+	found := 0
+	err = splitMetadataCollection(rawBytes, func(id string, countryCode int32, encoded []byte) {
+		tables.addMetadata(id, countryCode, newLazyMetadata(encoded))
+		found++
+	})
+	if err != nil {
+		return nil, err
 	}
+	if found == 0 {
+		return nil, ErrEmptyMetadata
+	}
+	return tables, nil
+}
 
-	for _, meta := range metadataList {
-		region := meta.GetId()
-		if region == "001" {
-			// it's a non geographical entity
-			writeToCountryCodeToNonGeographicalMetadataMap(meta.GetCountryCode(), meta)
-		} else {
-			writeToRegionToMetadataMap(region, meta)
+// addMetadata adds the metadata for the passed in region to our tables
+func (t *metadataTables) addMetadata(region string, countryCode int32, metadata *lazyMetadata) {
+	if region == "001" {
+		// it's a non geographical entity
+		if countryCode >= 0 && countryCode < countryCodeIndexSize {
+			t.countryCodeToNonGeographicalMetadata[countryCode] = metadata
 		}
+	} else if index := regionIndex(region); index >= 0 {
+		// every region in the metadata has a two letter code, anything else
+		// couldn't be looked up anyway
+		t.regionToMetadata[index] = metadata
 	}
-	return nil
 }
 
 var (
 	currMetadataColl *PhoneMetadataCollection
 	reloadMetadata   = true
+
+	// guards the above when MetadataCollection is called concurrently with itself or SwapMetadata
+	metadataCollMutex sync.Mutex
 )
 
 func MetadataCollection() (*PhoneMetadataCollection, error) {
+	metadataCollMutex.Lock()
+	defer metadataCollMutex.Unlock()
+
 	if !reloadMetadata {
 		return currMetadataColl, nil
 	}
@@ -680,8 +753,101 @@ func MetadataCollection() (*PhoneMetadataCollection, error) {
 
 	var metadataCollection = &PhoneMetadataCollection{}
 	err = proto.Unmarshal(rawBytes, metadataCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	currMetadataColl = metadataCollection
 	reloadMetadata = false
-	return metadataCollection, err
+	return metadataCollection, nil
+}
+
+// LoadMetadataCollection replaces the metadata used by this package with the passed in
+// collection. This is mostly useful for tests which want to run against stable metadata,
+// such as that in the testmetadata package, rather than real world numbering plans which
+// change with every release. Use ResetMetadata to go back to the embedded metadata.
+//
+// It's the same as SwapMetadata and so is safe to call at any time.
+func LoadMetadataCollection(metadataCollection *PhoneMetadataCollection) error {
+	return SwapMetadata(metadataCollection)
+}
+
+// ResetMetadata restores the metadata embedded in this package after a call to
+// LoadMetadataCollection or SwapMetadata. It's safe to call at any time.
+func ResetMetadata() error {
+	regionMap, err := loadIntStringArrayMap(regionMapData)
+	if err != nil {
+		return err
+	}
+	tables, err := loadMetadataTables(regionMap.Map, nil)
+	if err != nil {
+		return err
+	}
+
+	useMetadata(tables, nil)
+	return nil
+}
+
+// useMetadata makes the passed in tables, built from the passed in collection or our embedded
+// metadata if that is nil, the ones used by this package
+func useMetadata(tables *metadataTables, metadataCollection *PhoneMetadataCollection) {
+	metadataCollMutex.Lock()
+	defer metadataCollMutex.Unlock()
+
+	currMetadataColl = metadataCollection
+	reloadMetadata = metadataCollection == nil
+	activeMetadata.Store(tables)
+	atomic.AddUint64(&lookupDataGeneration, 1)
+}
+
+// newMetadataTables returns new tables for our metadata, populating those that only depend on
+// the passed in map of country codes to regions
+func newMetadataTables(regionMap map[int32][]string) *metadataTables {
+	t := &metadataTables{
+		supportedRegions:                     make(map[string]bool, 320),
+		supportedCallingCodes:                make(map[int32]bool, 320),
+		countryCodesForNonGeographicalRegion: make(map[int32]bool, 16),
+		nanpaRegions:                         make(map[string]struct{}),
+	}
+
+	for eKey, regionCodes := range regionMap {
+		if eKey >= 0 && eKey < countryCodeIndexSize {
+			t.countryCodeToRegion[eKey] = regionCodes
+		}
+
+		// We can assume that if the county calling code maps to the
+		// non-geo entity region code then that's the only region code
+		// it maps to.
+		if len(regionCodes) == 1 && REGION_CODE_FOR_NON_GEO_ENTITY == regionCodes[0] {
+			// This is the subset of all country codes that map to the
+			// non-geo entity region code.
+			t.countryCodesForNonGeographicalRegion[eKey] = true
+		} else {
+			// The supported regions set does not include the "001"
+			// non-geo entity region code.
+			for _, val := range regionCodes {
+				t.supportedRegions[val] = true
+			}
+		}
+
+		t.supportedCallingCodes[eKey] = true
+	}
+	// If the non-geo entity still got added to the set of supported
+	// regions it must be because there are entries that list the non-geo
+	// entity alongside normal regions (which is wrong). If we discover
+	// this, remove the non-geo entity from the set of supported regions
+	// and log (or not log).
+	delete(t.supportedRegions, REGION_CODE_FOR_NON_GEO_ENTITY)
+	for region := range t.supportedRegions {
+		if index := regionIndex(region); index >= 0 {
+			t.supportedRegionIndex[index] = true
+		}
+	}
+
+	for _, val := range t.countryCodeToRegion[NANPA_COUNTRY_CODE] {
+		t.nanpaRegions[val] = struct{}{}
+	}
+	return t
 }
 
 // Attempts to extract a possible number from the string passed in.
@@ -695,22 +861,43 @@ func MetadataCollection() (*PhoneMetadataCollection, error) {
 // actually two phone numbers, (530) 583-6985 x302 and (530) 583-6985 x2303.
 // We remove the second extension so that the first number is parsed correctly.
 func extractPossibleNumber(number string) string {
-	if VALID_START_CHAR_PATTERN.MatchString(number) {
-		start := VALID_START_CHAR_PATTERN.FindIndex([]byte(number))[0]
-		number = number[start:]
-		// Remove trailing non-alpha non-numerical characters.
-		indices := UNWANTED_END_CHAR_PATTERN.FindIndex([]byte(number))
-		if len(indices) > 0 {
+	// most numbers already start with a digit or plus, in which case we can skip the regex
+	if len(number) == 0 || (number[0] != '+' && !isASCIIDigit(number[0])) {
+		indices := VALID_START_CHAR_PATTERN.FindStringIndex(number)
+		if len(indices) == 0 {
+			return ""
+		}
+		number = number[indices[0]:]
+	}
+
+	// Remove trailing non-alpha non-numerical characters. This pattern
+	// can only match text containing "&&" so we skip it when that's absent.
+	if strings.Contains(number, "&&") {
+		if indices := UNWANTED_END_CHAR_PATTERN.FindStringIndex(number); len(indices) > 0 {
 			number = number[0:indices[0]]
 		}
-		// Check for extra numbers at the end.
-		indices = SECOND_NUMBER_START_PATTERN.FindIndex([]byte(number))
-		if len(indices) > 0 {
+	}
+	// Check for extra numbers at the end, which can only start with a slash.
+	if strings.ContainsAny(number, "\\/") {
+		if indices := SECOND_NUMBER_START_PATTERN.FindStringIndex(number); len(indices) > 0 {
 			number = number[0:indices[0]]
 		}
-		return number
 	}
-	return ""
+	return number
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isASCII returns whether the passed in string is made up only of ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
 }
 
 // Checks to see if the string of characters could possibly be a phone
@@ -748,12 +935,28 @@ func isViablePhoneNumber(number string) bool {
 //
 //   - Spurious alpha characters are stripped.
 func normalize(number string) string {
-	if VALID_ALPHA_PHONE_PATTERN.MatchString(number) {
+	if isAlphaPhoneNumber(number) {
 		return normalizeHelper(number, ALPHA_PHONE_MAPPINGS, true)
 	}
 	return NormalizeDigitsOnly(number)
 }
 
+// isAlphaPhoneNumber is equivalent to matching VALID_ALPHA_PHONE_PATTERN, ie the number has
+// at least three ASCII letters and no newlines, but without the cost of the regex
+func isAlphaPhoneNumber(number string) bool {
+	letters := 0
+	for i := 0; i < len(number); i++ {
+		c := number[i]
+		if c == '\n' {
+			return false
+		}
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+			letters++
+		}
+	}
+	return letters >= 3
+}
+
 // Normalizes a string of characters representing a phone number. This is
 // a wrapper for normalize(String number) but does in-place normalization
 // of the StringBuilder provided.
@@ -807,17 +1010,49 @@ var arabicIndicNumberals = map[rune]rune{
 }
 
 func normalizeDigits(number string, keepNonDigits bool) string {
-	buf := number
-	var normalizedDigits = NewBuilder(nil)
-	for _, c := range buf {
-		if unicode.IsDigit(c) {
+	// if the number is already only ASCII digits there's nothing to do
+	i := 0
+	for i < len(number) && isASCIIDigit(number[i]) {
+		i++
+	}
+	if i == len(number) {
+		return number
+	}
+
+	// most input is ASCII, in which case we can skip decoding runes entirely
+	if isASCII(number[i:]) {
+		if keepNonDigits {
+			return number
+		}
+
+		var normalizedDigits strings.Builder
+		normalizedDigits.Grow(len(number))
+		normalizedDigits.WriteString(number[:i])
+		for ; i < len(number); i++ {
+			if isASCIIDigit(number[i]) {
+				normalizedDigits.WriteByte(number[i])
+			}
+		}
+		return normalizedDigits.String()
+	}
+
+	var normalizedDigits strings.Builder
+	normalizedDigits.Grow(len(number))
+	normalizedDigits.WriteString(number[:i])
+
+	for _, c := range number[i:] {
+		if c < utf8.RuneSelf {
+			if isASCIIDigit(byte(c)) || keepNonDigits {
+				normalizedDigits.WriteByte(byte(c))
+			}
+		} else if unicode.IsDigit(c) {
 			if v, ok := arabicIndicNumberals[c]; ok {
-				_, _ = normalizedDigits.WriteRune(v)
+				normalizedDigits.WriteRune(v)
 			} else {
-				_, _ = normalizedDigits.WriteRune(c)
+				normalizedDigits.WriteRune(c)
 			}
 		} else if keepNonDigits {
-			_, _ = normalizedDigits.WriteRune(c)
+			normalizedDigits.WriteRune(c)
 		}
 	}
 	return normalizedDigits.String()
@@ -934,11 +1169,11 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 	nationalSignificantNumber := Format(copiedProto, INTERNATIONAL)
 	numberGroups := DIGITS_PATTERN.FindAllString(nationalSignificantNumber, -1)
 
-	// The pattern will start with "+COUNTRY_CODE " so the first group
-	// will always be the empty string (before the + symbol) and the
-	// second group will be the country calling code. The third group
-	// will be area code if it is not the last group.
-	if len(numberGroups) <= 3 {
+	// The formatted number will start with "+COUNTRY_CODE " so the first
+	// group will always be the country calling code. Unlike the Java
+	// version, which splits on non-digits, there is no empty group before
+	// it. The second group will be area code if it is not the last group.
+	if len(numberGroups) <= 2 {
 		return 0
 	}
 	if GetNumberType(number) == MOBILE {
@@ -975,6 +1210,26 @@ func normalizeHelper(number string,
 	normalizationReplacements map[rune]rune,
 	removeNonMatches bool) string {
 
+	// for ASCII input we can look up each byte, upper casing it ourselves
+	if isASCII(number) {
+		var normalizedNumber strings.Builder
+		normalizedNumber.Grow(len(number))
+		for i := 0; i < len(number); i++ {
+			character := number[i]
+			upper := character
+			if upper >= 'a' && upper <= 'z' {
+				upper -= 'a' - 'A'
+			}
+			newDigit, ok := normalizationReplacements[rune(upper)]
+			if ok {
+				normalizedNumber.WriteRune(newDigit)
+			} else if !removeNonMatches {
+				normalizedNumber.WriteByte(character)
+			}
+		}
+		return normalizedNumber.String()
+	}
+
 	var normalizedNumber = NewBuilder(nil)
 	for _, character := range number {
 		newDigit, ok := normalizationReplacements[unicode.ToUpper(character)]
@@ -990,7 +1245,7 @@ func normalizeHelper(number string,
 
 // GetSupportedRegions returns all regions the library has metadata for.
 func GetSupportedRegions() map[string]bool {
-	return supportedRegions
+	return currentMetadata().supportedRegions
 }
 
 // GetSupportedCallingCodes returns all country calling codes the library has metadata for, covering both non-geographical
@@ -998,12 +1253,12 @@ func GetSupportedRegions() map[string]bool {
 // used to populate a drop-down box of country calling codes for a phone-number widget, for
 // instance.
 func GetSupportedCallingCodes() map[int32]bool {
-	return supportedCallingCodes
+	return currentMetadata().supportedCallingCodes
 }
 
 // GetSupportedGlobalNetworkCallingCodes returns all global network calling codes the library has metadata for.
 func GetSupportedGlobalNetworkCallingCodes() map[int32]bool {
-	return countryCodesForNonGeographicalRegion
+	return currentMetadata().countryCodesForNonGeographicalRegion
 }
 
 // Helper function to check if the national prefix formatting rule has the
@@ -1023,23 +1278,27 @@ func formattingRuleHasFirstGroupOnly(nationalPrefixFormattingRule string) bool {
 // number types were added, we should check if this other method should be
 // updated too.
 func isNumberGeographical(phoneNumber *PhoneNumber) bool {
-	numberType := GetNumberType(phoneNumber)
-	// TODO: Include mobile phone numbers from countries like Indonesia,
-	// which has some mobile numbers that are geographical.
+	return isNumberTypeGeographical(GetNumberType(phoneNumber), phoneNumber.GetCountryCode())
+}
+
+// Tests whether numbers of the passed in type and country calling code have a
+// geographical association, i.e. fixed line numbers and mobile numbers in
+// geoMobileCountries.
+func isNumberTypeGeographical(numberType PhoneNumberType, countryCallingCode int32) bool {
 	return numberType == FIXED_LINE ||
-		numberType == FIXED_LINE_OR_MOBILE
+		numberType == FIXED_LINE_OR_MOBILE ||
+		(numberType == MOBILE && geoMobileCountries[countryCallingCode])
 }
 
 // Helper function to check region code is not unknown or null.
 func isValidRegionCode(regionCode string) bool {
-	valid := supportedRegions[regionCode]
-	return len(regionCode) != 0 && valid
+	index := regionIndex(regionCode)
+	return index >= 0 && currentMetadata().supportedRegionIndex[index]
 }
 
 // Helper function to check the country calling code is valid.
 func hasValidCountryCallingCode(countryCallingCode int32) bool {
-	_, containsKey := countryCodeToRegion[countryCallingCode]
-	return containsKey
+	return len(regionCodesForCountryCode(countryCallingCode)) > 0
 }
 
 // Formats a phone number in the specified format using default rules. Note
@@ -1534,11 +1793,11 @@ func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string)
 		// (e.g. 0777123) if we just do prefix matching. To tackle that,
 		// we check the validity of the number if the assumed national
 		// prefix is removed (777123 won't be valid in Japan).
-		num, err := Parse(normalizedNationalNumber[len(nationalPrefix):], regionCode)
+		num, err := parseQuietly(normalizedNationalNumber[len(nationalPrefix):], regionCode)
 		if err != nil {
 			return false
 		}
-		return IsValidNumber(num)
+		return isValidNumber(num)
 
 	}
 	return false
@@ -1737,6 +1996,12 @@ func formatNsn(
 // carrierCode is specified, this will be inserted into the formatted
 // string to replace $CC.
 func formatNsnWithCarrier(number string, metadata *PhoneMetadata, numberFormat PhoneNumberFormat, carrierCode string) string {
+	return formatNsnWithRegexes(number, metadata, numberFormat, carrierCode, cachedRegexes{})
+}
+
+// formatNsnWithRegexes is formatNsnWithCarrier with the regexes of metadata's formats coming from
+// the passed in source
+func formatNsnWithRegexes(number string, metadata *PhoneMetadata, numberFormat PhoneNumberFormat, carrierCode string, regexes regexSource) string {
 	var intlNumberFormats []*NumberFormat = metadata.GetIntlNumberFormat()
 	// When the intlNumberFormats exists, we use that to format national
 	// number for the INTERNATIONAL format instead of using the
@@ -1745,24 +2010,32 @@ func formatNsnWithCarrier(number string, metadata *PhoneMetadata, numberFormat P
 	if len(intlNumberFormats) == 0 || numberFormat == NATIONAL {
 		availableFormats = metadata.GetNumberFormat()
 	}
-	var formattingPattern *NumberFormat = chooseFormattingPatternForNumber(availableFormats, number)
+	var formattingPattern *NumberFormat = chooseFormattingPatternWithRegexes(availableFormats, number, regexes)
 	if formattingPattern == nil {
 		return number
 	}
-	return formatNsnUsingPatternWithCarrier(
-		number, formattingPattern, numberFormat, carrierCode)
+	return formatNsnUsingPatternWithRegexes(
+		number, formattingPattern, numberFormat, carrierCode, regexes)
 }
 
 func chooseFormattingPatternForNumber(
 	availableFormats []*NumberFormat,
 	nationalNumber string) *NumberFormat {
+	return chooseFormattingPatternWithRegexes(availableFormats, nationalNumber, cachedRegexes{})
+}
+
+// chooseFormattingPatternWithRegexes is chooseFormattingPatternForNumber with the regexes of the
+// formats coming from the passed in source
+func chooseFormattingPatternWithRegexes(
+	availableFormats []*NumberFormat,
+	nationalNumber string,
+	regexes regexSource) *NumberFormat {
 
 	for _, numFormat := range availableFormats {
 		leadingDigitsPattern := numFormat.GetLeadingDigitsPattern()
 		size := len(leadingDigitsPattern)
 
-		patP := `^(?:` + numFormat.GetPattern() + `)$` // Strictly match
-		m := regexFor(patP)
+		m := regexes.strict(numFormat.GetPattern()) // Strictly match
 
 		if size == 0 {
 			mat := m.FindString(nationalNumber)
@@ -1775,7 +2048,7 @@ func chooseFormattingPatternForNumber(
 
 		// We always use the last leading_digits_pattern, as it is the
 		// most detailed.
-		reg := regexFor(leadingDigitsPattern[size-1])
+		reg := regexes.regex(leadingDigitsPattern[size-1])
 
 		inds := reg.FindStringIndex(nationalNumber)
 		if len(inds) > 0 && inds[0] == 0 && m.MatchString(nationalNumber) { // inds[0] == 0 ensures strict match of leading digits
@@ -1801,9 +2074,20 @@ func formatNsnUsingPatternWithCarrier(
 	formattingPattern *NumberFormat,
 	numberFormat PhoneNumberFormat,
 	carrierCode string) string {
+	return formatNsnUsingPatternWithRegexes(nationalNumber, formattingPattern, numberFormat, carrierCode, cachedRegexes{})
+}
+
+// formatNsnUsingPatternWithRegexes is formatNsnUsingPatternWithCarrier with the regex of the
+// format coming from the passed in source
+func formatNsnUsingPatternWithRegexes(
+	nationalNumber string,
+	formattingPattern *NumberFormat,
+	numberFormat PhoneNumberFormat,
+	carrierCode string,
+	regexes regexSource) string {
 
 	numberFormatRule := formattingPattern.GetFormat()
-	m := regexFor(formattingPattern.GetPattern())
+	m := regexes.regex(formattingPattern.GetPattern())
 
 	formattedNationalNumber := ""
 	if numberFormat == NATIONAL &&
@@ -1893,6 +2177,46 @@ func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumbe
 	return nil
 }
 
+// GetInvalidExampleNumber gets an invalid number for the specified region. This is useful for unit
+// testing purposes, where you want to test what will happen with an invalid number. Note that the
+// number that is returned will always be able to be parsed and will have the correct country code.
+// It may also be a valid *short* number/code for this region. Validity checking such numbers is
+// handled with ShortNumberInfo.
+func GetInvalidExampleNumber(regionCode string) *PhoneNumber {
+	if !isValidRegionCode(regionCode) {
+		return nil
+	}
+	// We start off with a valid fixed-line number since every country supports this. Alternatively
+	// we could start with a different number type, since fixed-line numbers typically have a wide
+	// breadth of valid number lengths and we may have to make it very short before we get an invalid
+	// number.
+	desc := getNumberDescByType(getMetadataForRegion(regionCode), FIXED_LINE)
+	exampleNumber := desc.GetExampleNumber()
+	if exampleNumber == "" {
+		return nil
+	}
+
+	// Try and make the number invalid. We do this by changing the length. We try reducing the length
+	// of the number, since currently no region has a number that is the same length as
+	// MIN_LENGTH_FOR_NSN. This is probably quicker than making the number longer, which is another
+	// alternative. We could also use the possible number pattern to extract the possible lengths of
+	// the number to make this faster, but this method is only for unit-testing so simplicity is
+	// preferred to performance. We don't want to return a number that can't be parsed, so we check
+	// the number is long enough. We try all possible lengths because phone number plans often have
+	// overlapping prefixes so the number 123456 might be valid as a fixed-line number, and 12345 as
+	// a mobile number. It would be faster to loop in a different order, but we prefer numbers that
+	// look closer to real numbers (and it gives us a variety of different lengths for the resulting
+	// phone numbers - otherwise they would all be MIN_LENGTH_FOR_NSN digits long.)
+	for length := len(exampleNumber) - 1; length >= MIN_LENGTH_FOR_NSN; length-- {
+		possiblyValidNumber, err := parseQuietly(exampleNumber[:length], regionCode)
+		if err == nil && !isValidNumber(possiblyValidNumber) {
+			return possiblyValidNumber
+		}
+	}
+	// We have a test to check that this doesn't happen for any of our supported regions.
+	return nil
+}
+
 // Gets a valid number for the specified country calling code for a non-geographical entity.
 func GetExampleNumberForNonGeoEntity(countryCallingCode int32) *PhoneNumber {
 	var metadata *PhoneMetadata = getMetadataForNonGeographicalRegion(countryCallingCode)
@@ -1910,7 +2234,8 @@ func GetExampleNumberForNonGeoEntity(countryCallingCode int32) *PhoneNumber {
 		if desc != nil && desc.GetExampleNumber() != "" {
 			num, err := Parse("+"+strconv.FormatInt(int64(countryCallingCode), 10)+desc.GetExampleNumber(), "ZZ")
 			if err != nil {
-				return nil
+				// as in Java, try the next type rather than giving up
+				continue
 			}
 			return num
 		}
@@ -1987,6 +2312,16 @@ func GetNumberType(number *PhoneNumber) PhoneNumberType {
 }
 
 func getNumberTypeHelper(nationalNumber string, metadata *PhoneMetadata) PhoneNumberType {
+	return getNumberTypeWithRegexes(nationalNumber, metadata, cachedRegexes{})
+}
+
+// getNumberTypeWithRegexes is getNumberTypeHelper with the regexes of metadata's number
+// descriptions coming from the passed in source
+func getNumberTypeWithRegexes(nationalNumber string, metadata *PhoneMetadata, regexes regexSource) PhoneNumberType {
+	isNumberMatchingDesc := func(nationalNumber string, numberDesc *PhoneNumberDesc) bool {
+		return isNumberPossibleForDescWithRegexes(nationalNumber, numberDesc, regexes)
+	}
+
 	if !isNumberMatchingDesc(nationalNumber, metadata.GetGeneralDesc()) {
 		return UNKNOWN
 	}
@@ -2042,20 +2377,25 @@ func getMetadataForRegion(regionCode string) *PhoneMetadata {
 	if !isValidRegionCode(regionCode) {
 		return nil
 	}
-	val, _ := readFromRegionToMetadataMap(regionCode)
+	val, _ := readFromRegionToMetadata(regionCode)
 	return val
 }
 
 func getMetadataForNonGeographicalRegion(countryCallingCode int32) *PhoneMetadata {
-	_, ok := countryCodeToRegion[countryCallingCode]
-	if !ok {
+	if !hasValidCountryCallingCode(countryCallingCode) {
 		return nil
 	}
-	val, _ := readFromCountryCodeToNonGeographicalMetadataMap(countryCallingCode)
+	val, _ := readFromCountryCodeToNonGeographicalMetadata(countryCallingCode)
 	return val
 }
 
 func isNumberPossibleForDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bool {
+	return isNumberPossibleForDescWithRegexes(nationalNumber, numberDesc, cachedRegexes{})
+}
+
+// isNumberPossibleForDescWithRegexes is isNumberPossibleForDesc with the regex of the description
+// coming from the passed in source
+func isNumberPossibleForDescWithRegexes(nationalNumber string, numberDesc *PhoneNumberDesc, regexes regexSource) bool {
 	// Check if any possible number lengths are present; if so, we use them to avoid checking the
 	// validation pattern if they don't match. If they are absent, this means they match the general
 	// description, which we have already checked before checking a specific number type.
@@ -2072,27 +2412,102 @@ func isNumberPossibleForDesc(nationalNumber string, numberDesc *PhoneNumberDesc)
 			return false
 		}
 	}
-	possiblePattern := "^(?:" + numberDesc.GetNationalNumberPattern() + ")$" // Strictly match
-	pat := regexFor(possiblePattern)
+	// most numbers that don't match can be rejected on their first two digits without the pattern
+	pattern := numberDesc.GetNationalNumberPattern()
+	if prefixes := digitPrefixesFor(pattern); prefixes != nil && !prefixes.allows(nationalNumber) {
+		return false
+	}
+	pat := regexes.strict(pattern)
 	return pat.MatchString(nationalNumber)
 }
 
 func isNumberMatchingDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bool {
-	patP := "^(?:" + numberDesc.GetNationalNumberPattern() + ")$" // Strictly match
-	pat := regexFor(patP)
-	return isNumberPossibleForDesc(nationalNumber, numberDesc) &&
-		pat.MatchString(nationalNumber)
-
+	// isNumberPossibleForDesc already strictly matches against the national number pattern
+	return isNumberPossibleForDesc(nationalNumber, numberDesc)
 }
 
 // Tests whether a phone number matches a valid pattern. Note this doesn't
 // verify the number is actually in use, which is impossible to tell by
 // just looking at a number itself.
 func IsValidNumber(number *PhoneNumber) bool {
+	valid := isValidNumber(number)
+	if !valid {
+		if h := currentHooks(); h != nil && h.InvalidNumber != nil {
+			h.InvalidNumber(number, GetRegionCodeForCountryCode(number.GetCountryCode()))
+		}
+	}
+	return valid
+}
+
+// isValidNumber is IsValidNumber for internal use, without calling any hooks
+func isValidNumber(number *PhoneNumber) bool {
 	var regionCode string = GetRegionCodeForNumber(number)
 	return IsValidNumberForRegion(number, regionCode)
 }
 
+// IsValidE164String tests whether the passed in string is a valid number in
+// strict E164 format, that is a '+' followed by only digits, such as
+// "+16502530000", written exactly as Format would write it in E164. For such
+// numbers it returns the same result as calling IsValidNumber on the parsed
+// number, but without parsing, and numbers with an unknown country calling
+// code or impossible length are rejected without evaluating any patterns.
+// Anything not in that form returns false, including numbers with spaces or
+// an extension, and numbers such as "+112015550123" with a national prefix
+// after the country calling code, even though Parse would strip it.
+func IsValidE164String(number string) bool {
+	if len(number) < 1+MIN_LENGTH_FOR_NSN || number[0] != '+' || number[1] == '0' {
+		return false
+	}
+	for i := 1; i < len(number); i++ {
+		if !isASCIIDigit(number[i]) {
+			return false
+		}
+	}
+
+	var countryCode int32
+	for i := 1; i <= MAX_LENGTH_COUNTRY_CODE && i < len(number); i++ {
+		countryCode = countryCode*10 + int32(number[i]-'0')
+		regionCodes := regionCodesForCountryCode(countryCode)
+		if len(regionCodes) == 0 {
+			continue
+		}
+
+		nationalNumber := number[i+1:]
+		if len(nationalNumber) < MIN_LENGTH_FOR_NSN || len(nationalNumber) > MAX_LENGTH_FOR_NSN {
+			return false
+		}
+
+		// if Parse would strip a national prefix then this isn't the number in E164 format
+		metadata := getMetadataForRegionOrCallingCode(countryCode, regionCodes[0])
+		if metadata == nil {
+			return false
+		}
+		stripped := NewBuilderString(nationalNumber)
+		if maybeStripNationalPrefixAndCarrierCode(stripped, metadata, NewBuilder(nil)) {
+			switch testNumberLength(stripped.String(), metadata, UNKNOWN) {
+			case TOO_SHORT, IS_POSSIBLE_LOCAL_ONLY, INVALID_LENGTH:
+			default:
+				return false
+			}
+		}
+
+		regionCode := regionCodes[0]
+		if len(regionCodes) > 1 {
+			regionCode = getRegionCodeForNationalNumber(nationalNumber, regionCodes)
+			metadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
+		}
+		if metadata == nil || (REGION_CODE_FOR_NON_GEO_ENTITY != regionCode && countryCode != metadata.GetCountryCode()) {
+			return false
+		}
+		switch testNumberLength(nationalNumber, metadata, UNKNOWN) {
+		case IS_POSSIBLE, IS_POSSIBLE_LOCAL_ONLY:
+			return getNumberTypeHelper(nationalNumber, metadata) != UNKNOWN
+		}
+		return false
+	}
+	return false
+}
+
 // Tests whether a phone number is valid for a certain region. Note this
 // doesn't verify the number is actually in use, which is impossible to
 // tell by just looking at a number itself. If the country calling code is
@@ -2119,7 +2534,7 @@ func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
 // Returns the region where a phone number is from. This could be used for
 // geocoding at the region level.
 func GetRegionCodeForNumber(number *PhoneNumber) string {
-	var regions []string = countryCodeToRegion[number.GetCountryCode()]
+	var regions []string = regionCodesForCountryCode(number.GetCountryCode())
 	if len(regions) == 0 {
 		return ""
 	}
@@ -2129,11 +2544,47 @@ func GetRegionCodeForNumber(number *PhoneNumber) string {
 	return getRegionCodeForNumberFromRegionList(number, regions)
 }
 
+// Returns every region sharing the number's country calling code whose
+// patterns the number matches, i.e. every region it's valid for, with the
+// main region for the calling code first if the number is valid for it.
+// Numbers such as toll free numbers in the NANPA are valid for many regions,
+// whereas GetRegionCodeForNumber only returns the first.
+func GetRegionCodesForNumber(number *PhoneNumber) []string {
+	countryCode := number.GetCountryCode()
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+
+	var matches []string
+	for _, regionCode := range regionCodesForCountryCode(countryCode) {
+		metadata := getMetadataForRegionOrCallingCode(countryCode, regionCode)
+		if metadata != nil && getNumberTypeHelper(nationalSignificantNumber, metadata) != UNKNOWN {
+			matches = append(matches, regionCode)
+		}
+	}
+	return matches
+}
+
+// Returns the region where a phone number is from like GetRegionCodeForNumber,
+// but preferring the passed in hint region, such as the region of the user,
+// if the number is valid for it. This gives better answers for numbers which
+// are valid for more than one region.
+func GetRegionCodeForNumberWithHint(number *PhoneNumber, hintRegion string) string {
+	if hintRegion != "" && GetCountryCodeForRegion(hintRegion) == number.GetCountryCode() {
+		metadata := getMetadataForRegion(hintRegion)
+		if metadata != nil && getNumberTypeHelper(GetNationalSignificantNumber(number), metadata) != UNKNOWN {
+			return hintRegion
+		}
+	}
+	return GetRegionCodeForNumber(number)
+}
+
 func getRegionCodeForNumberFromRegionList(
 	number *PhoneNumber,
 	regionCodes []string) string {
 
-	var nationalNumber string = GetNationalSignificantNumber(number)
+	return getRegionCodeForNationalNumber(GetNationalSignificantNumber(number), regionCodes)
+}
+
+func getRegionCodeForNationalNumber(nationalNumber string, regionCodes []string) string {
 	for _, regionCode := range regionCodes {
 		// If leadingDigits is present, use this. Otherwise, do
 		// full validation. Metadata cannot be null because the
@@ -2161,7 +2612,7 @@ func getRegionCodeForNumberFromRegionList(
 // value "001" will be returned (corresponding to the value for World in
 // the UN M.49 schema).
 func GetRegionCodeForCountryCode(countryCallingCode int32) string {
-	var regionCodes []string = countryCodeToRegion[countryCallingCode]
+	var regionCodes []string = regionCodesForCountryCode(countryCallingCode)
 	if len(regionCodes) == 0 {
 		return UNKNOWN_REGION
 	}
@@ -2173,7 +2624,7 @@ func GetRegionCodeForCountryCode(countryCallingCode int32) string {
 // code 001 is returned. Also, in the case of no region code being found,
 // an empty list is returned.
 func GetRegionCodesForCountryCode(countryCallingCode int32) []string {
-	var regionCodes []string = countryCodeToRegion[countryCallingCode]
+	var regionCodes []string = regionCodesForCountryCode(countryCallingCode)
 	return regionCodes
 }
 
@@ -2222,6 +2673,45 @@ func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
 	return nationalPrefix
 }
 
+// Returns the international dialling prefix used to call other countries
+// from a specific region. For example, this would be 011 for the United
+// States, 00 for the United Kingdom and 0011 for Australia. Regions which
+// have more than one international prefix return the preferred one, or
+// an empty string if there isn't one, as do unknown regions. Set
+// stripNonDigits to true to strip symbols like "~" (which indicates a
+// wait for a dialling tone) from the prefix returned.
+func GetInternationalPrefixForRegion(regionCode string, stripNonDigits bool) string {
+	metadata := getMetadataForRegion(regionCode)
+	if metadata == nil {
+		return ""
+	}
+
+	// The international prefix is a pattern, which is only a prefix that
+	// can be dialled if it matches in its entirety.
+	internationalPrefix := metadata.GetInternationalPrefix()
+	if loc := UNIQUE_INTERNATIONAL_PREFIX.FindStringIndex(internationalPrefix); loc == nil || loc[0] != 0 || loc[1] != len(internationalPrefix) {
+		internationalPrefix = metadata.GetPreferredInternationalPrefix()
+	}
+	if stripNonDigits {
+		internationalPrefix = strings.Replace(internationalPrefix, "~", "", -1)
+	}
+	return internationalPrefix
+}
+
+// Returns the prefix used before extensions when formatting numbers for
+// a specific region, e.g. " ext. " for most regions, or an empty string
+// for unknown regions.
+func GetExtensionPrefixForRegion(regionCode string) string {
+	metadata := getMetadataForRegion(regionCode)
+	if metadata == nil {
+		return ""
+	}
+	if prefix := metadata.GetPreferredExtnPrefix(); prefix != "" {
+		return prefix
+	}
+	return DEFAULT_EXTN_PREFIX
+}
+
 // Checks if this is a region under the North American Numbering Plan
 // Administration (NANPA).
 func IsNANPACountry(regionCode string) bool {
@@ -2427,7 +2917,7 @@ func isPossibleNumberWithRegion(number, regionDialingFrom string) bool {
 // version. If no valid number could be extracted, the PhoneNumber object
 // passed in will not be modified.
 func TruncateTooLongNumber(number *PhoneNumber) bool {
-	if IsValidNumber(number) {
+	if isValidNumber(number) {
 		return true
 	}
 	numberCopy := &PhoneNumber{}
@@ -2438,7 +2928,7 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 	if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT || nationalNumber == 0 {
 		return false
 	}
-	for !IsValidNumber(numberCopy) {
+	for !isValidNumber(numberCopy) {
 		nationalNumber /= 10
 		numberCopy.NationalNumber = nationalNumber
 		if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT ||
@@ -2451,12 +2941,6 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 	return true
 }
 
-// Gets an AsYouTypeFormatter for the specific region.
-// TODO(ttacon): uncomment once we do asyoutypeformatter.go
-//public AsYouTypeFormatter getAsYouTypeFormatter(String regionCode) {
-//    return new AsYouTypeFormatter(regionCode);
-//}
-
 // Extracts country calling code from fullNumber, returns it and places
 // the remaining number in nationalNumber. It assumes that the leading plus
 // sign or IDD has already been removed. Returns 0 if fullNumber doesn't
@@ -2475,7 +2959,7 @@ func extractCountryCode(fullNumber, nationalNumber *Builder) int32 {
 	for i := 1; i <= MAX_LENGTH_COUNTRY_CODE && i <= numberLength; i++ {
 		temp, _ := strconv.ParseInt(string(fullNumBytes[0:i]), 10, 32)
 		potentialCountryCode = int32(temp)
-		if _, ok := countryCodeToRegion[potentialCountryCode]; ok {
+		if len(regionCodesForCountryCode(potentialCountryCode)) > 0 {
 			_, _ = nationalNumber.Write(fullNumBytes[i:])
 			return potentialCountryCode
 		}
@@ -2483,8 +2967,6 @@ func extractCountryCode(fullNumber, nationalNumber *Builder) int32 {
 	return 0
 }
 
-var ErrTooShortAfterIDD = errors.New("phone number had an IDD, but after this was not long enough to be a viable phone number")
-
 // Tries to extract a country calling code from a number. This method will
 // return zero if no country calling code is considered to be present.
 // Country calling codes are extracted in the following ways:
@@ -2526,6 +3008,9 @@ func maybeExtractCountryCode(
 	if keepRawInput {
 		phoneNumber.CountryCodeSource = &countryCodeSource
 	}
+	if countryCodeSource == PhoneNumber_FROM_NUMBER_WITH_IDD && debugLogging() {
+		logDebug("stripped international prefix", "number", number, "prefix", possibleCountryIddPrefix)
+	}
 	if countryCodeSource != PhoneNumber_FROM_DEFAULT_COUNTRY {
 		if len(fullNumber.String()) <= MIN_LENGTH_FOR_NSN {
 			return 0, ErrTooShortAfterIDD
@@ -2570,6 +3055,9 @@ func maybeExtractCountryCode(
 
 			if (!fullValid && nationalValid) || lengthValid == TOO_LONG {
 				_, _ = nationalNumber.Write(potentialNationalNumber.Bytes())
+				if debugLogging() {
+					logDebug("stripped country code without plus sign", "number", number, "country_code", defaultCountryCode)
+				}
 				if keepRawInput {
 					val := PhoneNumber_FROM_NUMBER_WITHOUT_PLUS_SIGN
 					phoneNumber.CountryCodeSource = &val
@@ -2595,15 +3083,13 @@ func parsePrefixAsIdd(iddPattern *regexp.Regexp, number *Builder) bool {
 	matchEnd := ind[1] // ind is a two element slice
 	// Only strip this if the first digit after the match is not
 	// a 0, since country calling codes cannot begin with 0.
-	find := CAPTURING_DIGIT_PATTERN.FindAllString(numStr[matchEnd:], -1)
-	if len(find) > 0 {
-		if NormalizeDigitsOnly(find[0]) == "0" {
+	if digit := CAPTURING_DIGIT_PATTERN.FindString(numStr[matchEnd:]); digit != "" {
+		if NormalizeDigitsOnly(digit) == "0" {
 			return false
 		}
 	}
 
-	numBytes := []byte(numStr)
-	_, _ = number.ResetWith(numBytes[matchEnd:])
+	_, _ = number.ResetWithString(numStr[matchEnd:])
 	return true
 }
 
@@ -2614,29 +3100,41 @@ func maybeStripInternationalPrefixAndNormalize(
 	number *Builder,
 	possibleIddPrefix string) PhoneNumber_CountryCodeSource {
 
-	numBytes := number.Bytes()
-	if len(numBytes) == 0 {
+	numStr := number.String()
+	if len(numStr) == 0 {
 		return PhoneNumber_FROM_DEFAULT_COUNTRY
 	}
 	// Check to see if the number begins with one or more plus signs.
-	ind := PLUS_CHARS_PATTERN.FindIndex(numBytes) // Return is an int pair [start,end]
-	if len(ind) > 0 && ind[0] == 0 {              // Strictly match from string start
-		_, _ = number.ResetWith(numBytes[ind[1]:])
+	if plusEnd := leadingPlusChars(numStr); plusEnd > 0 {
 		// Can now normalize the rest of the number since we've consumed
 		// the "+" sign at the start.
-		_, _ = number.ResetWithString(normalize(number.String()))
+		_, _ = number.ResetWithString(normalize(numStr[plusEnd:]))
 		return PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN
 	}
 
 	// Attempt to parse the first digits as an international prefix.
 	iddPattern := regexFor(possibleIddPrefix)
-	_, _ = number.ResetWithString(normalize(string(numBytes)))
+	_, _ = number.ResetWithString(normalize(numStr))
 	if parsePrefixAsIdd(iddPattern, number) {
 		return PhoneNumber_FROM_NUMBER_WITH_IDD
 	}
 	return PhoneNumber_FROM_DEFAULT_COUNTRY
 }
 
+// leadingPlusChars returns the length of the run of PLUS_CHARS at the start of number
+func leadingPlusChars(number string) int {
+	end := 0
+	for {
+		if strings.HasPrefix(number[end:], "+") {
+			end++
+		} else if strings.HasPrefix(number[end:], "\uFF0B") {
+			end += len("\uFF0B")
+		} else {
+			return end
+		}
+	}
+}
+
 // Strips any national prefix (such as 0, 1) present in the number provided.
 // @VisibleForTesting
 func maybeStripNationalPrefixAndCarrierCode(
@@ -2644,7 +3142,7 @@ func maybeStripNationalPrefixAndCarrierCode(
 	metadata *PhoneMetadata,
 	carrierCode *Builder) bool {
 
-	numberLength := len(number.String())
+	numberLength := number.Len()
 	possibleNationalPrefix := metadata.GetNationalPrefixForParsing()
 	if numberLength == 0 || len(possibleNationalPrefix) == 0 {
 		// Early return for numbers of zero length.
@@ -2653,7 +3151,7 @@ func maybeStripNationalPrefixAndCarrierCode(
 	possibleNationalPrefix = "^(?:" + possibleNationalPrefix + ")" // Strictly match from string start
 	// Attempt to parse the first digits as a national prefix.
 	prefixMatcher := regexFor(possibleNationalPrefix)
-	if prefixMatcher.MatchString(number.String()) {
+	if prefixMatcher.Match(number.Bytes()) {
 		natRulePattern := "^(?:" + metadata.GetGeneralDesc().GetNationalNumberPattern() + ")$" // Strictly match
 		nationalNumberRule := regexFor(natRulePattern)
 		// Check if the original number is viable.
@@ -2670,8 +3168,8 @@ func maybeStripNationalPrefixAndCarrierCode(
 			// If the original number was viable, and the resultant number
 			// is not, we return.
 			if isViableOriginalNumber &&
-				!nationalNumberRule.MatchString(
-					number.String()[groups[1]:]) { // groups[1] == last match idx
+				!nationalNumberRule.Match(
+					number.Bytes()[groups[1]:]) { // groups[1] == last match idx
 				return false
 			}
 			if len(carrierCode.Bytes()) != 0 &&
@@ -2779,7 +3277,10 @@ func checkRegionForParsing(numberToParse, defaultRegion string) bool {
 // throw a NumberParseException if the number is not considered to be a
 // possible number. Note that validation of whether the number is actually
 // a valid number for a particular region is not performed. This can be
-// done separately with IsValidNumber().
+// done separately with IsValidNumber(). If defaultRegion is empty, the
+// region set with SetDefaultRegion() is used. Unlike ParseAndKeepRawInput(),
+// the raw input, country code source and preferred domestic carrier code
+// are never stored, so parsed numbers hold only their canonical fields.
 func Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
 	var phoneNumber *PhoneNumber = &PhoneNumber{}
 	err := ParseToNumber(numberToParse, defaultRegion, phoneNumber)
@@ -2789,7 +3290,37 @@ func Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
 // Same as Parse(string, string), but accepts mutable PhoneNumber as a
 // parameter to decrease object creation when invoked many times.
 func ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, false, true, phoneNumber)
+	return parseWithHooks(numberToParse, defaultRegion, false, phoneNumber)
+}
+
+// ParseInto is the same as Parse, but parses into the passed in PhoneNumber,
+// which is reset first so that nothing from a previous parse is carried over.
+// This allows numbers to be reused, for example from a sync.Pool, when parsing
+// in bulk. If an error is returned the contents of number are undefined.
+func ParseInto(numberToParse, defaultRegion string, number *PhoneNumber) error {
+	number.Reset()
+	return parseWithHooks(numberToParse, defaultRegion, false, number)
+}
+
+// MustParse is the same as Parse but panics if the number can't be parsed.
+// It's intended for numbers which are known to be good, such as constants,
+// static configuration and tests, and shouldn't be used for user input.
+func MustParse(numberToParse, defaultRegion string) *PhoneNumber {
+	number, err := Parse(numberToParse, defaultRegion)
+	if err != nil {
+		panic(fmt.Sprintf("phonenumbers: MustParse(%q, %q): %s", numberToParse, defaultRegion, err))
+	}
+	return number
+}
+
+// ParseOrNil is the same as Parse but returns nil if the number can't be
+// parsed, for callers which don't care why.
+func ParseOrNil(numberToParse, defaultRegion string) *PhoneNumber {
+	number, err := Parse(numberToParse, defaultRegion)
+	if err != nil {
+		return nil
+	}
+	return number
 }
 
 // Parses a string and returns it in proto buffer format. This method
@@ -2809,7 +3340,7 @@ func ParseAndKeepRawInput(
 func ParseAndKeepRawInputToNumber(
 	numberToParse, defaultRegion string,
 	phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, true, true, phoneNumber)
+	return parseWithHooks(numberToParse, defaultRegion, true, phoneNumber)
 }
 
 // Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
@@ -2853,12 +3384,60 @@ func setItalianLeadingZerosForPhoneNumber(
 	}
 }
 
+// The errors returned by Parse and ParseAndKeepRawInput, which correspond to the error types of
+// libphonenumber's NumberParseException. Use errors.Is to check for them as they may be wrapped.
 var (
 	ErrInvalidCountryCode = errors.New("invalid country code")
 	ErrNotANumber         = errors.New("the phone number supplied is not a number")
+	ErrTooShortAfterIDD   = errors.New("phone number had an IDD, but after this was not long enough to be a viable phone number")
 	ErrTooShortNSN        = errors.New("the string supplied is too short to be a phone number")
+	ErrTooLong            = errors.New("the string supplied is too long to be a phone number")
 )
 
+// ParseError is returned in place of ErrNotANumber when the number contains a character which can't
+// appear in phone numbers, and gives the offending character and where it is in the input. It wraps
+// ErrNotANumber, so errors.Is(err, ErrNotANumber) still works.
+type ParseError struct {
+	Err      error
+	Char     rune
+	Offset   int // the byte offset of the character in the input
+	Position int // the rune offset of the character in the input
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s: invalid character '%c' at position %d", e.Err, e.Char, e.Position)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// notANumberError returns the error for an input which isn't a viable number, which is a ParseError
+// for the first invalid character from the start of the possible number extracted from it, if any
+func notANumberError(input, possibleNumber string) error {
+	start := strings.Index(input, possibleNumber)
+	if start < 0 {
+		start = 0
+	}
+	indices := INVALID_PHONE_NUMBER_CHAR_PATTERN.FindStringIndex(input[start:])
+	if indices == nil {
+		return ErrNotANumber
+	}
+
+	offset := start + indices[0]
+	char, _ := utf8.DecodeRuneInString(input[offset:])
+	return &ParseError{Err: ErrNotANumber, Char: char, Offset: offset, Position: utf8.RuneCountInString(input[:offset])}
+}
+
+// ErrNumTooLong is the previous name of ErrTooLong.
+//
+// Deprecated: use ErrTooLong instead.
+var ErrNumTooLong = ErrTooLong
+
+// ErrInvalidNumber is returned by functions which only accept valid numbers, such as NewPhoneE164,
+// when passed a number which can be parsed but isn't valid
+var ErrInvalidNumber = errors.New("the phone number supplied is not a valid number")
+
 // Parses a string and fills up the phoneNumber. This method is the same
 // as the public Parse() method, with the exception that it allows the
 // default region to be null, for use by IsNumberMatch(). checkRegion should
@@ -2870,21 +3449,22 @@ func parseHelper(
 	phoneNumber *PhoneNumber) error {
 	if len(numberToParse) == 0 {
 		return ErrNotANumber
-	} else if len(numberToParse) > MAX_INPUT_STRING_LENGTH {
-		return ErrNumTooLong
+	} else if err := checkParseLength(len(numberToParse)); err != nil {
+		return err
 	}
 
 	nationalNumber := NewBuilder(nil)
 	buildNationalNumberForParsing(numberToParse, nationalNumber)
+	nationalNumberStr := nationalNumber.String()
 
-	if !isViablePhoneNumber(nationalNumber.String()) {
-		return ErrNotANumber
+	if !isViablePhoneNumber(nationalNumberStr) {
+		return notANumberError(numberToParse, nationalNumberStr)
 	}
 
 	// Check the region supplied is valid, or that the extracted number
 	// starts with some sort of + sign so the number's region can be determined.
 	if checkRegion &&
-		!checkRegionForParsing(nationalNumber.String(), defaultRegion) {
+		!checkRegionForParsing(nationalNumberStr, defaultRegion) {
 		return ErrInvalidCountryCode
 	}
 
@@ -2897,6 +3477,7 @@ func parseHelper(
 	extension := maybeStripExtension(nationalNumber)
 	if len(extension) > 0 {
 		phoneNumber.Extension = proto.String(extension)
+		nationalNumberStr = nationalNumber.String()
 	}
 	var regionMetadata *PhoneMetadata = getMetadataForRegion(defaultRegion)
 	// Check to see if the number is given in international format so we
@@ -2906,15 +3487,15 @@ func parseHelper(
 	// has already been created, and just remove the prefix, rather than
 	// taking in a string and then outputting a string buffer.
 	countryCode, err := maybeExtractCountryCode(
-		nationalNumber.String(), regionMetadata,
+		nationalNumberStr, regionMetadata,
 		normalizedNationalNumber, keepRawInput, phoneNumber)
 	if err != nil {
 		// There might be a plus at the beginning
-		inds := PLUS_CHARS_PATTERN.FindStringIndex(nationalNumber.String())
-		if err == ErrInvalidCountryCode && len(inds) > 0 {
+		inds := PLUS_CHARS_PATTERN.FindStringIndex(nationalNumberStr)
+		if errors.Is(err, ErrInvalidCountryCode) && len(inds) > 0 {
 			// Strip the plus-char, and try again.
 			countryCode, err = maybeExtractCountryCode(
-				nationalNumber.String()[inds[1]:], regionMetadata,
+				nationalNumberStr[inds[1]:], regionMetadata,
 				normalizedNationalNumber, keepRawInput, phoneNumber)
 			if err != nil {
 				return err
@@ -2937,30 +3518,34 @@ func parseHelper(
 		// If no extracted country calling code, use the region supplied
 		// instead. The national number is just the normalized version of
 		// the number we were given to parse.
-		_, _ = normalizedNationalNumber.WriteString(normalize(nationalNumber.String()))
+		_, _ = normalizedNationalNumber.WriteString(normalize(nationalNumberStr))
 		if len(defaultRegion) != 0 {
 			phoneNumber.CountryCode = regionMetadata.GetCountryCode()
 		} else if keepRawInput {
 			phoneNumber.CountryCodeSource = nil
 		}
 	}
-	if len(normalizedNationalNumber.String()) < MIN_LENGTH_FOR_NSN {
+	if normalizedNationalNumber.Len() < MIN_LENGTH_FOR_NSN {
 		return ErrTooShortNSN
 	}
 
 	if regionMetadata != nil {
-		carrierCode := NewBuilder(nil)
+		var carrierCode Builder
 		bufferCopy := make([]byte, normalizedNationalNumber.Len())
 		copy(bufferCopy, normalizedNationalNumber.Bytes())
 		potentialNationalNumber := NewBuilder(bufferCopy)
 		maybeStripNationalPrefixAndCarrierCode(
-			potentialNationalNumber, regionMetadata, carrierCode)
+			potentialNationalNumber, regionMetadata, &carrierCode)
 		// We require that the NSN remaining after stripping the national
 		// prefix and carrier code be of a possible length for the region.
 		// Otherwise, we don't do the stripping, since the original number
 		// could be a valid short number.
 		validationResult := testNumberLength(potentialNationalNumber.String(), regionMetadata, UNKNOWN)
 		if validationResult != TOO_SHORT && validationResult != IS_POSSIBLE_LOCAL_ONLY && validationResult != INVALID_LENGTH {
+			if debugLogging() && potentialNationalNumber.Len() != normalizedNationalNumber.Len() {
+				logDebug("stripped national prefix", "number", normalizedNationalNumber.String(), "region", regionMetadata.GetId(),
+					"national_number", potentialNationalNumber.String(), "carrier_code", carrierCode.String())
+			}
 			normalizedNationalNumber = potentialNationalNumber
 			if keepRawInput {
 				phoneNumber.PreferredDomesticCarrierCode =
@@ -2968,22 +3553,21 @@ func parseHelper(
 			}
 		}
 	}
-	lengthOfNationalNumber := len(normalizedNationalNumber.String())
+	lengthOfNationalNumber := normalizedNationalNumber.Len()
 	if lengthOfNationalNumber < MIN_LENGTH_FOR_NSN {
 		return ErrTooShortNSN
 	}
 	if lengthOfNationalNumber > MAX_LENGTH_FOR_NSN {
-		return ErrNumTooLong
+		return ErrTooLong
 	}
+	normalizedNationalNumberStr := normalizedNationalNumber.String()
 	setItalianLeadingZerosForPhoneNumber(
-		normalizedNationalNumber.String(), phoneNumber)
-	val, _ := strconv.ParseUint(normalizedNationalNumber.String(), 10, 64)
+		normalizedNationalNumberStr, phoneNumber)
+	val, _ := strconv.ParseUint(normalizedNationalNumberStr, 10, 64)
 	phoneNumber.NationalNumber = val
 	return nil
 }
 
-var ErrNumTooLong = errors.New("the string supplied is too long to be a phone number")
-
 // Converts numberToParse to a form that we can parse and write it to
 // nationalNumber if it is written in RFC3966; otherwise extract a possible
 // number out of it and write to nationalNumber.
@@ -2996,14 +3580,14 @@ func buildNationalNumberForParsing(
 		phoneContextStart := indexOfPhoneContext + len(RFC3966_PHONE_CONTEXT)
 		// If the phone context contains a phone number prefix, we need
 		// to capture it, whereas domains will be ignored.
-		if numberToParse[phoneContextStart] == PLUS_SIGN {
+		if phoneContextStart < len(numberToParse) && numberToParse[phoneContextStart] == PLUS_SIGN {
 			// Additional parameters might follow the phone context. If so,
 			// we will remove them here because the parameters after phone
 			// context are not important for parsing the phone number.
 			phoneContextEnd := strings.Index(numberToParse[phoneContextStart:], ";")
 			if phoneContextEnd > 0 {
 				_, _ = nationalNumber.WriteString(
-					numberToParse[phoneContextStart:phoneContextEnd])
+					numberToParse[phoneContextStart : phoneContextStart+phoneContextEnd])
 			} else {
 				_, _ = nationalNumber.WriteString(numberToParse[phoneContextStart:])
 			}
@@ -3014,7 +3598,7 @@ func buildNationalNumberForParsing(
 		// handle the case when "tel:" is missing, as we have seen in some
 		// of the phone number inputs. In that case, we append everything
 		// from the beginning.
-		indexOfRfc3966Prefix := strings.Index(numberToParse, RFC3966_PREFIX)
+		indexOfRfc3966Prefix := strings.Index(numberToParse[:indexOfPhoneContext], RFC3966_PREFIX)
 		indexOfNationalNumber := 0
 		if indexOfRfc3966Prefix >= 0 {
 			indexOfNationalNumber = indexOfRfc3966Prefix + len(RFC3966_PREFIX)
@@ -3031,7 +3615,7 @@ func buildNationalNumberForParsing(
 	// Delete the isdn-subaddress and everything after it if it is present.
 	// Note extension won't appear at the same time with isdn-subaddress
 	// according to paragraph 5.3 of the RFC3966 spec,
-	indexOfIsdn := strings.Index(nationalNumber.String(), RFC3966_ISDN_SUBADDRESS)
+	indexOfIsdn := bytes.Index(nationalNumber.Bytes(), []byte(RFC3966_ISDN_SUBADDRESS))
 	if indexOfIsdn > 0 {
 		natNumBytes := nationalNumber.Bytes()
 		_, _ = nationalNumber.ResetWith(natNumBytes[:indexOfIsdn])
@@ -3141,17 +3725,17 @@ func isNationalNumberSuffixOfTheOther(firstNumber, secondNumber *PhoneNumber) bo
 // a convenience wrapper for IsNumberMatch(PhoneNumber, PhoneNumber). No
 // default region is known.
 func IsNumberMatch(firstNumber, secondNumber string) MatchType {
-	firstNumberAsProto, err := Parse(firstNumber, UNKNOWN_REGION)
+	firstNumberAsProto, err := parseQuietly(firstNumber, UNKNOWN_REGION)
 	if err == nil {
 		return IsNumberMatchWithOneNumber(firstNumberAsProto, secondNumber)
-	} else if err != ErrInvalidCountryCode {
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := parseQuietly(secondNumber, UNKNOWN_REGION)
 	if err == nil {
 		return IsNumberMatchWithOneNumber(secondNumberAsProto, firstNumber)
-	} else if err != ErrInvalidCountryCode {
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
@@ -3174,11 +3758,11 @@ func IsNumberMatchWithOneNumber(
 	firstNumber *PhoneNumber, secondNumber string) MatchType {
 	// First see if the second number has an implicit country calling
 	// code, by attempting to parse it.
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := parseQuietly(secondNumber, UNKNOWN_REGION)
 	if err == nil {
 		return IsNumberMatchWithNumbers(firstNumber, secondNumberAsProto)
 	}
-	if err != ErrInvalidCountryCode {
+	if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 	// The second number has no country calling code. EXACT_MATCH is no
@@ -3187,9 +3771,12 @@ func IsNumberMatchWithOneNumber(
 	// this with NSN_MATCH.
 	firstNumberRegion := GetRegionCodeForCountryCode(firstNumber.GetCountryCode())
 
-	if firstNumberRegion != UNKNOWN_REGION {
+	// Numbers can't be parsed with the region of non-geographical entities,
+	// so for those we parse the second number without a region, as we do
+	// when the first number doesn't have a valid country calling code.
+	if firstNumberRegion != UNKNOWN_REGION && firstNumberRegion != REGION_CODE_FOR_NON_GEO_ENTITY {
 		secondNumberWithFirstNumberRegion, err :=
-			Parse(secondNumber, firstNumberRegion)
+			parseQuietly(secondNumber, firstNumberRegion)
 		if err != nil {
 			return NOT_A_NUMBER
 		}
@@ -3202,7 +3789,7 @@ func IsNumberMatchWithOneNumber(
 	} else {
 		// If the first number didn't have a valid country calling
 		// code, then we parse the second number without one as well.
-		var secondNumberProto *PhoneNumber
+		secondNumberProto := &PhoneNumber{}
 		err := parseHelper(secondNumber, "", false, false, secondNumberProto)
 		if err != nil {
 			return NOT_A_NUMBER
@@ -3240,56 +3827,85 @@ func IsMobileNumberPortableRegion(regionCode string) bool {
 	return metadata.GetMobileNumberPortableRegion()
 }
 
+// Preload unmarshals the metadata for the passed in regions and compiles the regular expressions
+// used to parse, validate and format their numbers, so that latency sensitive programs can pay
+// those costs at startup rather than on the first request for each region. Regions which aren't
+// supported are ignored.
+func Preload(regions ...string) {
+	for _, region := range regions {
+		if metadata := getMetadataForRegion(region); metadata != nil {
+			metadata.precompileRegexes()
+		}
+		if metadata := getShortNumberMetadataForRegion(region); metadata != nil {
+			metadata.precompileRegexes()
+		}
+	}
+}
+
+// PreloadAll is like Preload for every supported region and non-geographical calling code.
+func PreloadAll() {
+	tables := currentMetadata()
+
+	regions := make([]string, 0, len(tables.supportedRegions))
+	for region := range tables.supportedRegions {
+		regions = append(regions, region)
+	}
+	Preload(regions...)
+
+	for countryCode := range tables.countryCodesForNonGeographicalRegion {
+		if metadata := getMetadataForNonGeographicalRegion(countryCode); metadata != nil {
+			metadata.precompileRegexes()
+		}
+	}
+}
+
 func init() {
 	// load our regions
 	regionMap, err := loadIntStringArrayMap(regionMapData)
 	if err != nil {
 		panic(err)
 	}
-	countryCodeToRegion = regionMap.Map
-
 	// then our metadata
-	err = loadMetadataFromFile("US", 1)
+	tables, err := loadMetadataTables(regionMap.Map, nil)
 	if err != nil {
 		panic(err)
 	}
+	activeMetadata.Store(tables)
+}
 
-	for eKey, regionCodes := range countryCodeToRegion {
-		// We can assume that if the county calling code maps to the
-		// non-geo entity region code then that's the only region code
-		// it maps to.
-		if len(regionCodes) == 1 && REGION_CODE_FOR_NON_GEO_ENTITY == regionCodes[0] {
-			// This is the subset of all country codes that map to the
-			// non-geo entity region code.
-			countryCodesForNonGeographicalRegion[eKey] = true
-		} else {
-			// The supported regions set does not include the "001"
-			// non-geo entity region code.
-			for _, val := range regionCodes {
-				supportedRegions[val] = true
-			}
-		}
-
-		supportedCallingCodes[eKey] = true
+// RegisterCarrierData registers the encoded prefix to carrier data for each language. This
+// is called by the carrierdata package when imported and shouldn't be called directly.
+//
+// Like the other Register functions this is only safe to call from an init function.
+func RegisterCarrierData(data map[string]string) {
+	for lang, encoded := range data {
+		carrierMaps[lang] = &lazyPrefixMap{encoded: encoded, evictable: true}
 	}
-	// If the non-geo entity still got added to the set of supported
-	// regions it must be because there are entries that list the non-geo
-	// entity alongside normal regions (which is wrong). If we discover
-	// this, remove the non-geo entity from the set of supported regions
-	// and log (or not log).
-	delete(supportedRegions, REGION_CODE_FOR_NON_GEO_ENTITY)
+}
 
-	for _, val := range countryCodeToRegion[NANPA_COUNTRY_CODE] {
-		writeToNanpaRegions(val, struct{}{})
+// RegisterGeocodingData registers the encoded prefix to geocoding data for each language. This
+// is called by the geocodingdata package when imported and shouldn't be called directly.
+func RegisterGeocodingData(data map[string]string) {
+	for lang, encoded := range data {
+		geocodingMaps[lang] = &lazyPrefixMap{encoded: encoded, evictable: true}
 	}
+}
 
-	// Create our sync.Onces for each of our languages for carriers
-	for lang := range carrierMapData {
-		carrierOnces[lang] = &sync.Once{}
-	}
-	for lang := range geocodingMapData {
-		geocodingOnces[lang] = &sync.Once{}
+// RegisterTimezoneData registers the encoded prefix to timezone data. This is called by the
+// timezonedata package when imported and shouldn't be called directly.
+func RegisterTimezoneData(data string) {
+	timezoneMap = &lazyPrefixArrayMap{encoded: data}
+}
+
+// RegisterMccMncData registers the encoded prefix to MCC/MNC data. This is called by the package
+// generated by buildmetadata when imported and shouldn't be called directly. Registering empty
+// data unregisters it.
+func RegisterMccMncData(data string) {
+	if data == "" {
+		mccMncMap = nil
+		return
 	}
+	mccMncMap = &lazyPrefixMap{encoded: data}
 }
 
 // GetTimezonesForPrefix returns a slice of Timezones corresponding to the number passed
@@ -3297,21 +3913,21 @@ func init() {
 // The algorythm tries to match the timezones starting from the maximum
 // number of phone number digits and decreasing until it finds one or reaches 0
 func GetTimezonesForPrefix(number string) ([]string, error) {
-	var err error
-	timezoneOnce.Do(func() {
-		timezoneMap, err = loadIntStringArrayMap(timezoneMapData)
-	})
-
 	if timezoneMap == nil {
-		return nil, fmt.Errorf("error loading timezone map: %v", err)
+		return nil, ErrTimezoneDataNotLoaded
+	}
+
+	prefixMap, err := timezoneMap.get()
+	if err != nil {
+		return nil, fmt.Errorf("error loading timezone map: %w", err)
 	}
 
 	// strip any leading +
 	number = strings.TrimLeft(number, "+")
 
-	matchLength := len(number) // maxLength: min( len(number), timezoneMap.MaxLength )
-	if matchLength > timezoneMap.MaxLength {
-		matchLength = timezoneMap.MaxLength
+	matchLength := len(number) // maxLength: min( len(number), prefixMap.MaxLength )
+	if matchLength > prefixMap.MaxLength {
+		matchLength = prefixMap.MaxLength
 	}
 
 	for i := matchLength; i > 0; i-- {
@@ -3319,7 +3935,7 @@ func GetTimezonesForPrefix(number string) ([]string, error) {
 		if err != nil {
 			return nil, err
 		}
-		tzs, found := timezoneMap.Map[int32(index)]
+		tzs, found := prefixMap.Map[int32(index)]
 		if found {
 			return tzs, nil
 		}
@@ -3328,51 +3944,88 @@ func GetTimezonesForPrefix(number string) ([]string, error) {
 }
 
 // GetTimezonesForNumber returns the names of timezones which we believe maps to the
-// passed in number.
+// passed in number. As in the Java library, numbers which aren't geographical, such
+// as mobile numbers in most countries, get all the timezones of their country rather
+// than those of the fixed line numbers which happen to share their prefix. Numbers
+// of an unknown type are looked up by their prefix.
 func GetTimezonesForNumber(number *PhoneNumber) ([]string, error) {
+	numberType := GetNumberType(number)
+	if numberType != UNKNOWN && !isNumberTypeGeographical(numberType, number.GetCountryCode()) {
+		return getCountryLevelTimezones(number.GetCountryCode())
+	}
+	return GetTimezonesForGeographicalNumber(number)
+}
+
+// GetTimezonesForGeographicalNumber returns the names of timezones which we believe
+// maps to the passed in number based on its prefix alone, whatever its type.
+func GetTimezonesForGeographicalNumber(number *PhoneNumber) ([]string, error) {
 	e164 := Format(number, E164)
 	return GetTimezonesForPrefix(e164)
 }
 
-func getValueForNumber(onceMap map[string]*sync.Once, langMap map[string]*intStringMap, binMap map[string]string, language string, maxLength int, number *PhoneNumber) (string, int32, error) {
+// getCountryLevelTimezones returns the timezones of the whole country with the passed
+// in country code
+func getCountryLevelTimezones(countryCode int32) ([]string, error) {
+	if timezoneMap == nil {
+		return nil, ErrTimezoneDataNotLoaded
+	}
+
+	prefixMap, err := timezoneMap.get()
+	if err != nil {
+		return nil, fmt.Errorf("error loading timezone map: %w", err)
+	}
+
+	if tzs, found := prefixMap.Map[countryCode]; found {
+		return tzs, nil
+	}
+	return []string{UNKNOWN_TIMEZONE}, nil
+}
+
+func getValueForNumber(langMaps map[string]*lazyPrefixMap, language string, maxLength int, number *PhoneNumber) (string, int32, error) {
 	// do we have data for this language
-	_, existing := binMap[language]
+	langMap, existing := langMaps[language]
 	if !existing {
 		return "", 0, nil
 	}
 
-	// load it into our map
-	onceMap[language].Do(func() {
-		prefixMap, err := loadPrefixMap(binMap[language])
-		if err == nil {
-			langMap[language] = prefixMap
-		}
-	})
-
-	// do we have a map for this language?
-	prefixMap, ok := langMap[language]
-	if !ok {
-		return "", 0, fmt.Errorf("error loading language map for %s", language)
+	prefixMap, err := langMap.get()
+	if err != nil {
+		return "", 0, fmt.Errorf("error loading language map for %s: %w", language, err)
 	}
 
-	e164 := Format(number, E164)
-
-	l := len(e164)
-	if maxLength > l {
-		maxLength = l
+	// work out the value of each prefix of the number's digits, maxLength includes the leading + of
+	// its E164 format so we look at one less digit than that
+	var buf [32]byte
+	digits := appendE164Digits(buf[:0], number)
+	if maxLength-1 < len(digits) {
+		digits = digits[:maxLength-1]
 	}
-	for i := maxLength; i > 1; i-- {
-		index, err := strconv.ParseInt(e164[0:i], 10, 32)
-		if err != nil {
-			return "", 0, err
-		}
-		if value, has := prefixMap.Map[int32(index)]; has {
-			return value, int32(index), nil
+	var prefixes [32]int32
+	for i, digit := range digits {
+		prefixes[i+1] = prefixes[i]*10 + int32(digit-'0')
+	}
+
+	// then look for the longest prefix we have a value for, values are interned when the map is
+	// loaded so every number with the same value gets the same string
+	for i := len(digits); i > 0; i-- {
+		if value, has := prefixMap.Map[prefixes[i]]; has {
+			return value, prefixes[i], nil
 		}
 	}
 	return "", 0, nil
 }
 
+// appendE164Digits appends the digits of the passed in number in E164 format, without the leading +
+func appendE164Digits(buf []byte, number *PhoneNumber) []byte {
+	buf = strconv.AppendInt(buf, int64(number.GetCountryCode()), 10)
+	if number.GetItalianLeadingZero() {
+		for i := int32(0); i < number.GetNumberOfLeadingZeros(); i++ {
+			buf = append(buf, '0')
+		}
+	}
+	return strconv.AppendUint(buf, number.GetNationalNumber(), 10)
+}
+
 // GetCarrierForNumber returns the carrier we believe the number belongs to. Note due
 // to number porting this is only a guess, there is no guarantee to its accuracy.
 func GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
@@ -3382,8 +4035,9 @@ func GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
 
 // GetCarrierWithPrefixForNumber returns the carrier we believe the number belongs to, as well as
 // its prefix. Note due to number porting this is only a guess, there is no guarantee to its accuracy.
+// Carriers from overlays set with SetCarrierOverlay take precedence as described there.
 func GetCarrierWithPrefixForNumber(number *PhoneNumber, lang string) (string, int32, error) {
-	carrier, prefix, err := getValueForNumber(carrierOnces, carrierPrefixMap, carrierMapData, lang, 10, number)
+	carrier, prefix, err := getCarrierWithOverlay(number, lang)
 	if err != nil {
 		return "", 0, err
 	}
@@ -3392,32 +4046,103 @@ func GetCarrierWithPrefixForNumber(number *PhoneNumber, lang string) (string, in
 	}
 
 	// fallback to english
-	return getValueForNumber(carrierOnces, carrierPrefixMap, carrierMapData, "en", 10, number)
+	return getCarrierWithOverlay(number, "en")
+}
+
+// GetMccMncForNumber returns the mobile country code and mobile network code of the network we
+// believe the number belongs to, or empty strings if it isn't covered by the registered data. The
+// MNC is returned as a string as leading zeros are significant. Note due to number porting this is
+// only a guess, there is no guarantee to its accuracy.
+func GetMccMncForNumber(number *PhoneNumber) (string, string, error) {
+	if mccMncMap == nil {
+		return "", "", ErrMccMncDataNotLoaded
+	}
+
+	prefixMap, err := mccMncMap.get()
+	if err != nil {
+		return "", "", fmt.Errorf("error loading MCC/MNC map: %w", err)
+	}
+
+	digits := strings.TrimLeft(Format(number, E164), "+")
+
+	matchLength := len(digits)
+	if matchLength > prefixMap.MaxLength {
+		matchLength = prefixMap.MaxLength
+	}
+
+	for i := matchLength; i > 0; i-- {
+		index, err := strconv.ParseInt(digits[0:i], 10, 32)
+		if err != nil {
+			return "", "", err
+		}
+		if value, found := prefixMap.Map[int32(index)]; found {
+			mcc, mnc, _ := strings.Cut(value, "|")
+			return mcc, mnc, nil
+		}
+	}
+	return "", "", nil
 }
 
 // GetGeocodingForNumber returns the location we think the number was first acquired in. This is
 // just our best guess, there is no guarantee to its accuracy.
 func GetGeocodingForNumber(number *PhoneNumber, lang string) (string, error) {
-	geocoding, _, err := getValueForNumber(geocodingOnces, geocodingPrefixMap, geocodingMapData, lang, 10, number)
+	if len(geocodingMaps) == 0 {
+		return "", ErrGeocodingDataNotLoaded
+	}
+
+	geocoding, _, err := getValueForNumber(geocodingMaps, lang, 10, number)
 	if err != nil || geocoding != "" {
 		return geocoding, err
 	}
 
 	// fallback to english
-	geocoding, _, err = getValueForNumber(geocodingOnces, geocodingPrefixMap, geocodingMapData, "en", 10, number)
+	geocoding, _, err = getValueForNumber(geocodingMaps, "en", 10, number)
 	if err != nil || geocoding != "" {
 		return geocoding, err
 	}
 
 	// fallback to locale
-	var reg language.Region
-	if reg, err = language.ParseRegion(GetRegionCodeForNumber(number)); err != nil {
+	return getRegionDisplayName(GetRegionCodeForNumber(number), lang)
+}
+
+type regionDisplayNameKey struct {
+	region string
+	lang   string
+}
+
+// cache of the display names of regions in each language, so we don't need to parse both for every lookup
+var (
+	regionDisplayNames      = make(map[regionDisplayNameKey]string)
+	regionDisplayNamesMutex sync.RWMutex
+)
+
+// getRegionDisplayName returns the name of the passed in region in the passed in language, falling
+// back to English if the language isn't known
+func getRegionDisplayName(region, lang string) (string, error) {
+	key := regionDisplayNameKey{region: region, lang: lang}
+	regionDisplayNamesMutex.RLock()
+	name, found := regionDisplayNames[key]
+	regionDisplayNamesMutex.RUnlock()
+	if found {
+		return name, nil
+	}
+
+	reg, err := language.ParseRegion(region)
+	if err != nil {
 		return "", err
 	}
 
-	var langT language.Tag
-	if langT, err = language.Parse(lang); err != nil {
+	langT, err := language.Parse(lang)
+	if err != nil {
 		langT = language.English // fallback to english
 	}
-	return display.Regions(langT).Name(reg), nil
+	name = display.Regions(langT).Name(reg)
+
+	// languages come from callers so only cache those we have geocoding data for, to keep the cache bounded
+	if _, known := geocodingMaps[lang]; known {
+		regionDisplayNamesMutex.Lock()
+		regionDisplayNames[key] = name
+		regionDisplayNamesMutex.Unlock()
+	}
+	return name, nil
 }
@@ -1,11 +1,15 @@
 package phonenumbers
 
 import (
+	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -28,6 +32,17 @@ func TestParse(t *testing.T) {
 		{input: "967717105526", region: "YE", err: nil, expectedNum: 717105526},
 		{input: "+68672098006", region: "", err: nil, expectedNum: 72098006},
 		{input: "8409990936", region: "US", err: nil, expectedNum: 8409990936},
+
+		// invisible characters copied along with numbers
+		{input: "\u200E+1 650 253 0000\u200F", region: "", err: nil, expectedNum: 6502530000},
+		{input: "\u202A+1 (650) 253-0000\u202C", region: "", err: nil, expectedNum: 6502530000},
+		{input: "\u2066+44 20 7031 3000\u2069", region: "", err: nil, expectedNum: 2070313000},
+		{input: "650\u200B253\u200D0000", region: "US", err: nil, expectedNum: 6502530000},
+		{input: "650\u00A0253\u202F0000", region: "US", err: nil, expectedNum: 6502530000},
+		{input: "\uFEFF0788\u2007383\u2009383", region: "RW", err: nil, expectedNum: 788383383},
+		{input: "tel:253-0000;phone-context=+1-650;isub=1234", region: "US", err: nil, expectedNum: 6502530000},
+		{input: "tel:253-0000;phone-context=", region: "US", err: nil, expectedNum: 2530000},
+		{input: "253-0000;phone-context=tel:+1-650", region: "US", err: nil, expectedNum: 2530000},
 	}
 
 	for _, tc := range tests {
@@ -42,6 +57,128 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseErrors(t *testing.T) {
+	var tests = []struct {
+		input  string
+		region string
+		err    error
+	}{
+		{input: "", region: "US", err: ErrNotANumber},
+		{input: "hello", region: "US", err: ErrNotANumber},
+		{input: "6502530000", region: "", err: ErrInvalidCountryCode},
+		{input: "+999 1234 5678", region: "", err: ErrInvalidCountryCode},
+		{input: "011 12", region: "US", err: ErrTooShortAfterIDD},
+		{input: "+49 0", region: "", err: ErrTooShortNSN},
+		{input: "+1 650 253 0000 1234 5678 9", region: "", err: ErrTooLong},
+		{input: strings.Repeat("1", MAX_INPUT_STRING_LENGTH+1), region: "US", err: ErrTooLong},
+	}
+
+	for _, tc := range tests {
+		_, err := Parse(tc.input, tc.region)
+		assert.ErrorIs(t, err, tc.err, "error mismatch for input %s", tc.input)
+
+		_, err = ParseAndKeepRawInput(tc.input, tc.region)
+		assert.ErrorIs(t, err, tc.err, "error mismatch for input %s", tc.input)
+	}
+
+	// the old name for ErrTooLong still works
+	assert.ErrorIs(t, ErrTooLong, ErrNumTooLong)
+}
+
+func TestParseErrorPositions(t *testing.T) {
+	var tests = []struct {
+		input    string
+		char     rune
+		offset   int
+		position int
+		message  string
+	}{
+		{"650 25۔0000", '۔', 6, 6, "the phone number supplied is not a number: invalid character '۔' at position 6"},
+		{"١٢٣۔٤", '۔', 6, 3, "the phone number supplied is not a number: invalid character '۔' at position 3"},
+		{"+1 650 253 0000 ☎", '☎', 16, 16, "the phone number supplied is not a number: invalid character '☎' at position 16"},
+		{"tel: 650_253_0000", '_', 8, 8, "the phone number supplied is not a number: invalid character '_' at position 8"},
+	}
+
+	for _, tc := range tests {
+		_, err := Parse(tc.input, "US")
+		assert.ErrorIs(t, err, ErrNotANumber, "error mismatch for input %s", tc.input)
+		assert.EqualError(t, err, tc.message, "message mismatch for input %s", tc.input)
+
+		var parseErr *ParseError
+		if assert.ErrorAs(t, err, &parseErr, "error type mismatch for input %s", tc.input) {
+			assert.Equal(t, tc.char, parseErr.Char, "char mismatch for input %s", tc.input)
+			assert.Equal(t, tc.offset, parseErr.Offset, "offset mismatch for input %s", tc.input)
+			assert.Equal(t, tc.position, parseErr.Position, "position mismatch for input %s", tc.input)
+		}
+	}
+
+	// numbers which are only made up of valid characters don't get a position
+	for _, input := range []string{"hello", "190022+22222", "1"} {
+		_, err := Parse(input, "US")
+		assert.Equal(t, ErrNotANumber, err, "error mismatch for input %s", input)
+	}
+}
+
+func TestParseInto(t *testing.T) {
+	num := &PhoneNumber{}
+
+	err := ParseInto("+39 06 1234 5678 ext. 123", "US", num)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", num.GetExtension())
+	assert.True(t, num.GetItalianLeadingZero())
+
+	// nothing from the previous number should be carried over
+	err = ParseInto("6502530000", "US", num)
+	assert.NoError(t, err)
+	assert.Equal(t, "+16502530000", Format(num, E164))
+	assert.Equal(t, "", num.GetExtension())
+	assert.False(t, num.GetItalianLeadingZero())
+
+	expected, _ := Parse("6502530000", "US")
+	assert.True(t, proto.Equal(expected, num))
+
+	err = ParseInto("", "US", num)
+	assert.EqualError(t, err, ErrNotANumber.Error())
+}
+
+func TestMustParse(t *testing.T) {
+	expected, _ := Parse("650 253 0000", "US")
+	assert.True(t, proto.Equal(expected, MustParse("650 253 0000", "US")))
+
+	assert.PanicsWithValue(t, `phonenumbers: MustParse("", "US"): `+ErrNotANumber.Error(), func() { MustParse("", "US") })
+	assert.Panics(t, func() { MustParse("650 253 0000", "XX") })
+}
+
+func TestParseOrNil(t *testing.T) {
+	expected, _ := Parse("+44 20 7031 3000", "")
+	assert.True(t, proto.Equal(expected, ParseOrNil("+44 20 7031 3000", "")))
+
+	assert.Nil(t, ParseOrNil("", "US"))
+	assert.Nil(t, ParseOrNil("650 253 0000", "XX"))
+	assert.Nil(t, ParseOrNil("not a number", "US"))
+}
+
+func TestParseOnlyKeepsCanonicalFields(t *testing.T) {
+	// numbers parsed with ParseAndKeepRawInput keep how they were written
+	num, err := ParseAndKeepRawInput("012 3121286979", "BR")
+	require.NoError(t, err)
+	assert.Equal(t, "012 3121286979", num.GetRawInput())
+	assert.Equal(t, PhoneNumber_FROM_DEFAULT_COUNTRY, num.GetCountryCodeSource())
+
+	// but numbers parsed with Parse and ParseInto don't
+	num, err = Parse("012 3121286979", "BR")
+	require.NoError(t, err)
+	assert.Nil(t, num.RawInput)
+	assert.Nil(t, num.PreferredDomesticCarrierCode)
+	assert.Nil(t, num.CountryCodeSource)
+
+	err = ParseInto("012 3121286979", "BR", num)
+	require.NoError(t, err)
+	assert.Nil(t, num.RawInput)
+	assert.Nil(t, num.PreferredDomesticCarrierCode)
+	assert.Nil(t, num.CountryCodeSource)
+}
+
 func TestConvertAlphaCharactersInNumber(t *testing.T) {
 	var tests = []struct {
 		input, expected string
@@ -49,6 +186,8 @@ func TestConvertAlphaCharactersInNumber(t *testing.T) {
 		{input: "1800AWWPOOP", expected: "18002997667"},
 		{input: "(800) DAW-ORLD", expected: "(800) 329-6753"},
 		{input: "1800-ABC-DEF", expected: "1800-222-333"},
+		{input: "1800-abc-def", expected: "1800-222-333"},
+		{input: "1800–ABC–DEF", expected: "1800–222–333"},
 	}
 
 	for _, tc := range tests {
@@ -67,6 +206,9 @@ func TestNormalizeDigits(t *testing.T) {
 		{input: "(444)5556666", keepNonDigits: false, expected: []byte("4445556666")},
 		{input: "(444)555a6666", keepNonDigits: false, expected: []byte("4445556666")},
 		{input: "(444)555a6666", keepNonDigits: true, expected: []byte("(444)555a6666")},
+		{input: "(444) ٥٥٥ 6666", keepNonDigits: false, expected: []byte("4445556666")},
+		{input: "(444) ٥٥٥ 6666", keepNonDigits: true, expected: []byte("(444) 555 6666")},
+		{input: "４４４–555", keepNonDigits: true, expected: []byte("444–555")},
 	}
 
 	for _, tc := range tests {
@@ -187,6 +329,51 @@ func TestIsValidNumber(t *testing.T) {
 	}
 }
 
+func TestIsValidE164String(t *testing.T) {
+	var tests = []struct {
+		input   string
+		isValid bool
+	}{
+		{"+14437990238", true},
+		{"+441932567890", true},
+		{"+12424654321", true},
+		{"+16041234567", false},
+		{"+343511234567", false},
+		{"+2349090000001", true},
+		{"+40712276797", true},
+		{"+923260000000", true},
+		{"+390236618300", true},
+		{"+80012345678", true},
+		{"+80012345", false},
+		{"+9991234567", false},
+		{"+1", false},
+		{"+", false},
+		{"", false},
+		{"14437990238", false},
+		{"+1 443 799 0238", false},
+		{"+14437990238;ext=12", false},
+		{"+014437990238", false},
+		{"+4402071234567", false},
+		{"+112015550123", false},
+		{"+2001001234567", false},
+		{"+44123456789012345678", false},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.isValid, IsValidE164String(tc.input), "is valid mismatch for input %s", tc.input)
+
+		// for anything which parses back to the same E164 string we should agree with IsValidNumber,
+		// and anything which Parse rewrites by stripping a national prefix isn't valid E164
+		if num, err := Parse(tc.input, UNKNOWN_REGION); err == nil {
+			if Format(num, E164) == tc.input {
+				assert.Equal(t, IsValidNumber(num), IsValidE164String(tc.input), "mismatch with IsValidNumber for input %s", tc.input)
+			} else if !num.GetItalianLeadingZero() {
+				assert.False(t, IsValidE164String(tc.input), "non canonical input %s is valid", tc.input)
+			}
+		}
+	}
+}
+
 func TestIsValidNumberForRegion(t *testing.T) {
 	var tests = []struct {
 		input            string
@@ -219,6 +406,32 @@ func TestIsValidNumberForRegion(t *testing.T) {
 	}
 }
 
+func TestGetRegionCodesForNumber(t *testing.T) {
+	var tests = []struct {
+		input   string
+		regions []string
+		hint    string
+		region  string
+	}{
+		{input: "+16502530000", regions: []string{"US"}, hint: "CA", region: "US"},
+		{input: "+18765551234", regions: []string{"JM"}, hint: "US", region: "JM"},
+		{input: "+18002530000", regions: []string{"US", "AG", "AI", "AS", "BB", "BM", "BS", "CA", "DM", "DO", "GD", "GU", "JM", "KN", "KY", "LC", "MP", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI"}, hint: "CA", region: "CA"},
+		{input: "+18002530000", regions: []string{"US", "AG", "AI", "AS", "BB", "BM", "BS", "CA", "DM", "DO", "GD", "GU", "JM", "KN", "KY", "LC", "MP", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI"}, hint: "GB", region: "US"},
+		{input: "+77012345678", regions: []string{"KZ"}, hint: "RU", region: "KZ"},
+		{input: "+74951234567", regions: []string{"RU"}, hint: "KZ", region: "RU"},
+		{input: "+80012345678", regions: []string{"001"}, hint: "", region: "001"},
+		{input: "+15555555555", regions: nil, hint: "US", region: ""},
+	}
+
+	for _, tc := range tests {
+		num, err := Parse(tc.input, UNKNOWN_REGION)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.regions, GetRegionCodesForNumber(num), "regions mismatch for input %s", tc.input)
+		assert.Equal(t, tc.region, GetRegionCodeForNumberWithHint(num, tc.hint), "region mismatch for input %s with hint %s", tc.input, tc.hint)
+	}
+}
+
 func TestIsPossibleNumberWithReason(t *testing.T) {
 	var tests = []struct {
 		input  string
@@ -624,6 +837,18 @@ func TestIsNumberMatchWithOneNumber(t *testing.T) {
 		{
 			"0721 123456", "ES", "+43 721 123456", NO_MATCH,
 		},
+		{
+			"+800 1234 5678", "", "+80012345678", EXACT_MATCH,
+		},
+		{
+			"+800 1234 5678", "", "1234 5678", NSN_MATCH,
+		},
+		{
+			"+800 1234 5678", "", "5678", SHORT_NSN_MATCH,
+		},
+		{
+			"+800 1234 5678", "", "1234 5679", NO_MATCH,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -633,6 +858,55 @@ func TestIsNumberMatchWithOneNumber(t *testing.T) {
 			t.Errorf(`"%s"(%s) == "%s" returned %d when expecting %d`, tc.num1, tc.reg1, tc.num2, result, tc.expected)
 		}
 	}
+
+	// a first number without a valid country calling code
+	result := IsNumberMatchWithOneNumber(&PhoneNumber{CountryCode: 999, NationalNumber: 12345678}, "1234 5678")
+	if result != NSN_MATCH {
+		t.Errorf(`"+999 12345678" == "1234 5678" returned %d when expecting %d`, result, NSN_MATCH)
+	}
+}
+
+func TestNonGeographicalNumbers(t *testing.T) {
+	tests := []struct {
+		input         string
+		numberType    PhoneNumberType
+		valid         bool
+		international string
+		national      string
+		fromUS        string
+	}{
+		{"+800 1234 5678", TOLL_FREE, true, "+800 1234 5678", "1234 5678", "011 800 1234 5678"},
+		{"+808 1234 5678", SHARED_COST, true, "+808 1234 5678", "1234 5678", "011 808 1234 5678"},
+		{"+870 301 234 567", MOBILE, true, "+870 301 234 567", "301 234 567", "011 870 301 234 567"},
+		{"+979 1 2345 6789", PREMIUM_RATE, true, "+979 1 2345 6789", "1 2345 6789", "011 979 1 2345 6789"},
+		{"+882 348 5123 4567", VOICEMAIL, true, "+882 348 5123 4567", "348 5123 4567", "011 882 348 5123 4567"},
+		{"+883 510 012 345", VOIP, true, "+883 510 012 345", "510 012 345", "011 883 510 012 345"},
+		{"+888 123 456 78901", UAN, true, "+888 123 456 78901", "123 456 78901", "011 888 123 456 78901"},
+		{"+800 1234 567", UNKNOWN, false, "+800 1234567", "1234567", "011 800 1234567"},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.input, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, REGION_CODE_FOR_NON_GEO_ENTITY, GetRegionCodeForNumber(number), "region mismatch for %s", tc.input)
+		if tc.valid {
+			assert.Equal(t, []string{REGION_CODE_FOR_NON_GEO_ENTITY}, GetRegionCodesForNumber(number), "regions mismatch for %s", tc.input)
+		}
+		assert.Equal(t, tc.numberType, GetNumberType(number), "type mismatch for %s", tc.input)
+		assert.Equal(t, tc.valid, IsValidNumber(number), "valid mismatch for %s", tc.input)
+		assert.Equal(t, tc.valid, IsValidNumberForRegion(number, REGION_CODE_FOR_NON_GEO_ENTITY), "valid for region mismatch for %s", tc.input)
+		assert.False(t, IsValidNumberForRegion(number, "US"), "valid for US mismatch for %s", tc.input)
+		assert.Equal(t, tc.international, Format(number, INTERNATIONAL), "international mismatch for %s", tc.input)
+		assert.Equal(t, tc.national, Format(number, NATIONAL), "national mismatch for %s", tc.input)
+		assert.Equal(t, tc.fromUS, FormatOutOfCountryCallingNumber(number, "US"), "out of country mismatch for %s", tc.input)
+		assert.Equal(t, tc.international, FormatOutOfCountryCallingNumber(number, REGION_CODE_FOR_NON_GEO_ENTITY), "out of country mismatch for %s", tc.input)
+
+		// can also be dialled from abroad
+		fromCH, err := Parse("00"+NormalizeDigitsOnly(tc.input), "CH")
+		require.NoError(t, err)
+		assert.True(t, Equal(number, fromCH), "number mismatch for %s dialled from CH", tc.input)
+	}
 }
 
 ////////// Copied from java-libphonenumber
@@ -712,6 +986,30 @@ func getTestNumber(alias string) *PhoneNumber {
 	return val
 }
 
+func TestRegionIndex(t *testing.T) {
+	assert.Equal(t, 0, regionIndex("AA"))
+	assert.Equal(t, 20*26+18, regionIndex("US"))
+	assert.Equal(t, regionIndexSize-1, regionIndex("ZZ"))
+	for _, region := range []string{"", "U", "us", "USA", "001", "U1"} {
+		assert.Equal(t, -1, regionIndex(region), "expected no index for %s", region)
+	}
+
+	// every region and calling code we support can be found in our indexes
+	for region := range GetSupportedRegions() {
+		assert.True(t, isValidRegionCode(region), "expected %s to be valid", region)
+		assert.NotNil(t, getMetadataForRegion(region), "no metadata for %s", region)
+	}
+	for code := range GetSupportedCallingCodes() {
+		assert.NotEmpty(t, regionCodesForCountryCode(int32(code)), "no regions for %d", code)
+	}
+	assert.False(t, isValidRegionCode("us"))
+	assert.False(t, isValidRegionCode(REGION_CODE_FOR_NON_GEO_ENTITY))
+	assert.Nil(t, regionCodesForCountryCode(-1))
+	assert.Nil(t, regionCodesForCountryCode(1000))
+	assert.Nil(t, getMetadataForNonGeographicalRegion(1))
+	assert.NotNil(t, getMetadataForNonGeographicalRegion(800))
+}
+
 func TestGetSupportedRegions(t *testing.T) {
 	if len(GetSupportedRegions()) == 0 {
 		t.Error("there should be supported regions, found none")
@@ -815,6 +1113,22 @@ func TestIsNumberGeographical(t *testing.T) {
 	if isNumberGeographical(getTestNumber("INTERNATIONAL_TOLL_FREE")) {
 		t.Error("An international toll free number should not be geographical")
 	}
+
+	// mobile numbers are only geographical in some countries
+	tests := []struct {
+		number       string
+		geographical bool
+	}{
+		{"+5491187654321", true}, // Argentina
+		{"+5511961234567", true}, // Brazil
+		{"+447400123456", false}, // UK
+	}
+	for _, tc := range tests {
+		number, err := Parse(tc.number, UNKNOWN_REGION)
+		assert.NoError(t, err)
+		assert.Equal(t, MOBILE, GetNumberType(number), "type mismatch for %s", tc.number)
+		assert.Equal(t, tc.geographical, isNumberGeographical(number), "geographical mismatch for %s", tc.number)
+	}
 }
 
 func TestGetLengthOfGeographicalAreaCode(t *testing.T) {
@@ -850,6 +1164,33 @@ func TestGetCountryMobileToken(t *testing.T) {
 	}
 }
 
+func TestGetInternationalPrefixForRegion(t *testing.T) {
+	tests := []struct {
+		region         string
+		stripNonDigits bool
+		expected       string
+	}{
+		{"US", false, "011"},
+		{"GB", false, "00"},
+		{"AU", false, "0011"},
+		{"AU", true, "0011"},
+		{"NZ", false, "00"}, // has more than one, 00 is preferred
+		{"UA", false, "00"},
+		{"KR", false, ""}, // has more than one, none preferred
+		{"XX", false, ""},
+		{"001", false, ""},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, GetInternationalPrefixForRegion(tc.region, tc.stripNonDigits), "prefix mismatch for %s", tc.region)
+	}
+}
+
+func TestGetExtensionPrefixForRegion(t *testing.T) {
+	assert.Equal(t, " ext. ", GetExtensionPrefixForRegion("US"))
+	assert.Equal(t, "", GetExtensionPrefixForRegion("XX"))
+}
+
 func TestGetNationalSignificantNumber(t *testing.T) {
 	var tests = []struct {
 		name, exp string
@@ -898,6 +1239,25 @@ func TestGetExampleNumberForType(t *testing.T) {
 	}
 }
 
+func TestGetInvalidExampleNumber(t *testing.T) {
+	for region := range GetSupportedRegions() {
+		number := GetInvalidExampleNumber(region)
+		if assert.NotNil(t, number, "no invalid example number for %s", region) {
+			assert.False(t, IsValidNumber(number), "invalid example number for %s is valid", region)
+			assert.Equal(t, GetCountryCodeForRegion(region), number.GetCountryCode(), "country code mismatch for %s", region)
+		}
+	}
+
+	assert.Nil(t, GetInvalidExampleNumber("CS"))
+	assert.Nil(t, GetInvalidExampleNumber(REGION_CODE_FOR_NON_GEO_ENTITY))
+
+	// the numbers we try aren't parsed with the parse cache
+	SetParseCache(ParseCacheOptions{MaxEntries: 32})
+	defer SetParseCache(ParseCacheOptions{})
+	GetInvalidExampleNumber("GB")
+	assert.Equal(t, ParseCacheStats{}, GetParseCacheStats())
+}
+
 func TestGetExampleNumberForNonGeoEntity(t *testing.T) {
 	if !reflect.DeepEqual(
 		getTestNumber("INTERNATIONAL_TOLL_FREE"),
@@ -911,6 +1271,20 @@ func TestGetExampleNumberForNonGeoEntity(t *testing.T) {
 	}
 }
 
+func TestGetExampleNumberForEveryNonGeoEntity(t *testing.T) {
+	for countryCode := range GetSupportedGlobalNetworkCallingCodes() {
+		number := GetExampleNumberForNonGeoEntity(countryCode)
+		if assert.NotNil(t, number, "no example number for %d", countryCode) {
+			assert.Equal(t, countryCode, number.GetCountryCode(), "country code mismatch for %d", countryCode)
+			assert.True(t, IsValidNumber(number), "example number for %d isn't valid", countryCode)
+			assert.Equal(t, REGION_CODE_FOR_NON_GEO_ENTITY, GetRegionCodeForNumber(number), "region mismatch for %d", countryCode)
+		}
+	}
+
+	assert.Nil(t, GetExampleNumberForNonGeoEntity(1))
+	assert.Nil(t, GetExampleNumberForNonGeoEntity(999))
+}
+
 func TestNormalizeDigitsOnly(t *testing.T) {
 	if NormalizeDigitsOnly("034-56&+a#234") != "03456234" {
 		t.Errorf("didn't fully normalize digits only")
@@ -932,11 +1306,6 @@ type testCase struct {
 	isValidRegion bool
 }
 
-type timeZonesTestCases struct {
-	num               string
-	expectedTimeZones []string
-}
-
 func runTestBatch(t *testing.T, tests []testCase) {
 	for _, test := range tests {
 		n, err := Parse(test.num, test.parseRegion)
@@ -1135,228 +1504,58 @@ func TestParsing(t *testing.T) {
 	}
 }
 
-func TestGetTimeZonesForPrefix(t *testing.T) {
-	tests := []timeZonesTestCases{
-		{
-			num:               "+442073238299",
-			expectedTimeZones: []string{"Europe/London"},
-		},
-		{
-			num:               "+61491570156",
-			expectedTimeZones: []string{"Australia/Sydney"},
-		},
-		{
-			num:               "+61255501234",
-			expectedTimeZones: []string{"Australia/Sydney"},
-		},
-		{
-			num:               "+12067798181",
-			expectedTimeZones: []string{"America/Los_Angeles"},
-		},
-		{
-			num:               "+390399123456",
-			expectedTimeZones: []string{"Europe/Rome"},
-		},
-		{
-			num:               "+541151123456",
-			expectedTimeZones: []string{"America/Buenos_Aires"},
-		},
-		{
-			num:               "+15167706076",
-			expectedTimeZones: []string{"America/New_York"},
-		},
-		{
-			num:               "+917999999543",
-			expectedTimeZones: []string{"Asia/Calcutta"},
-		},
-		{
-			num:               "+540111561234567",
-			expectedTimeZones: []string{"America/Buenos_Aires"},
-		},
-		{
-			num:               "+18504320800",
-			expectedTimeZones: []string{"America/Chicago"},
-		},
-		{
-			num:               "+14079395277",
-			expectedTimeZones: []string{"America/New_York"},
-		},
-		{
-			num:               "+18508632167",
-			expectedTimeZones: []string{"America/Chicago"},
-		},
-		{
-			num:               "+40213158207",
-			expectedTimeZones: []string{"Europe/Bucharest"},
-		},
-		// UTC +5:45
-		{
-			num:               "+97714240520",
-			expectedTimeZones: []string{"Asia/Katmandu"},
-		},
-		// UTC -3:30
-		{
-			num:               "+17097264534",
-			expectedTimeZones: []string{"America/St_Johns"},
-		},
-		{
-			num:               "0000000000",
-			expectedTimeZones: []string{"Etc/Unknown"},
-		},
-		{
-			num:               "+31112",
-			expectedTimeZones: []string{"Europe/Amsterdam"},
-		},
-		{
-			num:               "+6837000",
-			expectedTimeZones: []string{"Pacific/Niue"},
-		},
-		{
-			num: "+1911",
-			expectedTimeZones: []string{
-				"America/Adak", "America/Anchorage", "America/Anguilla", "America/Antigua",
-				"America/Barbados", "America/Boise", "America/Cayman", "America/Chicago",
-				"America/Denver", "America/Dominica", "America/Edmonton", "America/Fort_Nelson",
-				"America/Grand_Turk", "America/Grenada", "America/Halifax", "America/Jamaica",
-				"America/Juneau", "America/Los_Angeles", "America/Lower_Princes", "America/Montserrat",
-				"America/Nassau", "America/New_York", "America/North_Dakota/Center",
-				"America/Phoenix", "America/Port_of_Spain", "America/Puerto_Rico",
-				"America/Regina", "America/Santo_Domingo", "America/St_Johns", "America/St_Kitts",
-				"America/St_Lucia", "America/St_Thomas", "America/St_Vincent", "America/Toronto",
-				"America/Tortola", "America/Vancouver", "America/Winnipeg", "Atlantic/Bermuda",
-				"Pacific/Guam", "Pacific/Honolulu", "Pacific/Pago_Pago", "Pacific/Saipan",
-			},
-		},
-	}
-
-	for _, test := range tests {
-		timeZones, err := GetTimezonesForPrefix(test.num)
-		if err != nil {
-			t.Errorf("Failed to getTimezone for the number %s: %s", test.num, err)
-		}
+func TestPrefixDataNotLoaded(t *testing.T) {
+	num, err := Parse("+8613702032331", "")
+	assert.NoError(t, err)
 
-		if len(timeZones) == 0 {
-			t.Errorf("Expected at least 1 timezone.")
-		}
+	_, err = GetCarrierForNumber(num, "en")
+	assert.Equal(t, ErrCarrierDataNotLoaded, err)
 
-		if !reflect.DeepEqual(timeZones, test.expectedTimeZones) {
-			t.Errorf("Expected '%v', got '%v' for '%s'", test.expectedTimeZones, timeZones, test.num)
-		}
+	_, err = GetGeocodingForNumber(num, "en")
+	assert.Equal(t, ErrGeocodingDataNotLoaded, err)
 
-		num, err := Parse(test.num, "")
-		if err != nil {
-			continue
-		}
+	_, err = GetTimezonesForNumber(num)
+	assert.Equal(t, ErrTimezoneDataNotLoaded, err)
+}
 
-		timeZones, err = GetTimezonesForNumber(num)
-		if err != nil {
-			t.Errorf("Failed to getTimezone for the number %s: %s", num, err)
-		}
+func TestGetMccMncForNumber(t *testing.T) {
+	num, err := Parse("+447700900123", "")
+	assert.NoError(t, err)
 
-		if len(timeZones) == 0 {
-			t.Errorf("Expected at least 1 timezone.")
-		}
+	_, _, err = GetMccMncForNumber(num)
+	assert.Equal(t, ErrMccMncDataNotLoaded, err)
 
-		if !reflect.DeepEqual(timeZones, test.expectedTimeZones) {
-			t.Errorf("Expected '%v', got '%v' for '%s'", test.expectedTimeZones, timeZones, num)
-		}
-	}
-}
+	// 447700 => 234|10, 4477009 => 234|15, 1201 => 310|410, 8613 => 460|00
+	RegisterMccMncData("H4sIAAAAAAAA/wA3AMj/HAAAADIzNHwxMAoyMzR8MTUKMzEwfDQxMAo0NjB8MDAEAAAAsQkCAPQ5AwCv5hoAAP329QEBAAMAA7SdjTcAAAA=")
+	defer RegisterMccMncData("")
 
-func TestGetCarrierForNumber(t *testing.T) {
 	tests := []struct {
-		num      string
-		lang     string
-		expected string
+		num string
+		mcc string
+		mnc string
 	}{
-		{num: "+8613702032331", lang: "en", expected: "China Mobile"},
-		{num: "+8613702032331", lang: "zh", expected: "中国移动"},
-		{num: "+6281377468527", lang: "en", expected: "Telkomsel"},
-		{num: "+8613323241342", lang: "en", expected: "China Telecom"},
-		{num: "+61491570156", lang: "en", expected: "Telstra"},
-		{num: "+917999999543", lang: "en", expected: "Reliance Jio"},
-		{num: "+593992218722", lang: "en", expected: "Claro"},
+		{"+447700900123", "234", "15"},
+		{"+447700100123", "234", "10"},
+		{"+12015550123", "310", "410"},
+		{"+8613702032331", "460", "00"},
+		{"+33612345678", "", ""},
 	}
-	for _, test := range tests {
-		number, err := Parse(test.num, "ZZ")
-		if err != nil {
-			t.Errorf("Failed to parse number %s: %s", test.num, err)
-		}
-		carrier, err := GetCarrierForNumber(number, test.lang)
-		if err != nil {
-			t.Errorf("Failed to getCarrier for the number %s: %s", test.num, err)
-		}
-		if test.expected != carrier {
-			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, carrier, test.num)
-		}
-	}
-}
+	for _, tc := range tests {
+		num, err := Parse(tc.num, "")
+		assert.NoError(t, err)
 
-func TestGetCarrierWithPrefixForNumber(t *testing.T) {
-	tests := []struct {
-		num             string
-		lang            string
-		expectedCarrier string
-		expectedPrefix  int32
-	}{
-		{num: "+8613702032331", lang: "en", expectedCarrier: "China Mobile", expectedPrefix: 86137},
-		{num: "+8613702032331", lang: "zh", expectedCarrier: "中国移动", expectedPrefix: 86137},
-		{num: "+6281377468527", lang: "en", expectedCarrier: "Telkomsel", expectedPrefix: 62813},
-		{num: "+8613323241342", lang: "en", expectedCarrier: "China Telecom", expectedPrefix: 86133},
-		{num: "+61491570156", lang: "en", expectedCarrier: "Telstra", expectedPrefix: 6149},
-		{num: "+917999999543", lang: "en", expectedCarrier: "Reliance Jio", expectedPrefix: 917999},
-		{num: "+593992218722", lang: "en", expectedCarrier: "Claro", expectedPrefix: 5939922},
-		{num: "+201987654321", lang: "en", expectedCarrier: "", expectedPrefix: 0},
+		mcc, mnc, err := GetMccMncForNumber(num)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.mcc, mcc, "mcc mismatch for %s", tc.num)
+		assert.Equal(t, tc.mnc, mnc, "mnc mismatch for %s", tc.num)
 	}
-	for _, test := range tests {
-		number, err := Parse(test.num, "ZZ")
-		if err != nil {
-			t.Errorf("Failed to parse number %s: %s", test.num, err)
-		}
-		carrier, prefix, err := GetCarrierWithPrefixForNumber(number, test.lang)
-		if err != nil {
-			t.Errorf("Failed to getCarrierWithPrefix for the number %s: %s", test.num, err)
-		}
-		if test.expectedCarrier != carrier {
-			t.Errorf("Expected '%s', got '%s' for '%s'", test.expectedCarrier, carrier, test.num)
-		}
-		if test.expectedPrefix != prefix {
-			t.Errorf("Expected '%d', got '%d' for '%s'", test.expectedPrefix, prefix, test.num)
-		}
-	}
-}
 
-func TestGetGeocodingForNumber(t *testing.T) {
-	tests := []struct {
-		num      string
-		lang     string
-		expected string
-	}{
-		{num: "+8613702032331", lang: "en", expected: "Tianjin"},
-		{num: "+8613702032331", lang: "zh", expected: "天津市"},
-		{num: "+863197785050", lang: "zh", expected: "河北省邢台市"},
-		{num: "+8613323241342", lang: "en", expected: "Baoding, Hebei"},
-		{num: "+917999999543", lang: "en", expected: "Ahmedabad Local, Gujarat"},
-		{num: "+17047181840", lang: "en", expected: "North Carolina"},
-		{num: "+12542462158", lang: "en", expected: "Texas"},
-		{num: "+16193165996", lang: "en", expected: "California"},
-		{num: "+12067799191", lang: "en", expected: "Washington State"},
-		{num: "+447825602614", lang: "en", expected: "United Kingdom"},
-	}
-	for _, test := range tests {
-		number, err := Parse(test.num, "ZZ")
-		if err != nil {
-			t.Errorf("Failed to parse number %s: %s", test.num, err)
-		}
-		geocoding, err := GetGeocodingForNumber(number, test.lang)
-		if err != nil {
-			t.Errorf("Failed to getGeocoding for the number %s: %s", test.num, err)
-		}
-		if test.expected != geocoding {
-			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, geocoding, test.num)
-		}
-	}
+	// registering empty data unregisters it
+	RegisterMccMncData("")
+	_, _, err = GetMccMncForNumber(num)
+	assert.Equal(t, ErrMccMncDataNotLoaded, err)
 }
+
 func TestMaybeStripExtension(t *testing.T) {
 	var tests = []struct {
 		input     string
@@ -1517,6 +1716,120 @@ func TestGetSupportedCallingCodes(t *testing.T) {
 	}
 }
 
+func TestLoadMetadataCollection(t *testing.T) {
+	collection, err := BuildPhoneMetadataCollection([]byte(`
+<phoneNumberMetadata>
+  <territories>
+    <territory id="AD" countryCode="376" internationalPrefix="00">
+      <generalDesc>
+        <nationalNumberPattern>[1-9]\d{5}</nationalNumberPattern>
+      </generalDesc>
+      <fixedLine>
+        <possibleLengths national="6"/>
+        <exampleNumber>712345</exampleNumber>
+        <nationalNumberPattern>7\d{5}</nationalNumberPattern>
+      </fixedLine>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`), false, false, false)
+	assert.NoError(t, err)
+
+	assert.Equal(t, ErrEmptyMetadata, LoadMetadataCollection(&PhoneMetadataCollection{}))
+
+	err = LoadMetadataCollection(collection)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{"AD": true}, GetSupportedRegions())
+	assert.Equal(t, map[int32]bool{376: true}, GetSupportedCallingCodes())
+
+	num, err := Parse("712345", "AD")
+	assert.NoError(t, err)
+	assert.True(t, IsValidNumber(num))
+
+	num, err = Parse("812345", "AD")
+	assert.NoError(t, err)
+	assert.False(t, IsValidNumber(num))
+
+	_, err = Parse("2015550123", "US")
+	assert.Equal(t, ErrInvalidCountryCode, err)
+
+	err = ResetMetadata()
+	assert.NoError(t, err)
+
+	assert.True(t, GetSupportedRegions()["US"])
+
+	num, err = Parse("2015550123", "US")
+	assert.NoError(t, err)
+	assert.True(t, IsValidNumber(num))
+}
+
+func TestLazyMetadata(t *testing.T) {
+	err := ResetMetadata()
+	assert.NoError(t, err)
+
+	// nothing is unmarshalled until a region is used
+	assert.Nil(t, currentMetadata().regionToMetadata[regionIndex("RW")].metadata)
+
+	num, err := Parse("0788383383", "RW")
+	assert.NoError(t, err)
+	assert.True(t, IsValidNumber(num))
+	assert.NotNil(t, currentMetadata().regionToMetadata[regionIndex("RW")].metadata)
+	assert.Nil(t, currentMetadata().regionToMetadata[regionIndex("RW")].encoded)
+
+	// every region is indexed by its own metadata
+	for index, metadata := range currentMetadata().regionToMetadata {
+		if metadata != nil {
+			assert.Equal(t, index, regionIndex(metadata.get().GetId()))
+		}
+	}
+	for code, metadata := range currentMetadata().countryCodeToNonGeographicalMetadata {
+		if metadata != nil {
+			assert.Equal(t, int32(code), metadata.get().GetCountryCode())
+		}
+	}
+	for region, metadata := range shortNumberRegionToMetadataMap {
+		assert.Equal(t, region, metadata.get().GetId())
+	}
+
+	// and matches what we get unmarshalling the whole collection
+	collection, err := MetadataCollection()
+	assert.NoError(t, err)
+	for _, metadata := range collection.GetMetadata() {
+		if metadata.GetId() != REGION_CODE_FOR_NON_GEO_ENTITY {
+			assert.True(t, proto.Equal(metadata, getMetadataForRegion(metadata.GetId())), "metadata mismatch for %s", metadata.GetId())
+		}
+	}
+}
+
+func TestPreload(t *testing.T) {
+	err := ResetMetadata()
+	assert.NoError(t, err)
+
+	Preload("RW", "XX")
+	assert.NotNil(t, currentMetadata().regionToMetadata[regionIndex("RW")].metadata)
+	assert.NotNil(t, shortNumberRegionToMetadataMap["RW"].metadata)
+	assert.Nil(t, currentMetadata().regionToMetadata[regionIndex("GB")].metadata)
+
+	_, cached := readFromRegexCache("^(?:" + getMetadataForRegion("RW").GetMobile().GetNationalNumberPattern() + ")$")
+	assert.True(t, cached)
+
+	PreloadAll()
+	for index, metadata := range currentMetadata().regionToMetadata {
+		if metadata != nil {
+			assert.NotNil(t, metadata.metadata, "metadata not loaded for region index %d", index)
+		}
+	}
+	for code, metadata := range currentMetadata().countryCodeToNonGeographicalMetadata {
+		if metadata != nil {
+			assert.NotNil(t, metadata.metadata, "metadata not loaded for %d", code)
+		}
+	}
+
+	num, err := Parse("+250788383383", "")
+	assert.NoError(t, err)
+	assert.True(t, IsValidNumber(num))
+}
+
 func TestMergeLengths(t *testing.T) {
 	var tests = []struct {
 		l1     []int32
@@ -1590,6 +1903,82 @@ func TestRegexCacheRead(t *testing.T) {
 	}
 }
 
+func TestRegexCacheConcurrent(t *testing.T) {
+	patterns := make([]string, 200)
+	for i := range patterns {
+		patterns[i] = fmt.Sprintf("TestRegexCacheConcurrent%d", i)
+	}
+
+	// hammer the cache from lots of goroutines, all of which should end up with the same regexes
+	results := make([][]*regexp.Regexp, 16)
+	var wg sync.WaitGroup
+	for g := range results {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			results[g] = make([]*regexp.Regexp, len(patterns))
+			for i, pattern := range patterns {
+				results[g][i] = regexFor(pattern)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for i, pattern := range patterns {
+		cached, found := readFromRegexCache(pattern)
+		assert.True(t, found)
+		for g := range results {
+			assert.Same(t, cached, results[g][i])
+		}
+	}
+}
+
+func TestConcurrentFirstUse(t *testing.T) {
+	// start from scratch so nothing has been decoded or compiled yet
+	require.NoError(t, ResetMetadata())
+
+	regions := []string{"US", "GB", "RW", "DE", "BR", "IN", "JP", "AU", "FR", "NG", "MX"}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := range regions {
+				region := regions[(g+i)%len(regions)]
+				num := GetExampleNumber(region)
+				if !assert.NotNil(t, num, "no example number for %s", region) {
+					continue
+				}
+
+				formatted := Format(num, INTERNATIONAL)
+				parsed, err := Parse(formatted, region)
+				assert.NoError(t, err)
+				assert.True(t, IsValidNumber(parsed), "%s not valid for %s", formatted, region)
+				assert.True(t, IsValidE164String(Format(parsed, E164)))
+				assert.Equal(t, region, GetRegionCodeForNumber(parsed))
+				GetNumberType(parsed)
+				IsPossibleShortNumberForRegion(parsed, region)
+				GetMemStats()
+			}
+			_, err := MetadataCollection()
+			assert.NoError(t, err)
+		}(g)
+	}
+	wg.Wait()
+}
+
+func BenchmarkRegexFor(b *testing.B) {
+	pattern := "^(?:" + getMetadataForRegion("US").GetGeneralDesc().GetNationalNumberPattern() + ")$"
+	regexFor(pattern)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			regexFor(pattern)
+		}
+	})
+}
+
 func TestRegexCacheStrict(t *testing.T) {
 	const expectedResult = "(41) 3020-3445"
 	phoneToTest := &PhoneNumber{
@@ -1616,3 +2005,90 @@ func TestRegexCacheStrict(t *testing.T) {
 func s(str string) *string {
 	return &str
 }
+
+func BenchmarkParse(b *testing.B) {
+	inputs := []struct {
+		number string
+		region string
+	}{
+		{"+1 650 253 0000", "US"},
+		{"(650) 253-0000", "US"},
+		{"0788 383 383", "RW"},
+		{"+44 20 7031 3000 ext. 1234", "GB"},
+		{"011 44 20 7031 3000", "US"},
+	}
+	for _, input := range inputs {
+		Parse(input.number, input.region)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input := inputs[i%len(inputs)]
+		Parse(input.number, input.region)
+	}
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	inputs := []string{"+1 650 253 0000", "+44 20 7031 3000 ext. 1234", "+250 788 383 383"}
+	pool := sync.Pool{New: func() interface{} { return &PhoneNumber{} }}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		num := pool.Get().(*PhoneNumber)
+		ParseInto(inputs[i%len(inputs)], "US", num)
+		pool.Put(num)
+	}
+}
+
+func BenchmarkIsValidE164String(b *testing.B) {
+	inputs := []string{"+16502530000", "+442070313000", "+250788383383", "+9991234567", "+1650253000000000"}
+	for _, input := range inputs {
+		IsValidE164String(input)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		IsValidE164String(inputs[i%len(inputs)])
+	}
+}
+
+func BenchmarkNormalize(b *testing.B) {
+	inputs := []string{
+		"+1 (650) 253-0000",
+		"0788 383 383",
+		"1-800-FLOWERS",
+		"+٩٧١ ٥٠ ١٢٣ ٤٥٦٧",
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		normalize(inputs[i%len(inputs)])
+		NormalizeDigitsOnly(inputs[i%len(inputs)])
+	}
+}
+
+func BenchmarkGetRegionCodeForNumber(b *testing.B) {
+	var numbers []*PhoneNumber
+	for _, input := range []string{"+16502530000", "+14165550123", "+442070313000", "+250788383383", "+80012345678", "+79101234567"} {
+		number, err := Parse(input, UNKNOWN_REGION)
+		if err != nil {
+			b.Fatal(err)
+		}
+		numbers = append(numbers, number)
+	}
+	for _, number := range numbers {
+		GetRegionCodeForNumber(number)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		number := numbers[i%len(numbers)]
+		GetRegionCodeForNumber(number)
+		getMetadataForRegionOrCallingCode(number.GetCountryCode(), GetRegionCodeForCountryCode(number.GetCountryCode()))
+	}
+}
@@ -0,0 +1,142 @@
+// Package phonenumberstest provides helpers for testing code which uses the phonenumbers package,
+// such as assertions about how numbers parse, validate and format, and fixtures of valid numbers
+// for each region so that tests don't need to hard code numbers which may stop being valid when
+// a numbering plan changes.
+package phonenumberstest
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// the number types fixtures are available for
+var fixtureTypes = []phonenumbers.PhoneNumberType{
+	phonenumbers.FIXED_LINE,
+	phonenumbers.MOBILE,
+	phonenumbers.TOLL_FREE,
+	phonenumbers.PREMIUM_RATE,
+	phonenumbers.SHARED_COST,
+	phonenumbers.VOIP,
+	phonenumbers.PERSONAL_NUMBER,
+	phonenumbers.PAGER,
+	phonenumbers.UAN,
+	phonenumbers.VOICEMAIL,
+}
+
+// AssertParses asserts that the passed in string can be parsed for the passed in region, returning
+// the parsed number, or nil if it can't be
+func AssertParses(t testing.TB, s, region string) *phonenumbers.PhoneNumber {
+	t.Helper()
+
+	number, err := phonenumbers.Parse(s, region)
+	if err != nil {
+		t.Errorf("expected %q to parse for region %s, got error: %s", s, region, err)
+		return nil
+	}
+	return number
+}
+
+// AssertValid asserts that the passed in string parses to a valid number for the passed in region,
+// returning the parsed number
+func AssertValid(t testing.TB, s, region string) *phonenumbers.PhoneNumber {
+	t.Helper()
+
+	number := AssertParses(t, s, region)
+	if number != nil && !phonenumbers.IsValidNumber(number) {
+		t.Errorf("expected %q to be a valid number for region %s", s, region)
+	}
+	return number
+}
+
+// AssertInvalid asserts that the passed in string either doesn't parse or isn't a valid number
+// for the passed in region
+func AssertInvalid(t testing.TB, s, region string) {
+	t.Helper()
+
+	number, err := phonenumbers.Parse(s, region)
+	if err == nil && phonenumbers.IsValidNumber(number) {
+		t.Errorf("expected %q to be an invalid number for region %s", s, region)
+	}
+}
+
+// AssertType asserts that the passed in string parses to a number of the passed in type
+func AssertType(t testing.TB, s, region string, want phonenumbers.PhoneNumberType) {
+	t.Helper()
+
+	number := AssertParses(t, s, region)
+	if number == nil {
+		return
+	}
+	if got := phonenumbers.GetNumberType(number); got != want {
+		t.Errorf("expected %q to have type %d for region %s, got %d", s, want, region, got)
+	}
+}
+
+// AssertRegion asserts that the passed in string parses to a number for the passed in region
+func AssertRegion(t testing.TB, s, region string, want string) {
+	t.Helper()
+
+	number := AssertParses(t, s, region)
+	if number == nil {
+		return
+	}
+	if got := phonenumbers.GetRegionCodeForNumber(number); got != want {
+		t.Errorf("expected %q to be a number for region %s, got %q", s, want, got)
+	}
+}
+
+// AssertFormatsAs asserts that the passed in string parses to a number which is formatted as want
+// in the passed in format
+func AssertFormatsAs(t testing.TB, s, region string, format phonenumbers.PhoneNumberFormat, want string) {
+	t.Helper()
+
+	number := AssertParses(t, s, region)
+	if number == nil {
+		return
+	}
+	if got := phonenumbers.Format(number, format); got != want {
+		t.Errorf("expected %q to format as %q for region %s, got %q", s, want, region, got)
+	}
+}
+
+// ValidNumber returns a valid number of the passed in type for the passed in region in E164
+// format, or an empty string if there isn't one. Numbers are taken from the example numbers in the
+// current metadata, so they stay valid as numbering plans change. Example numbers which don't
+// validate as the region and type they are given for are skipped.
+func ValidNumber(region string, numberType phonenumbers.PhoneNumberType) string {
+	number := phonenumbers.GetExampleNumberForType(region, numberType)
+	if number == nil || !phonenumbers.IsValidNumberForRegion(number, region) {
+		return ""
+	}
+
+	// numbers which could be either are only reported as such
+	actualType := phonenumbers.GetNumberType(number)
+	if actualType != numberType && !(actualType == phonenumbers.FIXED_LINE_OR_MOBILE && (numberType == phonenumbers.FIXED_LINE || numberType == phonenumbers.MOBILE)) {
+		return ""
+	}
+	return phonenumbers.Format(number, phonenumbers.E164)
+}
+
+// ValidNumbers returns a valid number of each type the passed in region has, in E164 format
+func ValidNumbers(region string) map[phonenumbers.PhoneNumberType]string {
+	numbers := make(map[phonenumbers.PhoneNumberType]string)
+	for _, numberType := range fixtureTypes {
+		if number := ValidNumber(region, numberType); number != "" {
+			numbers[numberType] = number
+		}
+	}
+	return numbers
+}
+
+// Regions returns all the supported regions, sorted, e.g. to run a test against numbers from
+// every region
+func Regions() []string {
+	regions := make([]string, 0, len(phonenumbers.GetSupportedRegions()))
+	for region := range phonenumbers.GetSupportedRegions() {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
@@ -0,0 +1,71 @@
+package phonenumberstest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/phonenumberstest"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder is a testing.TB which records failures rather than failing the test
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertions(t *testing.T) {
+	r := &recorder{TB: t}
+
+	assert.NotNil(t, phonenumberstest.AssertValid(r, "0788 383 383", "RW"))
+	phonenumberstest.AssertInvalid(r, "555 555 5555", "US")
+	phonenumberstest.AssertInvalid(r, "abc", "US")
+	phonenumberstest.AssertType(r, "0788 383 383", "RW", phonenumbers.MOBILE)
+	phonenumberstest.AssertRegion(r, "+1 416 555 1234", "US", "CA")
+	phonenumberstest.AssertFormatsAs(r, "6502530000", "US", phonenumbers.INTERNATIONAL, "+1 650-253-0000")
+	assert.Empty(t, r.errors)
+
+	assert.Nil(t, phonenumberstest.AssertParses(r, "abc", "US"))
+	phonenumberstest.AssertValid(r, "555 555 5555", "US")
+	phonenumberstest.AssertInvalid(r, "0788 383 383", "RW")
+	phonenumberstest.AssertType(r, "0788 383 383", "RW", phonenumbers.FIXED_LINE)
+	phonenumberstest.AssertRegion(r, "6502530000", "US", "CA")
+	phonenumberstest.AssertFormatsAs(r, "6502530000", "US", phonenumbers.NATIONAL, "650-253-0000")
+	phonenumberstest.AssertFormatsAs(r, "abc", "US", phonenumbers.NATIONAL, "650-253-0000")
+
+	assert.Equal(t, []string{
+		`expected "abc" to parse for region US, got error: the phone number supplied is not a number`,
+		`expected "555 555 5555" to be a valid number for region US`,
+		`expected "0788 383 383" to be an invalid number for region RW`,
+		`expected "0788 383 383" to have type 0 for region RW, got 1`,
+		`expected "6502530000" to be a number for region CA, got "US"`,
+		`expected "6502530000" to format as "650-253-0000" for region US, got "(650) 253-0000"`,
+		`expected "abc" to parse for region US, got error: the phone number supplied is not a number`,
+	}, r.errors)
+}
+
+func TestValidNumbers(t *testing.T) {
+	assert.Equal(t, "", phonenumberstest.ValidNumber("XX", phonenumbers.MOBILE))
+
+	rw := phonenumberstest.ValidNumbers("RW")
+	assert.Contains(t, rw, phonenumbers.MOBILE)
+	assert.Contains(t, rw, phonenumbers.FIXED_LINE)
+	assert.NotContains(t, rw, phonenumbers.PAGER)
+
+	for _, region := range phonenumberstest.Regions() {
+		for numberType, number := range phonenumberstest.ValidNumbers(region) {
+			num := phonenumberstest.AssertValid(t, number, region)
+			if num != nil {
+				assert.Contains(t, []phonenumbers.PhoneNumberType{numberType, phonenumbers.FIXED_LINE_OR_MOBILE}, phonenumbers.GetNumberType(num), "type mismatch for %s", number)
+				assert.True(t, phonenumbers.IsValidNumberForRegion(num, region), "region mismatch for %s", number)
+			}
+		}
+	}
+}
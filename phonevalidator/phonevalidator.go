@@ -0,0 +1,75 @@
+// Package phonevalidator registers phone number validation tags with github.com/go-playground/validator.
+// It's a separate module so that only those who use it depend on the validator package.
+//
+//	validate := validator.New()
+//	phonevalidator.Register(validate)
+//
+//	type Contact struct {
+//		Country string
+//		Phone   string `validate:"phone=Country"` // valid for the region in the Country field
+//		Mobile  string `validate:"phone=US"`      // valid, and if not in international format, parsed as US
+//		Fax     string `validate:"omitempty,e164"`
+//	}
+package phonevalidator
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// Register registers the following tags with the passed in validator, for use on string fields:
+//
+//   - phone: the field must be a valid number, in international format unless a region is given
+//     as the tag's parameter. The parameter can be a two letter region code, or the name of another
+//     field of the struct which holds the region, e.g. phone=US or phone=Country. Parameters of two
+//     upper case letters are always taken to be region codes. If the region is taken from a field
+//     which is empty, the number must be in international format.
+//   - e164: the field must be a valid number in strict E164 format, e.g. +16502530000. This replaces
+//     the validator's built in e164 tag, which only checks the format of the number.
+func Register(validate *validator.Validate) error {
+	if err := validate.RegisterValidation("phone", validatePhone); err != nil {
+		return err
+	}
+	return validate.RegisterValidation("e164", validateE164)
+}
+
+func validatePhone(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	num, err := phonenumbers.Parse(field.String(), regionFor(fl))
+	return err == nil && phonenumbers.IsValidNumber(num)
+}
+
+func validateE164(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	return field.Kind() == reflect.String && phonenumbers.IsValidE164String(field.String())
+}
+
+// regionFor returns the region to parse the field being validated with, which is the tag parameter
+// itself if it's a region code, otherwise the value of the field it names
+func regionFor(fl validator.FieldLevel) string {
+	param := fl.Param()
+	if param == "" {
+		return phonenumbers.UNKNOWN_REGION
+	}
+	if isRegionCode(param) {
+		return param
+	}
+
+	regionField, kind, _, found := fl.GetStructFieldOKAdvanced2(fl.Parent(), param)
+	if !found || kind != reflect.String || regionField.String() == "" {
+		return phonenumbers.UNKNOWN_REGION
+	}
+	return strings.ToUpper(regionField.String())
+}
+
+// isRegionCode returns whether the passed in tag parameter is a region code, i.e. two upper case letters
+func isRegionCode(param string) bool {
+	return len(param) == 2 && param[0] >= 'A' && param[0] <= 'Z' && param[1] >= 'A' && param[1] <= 'Z'
+}
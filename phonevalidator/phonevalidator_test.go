@@ -0,0 +1,57 @@
+package phonevalidator_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/nyaruka/phonenumbers/phonevalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type contact struct {
+	Country string
+	Phone   string `validate:"phone=Country"`
+	Mobile  string `validate:"omitempty,phone=RW"`
+	Other   string `validate:"omitempty,phone"`
+	Fax     string `validate:"omitempty,e164"`
+}
+
+func TestRegister(t *testing.T) {
+	validate := validator.New()
+	require.NoError(t, phonevalidator.Register(validate))
+
+	tests := []struct {
+		contact contact
+		invalid []string
+	}{
+		{contact{Country: "US", Phone: "(650) 253-0000"}, nil},
+		{contact{Country: "gb", Phone: "020 7031 3000"}, nil},
+		{contact{Phone: "+442070313000"}, nil},
+		{contact{Phone: "020 7031 3000"}, []string{"Phone"}},
+		{contact{Country: "US", Phone: "020 7031 3000"}, []string{"Phone"}},
+		{contact{Country: "US"}, []string{"Phone"}},
+		{contact{Phone: "+16502530000", Mobile: "0788 383 383", Other: "+1 650 253 0000", Fax: "+16502530000"}, nil},
+		{contact{Phone: "+16502530000", Mobile: "(650) 253-0000"}, []string{"Mobile"}},
+		{contact{Phone: "+16502530000", Other: "(650) 253-0000"}, []string{"Other"}},
+		{contact{Phone: "+16502530000", Fax: "+1 650 253 0000"}, []string{"Fax"}},
+		{contact{Phone: "+16502530000", Fax: "+1650253000"}, []string{"Fax"}},
+	}
+
+	for _, tc := range tests {
+		err := validate.Struct(tc.contact)
+
+		var invalid []string
+		if err != nil {
+			for _, fieldErr := range err.(validator.ValidationErrors) {
+				invalid = append(invalid, fieldErr.Field())
+			}
+		}
+		assert.Equal(t, tc.invalid, invalid, "mismatch for %+v", tc.contact)
+	}
+
+	// tags on anything other than strings always fail
+	assert.Error(t, validate.Var(16502530000, "phone"))
+	assert.Error(t, validate.Var(16502530000, "e164"))
+	assert.NoError(t, validate.Var("+16502530000", "phone"))
+}
@@ -0,0 +1,151 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Policy decides which numbers are allowed, e.g. to block calls and messages to sanctioned regions
+// or premium rate numbers consistently across services. Numbers matching any deny rule are denied.
+// Otherwise, for each kind of rule with an allow list, numbers must match one of its entries.
+// Empty lists have no effect, so the zero Policy allows everything.
+type Policy struct {
+	AllowRegions []string // region codes, e.g. "US", or "001" for non-geographical numbers
+	DenyRegions  []string
+
+	AllowCountryCodes []int32 // country calling codes, e.g. 44
+	DenyCountryCodes  []int32
+
+	AllowTypes []PhoneNumberType // number types, e.g. MOBILE
+	DenyTypes  []PhoneNumberType
+
+	// prefixes of the number in E164 format without the +, e.g. "1900", or ranges of prefixes of the
+	// same length, e.g. "44870-44873"
+	AllowPrefixes []string
+	DenyPrefixes  []string
+
+	// whether numbers which aren't valid are denied
+	DenyInvalid bool
+}
+
+// Verdict is the result of evaluating a number against a policy
+type Verdict struct {
+	Allowed bool
+	Reason  string // why the number was denied, e.g. "region KP is denied"
+}
+
+// Evaluate returns whether the passed in number is allowed by this policy, and if not, why not
+func (p *Policy) Evaluate(number *PhoneNumber) Verdict {
+	if p.DenyInvalid && !IsValidNumber(number) {
+		return deny("number is invalid")
+	}
+
+	region := regionOrUnknown(GetRegionCodeForNumber(number))
+	if containsString(p.DenyRegions, region) {
+		return deny("region %s is denied", region)
+	}
+	if len(p.AllowRegions) > 0 && !containsString(p.AllowRegions, region) {
+		return deny("region %s is not allowed", region)
+	}
+
+	countryCode := number.GetCountryCode()
+	if containsCountryCode(p.DenyCountryCodes, countryCode) {
+		return deny("country code %d is denied", countryCode)
+	}
+	if len(p.AllowCountryCodes) > 0 && !containsCountryCode(p.AllowCountryCodes, countryCode) {
+		return deny("country code %d is not allowed", countryCode)
+	}
+
+	if len(p.DenyTypes) > 0 || len(p.AllowTypes) > 0 {
+		numberType := GetNumberType(number)
+		if containsNumberType(p.DenyTypes, numberType) {
+			return deny("number type %s is denied", policyNumberTypeNames[numberType])
+		}
+		if len(p.AllowTypes) > 0 && !containsNumberType(p.AllowTypes, numberType) {
+			return deny("number type %s is not allowed", policyNumberTypeNames[numberType])
+		}
+	}
+
+	if len(p.DenyPrefixes) > 0 || len(p.AllowPrefixes) > 0 {
+		digits := strconv.Itoa(int(countryCode)) + GetNationalSignificantNumber(number)
+		if prefix := matchingPrefix(p.DenyPrefixes, digits); prefix != "" {
+			return deny("prefix %s is denied", prefix)
+		}
+		if len(p.AllowPrefixes) > 0 && matchingPrefix(p.AllowPrefixes, digits) == "" {
+			return deny("prefix is not allowed")
+		}
+	}
+
+	return Verdict{Allowed: true}
+}
+
+// the names of number types used in verdict reasons
+var policyNumberTypeNames = map[PhoneNumberType]string{
+	FIXED_LINE:           "FIXED_LINE",
+	MOBILE:               "MOBILE",
+	FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	TOLL_FREE:            "TOLL_FREE",
+	PREMIUM_RATE:         "PREMIUM_RATE",
+	SHARED_COST:          "SHARED_COST",
+	VOIP:                 "VOIP",
+	PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	PAGER:                "PAGER",
+	UAN:                  "UAN",
+	VOICEMAIL:            "VOICEMAIL",
+	UNKNOWN:              "UNKNOWN",
+}
+
+func deny(reason string, args ...interface{}) Verdict {
+	return Verdict{Reason: fmt.Sprintf(reason, args...)}
+}
+
+func regionOrUnknown(region string) string {
+	if region == "" {
+		return UNKNOWN_REGION
+	}
+	return region
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCountryCode(list []int32, countryCode int32) bool {
+	for _, item := range list {
+		if item == countryCode {
+			return true
+		}
+	}
+	return false
+}
+
+func containsNumberType(list []PhoneNumberType, numberType PhoneNumberType) bool {
+	for _, item := range list {
+		if item == numberType {
+			return true
+		}
+	}
+	return false
+}
+
+// matchingPrefix returns the first of the passed in prefixes or prefix ranges which matches digits,
+// or an empty string if none do
+func matchingPrefix(prefixes []string, digits string) string {
+	for _, prefix := range prefixes {
+		if dash := strings.IndexByte(prefix, '-'); dash > 0 {
+			from, to := prefix[:dash], prefix[dash+1:]
+			if len(from) == len(to) && len(digits) >= len(from) && digits[:len(from)] >= from && digits[:len(from)] <= to {
+				return prefix
+			}
+		} else if prefix != "" && strings.HasPrefix(digits, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
@@ -0,0 +1,51 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	tests := []struct {
+		policy   Policy
+		number   string
+		expected Verdict
+	}{
+		{Policy{}, "+16502530000", Verdict{Allowed: true}},
+		{Policy{}, "+15555555555", Verdict{Allowed: true}},
+		{Policy{DenyInvalid: true}, "+15555555555", Verdict{Reason: "number is invalid"}},
+
+		{Policy{DenyRegions: []string{"KP", "IR"}}, "+850 2 381 2321", Verdict{Reason: "region KP is denied"}},
+		{Policy{DenyRegions: []string{"KP", "IR"}}, "+16502530000", Verdict{Allowed: true}},
+		{Policy{AllowRegions: []string{"US", "CA"}}, "+16502530000", Verdict{Allowed: true}},
+		{Policy{AllowRegions: []string{"US", "CA"}}, "+18765551234", Verdict{Reason: "region JM is not allowed"}},
+		{Policy{AllowRegions: []string{"US", "CA"}}, "+15555555555", Verdict{Reason: "region ZZ is not allowed"}},
+
+		{Policy{DenyCountryCodes: []int32{7}}, "+74951234567", Verdict{Reason: "country code 7 is denied"}},
+		{Policy{AllowCountryCodes: []int32{1, 44}}, "+442070313000", Verdict{Allowed: true}},
+		{Policy{AllowCountryCodes: []int32{1, 44}}, "+250788383383", Verdict{Reason: "country code 250 is not allowed"}},
+
+		{Policy{DenyTypes: []PhoneNumberType{PREMIUM_RATE}}, "+19002530000", Verdict{Reason: "number type PREMIUM_RATE is denied"}},
+		{Policy{DenyTypes: []PhoneNumberType{PREMIUM_RATE}}, "+18002530000", Verdict{Allowed: true}},
+		{Policy{AllowTypes: []PhoneNumberType{MOBILE, FIXED_LINE_OR_MOBILE}}, "+250788383383", Verdict{Allowed: true}},
+		{Policy{AllowTypes: []PhoneNumberType{MOBILE, FIXED_LINE_OR_MOBILE}}, "+18002530000", Verdict{Reason: "number type TOLL_FREE is not allowed"}},
+
+		{Policy{DenyPrefixes: []string{"1900", "44870-44873"}}, "+19002530000", Verdict{Reason: "prefix 1900 is denied"}},
+		{Policy{DenyPrefixes: []string{"1900", "44870-44873"}}, "+448712345678", Verdict{Reason: "prefix 44870-44873 is denied"}},
+		{Policy{DenyPrefixes: []string{"1900", "44870-44873"}}, "+448742345678", Verdict{Allowed: true}},
+		{Policy{AllowPrefixes: []string{"2507"}}, "+250788383383", Verdict{Allowed: true}},
+		{Policy{AllowPrefixes: []string{"2507"}}, "+250252123456", Verdict{Reason: "prefix is not allowed"}},
+
+		// deny rules win over allow rules
+		{Policy{AllowRegions: []string{"US"}, DenyPrefixes: []string{"1650"}}, "+16502530000", Verdict{Reason: "prefix 1650 is denied"}},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.number, UNKNOWN_REGION)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.expected, tc.policy.Evaluate(number), "verdict mismatch for %s with %+v", tc.number, tc.policy)
+	}
+}
@@ -0,0 +1,145 @@
+package phonenumbers
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// PortabilityProvider looks up numbers in a number portability (NP) database, such as a national
+// NP registry, which knows which carrier every ported number currently belongs to.
+type PortabilityProvider interface {
+	// LookupPortedCarrier returns the name of the carrier the number has been ported to, and whether
+	// it has been ported at all. Numbers which haven't been ported belong to the carrier our offline
+	// data gives for their prefix.
+	LookupPortedCarrier(ctx context.Context, number *PhoneNumber) (carrier string, ported bool, err error)
+}
+
+// PortabilityCacheOptions controls how the answers of a PortabilityProvider are cached
+type PortabilityCacheOptions struct {
+	TTL        time.Duration // how long answers are kept, zero meaning they aren't cached
+	MaxEntries int           // how many answers are kept, zero meaning 10,000
+}
+
+const defaultPortabilityCacheEntries = 10000
+
+// PortabilityResolver is a CarrierResolver which consults a PortabilityProvider, caching its answers,
+// both ported and not, so that numbers seen again don't need another lookup. Errors aren't cached.
+type PortabilityResolver struct {
+	provider PortabilityProvider
+	ttl      time.Duration
+	max      int
+
+	mutex   sync.Mutex
+	entries map[Key]portabilityEntry
+
+	// replaced in tests
+	now func() time.Time
+}
+
+type portabilityEntry struct {
+	carrier string
+	ported  bool
+	expires time.Time
+}
+
+// NewPortabilityResolver returns a resolver for the passed in provider, which can be registered with
+// SetCarrierResolver so that LookupCarrierForNumber returns the carrier a ported number belongs to,
+// and falls back to our offline data for numbers which haven't been ported.
+func NewPortabilityResolver(provider PortabilityProvider, opts PortabilityCacheOptions) *PortabilityResolver {
+	max := opts.MaxEntries
+	if max <= 0 {
+		max = defaultPortabilityCacheEntries
+	}
+	return &PortabilityResolver{
+		provider: provider,
+		ttl:      opts.TTL,
+		max:      max,
+		entries:  make(map[Key]portabilityEntry),
+		now:      time.Now,
+	}
+}
+
+// ResolveCarrier returns the carrier the number has been ported to, or an empty string if it hasn't
+// been ported, in which case the offline answer stands. Ported carriers are named by the provider so
+// are the same in every language.
+func (r *PortabilityResolver) ResolveCarrier(ctx context.Context, number *PhoneNumber, lang string, offline string) (string, error) {
+	// extensions don't change who a number belongs to
+	key := number.Key()
+	key.Extension = ""
+
+	if entry, found := r.cached(key); found {
+		if entry.ported {
+			return entry.carrier, nil
+		}
+		return "", nil
+	}
+
+	carrier, ported, err := r.provider.LookupPortedCarrier(ctx, number)
+	if err != nil {
+		return "", err
+	}
+	r.store(key, portabilityEntry{carrier: carrier, ported: ported})
+
+	if ported {
+		return carrier, nil
+	}
+	return "", nil
+}
+
+// Len returns the number of answers currently cached, including any which have expired but not yet
+// been dropped
+func (r *PortabilityResolver) Len() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.entries)
+}
+
+// Flush drops all cached answers, e.g. after the provider has been told of new ports
+func (r *PortabilityResolver) Flush() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.entries = make(map[Key]portabilityEntry)
+}
+
+func (r *PortabilityResolver) cached(key Key) (portabilityEntry, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entry, found := r.entries[key]
+	if found && !r.now().Before(entry.expires) {
+		delete(r.entries, key)
+		return portabilityEntry{}, false
+	}
+	return entry, found
+}
+
+func (r *PortabilityResolver) store(key Key, entry portabilityEntry) {
+	if r.ttl <= 0 {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := r.now()
+	entry.expires = now.Add(r.ttl)
+
+	// when full, drop what has expired, and if that isn't enough, whatever map iteration gives us first
+	if _, exists := r.entries[key]; !exists && len(r.entries) >= r.max {
+		for k, e := range r.entries {
+			if !now.Before(e.expires) {
+				delete(r.entries, k)
+			}
+		}
+		for k := range r.entries {
+			if len(r.entries) < r.max {
+				break
+			}
+			delete(r.entries, k)
+		}
+	}
+	r.entries[key] = entry
+}
@@ -0,0 +1,99 @@
+package phonenumbers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPortabilityProvider struct {
+	ported  map[uint64]string
+	lookups int
+}
+
+func (p *testPortabilityProvider) LookupPortedCarrier(ctx context.Context, number *PhoneNumber) (string, bool, error) {
+	p.lookups++
+	if number.GetNationalNumber() == 788383389 {
+		return "", false, errors.New("lookup failed")
+	}
+	carrier, ported := p.ported[number.GetNationalNumber()]
+	return carrier, ported, nil
+}
+
+func TestPortabilityResolver(t *testing.T) {
+	ctx := context.Background()
+	provider := &testPortabilityProvider{ported: map[uint64]string{788383383: "Airtel"}}
+	resolver := NewPortabilityResolver(provider, PortabilityCacheOptions{TTL: time.Hour, MaxEntries: 2})
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+
+	SetCarrierResolver(resolver)
+	defer SetCarrierResolver(nil)
+
+	tests := []struct {
+		number  uint64
+		carrier string
+		err     string
+		lookups int
+	}{
+		{788383383, "Airtel", "", 1},
+		{788383383, "Airtel", "", 1}, // cached
+		{788383384, "", "", 2},       // not ported, no carrier data so nothing to fall back to
+		{788383384, "", "", 2},       // not ported is cached too
+		{788383389, "", "lookup failed", 3},
+		{788383389, "", "lookup failed", 4}, // errors aren't cached
+	}
+	for _, tc := range tests {
+		number := &PhoneNumber{CountryCode: 250, NationalNumber: tc.number}
+		carrier, err := LookupCarrierForNumber(ctx, number, "en")
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err, "error mismatch for %d", tc.number)
+		} else {
+			assert.NoError(t, err, "error mismatch for %d", tc.number)
+		}
+		assert.Equal(t, tc.carrier, carrier, "carrier mismatch for %d", tc.number)
+		assert.Equal(t, tc.lookups, provider.lookups, "lookups mismatch for %d", tc.number)
+	}
+
+	// extensions share the answer of their number
+	number, err := Parse("+250 788 383 383 ext. 12", "")
+	require.NoError(t, err)
+	carrier, err := resolver.ResolveCarrier(ctx, number, "fr", "MTN")
+	assert.NoError(t, err)
+	assert.Equal(t, "Airtel", carrier)
+	assert.Equal(t, 4, provider.lookups)
+	assert.Equal(t, 2, resolver.Len())
+
+	// answers expire
+	now = now.Add(time.Hour)
+	carrier, err = resolver.ResolveCarrier(ctx, number, "en", "MTN")
+	assert.NoError(t, err)
+	assert.Equal(t, "Airtel", carrier)
+	assert.Equal(t, 5, provider.lookups)
+
+	// and we never keep more than our limit
+	for n := uint64(788383390); n < 788383395; n++ {
+		_, err := resolver.ResolveCarrier(ctx, &PhoneNumber{CountryCode: 250, NationalNumber: n}, "en", "")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, resolver.Len())
+
+	resolver.Flush()
+	assert.Equal(t, 0, resolver.Len())
+
+	// without a TTL nothing is cached
+	provider = &testPortabilityProvider{ported: map[uint64]string{788383383: "Airtel"}}
+	resolver = NewPortabilityResolver(provider, PortabilityCacheOptions{})
+	for i := 0; i < 2; i++ {
+		carrier, err = resolver.ResolveCarrier(ctx, number, "en", "MTN")
+		assert.NoError(t, err)
+		assert.Equal(t, "Airtel", carrier)
+	}
+	assert.Equal(t, 2, provider.lookups)
+	assert.Equal(t, 0, resolver.Len())
+}
@@ -1,13 +0,0 @@
-package phonenumbers
-
-var carrierMapData = map[string]string {
-	"en": "H4sIAAAAAAAA/8y9C5xcVZUvvNfau6q6dyeQBBISGuXwENOQhBCIDmgcq6sr3UV3VTVd1d2El5zuOt196KpzmlOnOunMRXQ0KHckXnSuoigSjZoE1EHRqChBE2dQPlCvekdlFMZHRPHBfOMj1xffb699zqlT1R1mvpnv3t/H759da6+99t5rvx9n7eb9VzC2+ZLLa+6EXbXk5pdv3mwMlfvk5r/osz1r0pebLzfyOmzLJWO26Vi+3HKZUbLmLUdu6TMXjLJVtSbdmtxSsaY9y6rLS+WlRs43q7YpL/Vdx6hv8ja5m+RlW0JRI92rfH7gMyfkZbOm4U4ZpfF0QYaqpDdmrGpVpi+R6UuMcppcq2rNujWZzhSMjFurNRx70vRt16kvwTJKO62K5ch0Xy4r0/25oaxRzg5lM8W8MVwcShf6ZDq3XaZzJZke6g10kemh7emoROl831K0UdqU3iTT+dLooEznyzI9PJqW6dJwri87YpTcakNnX7Aqllc1nYrRu2lsk0yXypm0TJcvKJNjZGxnulE1PWPc9qyqVVcR/J2uNyvT5YHBIZkez2Rkerxk9HuWY1ZMmb5aqWruNitGxa77nj1BGSnPpGcp0vQWDN9r1H2ZnrSMjKlqb2rK9SrmRNUycnVSpr3epqZnTKdZzCnXsWR6yrMnqfIDwkg7027VlGnbMTNKzPZK+meiUTf6rCnLmbQMlXxpzpy0VMCkVVU/VlisuvI5lm8UrJ3GNZZJyhSK44rta9md5rwl07PkqxoDbsPzbLOpWnVj3qyYnkxXTVumq1MmOYZdtXy7biuBqm06KvdqVbWnUZq3PHta+edmzKrtzMp01Tfn3V3qN0hTZ+ZbSrUNYVdN1yZNCqiR61Q8a2fduMBIe45brci04+hgxzc9q65+tS/U1FnYOWN5llFSWs1Zu5plmLOnXEemPcuaMGXam3adWP14jinTdRpi6bptbhw2J+0pe7IZu26bulnqdbvum47fDCIN5k2tyS7dirtss7pQl+ndlqej7faDAdS7UY9pNeh6Zxp+rAf0DjTJXPnYO2Rv7ppydihTNEpj2ZFcf1aN3t4ry7J3aFD25sNKJm4wUfSW+tU/I1sqFwu5tFEc1d667zq2KXtLhSEjX+zNDWVlb1n2lo2iYw3PKJV7y5lwtukdL8les2xVM67sNSfsqutszMtec8aZcacoN3OWStVrOtNV3by9pmfOmrJXtc6kK3utiQk7Q923X/ZaVtGx2rt/r2VVbZWx/g2LTgFzdcupKGL3btlrVVWH7lVSs6qTbyJ6t45Rsyq2aQz5FWN9nz1t96iwqu1Mz5g12Ws5Vs1yjOKCIuuuY+ScKdermb49qbKpNxux1/YtY8j051UlVRuWUZpd0ER5p0tEUEvVhjVuzi8uTLURlKbasOoqrjshe11PzUP29Iwve92Gb9/csBSxMN2w6rLXM+t2NZrZ+kt52etZlQk1OLfIzAXjMpMeyZXTJZnpLchMpiwz2aGh3LBqgEy2UM4WCrn0kMxkS/0yk73G6PfcxpzMbB+SmVw5l5GZoaujDk5xCmWZKebTMlMsDpWzQ0Z6uCQzpXLNnbeqMlPOy8xYvjiWHZKZ8ZLM0MR1QTRFSjWnzVUbiqChmTG9Sq9repXtdn1G+ez+qjthGSV/kzFo+74S9OZUpRnjpmfNuI26ZeQ8iyafYAgM2TXbtypK0rOm3IZHlG15RtZR+Xsyo3pTTf1YwW/VyNCPOenT76TpVTQRhGslA6KuO4zyeqZjqF+a+duaL+IXHUt5fKtqrC/b026P8jXmzGlXZizHtzxjS15mVAsbE9buimf6MjNjOtO2UfbMeatqlCxv3p606saw6lB+RWZmLMury8yMXbU2qQk8M2M7ZjjUtCfshS0+Y/12T82pPc1qaglW1W1Ww0Bjfabc3xOIjDp2lNzGAdeZnnWd6ZZcphs2NeKMPTdnKzVce9IydB/PzDSc6ZmdMbXsCdUHYn611KmfBZoGMlXTc2Wm6jYql8uMWzMGrJr6VVOhGv8Zt2Y3iON68WTc2pzp2KZBdeOYRh8NK2qWSdt1VCWanlmtqmpV634UoS+aLeLCKrpKaUnhowenXMfVQu7CjKnGYkyQKiMgglSoYtXqH/GjdnBrc1XrJtfIm05jypz0G57luSrp7M0Ne87VRZxyVSvUKZPMptymIFqQU6S4sSOSNo2Rhmc2C9tSOLtmyYzrTJkTjarpEz3thhs55XOsSaWkGumuM295080FPEzRLVPmXtv0pVnNZvFst2rH26lec1WOjQl7UmYajZopMwu+dvSOceOYWzFp5evLpWVfrj9HzlgxNyz7CmnZVyqrrt9XTmdkn+nYRiY9NCT7TG82vgPqM31zgOYJ3beJoQMaczN2TNBSWvdZVd9TlVyXfda8W7Vl34ztmeZ0Q/bZ/bbss80Jt+pSBNusNmPbZtWdDn4ai6ZytYwsyaMhS2STUitT8GukHd+uqnFC49a061bd6G8smKpWApFey6s1KmbkDzeYkV+JN70DrlNpeKbO3w+yUo2sFos+u+ZOurLP9l3Zd5O1e/eC7HOrrfXkmdO06ulNluzz7GrVrk/OGEWHVqu+hteItvbZrMyWh2R2VHFUP8peYwwPFAvZcMeQNesLsYOEsb43N9RXyBX6S+l+WpCMrVsv79Fi465XrcjspKvy1T9GdretDhbGkFV3/RlXZm1PaZmtWjXf2phz5q26L7NVu27KbG3Os+pm3ehtOLOWpztwlhYeWhpsZ9pQezKZdezpmrmxtFD3rZrM0iYsS0sAuReX7LlpNVy0r2xV3Wl7UmZvbpi+1fCimsp6thobWc+erKuNQmFMZtUexNols/6M7TYFfbtuVk1fZhuea4fbCbdZ54rvmDLbqM+atMXNzpu+XZfZXapEdVduT+8ohNLxPrY9VxiMjkml7MhYLiO3FwvZq5WbS6sG2V7KR/lsL+XLGbnd9LJmvaxG3nbTc616cxxvN+t+2zjfbk00S7LdnrBdo+B6FXuSEreduuXNW3K77dX9cIHaXrWuVqXY7k5Py+2uY88voXu06sntrufPqCbf7pmTFM+zLHKMjOmZ9kTMc/RjoS/MS9GReg1a0nXL9webZtmfLWdLadmf6y8N7gjj9Q8Ve9NqS0NUtlwczQyoEvUPFQvpUkn254czJdlfjOSLw1lD95i6YW6qb5L95bTsN2tqNPebtVpz6es3fWunudA+H/Sbu+c8txYNnX7LUoXut6iH9luO5dmTOrswI9lvT6gu1m9PTNkTniX7q64M1vAot6ZXrQdqgx/wRixzciagac3VZHXBSFfm1S6hQqN82jPVXiDa9qkNiJas+6ZnqL45FyZJmqotm3aD/H21w+rdNGasH9tYVnRhqEf2u3rGC34t2e9WY/N2f8ty0e/6dMKsq5OaL/s9s2ZZDu0EZb9fUYvmzQ1rJlx+FUtFrZt+wGiYzvQuNcBbKt3IuLK/YTu0gOp5kuLpLabaVCrftGfOzRh67V+QA+lyIVs2enND/TkjN5Qt50q5vFHODhaKQ8Urc0PZkZwcSO8oyoHcpf1GenJSbXQHBstyoFjoG1UdaqA4kitlC2oXM1AakgNlIztSLGTLcmA0ny6U5AAd8wbMml31Y5PAgOlUFpQ7Y5pywK1Z0c5T+4pTU/akRbTeD6tTjFQ7NWNQOb2ea+qTQHgzMeB6tUajIgfcaGgOePOmX5+1o1440PAnZ7Rrq0msz7NsI92o+55tylxfGC/Xl5G5vJFxnXqj6quKzu02J6yqKXP5jMwVCsWxdEbmhg19DUUdPje8sehUFxaPfZkbzl4tc8O57TuMoVw+V872ydzwYFHmhlX95YbHXiZzw1OuE53wcyMb85mczJUzxnbTcd26zJWHMzI3ZoxY9TnXqVsyN+k1T+cyV7FMmZva1ezWsW1ermbWjIFGvW5bjjHgVheM0oynlracc4HezuacijvZss1WHNXdiq5nVVzXiGpMhTTqsS2gXzGlOjPSRibnTDU85Z+2HLtRM4oNv+42vElVg9G2P1BNHTpyTt035wIdfKs6RRn4VnXertsB7cWU8i01Z9rzlkHLvFkNusVJQ4z+2sRAS3AlCK41I06a1WpAWQFBB+Ag2HErmqjPL5i7N27RHj9Sat7y6noHWjId3zS261pRpdtpy5zrGMGdYY4OKyovz7xZTXQ5z1ZVKfXlF6VWV5NkjlbQhmPKK/N9OXllMVeQV5blleUheeVoYcdYdqREFx1Xmjt3mlV5peWrWFe6tmNkd81Znk0XXr1WdVodKgY3hhsSNdcN9vXl5OBgOaOqtW7X5OBwQQ4OlzNysCwHx5dadgfNql0zfTlIZ4BBc97IVoMdE0mYVTmoynVxWlWxHLQWFlw5WDXloFudsI1cJisHXc+aVe6CS3chg42q6cjBxm5rwRi0Jzw1OTY8fWUyuGDPq7lYDvUboxfJoVw+K4fyZTlULI+W1IgZKl69g07nvXLINObcum9Fu/whqmLXj4io9wyZjuss7JJDZnOBH7ImTM8MfvSmtHnkHrKmLaei2mnImnbrcsiqubR5ImLeVoQrh+wJK34RSH61mxlSS5hRtiYdV+2oTOIsNA8cF6iwGQpc0EGOKYfsqSlrIZaYPWs5jjVve2bdV5nVbJ/uGoZsZzYm5syq0dp2IKIhNlS17Iq5SXWQIbWxa1+nh5SoWvnoPntoYTI6eCu61k6G99b5jaVyuizzl8h8enu6V+bT5exIId2eej599fBIsX8kW5L5bFHmsyOZ0ZEdRq5A0uVcsZCW+WxJ7Y57cyN9+jI6ny3rFSSfk/mcEV54RDuofK6QC9o/nys271/UHnw820tfCPLFQnmHMTpo6J2Pmng1b6BolLOZAbW89e8IJlxjfSZdyJXK2UyPzA+Xpdo25suZrMyr7pYv55VTUP+MjFmzPNd1lEdxS+qfsb43PZIuDbSWvkfmx/JqRpZ5U+06ZixTUb6tznl5c3rB9KJ1KW/ajtqEG+tLtuV5ZlSoHpk3q+ZOO2zrjfE9Jd3p5ctDSshp3uPmTZVbbK3Nm57t27X2c5wxbHq+Y3nG+nxmWKXhuZPxOHWZN+t5V/X0vFnXzPrsguvKvOmrgZA3G56tiXlb5s1ddl3StEs8q6KWgBfaCuetaXO7qktrWh2NZd6q2n7U//JWtWbWfVvmLW/W3G0vmDJv1evmtNVre5U4bfSOhd4J8lIvsnzL9dQPnbpVottDYvQa+hm3JsLfaFiGo1/xqcjuhD1LrpqjZXAtl/Z8ujEMvNuvbi5vapIN2MUgu6plDJsLITlmz9rOdP3iK3P5qKj0UzF90yjNbTJ2G67aBhBXzZaaqIe/di3YN/dqX8D3gxz0ekqk5tzcsP0ZlSX5qG3cCXtnUPid1kT0sasaFb5aoblYEeHUmncdc7I5d+Zdd17mXTXj513fd2Xenbdar1NU35mk7ObtqpHdZWspu6pmI0XQNK8IUkpttSYWfEtTk/q+RntUNdQ16VnTtOYYZc906rbfvAzMl8s9Mt9wbJlfMAqN2oTlyfyC3uLkFyiLowcrlFdhU35T76bSJllID6bV1rVA01+hLzMkC9lytlyOfVAw1qdzhXw5/JjQoyTyxZGs0T9SHKXL5kK2XM6lZSF7dVnNGIVcvne0tD03ltX7j0KxJAvF8aIsjPQH+5KwmxVK5eEhWSiXjT530q25smB6Ya8omL6h/vWattq3VWxnWvFU5ReChdcoWZNqDC40OUsMuHTDn3FjUtSpNLnTrljNQ4IsmLuoeqy52JmrYM35jcVfJwqWf6Hq4QXLz5CUT3eudMdeUGcQ3QkKegDGUvOn6XTVPBBFgtN1Cp+xfUqeLmCCLa3hThnlGUvfmtAgiwX4YYAsWLuCkli7/H7LCe/RLzZab+MLdsUMZ9+GY8+a9QXXkQV7urnfrMuCW7Fq5i5ZcGdtU7uxT6ixs4cS9SpGcMVolFRf0LcGYQn1QrM+bUfJGxu1boNurYek1aAouN6UW22u7AXX863JGVlw501yqHXIaViBL3ZHU2h4ddOXhaNv+OInamZlqTtgWQwvCopbZLHQV5RFWk2zmWJeFgvF7dubNyzpkiwOlzcWMrJYyspimf7pA3FxtDySzWdHjCjqpGU6htqJaLIui7YsztqOudNsHu2KNf0RsFhzHMuURac4NWWUjx6sHj1I/SBdGpVF/f2y6FTtmqV+Fsgx1pet6qRrFHM9sui4U1PRnq/ozLsLMjiqhL9BRyzOWY5ewopzdUMfTGi/prfoxTm/2UjxvVMU3KjLomc601bwY6TLIaX3i8oXXG8F2zlb1bJnT9tU3bsWpi3nL2Rxt+qVw9ni8FBWtfTw4LAx7Fbrs7bacTq2ZQy6Vesmd2dwahtWJ8DhYl+arl+M9aXZhYLl98jhYqksh8s5YzidyW3PZdJyuDwmg4+xrqE/SpU2DVMa5uTspOXTOjRsVs1GxqpqoqwJq+7bjnXSbz7Dple1ajLYJjSHa8aturUJ25TDZt2esmejbcxwNJEMW1X9bX6J88Sw5Uw3bMco5fJy2JoLvhsOW96UNek3VCpenU4VFDBN7Thsz7s0pai+UbV9Sw5XzQU5XG3UyblsNBzpwRma6lYtp+6safSbu92djj3p71QcOexZNbU3KVh+2znmpCFGaWQoCi0qik5uw55ds8qaUMPRCJfipoGFCtqlZd1pz6rbU83hMOy5wQ572HMd3zVyc0HPG/bcXXatUZdXZbJDQ/IqWp6uujoa6VeZzbpVK9BVDXX23KV+fd+z5IgcyaYzA3IkO56jsZxJF/pyhfRYLi1HTLtqZFxvzg16bqE0LkfM2YZvOe1dYMSs2caQNb8gR6wJ1QDRjDdiVYzeRrUarlYjVqViK3fetesquGp6tmuk+4319Klb9YYexSYzCONK25Uj1lxjomrTkUMN0RGrbldty/HliD09o+psxJ2w5Yhra7o+YzpyxF0wlfoNz5f6Y9ASK17s+qOkpihZSl8zmDbq5u5Zd940ZulwaHob1OCSpd5cQZYyZVnKltMjsrSd/l08Yk9ZspRLG4VsuTc9VNa0pdbjqh96mjT1znBxkKVcf7YsS4Nl+tY+K0v59EiZbt1kKZ8byspSvmQMe+68XbE8agzFGcj1D4ynm9dEpXxJ1eOC2qGUikOjhaLRm1HNXRrOFXqLVwfkSG60pMnm5XdJHelLpTJpHDODKCl2uV+WyjHZsWyhNGgMFgul0Xy2UFYHKVkaz/ZtLxayhWyZ4pkTtFcvmVOmRzvjklk1yfVlyfTodpdGp3LrblWWJixvwnRmN0bZWDrYqkV7/JLl0daNvo/2yGATbVSsujGszvV1w/Kb60O8Y2pR261H326anyNLlq+OMlEe8+rwPOdWo8WqZO00HaM9xRn6hBnGonMY6WtXZ6lwdq1ZY3ZtwlTuTtetyJLtTKtJoDS7oP6F9z2lWdp0lqqutWvO9XxFzZuzRtjtDTUQd5oLdWN9fym/caRHlmqmR18qFOFrN9xVBL5QPfJE6ijflGc5slSjUFc1ATWbO2lbFZMsqyxv0jarxoJRsaKV8OgbvvgRkw71lE4ovd2smhNWtRqMTH2VUHKnfHWcVb+qYYmw1GaeRhodXWaVWNWtBafPWUq0pmPVzKrdnD9KLn0JKNHnr5LrmVoBzfOt6nTD1kTNlCW3UTWGTd+3nbrqg3PmJF1yl+ZMb1aW5iwqWNvptuhNm469W9/BluYsS+2wjPVUVz2yNOfZji9LvukNNCboV/WHuizRJ2K66A4mEN9teIY6Q6uGaVR0p9C/wS6j1KiFc5gia1Rnw67nN6ZNxXKiZms4GbfWtKobblie7xoj9qSrwiYsc3KmfQouNRzPrqu4bs1yjDHbm7fmLW92VkXxLHKM9f0Ny3Pq1kJPiwlbeMwgGbVpJqLkNvwZI+1XTce3J5fitUZsDwvTibrfvGWqwyh9cwy+M5Z2mrttI18uhFRgz6Li7rSn6MaytNOu071CaUE18kLNjIbOQo0+vUWdpdVvDJd3yNKCGhb2vCwtePrKoXT0IzXbcWTpS583Nho5Z8oz677XmPUbninLhmpjWb5IljdukeVwFxwSxvpgdAbdtqcZki436fJMw67LcnpkRJb7tstyVpL5mXaNoWy6LztSohsoNdcY/Z5lOdQS6YtLxCqke4eyI5rMlum3VB5J96V1Zytnh3L9xSKdOrdb1aoaxD2KO2RkSzTJl7NDhYgcyZdkuX9IlnP9RVnO5WU5V8rnjN4xWc5rC9VyvizLhbD/lQt5WS72FzPZIVkuliP2SHosO0TT42Axnx8t5Abpck6Wy8ZVDZM2NMG+qlzOy7Jpq/kzjGxW7QVT/cyqf7KsNsc0WBTlKnc3mZmE3aVs1me05Zs60ykPWZ2pbi/Lpm+GR+EyXUGUrSoZeprG+nHbq1r1cELsUUHmvLuLqsKqhtKT7phdrZrTlvJYxhb6CdzI6lL5BtVBUBFabatqjZueDppU22hNWdXw19hu1l0Zbrou2RyRGbM24VZsM2JoA2OjpCpJzRvNWEO2NTnjW07dt2wn4hbMmj0Ri1+wG1bkGTOdhuk3aOgEvC0R8TK1NQg8lzbHyxJbohFrulE1fddbiN0HhEddg0yT5FImQy5dOCwRkjd3BZvVJQLVrmPUcWMClmOGv8b6/FghS+1nTbmTjbqMrIFMo0/toxqBPUOMX9SFnlJnW2Ko5NW/TMP0PTcI1EH5o5+Yt6uWTndWrWQyXI2CX0ONdsuxb7KaHG/Cvsk0zE0VnW/NnNTdo2ZPqy077diVX01d9Ot64a/uk7vUShNy4j2tbk/rgHqgAV18EdHQv74xYtUt0wuy9N3Z8HgbXwpUkP40ZjS/aelq3UkXfGWrOqV2GmW1flKAHbhGVh32jHRJe9Wy61FQUCmqBsIBTb6g48+6tbqmaiSuW7HuU+S6qftGfdJtRm56jMyI8vtb1FopyzOeZcmyraYEu+Y2by3Kdr1ma5dmLnfWbV7ql9256F6lOCrLbkNVkWc6dZrytU2hOkmWPTvvTqifIMCesCI9wk+Oik1Zeg0VOG8pSp9Yyw3HMvRM0HDsut1c3soNr3nEjT5TlRvzZrXRFNppV203Mt8M18/wWCpHN2boyOY606YcHWx+v5ajhVypnB4pKUIdVUaHM+qfvh0wiSzttP3dwXFytDwkR8sl9c/IpEfSud5sSY6SSqNKkdGpqXPkKN15j9Yc25yRZOvoNT8HjDq26rqjDh2rRndPWLPhVcpYbiwtx4pFOVa8Wv3rLRayRiktx8ojwaCSY6btmLMzzcuAK0sZOWZN+irH4Nfoi78ByOy2Jmeau15aSNbnG07FdnuiGEE1tXqbpptLi1/cZ5HtcHvsWuglk9cxy/GtqhuNyDHLdeQYdZPwc9aYPem7nm06tCc3yp45OSvH7AqZu4/Zlu+En8KUR1Vb8Eu3UpbqzROe6VR6In6oke0EH7jHbMeWY7Y3bTvBT/DxOvSEEcgX5UbG4rXmlcGY7atk5k1yFHtjvlwiD5XVnnflmFvRHv0bWpMZ64f9hR5aRyJjxJAw9HdLq6o/nkTsYs10It/FsU+CES8aQGNkIBvZ2Gi/b00qYs5IO2Z1oW7TnlqOubsmdBT6pR7mLpCupeGRIfJMW17L0U6NpjF3d9iJx9zdNLmN+Z7RazoV00g7kzOmsZ7Geo9ePlTgUh9Fx9MjpVzBGCgO9eUK/Wr/MN5bCltgPJeR47ntRc3PFfrkeDEvx82p5g5m3HSMsjsrx03Prshx0zedBSPrh4Zv4+a8NelZdV+OWxMTtjOtfi3lkIF+bjgwIw4Y9Wi2GLf0VK2KPac2/UZajlt13wh2QFH+djW4XR+3nYpy6Kr86iF5dfFqebVuj6vnqq5nyh1yR3lI7iAz/6CEO3QXHWg406a3IHfYNaNkmzW5w1WT8w63Ecq5u2umUbZrlt/wnLpRn/OqxsYd5bLc0ZhwfVdeE6Z4TZo+lV6TLQ5HrGx5Y2AVeY1pO+QYVxblNfoG+BrLnZPX2I5jm/Ia23MdeY3rTEuT3tv4dn9RmlV7XppV355wd0nz5qqcCF49GOaEnAgeLlTrrrHer0z2yImgY06anmXUm7f0s6ZB9bHR9uXkxIQeWXJyxq7aAVndZTjhwcKckJNu7TLlaIvm8JNYpSGtC4OPjNIKymhVq/Zc3TXq0a2kNWdPSov6v+r7dVNO2V6Nvm5O2V6d9lJTVUu/gZlqVKskOm1P12cXDHOuLqcjWzQ5Y26ct6qGHdqmzlieO2HWLWkbmXThNf2lvLQzluN71q5gHlM91s5nr5F0iWI6NXU8VrTjetOWorR0te7qSrMn48rb0SMh+2QWM8Z0bWJG2nMOfd6Q9lxgqWN7Nn3ubSZ2k7lAVgezwbufqjayCOuzak9ZV/TQD61dNDKCJqwuTJpxOjA9qFhUIKPqV2TtCpVmjT5XbarMStqu1GxjMjAWqIffmGu2Y6uuWbN93zXMaVkLvnmGitRcx18wGrOGrvkYP/g+WfPrRmUTfZGt0SoUqlZb8II1TTqWP+V6daPh2FO2VTFq1FHUyHesXf60FU7oRrXiG/7FptKzMuGaXmVKHUICIVUkR23E1jtm/Sa6dp7VG8BZ8tdrC07Fnp6x/B7pOu7UlDk3J93gu4erv1JQX5/05ZxbnbM8z5U3T9Rcz7o4Y1b0/YZnVYxiIUu/qhPWtcHBznDDVbdrQeXJemNOrwlhV6qrg/5EcNCPqrg+7xl+7OOHsV5tnY3gA649b/sLPdKvTEpfn9fUr7XJU7UTfnjWPLUrCX6DHuvTHE9u9JE6HNuK3ajToPFnFtQIo2nDp33kxLz0PXturmoZvlrMDUsbdjYce6Oq5nmzasp5e45oe96daUzI+WBV2mm6rlxwG3XLkrvVrNU7II++YyQb2JwcfYe3MD1t16duMr36rD3n25fNMfZOcZOAm8RFGTwKd3DxDDtlLWIvoM8uXibEufg3nd0CzgbYh3ya77obYTMCwrq7ke9D8X1+zkYGX0X+KIONDPvhzFEGL+OwD694HlYfV7HgOHS8AxWxUcmcdRnHJ/hFrwKew44nAbYyeCUCEZUkxxeDBN5xHrxUivwCF6MACxy2suSZAk6B7i8zmOHrjvILDzA4wOB5SBzlfJ2AdQKeBzjAcJ3A5wHWCR5y9kA39HUL7BaJmzjexOEmPtktMp+BsccR9+HcLxB+gfAB5Lcz+IAiOm5n+AEc34fYLTaMY227kClc3y0Gf8VxjVixHnE9wnpMvQLhFYivwPfAxQJ8Dt0CfY4XC+hUeQqFfVTq+zner4KP8EMCCGfsF3K/OO+AuBMOCnFIbDwo4KAK4AcFHhRbKR4ewTOP4GsLHAr8JadycRPAnxj8HUseY/BtBhlMHgWewe4TDG9A8xjjGeTH2FuSn+bnrkbxHOMfYn8Nj/LnAICfy0HjaoBz+bpuASmWuJxjt7gLtnHYxkc+g3icwVq24kkOa1nHWkXDcQZPcniS41q2+QTDHug8wZadYB2bOEwweClPnmB4N4ctQlAF8xMMu8WqFbxjD8M9DPYw+W7+ktsRCR3/zPGf+arP8q4LIXEj3p7sZfAIwCPAfQY+gzs49LLL/h4U8dcIwwySLOUz8QiAFngEbvEZEo13cPDZmrWYXIvL7+DKewd/EWICcdla/Cb8GhMPAfwa4SGAL8NPoVPwf0LoFNgpYBusWS4gjmUhcS4qLBfQKTSBUgChcZgdhQWhMK3AXyvgtQLmFH4CT3H+tGpheIrD0/zNcBuHpxCm2PkXI4wJuFTAv3D4OIcrONwFt8FLmMKPEc4GvEh1YoWzAX6M174M4WXE/1emBsa/EnaD93uA3wP+HmAI4eNc/orh3bhxlYDfcbgb+bBYczduv5b9DRwAuB3gBwgH4EVvxLWTCNfheT4DBxLn4ZtPu1qsP8HuOvVjDLag+BiDjzH4BXv3ihPsB3gOezpxD0ucYKMnGD/B9vFjLLEPvyxOMHG9+F+renlxWI0oPoc4BPBnpfa2DwEsR7EccTnyDwEQxHKEvMLP8PscNH4Cyr1MwN0I3+e/hssZfB8C93sK74avYhyCXPd8wGngjyG8HIKxtJr1fJ5BDBj3rsI4MO49rQUYo/cESe/DBM1bLXgZj2Pb1lDgXsD7GBTYea9E8TzgPrzhvyMQMIx7UchRiKf5txxPHqTwgqEb9uHF+1C8EvlWxqMgH9Uk+kpUnSbCC4b27+awm3e8EruI0OCvREWQcM/PEH6Gqmv+DJGITuKLV2LQZV8ZJht5n4fVROONrD3olfjy0wBOA7yfHYA1PLGRwROooInb4MKN7KJuwcdFEGdcKJwP8Bpc3S24ZoZ8fB6+DFsZUlEid+pUhvuoBd7OFUwcvZeJUQSGMKpqIvMeduprOBAS72HwHmaEXnwPQ+LAe9i1v+Ed72bwbmbHFqflTwIshQteieefCfAthpcKVbXPw0dVnetFaV2wCPArRbQAJsMmuO7VCD/hATYx8Wq8cR/CX3CoQM8Qn85zIGBIQJ7n3g3wbuDvZfDeIJGRNAdCYVjAcFB56CIMqzrL1dXimSDmnfApLkxMbWZiM+vczGAz67oBYCfAJRw+xWEzV8QlqtrgYnJvAMgyuAFSl6jQjs0cb4BdWxkkOe7Ay5McNIijWuCLAT1Ccyb/qIDfInxUaEXxowLfyJv1MkL8W9i1Wxk8y3EOYJjr+guwkW35Dlz4HYDvAD+fw3dAjQcipm8XcHuYTh9CH2IGPgt5Kv1WtiokoMHO3Ue9/u+5Git/z+GN7BmYQnw7F8sBlsPIu/jqPwC8i6tdwy5+Zid+D1bgS1fQsLEZrECxDydGOPwZYYRDiX8zEekXlWSdSBzla4jgtOdoQUysPShK6ihXiEuGybYHkbczikgDLhXS10ZJRdggdPSvdxY5LAV+Ev4l3fAjcYJ1/xz5zxF+jvhbgN8CnGD4c0ydYHCC8Z/jhg0AJ8El5C7bAIkN8N7kNrUf4v/E4J/YnamzBDc5nCV4heNnAM4S8BlQICZoTqVJIwWpNf6DXPErarFXwhqfAfh8KFzhECUYuWeJQOwbACBWR97PADzTxOuSJzhsRPE4Jp4B/gCHB/gp5Lbh2x16x/gLFLRdhF/gOs0xQHwAkZj4gWBXGeB2BlcAXAEB3xIKtzO4nZ1/BXAiLnsG8BnAbwJ/BgBFiuQ1+AcQxjmMcwyzVtjAIoEHkrTfFPsQMqj2IZmQeBphjMOAwLagDOKAwN/RGtHKDypkINzAxkP3IfyOBdArS2vczijfCPsCL2YwSfJc567dMAWM0t9HQVHKAyrxp7EIUAQsKABB7bBxHPmvoPd+NUT5G7jXwdBA2EPxpkROV4nOvFukbkEIwbsFXMcj8Ot4YR0D2qNP90HiTMQ+WD4jUjPiuqcZ/0eAf6B17GmGT7OeIxx+xOAIxx8xXAl8JcBKuB3OELCCwcf568QPGPyAJQWCwA+Lt3A4QrieaeBb+MtuZYnH4L88wpEAb+GwEkATj/A1j5D3Lco7cAuIfZi6IqiY1OWIlyNcji/zWcevuNoJ/4on1oiXrhHcZ7hGwBoBv1IbY0X77My/Fh2IXVx8Hqs88U7+EXgUurrF8jcIVQGPAjwKiTeIVf/I4QDCGwTsBwj5Ct1i2wqmS6Xw0QBInGvfJPjHOb+fq2p7k4CP8yv3AHxc8P8mYA/AHvgXNUZXMVjF+B2cLwAswNkrGHyEd5KLH+FJcpffyIXO5mMcPsZ3XMdAowYKbxIajzXH+wqGOgLhW7gPLvU572SiWyS7xYflM8APQfL+YKd2+W8ZLIWvpkY5EDoGOCyJUY4DHEd54hmADh4BY7TC6EnxNtyD+/FSjnfx1F0cLgE+SjHu4r/r/JCQfwViJVs2yZe9H7vej3d1PM7FHuR7EMnt2qP6sqLDscT3KX7HHsQUQErtiDEFb+f7BcRxoAlsC4oBD7RI/ntj/b9P8Jz/WGodDBbjNlLvBTT/t4I6/0Nxl/3HcjxJo/wbscIrgjY+/0+U+mRBS4b+x1IT5KZOJv8u0RWV61BLOviaJr+lrV/zn2ro/0NBh1qVf43QwHeJjv9Esv+x/rbmJGJBarc1gbf9b+lO/38I0oXluhVe80LFNP4PanUq1bmq/Ncr4P4lRl/ygIB7g3lvsW74n8j984m9HL/A4Qsc9pKriQcVln+B8y/wxBc4f5DzvYqPe/kZeznMAO7lfxB0Lxl1c0UcFLBH6IvM9QcF7gmuLOHGcBxMIx5SHIxFhB3B/WbTDVOePSgSO8VjOoLOIwIcCphwUCQowjJy+UGlgTzUOgDD1DGWwWL8I+zDX8IRtWcsmDxJW4qzj4RbzyMIMawxOTyCcBL30hcMXb0PXyj0EQpdDJO36BBteo4gHFQCF8Yk4RFECu0k5Ue0GLkYz87kt6HJO02O3QLOgJROMdqhm1ztmc8AhT34rdRuBiHwFRwIf/kEhwgZTDxMxMMEvXV/IuQQwTXnYY4hAQ/zqQyKf0UgCM2nc6QijrHHg2PJMaaQwVcdhdRROOUYg2Os6yjAIvTryMcYHuJwD8N7GBCx7FJ8xTioo8W/MBiH5Bcg8SeEIzB0A8INiDdggogAJxjqdAiHU5QfXwMQg1JoDQAFxYEZxFAmGfE1RxPRoUoHRXQGo9AEJZ6K+HE3FMMwOoaxcA0IOkthlDjpE4/7a+MnuG4NfCqxWfDNQqW0WWhwncwaQGKqeDooUp+SwTidQUGhAREWgh9jIkxNS/IMJvQxbw08gM/xZVElUMyODK4eYx/seJ7hWwX2Yge5zyRep8qkcgz7Gb4ORywAC5Bc+COL8F+OscQx9iX4X1zhc6p+7tcNqBrz06pfJchbyzHIsfZmIGb/CZb6IqhYD7OVJ9hNz7HUc2zNhxicBHw1wnMMvseV9zkGq1ET4nt81WqEk4D/kAMB+wT/IX+o81EO92KAaAg+ygN0i/8PQiMiCnqUw4swFYlFB4q4QIiEjngvwjOwhACljNp9lMP/bAbdJ7/EQePtEOAvhQaGQR0vZUBIvJQtD5kYyVOUVVGsCzgQREgk45Jvh7elzuXXXQ36q1YbUPNjoQkAzUxBM0jTCRJInctTIZ/rj2VXA4+lwylZDIPgXM4h9l9rdl+B9zGFCwQMUrVfIF6WYnA5j4AphkR0dSsZnmL8B/CzrnUcuzk8C7iOAwGfBezmiWcBCQkKhW4S6+a4TbkKRPAwFCLiWVBB5CJ97cNnocnUEbc15XWy2otRdOIIrZWOvo7jOp5cp5jJ7pYUminH4kYaNt1ngYfy/FnQBWxJpJvzbS1eneDy7liaMW0DhdvKHi9LN2/RKiR+KfQHzxB4nD5+arQGwaIgLYxrg4+l8CSXaxfFIvC17I7A8yTHttTpMyuuXSpj+ibbzFvHDRPBtYwfZyLMWxxnIop+vDW6loknG6JdmeNLlBOicq5lqKPo1LT7V7yZaTzK2jBKvI6OByVtcnT042xlTKvO4+z0mFfJxLGWdbXpGRd+kue+yuEYoUsofJXDV/mb4Vu4+q+Q/xXugR8zDfFjJr6CQOAhE37MPgvXiwj8h2pFSZ5gw7TM4I84LIVE645iqosD4b3aL/Qy1S3WdAseXk5q9y8OIRzCsx/A5CFMfBxvWMFhBX8j3xOkpL+oaxUghsG9CHsxdT6D8xk/nyERGtbtCEsBb8e/h1MEnK5yfgIuBLwQzrwRz74R3zZEn9LhDs41McDwDi7oEzuGH9pbBEJ0tXq18B9hLcbByV3WytTAMBTWYgfRK+jzPqzF5YuEeSjmteYax1/eweEqSF4FnzqZhAJiChEQ4VOwSulANKr/DHIvpIJiWNyeOzi26nFs+QbOr0V+Ld5wLcK1CBu4wmGAO4kmPlIQXotfgC+D+DWueggOr9kGEMfbFG5DG/6XWj8OMYX3COXqJe0Qg+X8wnMweQ6Chuafg0kS5ufyxLkcz0EleQ4iRdRuIHyIvV08xEDj0wwPM4gh+ZDiJD/NkAQkuUr+sBJWCCPCYYafDogAOuhwTPjTDPaGucQS+dtTjjK8EcBl8N/oc9+NAD9EcNm3YBnCfhTnsWWvQPg8vuPMpxCnGEyxlU9h8inEpxAIiZDQuHQrg27RSfRpt3HeLcQW1rEXzt0LK55CsZXBU9jxFK7eC8mnsIO+CPBukdwLfC/AXli1F1Z9Ti3Py0lS4TYOt3GxFyTlngqzflG36KC8kt0iuZXBFpbYwgR92uT09RkpFLayry+/iPGLGBDeupJsN+JAwtrfQ4e26VhKgN+NEMMb4ZscXsFa8E0e4b+q8+v/zeEqMXeJgEsEXMvhecDnAa/lcC2/8m8AjwG8HaHEgD4T3weMc8bhMgRykbhuRkCC4/MgsgKy4vq3IbwNxYsEvEjA3bicvApx3YjzXngP8k/gtWcDVgSeDVARQPR1hxHSAu5jsIsurO9GvB7gE9D/Pg7HEW5m8Dse4NNC4WYGN7O1v+NwM+vtFvwusfIuAQR+l+i6K/igwwmVPTz3G574DU/chsnbkL+Yd/2Gw22I2wAvQPgNx99w+A2HF4fYBnABKmiavF/r/CPA6zi+lf/F7QC3A78d8Hb4v2AdBigKKApOBL4R4U08WWXL34iyKGAddr6JJ9bhqW/iUGXJN3FYh0jySYqLOvqbOJCASqTK4E38VfMc4uAYoWcHe/FLQVzDkjsY7GDCYRscdnlCnJHEnpczfBE/6/UIDwN/OYMzGbxKwGV8+esxcaYK6jqT8fP4++CX/PTzMPVbvlzbTZ2H8Euu8FuO5yE8hPBb/s6HYZZFQHJFjKORnGWdswyuFt9Xp2uNE+qknbqHyX6+9sN4YvnlInGCnXYP+6/8BLtz2T1M0vrHT7DEW9myexi/h339or+EZ/gJFdIZHdJOsO4fw8p72Fev/D2DEMnfs1+u/QODPzB5IYML2EsuZH96M7xF5XvaffCNLrJPeirxEeysCyDgEQaEb3Sew+B1DF/Hfnr5PewMyiF5D1serpJfT4Yl4CHxkA487QTbj3cyuJM9u+K7HBed8hW+y2G1UO53OX6Xp1bTSXm1ACLwuzw4fx9j/BhLak4IfaHRPKNHtE7kGMOQEN/lYh9GEVWQ/sj6Xf7Fjboc4V7klBOMx7Ymp10vhu9hqfezdyX0Rcg9ze1BtBmJN+HJgIuZbyGXIsZDV1FF4kkS/KfNx9jzd0IeMY+cXG0TBstD5FGbiylaE/kYU/N1UORGEeNx85giDs+3CrTmlSCOiEVUiulMdfqRkvGsPwQ/2P5B/EDXPlz5Mv6Wy+9ncD+DP4PG6vsZ/hlOI6MmjaQW0NDMPwNq+Tg/SoRkpjTzz3CWlr+f/YR/F5xXiH+G4/CVtVsZavOOYTLs2cqgH6/7OQSWMrei5it3K4NTWJOOxVL8Uxi8io9o0yNtHKODdKxTAiMqlQXxP7qakdlUhFbDuHjoJ18HX+TwDuCb4eeiAfwBkJFp0IbQ5mwzNHFKEPq+U1/Kk3/Nn9j0C3zopZSs0FY38bxWhAgNclo4cRkdMU4vSuG+q97MgXDoLvg5dv4cD+DP8cvZm1hym4AQPTHTgQhGTKANb85dA/BVgM8ANxiE+B48iiltZvIZkK/Cb7yqgMu+BvA1OO0BLp9pMT6BP3ENPF/AAxw+CfBJwE2AkcB7IfW3CBQdNsHNDQ5fA3wJCb8Y8Sw4PH4FaGsUuJ3xKyAwP7mdnb2BwQYWmJxsYBA3ISGjlRa0qaVjRXFbhb+FTwC0Yt0T0DR6+RYPEIZeRYYuTcRMXOAKOItk9qcMiCuIH0Bt9ZL6BcLXGXwT4JuA5DbxC4zoroj55ZC5FeE0puJ+nXWFuvNIOQLfikrsSQZPMvE/YSEqw5KVFEFrGHqNtqrVaV4BW7Qa78KXRDVlwK9flcFEt3D2hQajVOGoLW80vkFN8M8MI05rj1QC+7BFfh8qN8S2fajjdj4T9KTRpxGeppvQp5s4hWQSaTZDsfCDDDP4NfwnWo0eRHwQX/IN4BR2VewuN8CAWBvzYgbhbfzcnICcaGqWE3AuBPgsvnhAdOxnQqu+XxUvKmEiJM77ptqrL5SF3m+LgVhqMVxIVkLJDG44icAl3eIgfBTPpjr+xNpuTBTgA5Jsh+BXEOGbifsxdT8u/xikPgaf6/wKh69weAoCt4OB5nyF/4Rfx/mtALfCv8CtgF/j4rMCWsFvQSQJdbAnQtBksppKopvuVdfxxHUcu8UtZIGUuI5/ZMWZmOoDmBEf7voRwx+xZUfIEIjAVwKuhN933cZgBfvN2mf4fVeQgZDGMm1cdISnjvAubWt0JAzVdKsNUpNDQAr6jTrlPgbNg/GtDG5lp5CrvI8B3MoSt7JV+nSvmSFfuY8BfDuIm2q7CtAytzIkyWYujwHXESOxCK0pHOt8hHc9wvkjHLTNVIjkStiwEv6UoubVn8HeBbdAG57p4kId67mAXSwg9EGfi59e8mqAVwM8z3+x/OMCIgwRQi/Gg1rxpdMWIEn2TbgA9yau54mLOLwW4bX4ktfiT84wuTD5Bm2t9BOIIFawB26D1bD8mUVz7jPwL1eN8sg8SY7ys2M2RWKUXzTAnVGO5MVRfuoAPz0m0F1nUGcpJoCJS3/GgfC3S9on8X9gh04nM6Pmh8Y9gQXdDXvwRWbMyrIV57bORKfuwW91THCIEFvAMWTiRLt1/eoDXBxQTDzAQWM503j/8rs4fI9FSDH45NQkB0KS3H8cJl2/ANoaKsJKAStFpOCqxzkshY5I/nG6nH88LPrjvG2ajeyvAoEUYBQxgp69u0UwmceDyFKLx0veLZS8zjRCmHiAKFNtFhp54zlq4nkOsiVHroP2hEwd6xloK1dnXMMYcE8stW7RXr17Wu07W0OX61KQ3ZoiWtsbo7z2BTZsXal2rXT9LO8WGG+gCG3PM+IRH+fnS7y+W3CtYQpaajWsZNEt8HGOj/NALK5tvJVjwKhdIklCh96ypgD3IV7ZbMRkpN6emJJ/B80CRolQVQRuqENHTAdsqxzSPGjQSEyn+Tjv0NX7OMd4qSNNou4da1/UAlG/iklinN/W/XTW8YGjHzlEjd4WK96xdRfS7a47W7yVY4UVEhP7sCVWhL8LElmxBxOxlFP72rtc0PGoijrCwnbuwfvPPSSOwn8V8EbCpIBZIj4hoIPhAXE0eUB8JxmaogU2aTFTk3/Ar8F7MTRj5OTifpEkzkVElG4R8AGyCLsztP0JDcSgg+2kZFZXg7Q73ijw9YK/RiT2Kze1XyBZMKkE3xjGvTd07yWjwmXslYfEKa8X6b8Vb0kcEOtfv8j45+8IH1R6XHxIfBj3k5XYp9qB+8XxyFTq0kXGmOKA6AqNjLoPCEl0pzb326foqw5T3P2iabUX2jx2dTD4sACHNNeJ3EkVMisOJPaJPzSrJIZA5L+ouuEOFeDOJpbtF52voRycmJqvEXBLmMItAt4s4G6RvEVVKsQRz+iAeGtKc++N1WqE1wv42CJmTI8XMLnqiiqhLe7fqgRfvF/AbqXimX8Vi7U/aFOVYywXuFZ0uQI09lOlTLbktXpe4EdExydJoKZkUo7ovEV0OQKuC5O9txW67uKctiI4YQu+c1HcByi0TT4moFo8LFEqFoQHYsawofHvFWHlvHS/+OkVf0B4kMMfEB8kC7W9HP+An9JUBPx7Br9HzjnwwJgNHwyM2TS6ZgBCtAXxTQI2CUV/oQXL0gAE/EJgKYetAvhTAI0HOb+Ht6mkEvw6tusZyxc7sJMyxYYImK9ieDpTiT8YSySCUGLLWpXHB0M1ZlrRmu/qVs1VxL1BLt944ZhtldKW04pIlS7AKEpYL4qThjaNYS/nZFCoCC35hUW5xBRti9uCqAFI+CvtwXG4/PTWcq74OEAMFz3I/3VTbCpPkcsPiWTMiFDhfSGIkwy9Z7QZHd4n8L8HxoVd9ylvJ7l4sJkyhgTcGOJQjCDs4+8QYCtcFtpVgjaKPCi6DopUzIaxg8RWHxKwM8Shdmh5leUehXZLyUMqzSUMJA81rSybFRGBNF4dk9e5rAotLrW96CkHRQcRZy+VDpLCulxJKkhTYXuJgkRYcTBm4NlW9lBzbS76MeP9Agjig2r16L+xWfG4J6gRjR2U6Mqwok8j94Iw6SXrKGqSk1Xffz5Uxi1aJ8jduURjnxWv3MVd4b4WrIxKvSjr0w8J692BYW3ioLjUDjoi2P9Gk2iIg0IejMlrlVrr+eydrUVoDb20NcENpNWph8SK19GgvFEsPyQ6CXBIXEHussW1F8NXVnwR8YuBqex9px1BTjax/BF8XfIR/Dm2bg8T+/DSI00jXG2Bq90fJo/gz+AIpkKT3PvwCL6azsCpR1BqbqsNL5K7LRYnCloVcu4KzoNkr4sRfQQveySME9++6tNy27a2NdfLWg/o5+1Tx5CPJskIWFv8nmLyhDYvDk2KeczU+Fendwt4cbD11scOfrboWQf8DEACnAGwFrr24JvLi28BM3hK3ESYcGqMTmVwMxFnhZzVT4TWw622xU08HLMwXpT4aSQjtdgTZGccyRMwJJJEd1AiHWH0zif4T1OLEtVmy10nmDzGMDRVXhoZRIoi/hUvaktnyS+Ird//zg+jwxN81RNhAaJyamFtLxTdrGrmIp3bomAGf3xqLL9Obb0btz/OYDJ+Y9umYmurdvw7ZHgsKPGCku9Pnrxi3saPsS33sMsPcTjE7+CHwiId4l2RlP7aSBIRsVJ7l0T0xZPQdQ8T4edfQQLiEP+YDvvk1ZRDag30xQy/rwgV74mYx9jGWKGF/txLSNHtt27HlbEKiEzERVg9goykl6gkoqPqFIQ4rdthJWUq46nF6nFxrCbdatbegrh9dNxSPaaSoN7EY90hRV0JQ9NuIE1WhNWii6lrpiuyio9nEWbU2drjumK583gPCiN2hUWOcolklsfoU8OiaYFE7OXxtbEGbS/7MWaEoT/5Mj7G1jzGIIIJ8LWml/cIiIfqeBMK0RsCjDGRxJruMSbicVuT4lGOUXZhXB09sQbQBDCB94g1UUYaF4r2UdrayL9OUnKRNskwePQx9ig8xr4t4spQSa9/jN2POqSiyVSPOAqPsQntexQ+zO9NUqD4GrtnxTGWDB8cBLgHFZbqf4HuoWRHyO/4YMB8sY57D27VhTjIFb6jsOYFJ1yNjqgmVkF3rFaCrnYhhwv5GfdgU9XorQUFbWtT+CTzm8IfeAs0s60e4vKUYIrcrkVqL3+DUuN0enUBi0fCMbYqppLcLDrbnp0sVvjfCk0tZsaftcTaS0bPPuLJUuiLKMrqpSK2jLe22SeWQldb3bYlFX+bEr47aUl2Ubvg4ryitzSUWnLJCTEmHDxPWcTXObakEJ9241WkJyOiHzrjJCs5X8RJRPQnUePU2DZGELFhqU4v/jcwNyy192vbBMRxMv7yuDcsF3wS8ZOLNnth0J9gjC3Gu/e2TyIxdMVoTgLfT8beEcEfmfgjAwtW/5GhpskLFpz+R9YTE3tGjax3KgR/ueKdTfXlsWCXxsNqaG633hkQR05d1Kz/kDjBHuj4NIcYbl+2UZwQDzMIgQ8zuJI90PFqAXG8XMBzrAn9Rkm/OXp5ECq+xyEOHSV60DTXir9DFYVSSOhXTnE8x6BNAXoAteaHHMI/NYc/5JqJ9E5KeT/MoC/M9DkGp0OQVPgkKtDkh7xDl6I3fF31XpHQb6ZeLpRYnwigvc8x+DDDkHkvpwzEc+xn0Cc48U5/teBhvNQPuSAiEXuJBSEnQcST5nq2gd4wbX45/+ri77Ea1z+M8ChHkhMP45r4myl6GHXaw/jiK7HrYbzzu3BeYD4k3scu3cGBEHxI1K+C3sdSlzH+S/bW+UVPWCB6YRPy+YMMwsdAiW4F/jbRlNdRujkMQiQGz0KHfgzUzbPdvIeI5LPwYnrEA5TIy56F5LPQ1c1X63cw22IJPgtIXqHfG3Xz5dt4B6WAFMS3caHfEm2Lab6ttSzbeBPdTRrDx0Bd3Xzjs7BsGz8rengUJRJ340+UurmgcolYvSW2tQi0vDd6FlaRkl2hDKenVLpoPHwVJIjZFSt+Mkyk41mQ3S01E28a6OadsWLqN0zLQm+qrVYXv1jaxmX44iqlq/1ZkPQWSlDrtOf7NqFwTyvWtRe5KfxgiLY+RpBavbcJ7eXrWt5LdWh6He9cxxP6Idq64AGZfkOWiN5U6SyiuDqddaq3cCqICJ92pSjl5W2FijrtkmittK5t/KQRuxfFPVnNLyV5Wby7tuJh2MYvCD1nh8QZrSme190e7V7Yxj+C27juS0niXUk18KXNJ3kidulJ+HCcXbeWXUD06pPJrGUvCol98XdRFy/1ru0jXdHjr9ZnU/AkH9EPrOhJWpsWgp5ZieMs9SSPXs3VFqUQ4Yy17Nww42XHWWotSy56XLY8pPE4O42Ic48zeZxJeimWIveCMMEeEuig1F4URiy05duaxR3t79lapdvrszW064VDj7M3nKzo8CTnT/JVba/WWlVpf8HWmpl8kuv66Apf0S1G578vndP/infq3tMqeSyu7njI5WvZqWvZE3HFLyW3Rmlccpw9sY6eyN0vFxmOP3bq/+BAeLAYe4wWARcRbVgZow+uvxBgEUSMfrO4EfFGhBvxb36f2IawDVeQ29n6UA2JiUs9AsM7+KV38MQdnGMzFtzBE0MMY8/bMBTmd/BlRIvFT+AQNfM0/fbCZ4DI12InIq7F1FqE6AEZkjLEgdbHdQGxDbnPuM/ENjxrG74eqACrt+GyeK7bEGIxuc86fAY7uX5QB//WE70ohSdwJ1fRMHiK1/Iyj8qhn8lxxITmb8PVazFJOsE2FFFxffbbFa3xjyE95PtDnPdNWIuCqFTIupQ0CPRoRddO/pEOyocjrg/zPAsxFWXb+jYR7uAzJPOKbdi9DQXRp4bCK7bhmm34RX7yZ4Gr7+A4gDCAeBUo7QYQB1RzJXVDUZBSbVHEZdEDwnhFX48HTgm1hm04FgoFWAGJkBbkvjkeWyzjsIz/NLWohM301+LqRe8xr2r1isUN38rvCInNITFBbo3kkyHz9Jh8Ym3Q2buW1Or9AH/kGsmldFbET4C3vqg8GTpan4rqKD2LB02ITkr/wJvgWpSHAWLQbzGb0O819ZPNCHeqoGToFZFMCK6DiMaIuYEnYolw/ejz2wjfRgyZ30sRd5mOfC1CROhUKOO3pnRAFBZpGibToaVj5cBIcYrFDwPeiSvixYoX9NqgfMlYlLYEW3KMdL0zUPT1p/4a4dcIX4YADy2C/vPbER3hy8BDflLLxIIiiIgZS5PHvaEkxmIFiGe3GK167v8hfAkUPgv/sPIwAxu4DSseYvywgn7TeuFhBlkevG+1AUMCOsi1AQ4z1HwbkLxNAQqC6Blslmv5IFZHQCicwyM+7whTOIc3n9nq1DooQe1SKI+ys6FL6xNmEfwlPU2fw4OgKNMotEPlLg4zEXEOxzSPiqnjnsOjiBjnx/INitMRq4fPUQ1omfh74Yij3cMxItIz4hxmLUULI+oWCd4ah9XerNiwdTqoBt4Ih1nnYXZZrDrwcKyEOumoMc7hEElGnMiNCv9QGNRWuZSUbrCg7qIE43/wLlJABz3EWsrQVsU6l3O4qtOOsOtErdLaA+JqxMtyQtxNb7I7GNzdDMa7Q6/m301Pvc/hkeRyzYziflp5eZR3GHoKxeKfZvxu9vXTOgA6AP8HqK3Eaxn8hl/fAR99aev78ziexugR+eFYTwkfr79rH3gs4bEvn3cR8IsAH2EwQriBw60cHmEBLgK8CEBD8yP3Vt4M0pxIRkNzKBG4lS+PotzK8VaeeISlbuUq3xvI1TrcypUOF4Fyb+UY1+ci+MCPoFvAXlhND8X5XuD0qvyMvZDaC6u2sgSFrtjKLt4L+hk5dIsECa8ib/QerWMv/ELop+rR33Ont+xBtKfIcHdLGKTpvQCf44HYFqborTGBvfQO8DYeiOkEt8RS1nzNeQoDQkfsFopDb+iBnsUHhI6iaZ311liQ9m5tzULTUcpaB9L22vjftSckw1gpKl3ytjCLLSEWRemIMgqRjIqmM9UIQzGMiPGgz3GNlP47AXGLZWotQX8YIFCgTY3o7xbshU7idG1hHTGBFBUhGWvZU7cwPsWCeg67BUyFLaiViaJHzC0Mo3ogPVvMyKmtheZrPIX6LyhI0g2oU+rOqpLtFvBxLsI/vdAZFkFFjHJ5qtUaP6peqgSxuK2jxt3CUNf2liXaLtktOttiaeGoIE81/0Bu8F5jqtmUUQvCVICOkAiaI2wUTmNSqar1iYZSLNMUGeefuhfEIn2aA0GjW+g/GoGxdsensL1PxmogSX95Irk1/N8D7IXEVsa7RVA58dSWqpDoD1dA+BcpVm1lpz+Fp3yOL9EBdLeJ/yGNaMg/hSLSthXNURCWUfX5ePGpZ6LOMep7sRS6tJ7xxKN21FNEXMlW9TqiXkeJqLFM4yK1F7ZtZYu1hadwGUVsr7QtrJl12Dea3SnqFfHhE01ct/Gz22oymrUIXVuW0mQqZMYnhG4RVE58GEaJ68HVVgmL5/zbOKdpSk07OoqGpls7m4yqV+cblkiji/5Kiu5jKZ0LTSO6fZFkOrYyHopBRCzZG+OdNiqmdsPG1dFTJ29xnSlsYVz3KO1OscCdYrpuA058QIXtjltZSz1EuUTRNR3NA2H//H/Y+xb4qopr71lrzT77PBJCCDk5ECFofBAqWNH4qKJtqUBVqPSln9RSULkVrdY2l9Zbe1FAFCQhsSAIiCBo8AIWFQ1qKqAHRQ4oShWsYlSsgIoKagUf+P32zJnJ7NchYID0ft+Pxc7seaxZa82aNbP32fu/vTqOaA4gtl7spC+Z/m+YAhuJDL0kH6uScaOCK+Jpn3QHUjSDdiNFJdvAOKAnkV7otXNqzqJfJ+cT5STjyRVDpHba99QiiKYvqWhjmzLrtdLjBmKSolo0m4OPTOiVawSztR3cc8oMC2iuejJTTChL8zfMq1dqHKEsM4K5lg+xV9EWdg2Hm1CrJnP8Cnr2Ce4g2TyscmnQ099jfx3cZFstgNZFG1nVSejtigy58qh3YrKaZ1/UW23ShMq28j1UNnetp2Layr5IeoWawqgtqQfI0MIy7O8ZmuBYoWzr8jQ5Rr1dK3jzzFVrdEBE8oRTcyXymEIKr3eP2pN9o2aeohGK0TCRmciGOymMaQc1nS0hIemNdw3YurveTJsa9USWgyv7HeHeYZrGkZ26YwvKXaUmJQyZXmqMEek5Yu7bKw3n0SuXvhYwo6ixGKEZi3QAqTSE15XNlVHTCO/Q22qZjpsuBFgg6nfwvDIpVEDZhd69P6g0lbugSuUJ+vpFu7SMgdo35NALW0X8W9lKYydsbi+lYfV1k2klHWP1ZYg+SgvrfVENwDZs5mNaVdbsnV0QSdeXJlUzgvxOaG57NDc9ZfRVm15WjKWHmrC93KIr651ayUpq4KhKlqxk7WqAKlm+EUyiwkt/79n9epYevcnUS4DyKw2uFtDKDAv6EsncoemVXS/TlUbl3sbls3+LrjfGHluZG0hztTJW86h2AK2LbOiZBf6rYM/euNIdr8xhMtuay41fCzW50KiMNb73r81rVTOqi96tJkQRHOxK34WtXApHuEO3e3CbtdNW0hFAb5/UpNMLdLMYvZmrvnZUbUnTefRdAr2maF10X1oYz30JEU/AvOLu7b4G1y4hR9PjdXrQtdimdmrTQqVcTlVUV3a8klnmfRWtiOZm8jE9SgugB067kCl2byO+mQaR15vikhOMi+LmeAUIgGgYGU2pAj1QiYc1EC3llihFOcHNG1amJJ47MKaOxhDHTUU8Iys2aVjKbY9T6cpCEdszmiKIoWFYu5T/cxXmhFz0nrorV4hTDGpYJI4oSgtzdrGhvcRwNL72JumzIpvApjMKOCjCVw3on5NxXqEbP1LSFRrB0UePHvEHhozxVxAbEBh7LHIX3YHLOC3jN1r+JbWU/2Q6Xy2BGf8YVHx3JyQ4RtDFaF+A+B7hYITBCJchvwBBEF7mnMJliMfQK5cUExRTbAHYb+HeHiUULyFeQhBBjCCJU4ciCBHkJYQlBCUEP4VECYHFyeJUQmhxKCFL1EfRFkQOilZOWiRutc/iv+xC46kzszoz6ELQmUl6Ma8cuSAsx1Q5RsqxgzhtL3K6lmNcJCKqDopEVCQ6i/S2D9oJQMX2AkGx6ErW1werCFeyB+FKNuMI46GJy93vqki64HI8vx5nToLZbBzOZtPK/Dh9ZQzLxHF3lo4Rp1DGLDNfgPRNv243S8NkRpPZCbPZqqVUxO/HIt547G5Wg7NZYg1EjM63brF7sL/NT8xmE+wZmJyBg2bgZTPw+Bl42gzscgr0n4Hfn4GXnQKJGVgyAy86BfJm4KUzcPEFi3FRrB9gP4B+QOIo6TPsB3w5WzHfEh2U+PRd/YPZrIGUbn8fA0exLKm8IlHvqCBjTSlOM7tfCIBjmkGdm/qRQ3UM61gqpElZHYuKOvEQtiX9iPoR9qOY4IP9KKrZmg8CBz1LbeZERRepOpavxZNPTvcjLhmKXpyjkNwS3UEd46ov8zHrzv2C3kEzdLf6UUQksI7ZyhqW4BMVmZbUWrSy6hiJOhElA8lOtTyC/M95o6jmJ6nCkUI1W3CgfhTVRcYxKY6PaLV5HeNKGqmGHsJsjhwGUccWIurKJNrairXk0061gjp268fcQNRswkv4dy7hL9s+N9MwmkN8cJmLes9m33NnlRkNPHRdOO7mybMZTGL5oi3OZmXhIJ18EvuZSBSK45ECmvVcoxcK6prcb8WFSXjxbBafzfrPZpaS5PN/Uh1LCAt27ueFNs0+8F/HTq1jHcRoWWlnkE6tY73qWKM0fEyNgBy0k+tYRzEOvdLson7UuR8V1bGudaxLHVu9NHEag9PYqocjp9CSv/FFrJb2AjcwNh9ZiccRjEYYjfi0+tRwf4SzCM6ip8YX7kDcgTVcvUCbmIbvTLLUl1SfK+jEF/TrxGsHNf+feDuKsv6d+Gj3l1iLO/E9Uwqb2HvZ+5UjmTWSQRPrfysuxD78q43xH0LTGPsnHOYh7GHwHoNqhB0UkRiCQxlMR5iOc7v8XAATmnQjwNlM0nL4ObhIABJqmpaFHaxEeA/hZQHPaAAOVkdWQve7AO4Cupw7nCVu47NO2VSrEuFElsUklGQIcc8PDVhDLhMdCbpBvBvAr9EhJWNWskcZPMr+5FFF0WYYQY583WDUywAmdXPEc6gjOeRpKbrM0kWsjxttMS6UPLUjwWnoov9CWAl2CYMShiuhu6z/FsFbREIZcuMyUjfAa8CeJ2AdhzIYyl6I5wTFLHOfvniLNRehHqC7wF2ajHAcb34r+xbW37MXMgAZYQO8BgW4vmAA32bL/43WAH7Zm0zSahB/Hup4JMAmgDEM/kPQSQw3IPwGB89nL+GdmB+jh7AAo/MZzGdd57P+BehHPuQFGBcVonPRFjn0JgOT3PUTnlLRVpe+2eExhMcw8hhuoQIndVPh+wymAl8KcBKD77BFq/BCXIQX4mq4EC+7EDNFvhcoCkq5RFuMycSn7ISLEC5CfjNaFyKY9AOaegcUwBECc7GsPQdB0XkM5rEihcXokKeTNbT9Q/g1n/O71xm8zuB6BhsI/ojwU9wIRyOcwGAUZmkWwO8QPkMo5zCKwQ8JBcEfEN4AWOfkwMeQ6MpgPcEEgq4M3mbZo6Kpf1SfIUX5kdQHCUq4pGLjI6WeIijhz3/i3zKD/55/eNEjtI1gGxVto/RqPok6LndIojna8kuzGvRxkgH9eAmLGGmYRHejBwpyPchE/XES+9FHOJr1CSmC0SyyiUaaQI4K+1FT19GsVAA8WuI7uOTh8A7BJkEZSGQgbzSLjGYkOrUkeuRoRtXER7P4aBYV+bHRLDGakbtTLjJBHGOimqxpy3QGIoKhJfgnFJplTLU6zWDV2RBvXIDOAisTM1AWYpF4BvKF3PZo1tPzHKSQo6eQafJwhfa5saP4JHBeIQyUmJeTCCdRXMF9nmzgX8ZWEKn8m8dG1wPcS7Qe6FtYU7mYoIzewnXsyx0S8aH3cXiiSJDxFd33SWTx9qx+fGHQZ3Ar6uCV6FcAPuLXYvxOej7xOoKgRRv8OJHy+3SwHT2E25H+wsAgNFrt1CkyuNzs+Z1fQt/JCREr5WcFzRss5dddTx0yBBlK+ID9mrHu9hLszaL3lYj+8ofRaz83ES3dlMHL2QLoRltviRUyKGRwN0Ihm74sMp/wCAKD+KNOjn0eG/wmdOzCLpYwdzca9LvsjQisgn4G1CG68SoHP8xAEM0isBj8jgAZzGWJ1wgERcTxx3MZ/IPpgEivkXO6juBPosl6gRX5DEEFg/mE8+lZU5SCOQSrGCQR+jH8rqh9sbjne7HgUs4vWU+0CuGfBP+kuJKs4muBQZlA53gkd47nEpxGMNeRDwaAQ+sFLucpzKm2juBNcGgd9RFtcR3Dn8CbcBLAbSxrOcn9BYEL+DMO6+hZWAKB9B+dCX7NHDavMOgCQmp+rQcsZY+BaXkqVlRy+C7hT8XPK0mEKUwWPQs9MLqKYTuElwBuBvg/4Bj37wzeBscC/4nwJl5DLNqHLYL+HO7FLEkTSFpLm/Bc+pe1isFwxHOJn+sY5EF6hUEQDT6FJY3TO6zeAD91RgMv5lANcDF3xr3U6ewH6q7mL8TRqmRwLu3yQlUa9MNVzJpK9DDFzfwnmVltIVwFjvHkeW83zqKFjq/dhI6yZv7FHJ5kC53BXAJgMUscR69i0/l6dFxolXDWanBsUyccdBJ8uA0f4FDNYbSAuRsnjnkM/iIQDq/nmYqJPH2xD0uv8dvzeXH456MTEqIw/FPSllGN3N+dThjVCoPapnzd5fhmdRfFv87zSW59WuFr4/14t9FB/nwem883/lZUsufzM4S8V/lqF6h01J2IKTRKk2JG2p7P46qnTRDChRtHf+PADshteUn+mqYN4gb/mO8U3ENcXSH8ouh3zvEPCvGpWHwB+dIF/LQF3FrAcRH/9gJ+zQL+a/lp3X198zfupm4LuL2I4wJ+8QK+0p7Pn/zhAg7DOPxSyDCJQw2HP3KsdpquHHgLp1v4rq08wU4+iwHnwDk+zWAowFDA0ziMgb+1k9CDkn7DHOrFcSklhzE4ATyogXwCfftWFgZwtxKuFgh5OwETDK93drmVE5wt6jMwgdBXvduXCF8iLaX2SymylOh67LyUiNOpPxFoi8cCHAu4Ht7FCYQxgARzZHiIgBN87bTswTkOJpB0PTiZgibjdzjUMpRfE45wpzgLEJi1QVyCLmroPkFfaWBEriSc09FdBYcCfJSlWC9nd54/Ift14vnwY/TSoxw+JvgImqUURBMcHdqVc0x4TVnsMxIupTcLgUEMAJgknEDQHaAbh1+y2dyo2/lmBjczPoEeep3cEGu/cp96UNamLo24Py8MK9DahcUrkJvoYbvQvozBbwh+Q19ftAJhO7loRTP21yq+HKEMYaeLpUO7sgnaidbPMW+5j8uVBP8NEHfotkuOYMXL8YgjGPRCF71F0FPUO9thHV+O/HnGPydYjn8tOYI5PTzPZLcS8gwM3LToFbTIXo5nLkdIMjjGEZIrIR8uW4HWnQA7kXZjYgUu4feDxEo7az1oQ1jL8d7zq9n652E2dP0Rds2DV+kFSljw3X747R+xF+FWWvd05AlqihmYCssjxjeJs18UznPjgpnEJUCYwaBUHD+A5+hXGtxCZE0bHHQD+Heixo3WbsYNcLC3uYmNYd6864veLyh4YCH2Wbqb+e+6lXnkcjMZC3eAi9y1N7wFQbfxJM2TBZl1dkiFVRYBUBZh46moAbfxkG3I8F/uVsNV4hwThMeP2SJwo1ZDEs5UDY5NwtUCceMzj8jHqkQvd/4VPpFf8mDp9BDifoQGOlSxOEoFigV6y2KNMTUbkjBQnWRQgLk8pLUeg6pp17747G1FKQ4mpZlVReAn948FDrkbvmGnWSTF35J/ttoic5stcyDNttpp9nRBFcXNHxhS/OECUVEzlp1TLYJBObr9h+2uGhO5Txa4c6Mid2FJUG4AkwdLZFfuXBKiWUvoVU/1t/fzfG1B7vKGkjSLVhFV0bKSKlpckmaU4reXVRFNZDCRaYQ6MuywpCTNttlpZqX4270msm22qLqwTI4BpXiNnIJey1bRgx6rOAPJcwvsPZdGf6IgxXnQwDnhQKjjtTOm2VstyopW0ct2EHdvXSvNmvZhfUqzNz1ZqwuqyMs7xV+3g3Ihxb3NvT2g4/TfzEW853feD26wMzBA1lyIQiuZBFyDJBRUcFDU1UhXZHw/hOnmGSZh4ByqJzBaQQUvSwpoufVM56BKyL4swSRuNIlkmIuJ7E6kiwN1EeQvai6VMp/JC4YDPCKknawEPjPLH9x4di4T6XSGeVHEtOJpdszVCGeCQ2lmp1mFQJfDF1GWOkdDnWYhtZqygsgs2+jkRyt4QdLJpDN5tkhX3orNAis59WrGKzglgWvoPQ3np6UVIlEFj1RwaKBoBc/zmO4K1EaDqzFqDtxadFzFFFuog1oFSaaVhmV7zJLbQ5y2GpxM9n6ma/StNHMsoEdK5FMScDiU1FMA3pse8Y1upfQP02rg+Jkc5lGzpqLtBtiMWm5c6ZoqhYXeH6GLZJGYP3YPDj043IUnypVUTqrhbuXTDLaAQz4wurj7tIOxmk86R2N/mY4jhhQzyrnkPNMkmyhD0vqsmdtrP9JWUTwT61lEOl0S0D9Q2nk3suZJI/NXMljpnhyeJuYkM0dsDYNCOqJBgNQOB5SmEYZ7MpIEUrwiJhKloSEWkrQFSq083WhXTDpmKjCwJp053kALbT/EWg9XnEI/0qDbZhgUdLiRxgyzMizWQPlC6AW2CGZoWsYHGWliWEaVukUNhBU8lmF395L1tHSK4sOhsN7ZLkWE00WlFPUE9ZTVup5QsUMzpmWa5eBpVmAMIeqQrgfecIisuH19+JeFhPWkgepcz1Gs9PpBfgNF6qmdYPh4pJ4ihofK6wLyRV6Zg0ZQzubohhmW10CRJKBQNVZPd9r1hMb6o13Dv25o+6MnoqRdK4NMR9xCRBsovp7F6qnGmUjC+B5PQU+OkJqrMeIipvF64rqOZFJP2ED8ESWN0tIRV0I5epYkod6iXmGLoltvNGOrjA2emSqtlQneAeho4WQ2kG6+1BYGR3cAdrlPmgWOAvqgOf34lZEMWxYAMmrsKzyTm9R0JHdniws8E9lckk1BpGUzQeuKWfNKzHpH2A5M20DtbJrjqLnMZ4xlTGo/HCINjn8c7bbEq+30BE2KPY4R/9AfwIdnd20J98SV133Z3YHIiZqYwA3KbYcL5NZhrgH6q+0J92qBkZEqO2vFxqZZPgPFUqc7NFCkLxZU8H8l01k44Sw9ouZfxpGuvTHtLLe/3FUgKll6d6C4YJpZIqRF5KyS+KdyeslLVT/usRLQaqCiepplq8noogrOJUdxREHk23jIbrJK1JOrDyUr+swS83eM7u5RB3rFUU4sJ3jWE+maetbrHC2WDkyCrHqiCs71BBUul/VDw8+lrHY93VSwVnBZyxyS0qzNjhmKTH10DL62+cG6yFpmGUseiH3rl13d+wbSkT7TvOdAt+dEdQW5OMg6apkjsXaaI2plWLye7rG1TVT7bIRVOyzKOHsiS5k06glm5i52pTOuzTsVz15Mx7kGmmHrQGCoovnGM+6drJIvkmFmVIuuZzMLMswzvV28fBd9aCia3QutZ+jeDGgPzDP9toHyMixWwadP8i71iprwr4jXMZgGDi1mJjXZR3Ew6EHbc19ss6ApIOmBZuz0evJvGOCBZkNkqSN6c/p6c4ZcjWCSuzRRQV4Oj1P8RYzPo/iNrKAvWhW841z64930ui2/ka7JjYM91T4B4YQsOnaROGpc9on+e4ESxXmIgRLtunXVF5d7byG6LxMSBuZzlqNg0V+cTvNCbPuMlIv1s/TA+xqouMzAM9Z0fdiXG56jo8KLAgl96Z5BmfAcJb6HEELYMkk+0vHnrsIOBCaZHb4G4COJTB37BcQ3MDDJ3UOiL0EQvZ6J72aov488V9xJ/wDpdHhicNCOhSeh0DfTMAkrF5sDVzYti+OcJfew9umLvwq5GV3qbvgrz31lHx/Qnzjw1bnzlB3NWlm72cJZ8EFzBu1mUtm318JEookEBq2zJxL15GBSnOaNy8I+a7rTRcUe9GYP+LMEjtZ0L3ORBwvaXZrQ4MySPKwWkYvcrBImhLUPYrosp1TFGkRakrvyid9n27xqeEzgkXu/Sj9iLlu7S72SuQfGNopsrfmpvELBdkdVqQTFjtUzqPeNkBLssxO3UHwLTbE8fbrFX/mkvRFhI8InDLogXESRJ8RPd6dS4yk2hy54x5yyIBBYCR061TJwRO+yjZOFloAK/QreYVaKfd3eBxVr0lh8h315TSBKqsBUPTPFdpK7yV8xBM61+E8UzwnKukCnZseCOpP0HdF0vMZVPSvFpoXh6koaYaRflX+uTrH4O2zHcYrpCa/SbEixDu6GfY30JkwxEqnCFJPgsqiO+e5m8yJqFLz0Kl1pgtAa6XpdqZcw1lOQYtUe+zRoHOAj32HzrHfYIAEge1M7o86j9Cp9HlG8Cg1k4h7iOM6UZ+byeAiWKxzNHs6N9noARWGZ+5Uv6YmxZytYzrFeDEsD0zSAfIih4MFlNajYfepp2z8ciHQWpfDqEIhLqKYhflzNQChO83RAtnsKqkMmKGgeAWJztfPZ8QMwWk1xyUSkebjoUE31rr4lLio63VcE1Za8JAKq04GoWeNisZVJ8mtpBek6FwY0o+V2qqYSkdiJqWbw1UgK+3iYudFZI8ouxQq2N0tmEwFFGognmwgfm24hVlsBbqzTnhLRV/aaQonuCzLzD1Sq81O4Vjc7ViUeyDeY5cu6wn/JA7Hq1nqTR6ZHO4taRVUsomvJdu5ZcZKHq7u02H1aB31wp6z4xd/l32sEvQ4pnH21BgCuYn+HKhYVvZ7klvPTfPfMSrhP67EPvnesoUdhuLPK0Znqz5LHhmD03QeZpERvAk0POtHBj3kbSBTiLM10JuE8gDNJkwkFnMUiFrTVM4geRhN/WU3rTi/yOvjS4ir2bLKKXVPF3utYxXYfGW6fBRji3PGQNnX5vqzlMQUivNBWfBfzPLLz6CUn4mwFF+mWd0MiZIo97M1y++DFRpHz76Qgy4ORL6k8qKsNa+LH08UCAHcO+ECBNQ3woAkbVBFU/3hfzs0mDG+pgBAOpIaBQ7BY1ds6QPw512hq1SEXiSES+1gce2m0YS2Y0eREkZNwYxlDA9iGeB/BELSNNlyyFkjC15oAwib4sDh2Eu0jRmm+D4n5PwSUMhxP99zhR++dNbXoHKRzCCRdAc5RoIJyD4yohjsVlPDloMMBYWozrmgzPq0BoVp0Dm2k12iwmbsNgLvYVbymBDoHPd1AlH0BWl4fTXdaOKRan4MwSuHWTmUx8Rx/sYdltomgBiZftZrgiMSzz/43YZTBKfxSaZvH2NPtr3UMxd18lvErYLvs/KvKI6mHxH4VRdbMLJYrzGQJI02yrZFjotjmG2nNwZ7JElGFFbvMVQeWGRRlLrYzWRdRx17mrraM2SL/8qir64S7rVk/Zkoljll4WQlWKzQye0kY6XyDZ0zk4EzWjL+7LMsQDNOZSLhy4CICRVeKna+7ljVnqsrCqolLAS4F8IDwRrMaXe7R0W0Bj/U8blicu3LO0oTPo3Oxcg/W1tG302UGbeIqNSdiZDdarpMj8TJFU/7RYQWrOU4D8yr8Xk2RXxL4qJ0qHe5OnKNRf78FW+SfM9zswKiRBQV2k/Ut6GpK4yY0K5tFHrbfAlsmfsyayVCgWHII0i1pMhTVOplMDDpbAxxrqwkOEyYl3qCt+c8AGDTGXgag6MOnIjWw7Ns18GRJE96f35t1rWR9KllUvKr0BDRSvJT3rwEJXltWKVBsS3n/RooKaJZbNZxRtOUJAa8qX4YKSEi8XIkWqBF9QxLXhYEaCerjgVVUlPQzzC12bjGEIaQ5TLgkrGRneAAVjfd6vPmio7hIx4TAZ1SyHmGGymmTfST2NToJX06xOu2gALiGa4ZasAcpql6NDUiIyhUeld2AqJcKPolwlU/SnSprBydyG0FZzzbMbrfELfc/kRDHcySsnI+S2s4tMN2BD/cBe0KIeSX9IMSxzUwT8dUO1yjRAmmL93eeKphBb7ioZFd7MmugrJIdJ/CvuvZm2uGlBYpUIOquImLLYxcoX/VAcUpYMGmck3xgpCiw2qQYpjFPU71U+Po9ye94NdBVzZrTTTRIj9Fyq+OzXp9KFhe07xFUDoM1cLbhBllAOSWDrYDjTtTNNXy3zGnC6Ih9+EZL/CdHNAgNOPuMaT6k8VwJjRoqe1dFicDKKiRGx9NvayDahF0FAluFCSyvcRSFAbsKYOfeyoGv0WqOJ40fG1VIcaH66oAfGGlzhgs0DKsRof1Y1noWN88Ft39mZ584LVbIitVZHHBzlfLltChxMMJoq7hj7rByAJqaKo+neCPFlen9E9i/jwlI5O4rx3osAEaLjYW22gOjGzdHuwn7yEQpn5vtoZGKG2kXqlkR0/uqoGVV0yihV6E43otNOJZUyUVNaKvuf9uEMRVqTCfUdJ0PltakjZaA0ecykI1oFmlUEzb4h5CE13o+T4FNGGmkjuZgqBkjc65pXR+tgcsPzJNaMJ36h+wLki2fHiryZRON1EP4UEdzZR5PReJ0IYQ4wi+CvmvgDLds34RdxGl14PcPoAkXOZ4pBCgTgmV3i41BAM+KftSEKHzkUrWOXWFwnJeN1Z5wV8p/4cezEN4fK+XXKkNfpyZCkRt9+vLAvWUpH+cMUSPluefZgCbsrlxyrJycttqYZDcXlWyMuT0uM0X1QeZWm1UTKlj3r2RztKf1F3KUVbLBlexzp5tGqhDdmBd3wWklUx+l+4kqhp2kdi8VSr+yUi7jzZDe7h1jDfxILn9qc1is1v1rTNllX73ZwjB/+HEjdRUtB/grCNNcl6NxnjieLrRp14SFsjcnDgpFxoHxQQIv1cAQFTyKldqD93lF2khzdVAukyG4km0Nky9ssryBUtLx5L1yczvc4JbzFWreQjlj6jz07x9EPwOMIRsiHCsuAKv1FWv/Jvyf7IpTA7U4gk21mpCa8FHvDHGr00lkrpKzkFey7zVS9msYjXSTDGez5SKBlWyxOeFWyG+4nGgw+6p5OfGsKyJncCM1I+aLABPvzebHjbA5AyV8rmhxi16osAmftv2o1Qb344M2XDc+ULoYYDEc9TeAv0H0FDx6IsBEiN8KVAtwn0N7JgAjYJTZSPcxZIx+D/B7sBkDxuAMgDMgegRiIcL9LNKfgSB6BQvuYyDIYmzxzwWALPio7FoGPpr+RWwt4AT45VsIim69nwSg62kSwVWSAHS9qoSuiiCW0NUldF4acTB7GwYzq4Tu3QECoLWgHBPleKZAZ12E5Qjl2KUcBwmo1rJytMsxX2RKGliOVI5TQKG6YjmWCzY3oSjh5VhcjueXY2E5xsrxmnLsLTKtchwq+ho3axrsZrQGqBHWTymZzG7/9MFQLNI0e++1XwRlFwZl3vPQPNIPwU1zESrMuug0tKchH8pi0zAxlFke/BsPefDuhjIPW7NyYijjZo9zcU93N7tvq7KImzXORb7DqYA70N6RrRw1+ogIUEZPx9Y0hEHcRXNRS2DNRTLqW+bzgaJ3Ev3u3H6ygm6kTvy+QZ24RHbETnzHwE68qzjZObAZ+vH1ZdiJrx9PnfiugZ34CufMRIZ0/i95F2RizqxsAjrxyc1/nJz7B0kGTvoDh9sYVdf5v33W36CJPY5NDEYy3sR6NjGriXVtYl+0G8m22SPZ7vgkXA2fwKUjWeDx1FJWNNJpnD+SJUayOhrJ3oV34ZpKp2Q1rGOXzYRGmIgv0lOIH+KAp/DvxevY1Z9Ah6cQB7J4KStoYj1LWfwT6DWSHfUJxN6FhtKRLO88nnoKsZSdNJJN/teVD9BnD82HC3FZyYW4vuBC3GZfiB/uPGckwki8JxYOTTdEpY+Ncojy57GUj+98OYFBe1/ruR5Gmxh0Jq0HFEcPrQQDpM6kXyynYEaXsB9fwqKXsJhIX60w0mASdTDSkhKizl+TGjDvEgbLiVQfW+fQaDZGPwdgVdPdMJphNfUYzfKq6fjR7LUbogK4DRWC25ajBOOiScQn0Q0PmZ1Ngkl0cweF39Z42wXfZXADvDKmZCG981kjDKN+xwBNZ3Qpe73jMKILGe4Aax2DT9GhhegxenIY4Q8YDCM+jG4qW8cg7lCHmxAeYg6JgpI/U+JW/v09UPJnGjAWYCxwDrfzkHF09lbXhhZBKU/8mQb+mfKv5fZKxGG03b4OvzOMOg2jq4aR0/854EdYKxcQbJQhFAlN7T/CMTCMtuEu1tEAfUNBHcTx8g+g4y7oei2BpmEEQ8ChYZS8lXccRhO+ivRlxbfAw02zYCyLnEvvb+weBvYzn7efz1etLZzPO87niwrn8+7z+a2fnhEDjAEIQuPIVaZMk8p3EgkGbspLMDPTX8GT+QQmWCrBjhW4OwFVTegdk2IAQ+HSoVAS4TCK0fMMKhBGMbwSjjGax1QioSB3NDlCfOR0+nQtDAQQ8EEm4QTq/xHAKAZV3KSNugatzCZ+tpJAEX6f8wkUmUBDPoP3yIOyM4rhboflh+3WAY5isA6a6RaEW7BsFMPvc/g+l0cnMYolRjFYSrSUUFBSnEZHsU9pqcMSunGHRjE+gVYXjGJwLOBnCk9pAuGP4U76lgD4/DH46QE+FKCnIE5Zuh6lNJ+R+AOfCoAkAZMkc7hIr+ai218tdbpZXdKNwx0MrgS6nXWOCfV+S2uoOxW8jPgygqZRzKFxsLW4O4GbUCX6C+SjSxPM0SSIkh81AyR5aSg0sxrFcAIt6ir6RH/VGDiDmmBZoUYxjAEPZ90tvOiNNQ6zkMJdobJ+BGU1hOMAgihHdzA0tOjufOWgJycYCa0i3XjZKGaPcmbCiHCmAQZSlJBTYRzAy+Io04KedKKB6IaudBL4D9z7APjmm6Q9E//h2dntTfbFbeVplgX30SXPUfZ9AZ1IMxcMUJrBx05pwg0NHrbbNGnrCe53Fb6O5uRRvd2zJ3198iDjjRxJDY+thBTvrgBkzHdMuRtSJqIS0RDxOopSK5V9rS1eRa5XolP8IolF4obwkLRpUC0eZ9Q+RnW2fKAo6K7O7x6kUp8P9ED0GPTowFo80SfgjYNUatygEB36pPjXA1M85mYnLVE/I7DJ5z/ydb99ZtgQlocIXCyO5Muf8K4nY0mDeWlykRuN6IIlBAbtPD9EiqmwhHAJ1Q5aQrtknX5B4EZNgxSnObNgCU0Y5Gavacl2D/+jU3zXwKCOU/6Czineu4q6Gt2ikZ7hZ9MpxWsHpfjHA9OsS4rPGGQY57ywBj8XXAPKJJWk+EVV9I531CK+4fg4eGCnSHd6ScuycAP5rLnU5SF+5y1TicmDzD81gzz5ewem+Fxv2e4A3/xz+Pw4IsXrZoEP0Cie4hu0zzRIh3w90Ilmala2j/lWryz3QorHfdUoxd8OnlHzZ/kl9qOBQZodpWz7wflG1f9UgFynByJ2Cfr7+b4efq+4fifEF6GKNpjNRvvkKaiicQNT/DJ37nmedhMgzXoojptkyaVB6t2rneQGf2H7Ktoj295oZj8aGnp+GOIL59Qi1uIuzzD/PNx5zqzFF5vH/4mBaVY7qPm/p9dbZdb85/NDwET6GK/2dk9CZDis9ELWuV8M/MjzwZJPPeezwzAJljTDgrgRX046m8AkN787vPzcxU9E3cw+zfdA1EhYH0WNNBw83XNfTsLzNqS7x+XFa9i9y70v8ipa7lXczbraBZA03Mv74UCQEMiwe7Lvpz/C/HSftRnfODvI5FP8H5c5VjCp2oxbIcM+7B/U6l2/8/4mCd9/hP1xM37ywxb208lQgRtVzXTxI15NMcOKN2PPJCxcTy8yMOgWeBFN6iys/RYPB8AIROuSlOfmdbTHZdazB/BF8ZK+my73a7kZ42sYDodB7g7mkHuY3znbrY2kQOF3oDBGe19tEjIkBL+YIcMPMiyeYV1E494ZxjdjJMP6ZVhHxbFQHOdCEm7QzO7t5+Nv7dMXCoUEmITR/sZCrC56NDcjzzCJ+GVn2G8zLJphSZGWoxwVpVGVfgkVo0em+O0UZqrnzLeYS31tyoOiBmVYmQlZ4B7PbrqokFyUYRoVy+PrPCgKcB+4TJ4PKgEzrETMBwkXI+cGmZBAipLh4c8LnuV5iTtjwBq5JaEW1JRCehQ518DwkKQvWrygf0nAzehXJzuW7ponZ7yf+pJaUxAMiUf4Ys/Qu+fewnUU5DwvOKZe45Wt12a0gqQdB+7g+zNfKPjLgODIKMUwmg71NT09CbQZXwgMra4J+I406fnCYaQptF9ZGfYncZRFJLDIQICFkJFpq+Oc2wND43PeQSwks7jWC/ZgUPbN/jXsW36nNJSYwDdj9yTMvh02o52ESqPoBtfat8Y1681twF3nSWy9zdjTaF2QhLm4htU58XdLoHKrUMTH9hm2R2YshSRMuN0fbp6BJKT92YXGMLavp5jySo+T5ikAnfZGBVzDuNEkVk8nus1UlIRjDG2udIMxuHBENuNHUqDoi2yeJ5Lf79nMRDYjV52e4kMVKtATy6eso4W5/tWTOUFvwiQ8iEqIu0whIi8Gr7p7A0CN6ul6d6+dfbOjIKhVu6BMS9AyMytaT/F6itSTtgEXja166lRPcom3RJ1UEq7YjJcpOVZMCdRhkwQZ8lBKHIsMoXEzHruW2SLfs0LcAEl47yGPvSNBnf3L8y1Ey92mvS+0fG8zXpOEC8SkP9u3qZH0sW6/pF/I9ujrsJ9f5QJFbmwzj3OepOevbzExd3qQYfkZr2kCozuqtTFwD2AFNZFrdSBzCFp8Avv19uVbRa0Me1KbpsNmtDOsnVxTQjr2Q2pZ7gDHfRUcY2100dj+Ldu57TUHrlR5iJ1hfUW38QyL+RhJL5SAbDHhXXGZzrCLg/qgzVgsWFkZ9jNxLE1CX+FmMeNoiSOJ4zM6cC317fSCunhDLuIRpcBpSfiVELE4w6zN2EuiZ4nTLhLCMsTvv2WYY5DYL8/Qc5CL48a3E88xMOi+8eRe4Yo9HuIu/fDdDuFwQxU+gKBXKQhI6PigzNeOF2LPWyzxgabuueIDtD5wI+S/T3GZeM/INH7ht3czrtGAdgfUKXyP0W4WfY/BBwgfYPR9ApE+8j1m+7jBXKxrSIls8vBTDzG0Pw1AUEycbpmZJ8ot8yOfQaJw0X0znQ4mdVD58bk47+HSLYR3cthCb1dP5OFYFZ+1O5pRUMEQ9ymF5Gs6N7yP/uFoGXmt3erhZf/i5wKkSNPbPQLfPBdvt7+wayx5Xjx0v2lYkfP9x4l8Jqv76lF8BONXISjiV6F1MuT9HhP/TYkSPOpEjP+UlbyN8UVwegR6L8OuZ7KiEbDj5Zl0BH4++Sa4FDf9KxLBjufh+GgE7RJ6NSo+N72wMIKLeAlZJdSzhKx32aYi9d3p9ct5F1ozrp14zmibXY5PlJTja7bx8JEkEgTisSUSx2g5pqEcS8txi6wSEQ8t2eqJJNnCEk8rxdXzTBFxjInM+0gwAfWVarO7pMjUpzdwwfi5o0TDl/zigegpXxRTOXZTX8qeoh+Ymm2Jh6RkOSpt/MTdp1iOD5DiAb5mlspBpbOtcmIi7dErITKxHOtl1fvQKFxqKVZQjo9Ja2E57ipWpnsZDdGpHNeOff/4NKv/n+nnXYhL3oULkT5ld86Sf6sHXYjpvV/GjMc79jw7vl/IdUUv9+n2VTUF6u5nPaZ4Iugm6WQ0Tq4S0VNepr5EafZi4H3V4420/OXugvAb2IkWFB2tuH2ov43Q0ffL2xFBP8ftqo6E88d/k6Jd8teNhKHXXP99whv1j25/vfHQKI37ajIm8CfSRC2Ol+LP8/9OKOl/DpEGB1B0Q6BOku7K8bRl6xaRLxFW2f/Z94Mh0h79G/vWr4O/L7P/lkZ1nBZocv2lk9mHzOptpCg/zbaHzZwPbmizM2d/i97jIdFDp+/wOPeO7GMbin4R/tsftvmiHJSjFbmr3WOWJVK8vXG6am9rzdQDK7o5RyQNXeWcUT68A3Rq23CPg+Fv2nkiKV57qAPJX6Q77ETjFYTbwsJcDlUOoElbKGp3uAU44KIcBs8LL3qrNf0Lq+izWPhCeskBSdgWbHvARSe3NsONN+cZFp1jH9al41AWFYSvTxhe9P7EQxw+H/E8bORFB29je9iDUbQxLBKtrs47yINxTBWt0Y8T54hEmGa/TLNq7hNxzR0d2uoE2GcRr6IvPN4X+BJh2/GUAyuKq1KeZs+ZoXJGrtsVT0XTLC/NuoQwzQ/vL098m/AZv7MUuE/j+wriMbVIyweBrTawRI2Z0cGn76YcVjz5EPr5mrCCwGaPBj5KLgn3VeSvsM8m/zuKMLxJmGX2q5ew7g6BplBF8XBKtI3h2HJjxDf/tvHDupic4Ct6wmqZbhSicKS1rZYXUj8w37HyDX4rt5FV7aZWv/ljXhJ0qaISUZnv71gGFiWWUIfWHssJzTOgPoctBhyQLfxvzeRukqiiRr6fanjqn9/aBmr1omR40fttd6J8w6IPDm9QPahFEbeva03/sb+u3LpFp4bUpLYxDY705YyryWslt5t3iG/pe0rtKupWRVZQIPT6xK/axmC0eiR76xBFsumH98ebQ1MUeJfxtRzRBZbQkPCiweGje/fMDhMZhBD+2xbVat/Z2kZuTP//otxFt3v2C/eMieznsK/y/Gq6I6zzw65rU1gMC9s+H4x4E3hreJ+PNkEVvbDXqqK7D/ZUvkuP5tg267OtW3SXHv1/jt1f399n0V8pzb5osxMisGjmzQdghdA3Tw7e5U91W7DdzsO1LfrmP4juvCFwnCcGPgQjKfCyYdY3seitLfnZsr3Y5vtLPwvWoI1shx6XhtwQaIMth2yLmx+S/3EYlza4L295UdgPr1valqtMD5xkn/57LRT7LLqLQgbt4HnEmDbihwfgogfBe9dpN/vnv6lrvf5N1ldbWJRXUbSVhujxSQfwYIfs/JlYml1tKDZl8sF4RmTcoQllm62QDcJ302y2JSRpt88Rr6JD6l0hBszRZMUhfWD5y7ARyDE+d8t7f18e9ml6CIpeCavUNsU92EUH0znHtGKoeCPwLb82ZcocRS+vODrcXme19gDcf9ivWVqrCNVRJr7wgx22tZE+lEUjDk5fEV+109uGvjmKXneFsf93nvLW9MihjI8H4Hg/aRt+kqOoW3jRN14kJx2WANqYSPGurW2m5EE0U2DRIboW0UXVEnyaC2hdzzPHYW87mc86U4rnq5wikciYbxvxFO8ojnjQPDnpe7lp5cHa5Y1tg5sGTbujbSOytGLRG2G124Jwkg5aHK1uXa9Z2ZIV89gDMsEhj5HfsIiq6N0w8x3GibzW84bIHi9Gg3kzZvoB9PdSQgTjQzY1Wtsv6g57iG15kU7MOEg71aIDCkmvVOsfI5/5XwNB4aFoFf1FbwheCLNE21lBWv3l5m13eG6S8yrqk+Lv7JX3nzfJ60XPBIwb9++PUyZGI2RCFb2T4520vBaIh74m3Mhv+jrw/vhT++unB/xjbHul5z7fC4i6jSsenA03TZmRHhVUgZQJWjjeGLKdvnmS+ajBk7ZPeu5eRKTocth7qCKZucWvz20tdD/PEOu7LLOy0i2xhfNRy25coDutK1jG0w3SlevCXiz+arLpnBv8HxTK9VhBzEi/fIP5jZbJOd4gThrpqHgnVdLZaTYrrCf/Uwem4ttyyLhzr39iVnukM6WIp9lZWpANORjXfh024++Oh2hOgv2N4I4cJs0e4//SjaQ5pszd0+zOHKZqI4vN/ha98W+kUuRAOWz1+uPLYd7ygHSPjq2tw7MBM2Kqf+L/QDjra4fSuuNlWay1+Z6Y83ncDY49HvSMwoSw2jl+yzdfFuRi1/BFDi6HxrAH9u6WcNKFpklepZA2PUL4WspUjQfyhEMrFgV8wgbSbEZgnH08Bx7KQSoaG1YjEDsukKMtyMyxajEpOOjKy5wL5g+l7gv9v529D7VY0xI1Ogr8OrsW4/uvc74Beahpf652p+zXw72LA9fnHHvRNnLN0+pFab/VNe3yuFynWuygBrF90HhBLf79kN64Mvv6JGz0PQ6wxJzdi8LU3659Vc61lDjGazEm8tv5nBkFtUz9ZiTVx9sCWkmOos0eFMMjarGzbxKj4QtomO3F1vGFv5ij91gbN9g+i9bnwIUMC44FIfjPYU2srO3DLvPnSou+fchsWa/9Y44WuKtYMPRKVnQYwsfBLsp67g1hdv43BXP8JkW35Vhvcu8xprdwCyLDdGF45UDyzEryLW/pw+OZt+rgV9/y2Rp2n6DtuMEBFD1AIRtLueR8Dr6VSiba+2KpHTToWIsLP14Da5hJT9wUcWesnuLJmLOxhzuj8S+eGrOm5LkzFr3vaTKr2tOkcdO33Rlr7+vgzth0m7fJDE8vbz+dcmdMH+upUXu3J2PP+Bcw6LMykh7qKf40vvP4s7CdmfTc5z1vxP8bAAD//1luxYyPkQEA",
-	"ko": "H4sIAAAAAAAA/5JkYGDwDuEK9lZ42zrl9fLGt41ruF73bngzd8ubvUsCGRgYJjswMrAyMDAyMjAyMDAyMcw8yMLEwMLIIMTAcKlHlxEswQRDDBiIEZUNR0wMzDBxNkZUWSYEYoELIpnJjMyFqWRiQtEIQox4Eao7NzcdZsZQAwgAAP//mclk4BwBAAA=",
-	"ru": "H4sIAAAAAAAA/4QWXWhcVXPOuWd/cjdnm/bmZts07bf7tV8+Ww1K4ouKiCD4IH0QQx8UBC0RC2kLIoIPQprSotJq0h8M+Vnd+NOCRDZp16bZ7lbUF0FlLlQQBJ8UkSK0D4rYPsiZc8/d+7NXYTg7M2f+Z87cvdsCGH3suVfL4xOTEweOHLKfODAxOXnvowdePviKvW/8KXt837it7kbt/ROTSmDy4AsTD+6xcQYXcBnP4YJCq2WcwXfxHM5jzafP4AJWcQlnsKZ5G94JXMc2trDhTWEL29goYxtXvTew4R31pnEN22WsYQPXsE6MNl7DNja9k2VcxgY2sYEb2MarNs6QhbqNs8RXSk1FrGMT63gFWzaexnVlSN9hcwQXcVkFchbr3pR3HOvYSph9R4d+WtvAunc8TWQOW960SsQ7imve23jZOzkyauO8d4wiaNm4iFVcxjk8bRvPi9jAi1jHD1QRbFzC9/E9rOEMLttY1WaVJFFVXVLlpO5NqQIF3DNYx8t4zZtSAZVVFOH4AqGmqi3WsZlIwRc5iyu4hHNlnMcqLqg++hdBbtSkSwF/fiTF2QLVawPreNV3ekW1w79d8k5502WcVeyA+lA1Fi/hOrbwYiBawzlcLOuxomGq4mLocpWCUvZbuDGSklbNH7HpEEcVa11lFfA+woaivanyaMjFCtm76k17p0ZUGXBNj6aNn/heBMCJrACxCYou8B7gEo6XhoEX4dbOHDCmwJLAJDABLAf3byFkAFgBGEBOgmB0K5Xka1IZYBK4UOeAC1kXpCBSwA4OGQ69Lvz1M5OQHybZYcgU4f9F5YQXgRUVh2lcwmAW8v1QyMDNL7QJJsDSyFZlVJBpbhxEBAwUoqQWvsNcCINFZ2+UqYGbW+ZCnvA+Sou5IBPClhF7Keo1DI8IYIOQHYRP0yQUcMhxdbIdsIXDVo1z4BzKdO6lRLlJd49QgYbjmD+2JQOsH1gGWK9B+n3k5vltDjAH+ujsiZaOE5N3C4sLGBOQEWDxjhYTkMl35AumHTq4XsJFsincZzrSDBBXcfdwlUnOJQs6H04G3UgHA1whjhofS4JwYMiBo4wScB3fNzNCLKRpSchLYJbfYvZvQxNY+JZbpMaNZnhWKA/dOItDRvMdcF3IUkzMARGkK+GPvqj+l5xG63aYd525IAjLGdYYReDHEYWCBSt58mNxuMv4HOLqpTLzjmOtfZFkHnJgu6MqyBzYZIT7HBhw4HMrfVBdAbwErAR8kKIrKZILyOpG0ZUKLaHYG4x0uND/gQtFEzVzYL8R8uF/aoNoXND5elhbVIBV4NdcIsOOfVcFHEvnySgpko2P8vMGuc8gz9N5SPiJa2Z/SD7j+uqFrlENAevzIdstZoXspPfebUPFIB9dXlplT/LRGOgh+z/UVy1T9WPxEOU/QmIgWOzZhcCNkjHdx9PnbNFy4XBKBkzAM8myda10mCxBeOvFZKxwzyv0oAOZPNxTUl21tRHCRXroTMD5iG+zyfXeTkprW3rAlQOSfCtiYrsPySwz3XKtsVJnGW4TUCLkFnc7byvrwsMxY9HHlzV1cc1W9iGsQpPWdV0U0ntTTqnaZ7Hv8Ig0m5eWr17eTPqrb3vAd+HrQG3YIKvhr7XUsjS/VuwFRbP+PhbTlUGSciRkeSgUN/4qxmJWo7dulJxlDtzSgrev698jBD8yF6qHg/0u4br650Rex6Jx/i6jL6sQJc9zB24Mh/LYnD6sujvnkix9Xu6+XHf7UPgvsAB2q6vkSusKVsqwdGAX8M3qDCC86f1PDcEvsSbGDL35rIBvHrDjrb7kSvhqQMIRCTf6JfxZSa/PBZ4y3HaKzqxMsK71mG/Exzljd0VUIFeB79TG2RmFQHNIeev6xBpxVnQGnw5dnbLon1yy8izE17Crm6uLd36rbANW7sBPe7vWivrxdwAAAP//TZiTy5YPAAA=",
-	"uk": "H4sIAAAAAAAA/zTIMarCQBSF4XMnSTOPB+IK3Ew6wVT2QScghKRxAWMKtxAQK63sohAI0WQN5+5IphA+/uLPAWTpJlunlTvabb3Pi7pyK945qmenLSd2tjwUbufK0vLMSRv26kP5Zs+RMz+WFw7a8qUnbdipt7yp58ArZz4XANqHifEn+DeIBBIHicEygQBiIBHk9wPBNwAA//9u6Y0YmwAAAA==",
-	"zh": "H4sIAAAAAAAA/2RTTWsTYRCe2Y/YnbW7fSn+CD0IfvwB7cWDCoLoualBAzUVaQ+9tWDB2oMearFNDslB20NrilCIxNKbf0BQ8KJIdtM9+QO8yPuZ2RSG5X1nnnlmnpl3/wLA1SsP6rON2iJdo5mbd+nWbOPRMt1enpt9ulCtz9foztL8Yr26vFij+8+Xnj1ZaNToYa1arTce06B3MDjpZM3jYnttuHl0+rErXVnzeLh5NDjp2MtuP3u1Zy6nq5vFyo66tDaKZl/mtzaKnWbeWi+232Rr+9nrngznva4E5m8Ph+1Vddo61EzFyo6sVHzYznvdrLtftPuDL6v5+4N863PeeVki0iCet4cAuzME+fdAQFaJAbVF4BEgs0osPZUIPAUg9Q1iFY2U2UQk8CJzMKZDxMARoGerMJLOn8sEKMAXMBWDT9J03iUCTC2HsN0JQFRfocpqv5AH5ACyHl0qNXiThfZKgMnI76M9J0wKmhRPMXs26rtyAmIxakk2gyZLnhMbckVdFCVVQBA4D7HOnUxhkC7RE2VOJs3A3Bwm1QQ0hu/EeYR1ugMyQo530myi3ojZp2B1mRCPYEJN4AUSRATX2Tg8Ygo1dcIWkJS1JezrxMfWOTZcRaUXZmbnCJGZayCxqrCktjTixFJNWljCtlJ+AbwNrqV9o/yPcfvtuR+F2OTtD/rp5zc/BD9Vj1m/59SEgjGupGTxGY+XAoaAEyPy0SNhOqZT+OFX4B73BoBQ4rpYsQ2F42UQ4R+6fs/YO5kRsuwQcMpKmIAokNUujFGGzAimFWZdtgSqtwB+yWd0Dub0bM7D1ylf8gdlnl4goNDF/wcAAP//HvEwBwMGAAA=",
-	"ar": "H4sIAAAAAAAA/9JiYGAIyyxKz8xTyM1PysxJ5bqx+GbHjY03u26sv9nBdWPTza6bbVw3G2923Wy8sZyFgYFhmzcDAyMzAyMjAzMTAyAAAP//efgyxz8AAAA=",
-	"be": "H4sIAAAAAAAA/1JmYGAIS81Jzs/lujDxwtYLCy8sgjB2X1gIIrkuzLmw6MJCHgYGhqlLmRgZOJgZuBkYLp8TYWBgZGZgZGBgZIIxmKEMQAAAAP//QXLPBVMAAAA=",
-	"fa": "H4sIAAAAAAAA/1SOMU7zQBSE53njP87+PoRFxQkQV+ASnCVIa2yJlehpKDdxA5FNQ5EjINHNy3ap4BSgZ1dIU4w+zbx5nwCYOPCNSYM5z8RRj0x8YdJ7z6R3fDfbcJdjwzFHbf/gQcNpz7TQtuFgsaWbIw9mOWrwHGwkRyZvHe3O23ja58jJWypHo54H7TlZe7J/NDTaas+dBTR4Ddpz0J7jebsUtNN2XulstddwAeDppoAUkA0EKBxkNQtGflwJqSEVpMTDY+ngHGTW8VIg/1CscV1bxtUoany/XnmIx6bEusLH1/Pqv+Hbar4x6zcAAP//JfzRUkkBAAA=",
-	"zh_Hant": "H4sIAAAAAAAA/2xTz2sTURCeN7vdmtn0pQ/xj/BSaAveRQQPehBEz0kMGqgb0fSQWz2J5lzRpIL1YL1I9SAEU6hH8VrwIIIiyTZ79+ZF3s/MBmF4+97MN9+bb2bfEQBsrN9s17NWlzYubK7p7yZdrj9sduhKPbvdo6u9Zv1ep9HeatG17a1uu9HrtujGg+37dztZi261Go12docmo3eT4/3pXr8YDIvhePb2qXZN9/qnB+Npf9cdZo8Oi52BOxTD8eR4Xx/ykXHnu6PT10dm9+y9zSt2Bppq9vhT/vIDFW9e5KNDHskEwMElgvxbrKAi4UeSgrBWBSQQzJJUe5IqoAGQWePURKvGfKIgwKrbOLMhcuCKiX78vUYgarBuVhdU/loFAs2qDJ/1K70RHEDeY+lrDu+y0B8JhJz7I/R7yWpEl4KGGW10FSKCSEGq5sXoMtDh9V76ULguRFGTxARx8JCXE9QpBwtZqMqEQVHFw4L8FSPcYniPg0d5p20gMjYODqJ8lp0CkidBhqT5RL4KJh6JSbJ0kjValsVItga1qXcutNJQ2cG4ZgVCZBYKkF4JehkLDZWeZ8UnSjaD8rB5DVzIq4vlF8Ltl3sBVfZzhneQwsn3k4gYnzGsgUj0lP9Ey3CddVIsgYAS9Pyy+ddtQplFCPjrYv+z5zojYdkJG2sFKkv6tnMLlAkzgrMG80SXBKa2JfiJEYgz0KzBl9VYM3+OFRQigX8BAAD//+G7sW0iBQAA",
-}
\ No newline at end of file
@@ -0,0 +1,75 @@
+package phonenumbers
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// the limit in bytes on decoded carrier and geocoding data, zero if there is none
+	prefixDataMemoryLimit int64
+
+	// incremented every time evictable prefix data is used, giving us the order to evict in
+	prefixDataClock uint64
+
+	// the number of times carrier or geocoding data has been evicted
+	prefixDataEvictions int64
+
+	// only one goroutine at a time works out what to evict
+	prefixDataEvictionMutex sync.Mutex
+)
+
+// SetPrefixDataMemoryLimit limits the memory used by decoded carrier and geocoding data to roughly
+// the passed in number of bytes. Each language's data is decoded when first used, and once the
+// total goes over the limit the least recently used languages are dropped until it is back under,
+// to be decoded again if they are needed later. The language just decoded is never dropped, so
+// the limit can be exceeded by a single language larger than it.
+//
+// The default of zero means there is no limit and data is kept for every language once used.
+// GetMemStats reports how much each language is currently using and how many have been dropped.
+func SetPrefixDataMemoryLimit(limit int64) {
+	atomic.StoreInt64(&prefixDataMemoryLimit, limit)
+	enforcePrefixDataMemoryLimit(nil)
+}
+
+// enforcePrefixDataMemoryLimit evicts the least recently used carrier and geocoding maps, other than
+// keep, until we're within our limit
+func enforcePrefixDataMemoryLimit(keep *lazyPrefixMap) {
+	limit := atomic.LoadInt64(&prefixDataMemoryLimit)
+	if limit <= 0 {
+		return
+	}
+
+	prefixDataEvictionMutex.Lock()
+	defer prefixDataEvictionMutex.Unlock()
+
+	var total int64
+	var loaded []*lazyPrefixMap
+	for _, langMaps := range []map[string]*lazyPrefixMap{carrierMaps, geocodingMaps} {
+		for _, langMap := range langMaps {
+			if size := langMap.bytes(); size > 0 {
+				total += size
+				loaded = append(loaded, langMap)
+			}
+		}
+	}
+	if total <= limit {
+		return
+	}
+
+	sort.Slice(loaded, func(i, j int) bool {
+		return atomic.LoadUint64(&loaded[i].lastUsed) < atomic.LoadUint64(&loaded[j].lastUsed)
+	})
+	for _, langMap := range loaded {
+		if total <= limit {
+			break
+		}
+		if langMap == keep {
+			continue
+		}
+		total -= langMap.bytes()
+		langMap.evict()
+		atomic.AddInt64(&prefixDataEvictions, 1)
+	}
+}
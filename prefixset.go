@@ -0,0 +1,140 @@
+package phonenumbers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPrefix is returned when adding a prefix to a PrefixSet which isn't made up of digits
+var ErrInvalidPrefix = errors.New("prefix must be made up of digits")
+
+// PrefixSet is a set of E164 prefixes, e.g. premium rate or fraud ranges, which numbers can be checked
+// against without allocating. Each prefix can have a label, such as the reason it is in the set. Build a
+// set with NewPrefixSet and Add, or ReadPrefixSetCSV, after which it's safe to use from multiple
+// goroutines as long as nothing more is added.
+type PrefixSet struct {
+	// a trie of digits, nodes[0] is the root, each node holds the index of the node for each next digit,
+	// zero meaning there isn't one, and the index plus one of its label if a prefix ends there
+	nodes  []prefixSetNode
+	labels []string
+	size   int
+}
+
+type prefixSetNode struct {
+	next  [10]int32
+	label int32
+}
+
+// NewPrefixSet returns a new prefix set containing the passed in prefixes, without labels
+func NewPrefixSet(prefixes ...string) (*PrefixSet, error) {
+	s := &PrefixSet{nodes: make([]prefixSetNode, 1)}
+	for _, prefix := range prefixes {
+		if err := s.Add(prefix, ""); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// ReadPrefixSetCSV reads a prefix set from CSV where each row is a prefix, optionally followed by its
+// label, e.g. "+44870,premium rate"
+func ReadPrefixSetCSV(r io.Reader) (*PrefixSet, error) {
+	s, _ := NewPrefixSet()
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return s, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		label := ""
+		if len(record) > 1 {
+			label = record[1]
+		}
+		if err := s.Add(record[0], label); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+// Add adds the passed in prefix to the set with the passed in label, which may be empty. The prefix may
+// start with a + and contain spaces and dashes, e.g. "+44 870". Adding a prefix which is already in the
+// set replaces its label.
+func (s *PrefixSet) Add(prefix string, label string) error {
+	node := int32(0)
+	digits := 0
+	for i := 0; i < len(prefix); i++ {
+		c := prefix[i]
+		switch {
+		case c >= '0' && c <= '9':
+			next := s.nodes[node].next[c-'0']
+			if next == 0 {
+				s.nodes = append(s.nodes, prefixSetNode{})
+				next = int32(len(s.nodes) - 1)
+				s.nodes[node].next[c-'0'] = next
+			}
+			node = next
+			digits++
+		case (c == '+' && i == 0) || c == ' ' || c == '-':
+		default:
+			return fmt.Errorf("%w: %q", ErrInvalidPrefix, prefix)
+		}
+	}
+	if digits == 0 {
+		return fmt.Errorf("%w: %q", ErrInvalidPrefix, prefix)
+	}
+
+	if s.nodes[node].label == 0 {
+		s.size++
+	}
+	s.labels = append(s.labels, label)
+	s.nodes[node].label = int32(len(s.labels))
+	return nil
+}
+
+// Len returns the number of prefixes in the set
+func (s *PrefixSet) Len() int {
+	return s.size
+}
+
+// Match returns the longest prefix in the set which the passed in number in E164 format starts with,
+// along with its label. The number may be missing its leading +. If no prefix matches, ok is false.
+func (s *PrefixSet) Match(e164 string) (prefix string, label string, ok bool) {
+	digits := strings.TrimPrefix(e164, "+")
+
+	node := int32(0)
+	for i := 0; i < len(digits); i++ {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			break
+		}
+		node = s.nodes[node].next[c-'0']
+		if node == 0 {
+			break
+		}
+		if l := s.nodes[node].label; l != 0 {
+			prefix, label, ok = digits[:i+1], s.labels[l-1], true
+		}
+	}
+	return prefix, label, ok
+}
+
+// Contains returns whether the passed in number in E164 format starts with any prefix in the set
+func (s *PrefixSet) Contains(e164 string) bool {
+	_, _, ok := s.Match(e164)
+	return ok
+}
+
+// MatchNumber is like Match for a parsed number
+func (s *PrefixSet) MatchNumber(number *PhoneNumber) (prefix string, label string, ok bool) {
+	return s.Match(strconv.Itoa(int(number.GetCountryCode())) + GetNationalSignificantNumber(number))
+}
@@ -0,0 +1,76 @@
+package phonenumbers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixSet(t *testing.T) {
+	set, err := ReadPrefixSetCSV(strings.NewReader("+44870,premium\n+44 8707,irsf\n1900\n+882-16,\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 4, set.Len())
+
+	tests := []struct {
+		number string
+		prefix string
+		label  string
+		ok     bool
+	}{
+		{"+448701234567", "44870", "premium", true},
+		{"+448707234567", "448707", "irsf", true},
+		{"448707234567", "448707", "irsf", true},
+		{"+19005550123", "1900", "", true},
+		{"+88216123456", "88216", "", true},
+		{"+447911123456", "", "", false},
+		{"+4487", "", "", false},
+		{"+1", "", "", false},
+		{"", "", "", false},
+		{"+44x870", "", "", false},
+	}
+
+	for _, tc := range tests {
+		prefix, label, ok := set.Match(tc.number)
+		assert.Equal(t, tc.prefix, prefix, "prefix mismatch for %s", tc.number)
+		assert.Equal(t, tc.label, label, "label mismatch for %s", tc.number)
+		assert.Equal(t, tc.ok, ok, "ok mismatch for %s", tc.number)
+		assert.Equal(t, tc.ok, set.Contains(tc.number), "contains mismatch for %s", tc.number)
+	}
+
+	number, err := Parse("+44 870 123 4567", UNKNOWN_REGION)
+	require.NoError(t, err)
+	prefix, label, ok := set.MatchNumber(number)
+	assert.Equal(t, "44870", prefix)
+	assert.Equal(t, "premium", label)
+	assert.True(t, ok)
+
+	// adding a prefix again replaces its label
+	require.NoError(t, set.Add("44870", "fraud"))
+	assert.Equal(t, 4, set.Len())
+	_, label, _ = set.Match("+448701234567")
+	assert.Equal(t, "fraud", label)
+
+	_, err = NewPrefixSet("+44", "44a")
+	assert.True(t, errors.Is(err, ErrInvalidPrefix))
+	_, err = NewPrefixSet("+")
+	assert.True(t, errors.Is(err, ErrInvalidPrefix))
+
+	_, err = ReadPrefixSetCSV(strings.NewReader("+44870\n+44 87O,typo\n"))
+	assert.EqualError(t, err, `line 2: prefix must be made up of digits: "+44 87O"`)
+}
+
+func BenchmarkPrefixSetMatch(b *testing.B) {
+	set, _ := NewPrefixSet()
+	for _, number := range []string{"+44870", "+448707", "+1900", "+1976", "+88216", "+2399", "+3725", "+5019"} {
+		set.Add(number, "")
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		set.Match("+448701234567")
+		set.Match("+16502530000")
+	}
+}
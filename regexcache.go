@@ -0,0 +1,119 @@
+package phonenumbers
+
+import (
+	"regexp"
+	"sync"
+	"sync/atomic"
+)
+
+// regexCacheShards is the number of shards our regex cache is split into, must be a power of two
+const regexCacheShards = 64
+
+// regexCacheShard is one shard of our cache of compiled region specific regular expressions.
+// Lookups load an immutable map and never take a lock, while additions copy the map under a
+// mutex and swap it in. The set of patterns is bounded by our metadata so after warming up
+// the cache is effectively read only, and sharding keeps the cost of each copy small.
+type regexCacheShard struct {
+	regexes atomic.Value // map[string]*regexp.Regexp
+	mutex   sync.Mutex
+
+	// pad shards out so that neighbours don't share a cache line
+	_ [40]byte
+}
+
+// regexCacheTable is a sharded cache of compiled regexes keyed by string
+type regexCacheTable [regexCacheShards]regexCacheShard
+
+// regexCache is keyed by the exact pattern compiled, while strictRegexCache is keyed by patterns
+// which are compiled wrapped in ^(?: and )$ so we don't need to build that key on every lookup
+var regexCache, strictRegexCache regexCacheTable
+
+// shardFor returns the shard the passed in key lives in, using FNV-1a to spread keys
+func (c *regexCacheTable) shardFor(key string) *regexCacheShard {
+	hash := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		hash ^= uint32(key[i])
+		hash *= 16777619
+	}
+	return &c[hash&(regexCacheShards-1)]
+}
+
+func (c *regexCacheTable) read(key string) (*regexp.Regexp, bool) {
+	// shards start out empty, and a nil map is fine to read from
+	regexes, _ := c.shardFor(key).regexes.Load().(map[string]*regexp.Regexp)
+	v, ok := regexes[key]
+	return v, ok
+}
+
+// write adds the passed in regex to the cache, returning the regex now cached for the key, which
+// will be an existing one if another goroutine got there first
+func (c *regexCacheTable) write(key string, value *regexp.Regexp) *regexp.Regexp {
+	shard := c.shardFor(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	current, _ := shard.regexes.Load().(map[string]*regexp.Regexp)
+	if existing, ok := current[key]; ok {
+		return existing
+	}
+
+	updated := make(map[string]*regexp.Regexp, len(current)+1)
+	for k, v := range current {
+		updated[k] = v
+	}
+	updated[key] = value
+	shard.regexes.Store(updated)
+	return value
+}
+
+// reset empties the cache
+func (c *regexCacheTable) reset() {
+	for i := range c {
+		shard := &c[i]
+		shard.mutex.Lock()
+		shard.regexes.Store(map[string]*regexp.Regexp{})
+		shard.mutex.Unlock()
+	}
+}
+
+func readFromRegexCache(key string) (*regexp.Regexp, bool) {
+	return regexCache.read(key)
+}
+
+func writeToRegexCache(key string, value *regexp.Regexp) *regexp.Regexp {
+	return regexCache.write(key, value)
+}
+
+func regexFor(pattern string) *regexp.Regexp {
+	regex, found := readFromRegexCache(pattern)
+	if !found {
+		if debugLogging() {
+			logDebug("compiled regex", "pattern", pattern)
+		}
+		regex = writeToRegexCache(pattern, regexp.MustCompile(pattern))
+	}
+	return regex
+}
+
+// regexSource is where the regexes for the patterns of some metadata come from, regex being keyed as
+// regexFor is and strict returning the regex which matches its pattern against an entire string
+type regexSource interface {
+	regex(pattern string) *regexp.Regexp
+	strict(pattern string) *regexp.Regexp
+}
+
+// cachedRegexes is the regexSource for the metadata in use, backed by our caches
+type cachedRegexes struct{}
+
+func (cachedRegexes) regex(pattern string) *regexp.Regexp  { return regexFor(pattern) }
+func (cachedRegexes) strict(pattern string) *regexp.Regexp { return strictRegexFor(pattern) }
+
+// strictRegexFor returns the same regex as regexFor("^(?:" + pattern + ")$"), matching the pattern
+// against an entire string, but without having to allocate that key once it's cached
+func strictRegexFor(pattern string) *regexp.Regexp {
+	regex, found := strictRegexCache.read(pattern)
+	if !found {
+		regex = strictRegexCache.write(pattern, regexFor("^(?:"+pattern+")$"))
+	}
+	return regex
+}
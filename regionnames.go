@@ -0,0 +1,133 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// BuildRegionNameData builds the table of the display names of the passed in regions in each of the
+// passed in languages, taken from CLDR, used by buildmetadata to generate regionnames_bin.go. Each
+// line of the table is a language, region and name separated by tabs.
+func BuildRegionNameData(regions []string, languages []string) ([]byte, error) {
+	sorted := append([]string(nil), regions...)
+	sort.Strings(sorted)
+
+	data := &bytes.Buffer{}
+	for _, lang := range languages {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			return nil, fmt.Errorf("invalid language %s: %w", lang, err)
+		}
+		namer := display.Regions(tag)
+
+		for _, region := range sorted {
+			reg, err := language.ParseRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("invalid region %s: %w", region, err)
+			}
+			if name := namer.Name(reg); name != "" {
+				fmt.Fprintf(data, "%s\t%s\t%s\n", lang, region, name)
+			}
+		}
+	}
+	return data.Bytes(), nil
+}
+
+// readRegionNames reads the table written by BuildRegionNameData into a map of language to region
+// to name, with languages lower cased
+func readRegionNames(rawBytes []byte) (map[string]map[string]string, error) {
+	names := make(map[string]map[string]string)
+	for _, line := range strings.Split(strings.TrimSuffix(string(rawBytes), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid region name entry: %q", line)
+		}
+		lang := strings.ToLower(parts[0])
+		if names[lang] == nil {
+			names[lang] = make(map[string]string)
+		}
+		names[lang][parts[1]] = parts[2]
+	}
+	return names, nil
+}
+
+var (
+	regionNames     map[string]map[string]string
+	regionNamesOnce sync.Once
+)
+
+// GetRegionDisplayName returns the name of the passed in region, e.g. "Germany", "Deutschland" or
+// "Allemagne" for DE, in the passed in language. Names are embedded in this package for a selection
+// of languages when it's built, so they always cover the regions we support. If there is no name in
+// the language, e.g. "pt-BR", its base language is tried, e.g. "pt", and then English. Returns an empty
+// string for regions we don't have a name for.
+func GetRegionDisplayName(regionCode string, lang string) string {
+	regionNamesOnce.Do(func() {
+		rawBytes, err := decodeUnzipString(regionNameData)
+		if err != nil {
+			panic(fmt.Sprintf("unable to decode region names: %s", err))
+		}
+		if regionNames, err = readRegionNames(rawBytes); err != nil {
+			panic(fmt.Sprintf("unable to read region names: %s", err))
+		}
+	})
+
+	regionCode = strings.ToUpper(regionCode)
+	lang = strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+
+	if name, found := regionNames[lang][regionCode]; found {
+		return name
+	}
+	if dash := strings.IndexByte(lang, '-'); dash > 0 {
+		if name, found := regionNames[lang[:dash]][regionCode]; found {
+			return name
+		}
+	}
+	return regionNames["en"][regionCode]
+}
+
+// IsSupportedRegion returns whether the passed in region code is one we have metadata for, e.g. "US".
+// Region codes are case sensitive, as they are everywhere else in this package.
+func IsSupportedRegion(regionCode string) bool {
+	return isValidRegionCode(regionCode)
+}
+
+// GetRegionFlag returns the flag emoji of the passed in region, e.g. "🇩🇪" for DE, or an empty
+// string if it isn't a supported region.
+func GetRegionFlag(regionCode string) string {
+	if !isValidRegionCode(regionCode) {
+		return ""
+	}
+
+	// flags are made up of the regional indicator symbols for each letter of the region code
+	const regionalIndicatorA = 0x1F1E6
+	return string([]rune{
+		rune(regionalIndicatorA + int(regionCode[0]-'A')),
+		rune(regionalIndicatorA + int(regionCode[1]-'A')),
+	})
+}
+
+// GetRegionAlpha3 returns the ISO 3166-1 alpha-3 code of the passed in region, e.g. "DEU" for DE, or
+// an empty string if it isn't a supported region or has no alpha-3 code.
+func GetRegionAlpha3(regionCode string) string {
+	if !isValidRegionCode(regionCode) {
+		return ""
+	}
+	reg, err := language.ParseRegion(regionCode)
+	if err != nil {
+		return ""
+	}
+	if alpha3 := reg.ISO3(); len(alpha3) == 3 && alpha3 != "ZZZ" {
+		return alpha3
+	}
+	return ""
+}
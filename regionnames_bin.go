@@ -0,0 +1,3 @@
+package phonenumbers
+
+var regionNameData = "H4sIAAAAAAAA/6S93XYTR9Y3flxzFX00K1nrz9yDLcuyrA8LtWRjnzVW2+5gWoy6lTxwZGSDjSGT5A0OJAMBEuEvDDYYiMkQONBNyOTEa/xk3hkk38N//fauqq6WTWae510rw7i+d+2v2nurqrdTE30J0d5qP9tfbj9tb1jtH9u7+8v7V9vP95f3l/av/gE9BkT7x/2r7e39pfbT9hpVJUV7bX+h/XD/SnsNle1NiypetZ+21/eXMdPa/sL+lfZm+3F7u71BgwYxz+X2z+21/avt3fYm/p8aUmi42t7cX27/vL/UXrP2l9rrNNNSe1uumOY+P+8v7S/vL8jKLCoX2uuYaX9ZVuZE+8f20/0r+8tG5Ug0XA0u8h5+bD9tb/HqEhxbtHfba/tXCBTus3+l/XR/eX8eW6M+JRq8f3X/CvpSVRnrYltPqUmtPIbqp7QjrjhDCG8/tdo/YCPALNVPoOOOROCWQk5/H61EuNjdv9reAHIw/2L7aXt3f5769AvGV3uNqLRLlQOivU5bXqDq5fZzqk6ieqG9he0wiP2DqFpqP8X+9q8C/5fba+3d/SVqTaF1AWQDDuSQIQlV+zEqJahpNc8SNrW/TJXDqLyq+2QZuVctgvZpexOoArGoNUcb2b8SEb4/z1WYck32GsGUSzTwsobotNhfpMqrGMr8Ny9hXld065dEB7bWwPX7CzTYltX7iyC8nLFEy0R82j+mKnaJBldlv3FUE1O2n2rsT6ByYX+5/Qx9En1ifx5I4SGJhOKB/fn9JfyvvWt9ROjHpq62f/6Yug0QWGgnQi5ZpyxJo+cgkJxsULS39q9g97RZSPH+Zfy53wB2GBVL7d32T/vXaEDq+LQKI+21/csKK4khASbYX27vKsFPpIl87cf7C0rg19pb1JKJb4nqsqK9SapkgSmXyMmVgWWoHKVjEnlqaL9QfJIYAcJAzqX9K0CvXL9I1aw92k8jHk6UuUEKWWKU53sKkbSknD9pv2w/pc5j3Bk8AGYkPk+cMRXh/jxmb++CGZiiiXGx3yA0vaDiBK/A+yMxHEhCyUDrGepoYBjUWWbGYSwMZHjoNniINAsPzwlIr1JcamcDI8fIS4PjPRlrAxIoEGKtvcq7TSaUssaeoQqWZENStB8ClftLEbzJlNi/0n4he0gxfwExb6+1HzAqf1ZanvoUMQsg24z0Q9JG5W5cMydLqHyEkyWi6GBa7F/ev6rklqqGUbUMtFEx4qzL4CxTaQ7mBGEB1OJNPNMTj0TDiFeIyoNFQbJxVUlPSupXqLd1xY6pfqrcv7J/hbj1pOMsNSDaP2PHUEwK9FQSxAJnbSk4UoMCoosievJMEgSFw1QKfSAPV8GBVDUk5EnJs6Sxm3WI3U+0mwbVZjUMJlZSOVSvmYKTytMKETFSBVQwP0A7rFPlaaMXQQruB4O019qrGlpWoqAjdiRRVlLz4SCFCMhzNlWWDftXqN9YbAkAqEUwNU5t0a6HMqzUr7Z/tpS6siJFAYCsj/av7F9t/7TfgNHxEIRQYvKkvdZ+0d4gVTqUx0zaiGGRHoI2AWfAgFGIGSoJOgWWlbgOlXlo7ABMD4j2Q+Bbst2ubiAlAFIaHJ3OgvNJiwKNrF7TuZjCuaIwmWZdSGtuU8cRJcANwvoVS51h+8vtnzBsfxmysay49DHqrWgS3kf6tJAqGwYK8U8asksaT61ti/YPtB0TesitXErbNcPE6Ri6y9MP03bAdGuR8hqWoMPI2uYlhguSeyBwas+ZJDSy5AqqSAk6vZ4SQzwzzcXMEPpeIY26rfunUQm0L2NWRbtMTikBWrMBbUv1eWmGtDfBWNBduxaTEohk9sgU5DERScNz2t6CkoSMPI6MHlv7V6V64x5j0Vm73N6kqnGtmObBZxHlMxPgxzVS3k/MLWf7BMm3NC6y/SiuR8KXTRjbgTRrdsymBXim/aS9SVYDjBl5xGYzGATlgamvKpJlizxiXWKTp7G5EobPJtuF2RJXkXKICJcto3ppf57OTtDoaftnahgF0BA0bbNlx6OlqCLXJ/UutBqrpFwCGh6SvwZUU9UAV/EheFkOlS6JVJIgFnyZJdaIuUGJIEt6LJuMgxyddtvtn9vP9xvMGrkhTR7u+hzzUksG6za0zPO6WaF4gop8IMmTfa391PpI2sRX2mukiXJ59JCuiJ5lBJVgh6X/nX7LFeJQx46bXrbNnVadJbaxzU1lTFCPIuBZkse6cYbnbEWOTanPiKdzJYkFKAmqKEcTwECSnJsb7V2ZTJnl/cs0aAyDwI1LEptn1DkMdlKwjWuMR6d9bgJDl9rPiOmWWb3l+wRwsH/F5LF8goSMsKEpKWUXoKjzPZ9ULt5ye4tZIz8Y09gQ9KdkkywwZPmU4O6m6OTTQhlphC86C6khG/NYqGpErolDCRNRZYEmgFpjPswXeVtLyqjJl6mHwlp+QhYBqjn9SE60X+1/ESmcQh+8lKvK4ymQb7ispi0MRn6WNq1OtF8K8BGhz7E3q9eEiKG1wCbl/mXSYFIVFTI0fn/eVHqFrFpeb6CQU2JMY5dh3MEMJu1KaoenK0pHlCwXaDipOQrSz8NxtNZ+qU5MwALvSIocTVGKHMVNMqYI9QW4gCgok6UA58+kLJPgdB+chZ+YbYpJAZRK+ZIwFke4MuYsFG0B0zti1mKZekX6vDjGFWsRUuy+k4zV9itlpHNxt/1KnpdEMbtfC+Lu/oJ5CNk4S0hqacc2+6CsSnUfqWzZOyQNa6cwjKwkiET7KZPbHpIUw0G7qP1bBhzOJFHtsmIYqh5G9WWJfqB2GzGPLa2+1QFmZ/R4OhzUeIQYpFEjjVTqrXlHaUic1NQinU8FPu9bWi4vJI24soipl5TVzxatbcMSgjJYt05AVYmXXbLAjFBFMrqF9QnOdWYZW7qsIMdl002zzxgojJ1e9rgGR259gitkaCPyCEp9QrpnUsAsEFPZ1DS2pGMSUmrm2SSCriQBYg1eGsBMCD8Q2UspQd1/ZlSWhtC61rP4sMCxIENOhoSXMjRYxj14AoQMwI4A4ZQFdoHVwigq5TA7wlRXemOIpTzGYTMM5Ygq/ix3V1RDJZeUShIjEEogg7kMe4H9qPYzyvMQN8qqMbXFJb34BHpdbT8zZbncB0cAhtDTWDUFO5faP0cCXGatBE0LXOtoatzfLI/zwKemnikjaLi/1H7WXo+jdlQqhcvSIdMhglHTVLwMfPGfS4r4hk+rBG00CYccrqkRfB1NKX4hixke79UTHBMGfjT9oc6bODbar3CeLvxbHIzmAQhsTi19o2U49FgKbC9t07FBvRxQtcCcDLW0GTH8mBnnBZBnMjK4BI3CE41LIwAMSegbl4YOqE+Wz0SfIf2xuBu15gRxxZVIpU+MCZLOK+rE3F/+w1kfcfiD1SsHa58frG4frL45WN3Df2uLB6vrB6svD1bXrYPVqweriwerz4xO6/T3A5pgQFAPWXWwdv1gdedg9Q21JQVmW21g8rXLB6sr1KnJc+4crG7xX08OVt9S+c3BapMGDvKkjw5Wb8kF0WOPh3MN9Uv1QM9Q3KP5btGS2werrw9W31gHq19bB6tb1AsrU+MDBWhaHKx+YczzLf3NM9DeZb8sw/WMJlhUcMk1qEeOe+zQBE8+0GmkB2y93HVjraI50x05U7S9ddXPFhKJ6LFDa63QrKDdHv31hMhiQlAiCCRG79G/O8fALJ/UCas8O9Z1TAN7GbiRtWc0uiKmsQ5WV+mvrYO1uYPVTfwHNH9PlXdo3ASPuxNRC38sqpp1dOrvE9Sw14NgSelXcmyEPEbv4xjy+vtFjCkwZpX67dEaA7zGG+LhZ6p58WD1JTUnqVli5I4JxcHqE+oxyD0uAzWrK3pti3ibxG3tOnVM6Y7PCFRNSzkhdRrS4LxS8rKo0JEmdGACJoISyNW31DysQWVsMQ6zIhJ3dL9nyshDg9ZP1Dw5YfR4EpOi/jy1STa5THMuHqwu0rgRHsec8/jYzk4LpR221eRvaUDUj5URz7mqOp2glRTtikQ7ydlvFH2eETQ2Q/NG8ckTpsm/Y8x+iM1jqr1ncOIYc2KTcBkJmsFm4yajvFH4YSaaMJnorVwp0Se0GNMIheQEFPYKsSj/u2d9pDiLxzc+/g92kgBnr5ia55TBny/VUUDESYCJnxysrtGc25ZUzZHOYDh3LFqHa1lQm4ooTRq6Q7Olele2TlnHKPVGMQnRKwHG31Pa+M7vEJ96pzWKcNSsSpYzVKACJcMdcTL9JziDtHynMU1L5XgpDYbSwhhKIpaAUHxHc3IZgrBCtHoi1+uRhUSRZeF6pHlX35iopmnKPM3bg9VrkcJNjFItQNgkBGoVCK1FPca4x2WFaz4gvqa2M9ym6bpHZEenXuxQdzD1nlJCmvJvpPwlwNffETq+6xX3gaSIyIhxTxSnk7IagLK6IzUAMNlUDSDYA6XFnhgTrNA4UOMBScUTxcoGzgZGjOZIE0aH5X/AvQPyaHqmTpVjSjoJAV1USKYGrPVATpDE1r9QpG0SsLHzCxtJQkZ4Cy/VOIjAJsoYxxh9og94ddrJ4eAgDZuE/tga0IEMxSbthNgzCQ23CN2P7l+jrWfcIKTrkULvB0RwcFh3uiNpNwjaPZK8f2zQfyB+g6DuE8Vvik8Zf4D/5TFIRwjSaK0dNg3+7UJFPW6HxjGMdKqkoJdvGXNuScSl+kWPlatG3+HeNHpAj95RHGjo9pQUC2W19OwnNUj7kdPuHazNWco+PnbipFKixwJW066D6kySFHjqdnxc2hQ9jYFnpiKioVlzJ3O/xwsp0O2W0o4nabwUdOQtLbFUVTgO/6o8JNduSA2UgtWwrpwHRvtPxD/Ys3VszmIPzEzR0vGVmrTSE7l1CWT5eD+y8VJjMehPqQ2yarxGXcZ1l2OUGoJcvCIDc+VgtWFJ7YB/r/KhKk8P1T/P/SWGTVWOpYaKIi4cWgMdk48h7PyVEiilY4fKulYf0VKXoDkNFtb24urvyV8a7HxVV/3ekZ0GOy1K5tcMjVnp/E9Lrwpli3ySR5biJjkXdcuL6NRjTzI9YlqBb5Xz9NIyO9Jccs9MtVuKRSQjXVEK4jsFEVhvUUFLp0860roaIOmbLRI3fGjvUuU2FbsRsw73HpFKaIdzusFQhswbwyOmAnkgoRgu6BGbEWyZpLYVjp0/GWmjsVd+S+vx6NiKphmiabRogx8eHJsvbc73VtneiuUyOT3HdcW2JJuZ/An+yYqi45509Zj92FzkYdjwNXXyNVmKVlRoogc0UG1VicxPNMmPv9M9sp80ixKjZcY1OmN8+R+cN5kJbeszlb45Ac9ZHDzQRXQq0zazOHKeEQhbkqlk38QJaHtGmmKvd0NZkIYdh+/kCLn4PSoqwyALNfVS6ZU5GsP6QTFftqghXFQezTH8ZW0NM/tKD6UDnC1pOB7Glewx7syW9UIrUjSkulaOKSpv0ayjPOsbxQzHPM/suF732LmU6xMRU8q1ViS8uYRuW1eg0EZyA7rhmdSRq29OWDgH+XuiKaTPD+OI4vkGTyCmafrKcBD1lXbjG7XqLWUqSDrJ0zs3pPvxHOzwPfsPmDWXEXEO35MmzImGbC7Lhqyh2HKRGSc7msa89VGciuj4MW0tz1uL3EZFNnO5kR7gVgi4r//toZo7pjFoYKTzzcNAIc5YXEd1/i36TvdgXgfz3spjJAcxeqLmlxrg2EbtHubZU+L25mD1HvUocY+Y8UYNZW7YUe6Fshxyo8aIOB9Q85jZ/IbQRaGdHNxGdqiUOK6+NeRh3Bz3YWskN6HlRp1ukXtMB2we4riuTr6TBDaf0AbhNUuBsy3XXlv8IJvmk3rmKL5AEw7yhDswvNeunxAnoF6p+PATNV8+rWGTkigl1FB2NFlW/A+iXPkRDeDXchp29PMFPQ0f+mS25Isa0muYee0y1Za5loMJ5iQTNAlgvqYsgA9YMSMQ668VpekYKvQJbXEoqhEqCkD3puRYBqFwkm+zqUT8AwZmIaUX2DTPDEsDfcwHKAxpx5Sn3lRkY5AzGuSVk+2dAsizaaj3Y5go5E5Q2IYbjQZoIGilJ0pUFPAyDMhLFUVsYxJfMmxgKR5TglaATtg0YNLHrHbdFhUqd3ptWhnsWWscrL6iyUo0WbTeZXWYEB8VxjSepDKg2vEeCI6xOPPm6Si42YxkrQiu4C0tEr+ZYkoIKYLZd1R85yS9WLR1TGrHUB9mD/A6w3WMn4rY1U4ErUFY2cMG5HvkgSKsGP2QhcXtfl78mYJw/T84EGyorD3Ja2Zo0R7QMc/ViP3spBkJlaEw6p6i7qtvoyMykgvCrz10Al++Uj61DEZQxzR3/Fxt5fHJyLaHCdkSzW/kz2IR7h9IJpc/2ljqlNDMzDvKnLjayrHVsrSarlXnnaUk+Ws1YU7zwHrPab0uRcWO+RWLMda2wWR7BpMdszLsoqaBmlYHA2z7ZHfiOCFLREhpz9/T60mc6VDkW0WtPaWuSI/ZOKy/YJNtT4H5WJ0aTO8zRO/Vtya946ZHRAQZy9UOmrndCd6uGT7/3aOg1CeinwsjJSp/MbFYmiyl9tbBhCxdJchCUyJWuhbKyVtRCkxGxv8D0SpBgr6jcas0PUSEMX1LMkIJMvFQTf2h/YDPm8a+P3A0lDJ6/hWlyJVqLIF7m8S9/KPZzqm4gm7KI7eUO44DM1p9fNW8nlnrzGNeXmlEgxZpB1quaCwnJydjq1QS8dCx/NmQ0LAqicIzbhlo04gFf96jnT6W7MkHfWlMI3Mxslmi3UzwbrTxLZVH74bK4DE+JnSwSzFzGXS+poRaElKNs0VcCORvXtYHgrc/KUlRPwCUx4W2nU442sqAn22lLWkSn0i1UcD/2EDcPcVZUkb2dLQKq46e5NHrn7J7G762DCdSKnYVlVmXhuMoDpLHaiCbrdFWorDnqPxh+ncCaFGw+j858EbT/w8U0MbL/3JtGSBUvITZmgo/pL9HyzqEuB4hBMV7koHHBkWcb/l8UHrqkfx1WKo2YroxW3zwJsYZKI0VpWAfS/EZB3XMo1KqAMw2Hnl2NM/a9chvZR0y0XfyIXTCz7bU3Ywonmg0TYzpXwV0jzcq2idh/EPFxW2ivmDS9QOv6lN5QPT5lWqt5lApKUbdmuv53nToWn0156wXTM64VvK8V3NCl7oMir6p6RnH94LQ4SlSos8Pvem6Y9X9itXv1M7WKzxdWvT503VvdpaLWdE3e9bxPZfH5URf7byriyPoXJVdi6KvNu36oaebbdF33q1557Dy5Mwp2zlf5a4l0fomCN1azfUmZ6imLPrqQVhzZtXYMdFXq5/l7mdE68qs41c8P3BnuXlC9AVu7azjVYLJGd5Uf5/orwZYnDY15NYuudPVzzyfJunvF9imU6kGVBwQ/Y4/PetU3IBB6E+Kfnd2Wq7fPyj667Vznu9Yg05QpQ4p0V+fnXZqqsuQ6Hdmao7HpTQG1P2KR6Vh0e/6siUr7PBPQHI409qYdc9fpNqc6Hdr5yXa+/Oiv1b3Xc8acGr1IHBmnfPUa0T0V2e9T9WSp0V/1Xe8mvv/Wbbnh1ayHoRO6NUD2rLtMML6i6K/5gSeQma/DUid8w5vvST6Z+qSE/rHRH81DOqOxNI4kAAQqDSBkneJuCjRJzKO7zC8iYTIVM9Vg4giiQGRqfrT1VMZzw9mnIC7DYoJ1wdZnSnNB65VdC/Uz85656hLSo7rrzmXLjmferOzvNyQsCdnPnO9S1RKi0Treehalb/PfZv+tOrVuFNGJKrVcwYUWZGY8eQMOZFxzru1Om00kUcL7zIxIjLV2fr5sxI/iaJIVIPQsYreJHcoi4xkvsSoSDhnq9aoW6vwtGMiUa85rTWHuCJxRoy53ozvTM6EjA6qHRcTFy+4NZ5+QpSw78kZud5AUgy49TCYnAFXo8vAsBgIJme8s/WQ+GcgIwZaTd8979QISwM5MVA97/kSvoERWTwRpQMTom922lV8mkyI5GTdqVRrVEqKZBCqdZMp0VqYvnghlF2HxJgbhIEz47B2SRZFsuaFNZdLtrAvaGWQLInWQjjjVS/IisG0GPR8X809OCwGSTxpR4MZMejMnouL8WBO5LxztarvBmqOETHYatZaL12CdrAoBmuOf04rilSfSDlnmaSpfkP1BVam9dL3pqOeAyJVcxXDppIi5VZrSrxTgzTvpdbLgFRTqu5clCKQSolU3a35gUtymhoSqRnVlBYp72zNmQ0dgi6VFala66XecSonUs75sx53zotU3fMZc6mCSNWdijtbrV8gLkqdFq2FP9edsFrznNnpqGNRpGqeOznjRrOWMDZ0zzusaFNllEk9pMbkGqf6vSBw6lQ3LlJ1tZmhjLCrfsWtferWPnNmw7o/HdTcaa/qW0NVf/pc1Z+mbnkxVPUr9RpriKGiyNSqTiiRNVQSQ47HjDlUFmV/2mHGTg+ItF8xqJdOinRNwZ3OinRQc1gg0jmRDmZdqzpl5VjzpPMYrAaOiP6aF4IWbmCV3FrNA2bq5y3vvIVuaPCtkUuuHHxapGsOcXu6iD+51sYiavmSSIf6PBlOimG3Jmk6nBPDznnHO0f4HB4Rw9VaRfP1cEEMOxd4nUxSZFyfCZpJiYxXm/YCfYxmhqBhzlbB5NwljS7eWYeRlcmJTPV8tcZ7zOTpHMh4YcjqOu9+6hG+MwWRr9Yq56pSzjJFYbdeG+Uxkal/5nghFcZFxvHOO34kRpkJkXECZ3JGAZbtE1mHD7psv8h6Zx2fbYdsgmDI1id5T9m0yILbQtcPQpfPq2xG2DXPyjo+4ydbxAxuTY6wRdYNquEMab9sSWS90KnzDrNlka3/l3v+bL1GbJUdFVk31MomO46JLnLfXJ/IObXquXM0Ty4hclXfmeTCgFDqzMpVZyvM17kkuoSu707XuNsg7SXn1EIGPJcSOafiTDvBOZbP3BDWCGac2dkIWbmMyDmX3EpV0TuXFTlnlgiWy4ncRcc/L4fnseJ0ddblxpEPylLOmZRAFkS+9bJWmfVgg+Wcmuf4cpXTgAXG0Z/rpAFyRZFz6jU31IyXs7FeGLi1mkOkzpUAWUh4z5Wpu4fDnsqjaHMr3qdy8BjKzmcM6hmRc//Lk7gdp5aLARMwNyFy1QBqisQn3yfyznlPKq18QuTd+jln1sBPPiny3jTr4/wgOHWqOntOH3X5FDfLCdIi7006NWe6zuWsyHtuxSWtQPvOj2CKz9xpOXtB5N0LDimJfFHknXqNMJkvi7zHmMpPAKjAdRUjjeTEyHnm9EKfKDi+c54WKyRFweXhhR4FX6jOXoz0VCElCs6FunMq79Yj3VsYEoUZb9a7cMGTRCtkRME5p+W9kBWF6qxsyhH3FTxYsSTOOe/PdXeW5axQFIW6WwurMCmICgVbFJzZVjMIMTkB5Vp99bDqV8977rR71nPZWi+URKFaC+vTjJPCGMYxdxXGRYFRS2rsNCwyeRQVk6LY2qj70kcojohi/XyrqWhYtIXt1pS9UyyLYj3QmrI4Jop1x+eD0u4TtlOveKfIleD+dr+wndnq+apEi50QtntxcsadlciwB4RdrzBF7CQbbhXZlBK25087F+oEpz1EaBtyZ10+oey0sGern2mfwh4W9gUvvHTWhTNBiB12fCvnSL1hZ6i/c46F0s4KGyRwrKxb9QmBdk7YNKLm+YR6Oy9sqA1GqA0xPu/MMr/aRWHXa57vnOexNmneQG+mJOzWj1WrVD3f2iBgCrXWY3/S41PcHhXJWct2Zj9V5pV9BtsNrZzj1KRRZY8L+6IyxuwJYX8G01zivtQnSjViMKviWIm6P0NglRKiVK+dC07RmgnHmzRt7dIAGZQOz5ASpeo0bbQ0JEozTjT3sCg5OJu8iIVLGVGqnnMlQ5WyouSdr9ZOZd2Aua+Uo4Xh4+kheVGqR7JTGhGlqj/NYBZFqfW6JmlRKmEvvldxKgR2qXrWkYCNilL9U2eWFx0TJcf7TE4+IUqOHzjyjC33ifI5uFQETDklytOKMcu26ejaoeNI/JbHRblWV0JRnhDl4Kwb7Xi0T4w6IZyPIHQqpFdH+RQc9fxJ1w8J1ornWmwuKtkfhWPtu5fqLptdo6nIRLGG6/70lFszzuDRdMzZPbFLXox6buizazdaFqOOX3dCQsrYoBjDMcWWwWA9rLN4jNlCu81nMiJTDaqfEkrHYdCcZ1DHcU5crIZMwok+4mH2uKgCAqHM0okxYXvnzzpnP3P/4Pqx4IIljSfXN2MMKCVF2fdCt0LxBRVaCGh8PLbg+mZs4Y86suD6sciC60eRBV5BBRa4pOMKrm/GFbhRRhUmHd9i1KCyxPEDOT6KJnBZBRNcPwomyN3yLiZE3yUKJ3zCm1CxBOxBBhI+leubgQTXjwUSZmiojCPUz1OpJ47g+kYcgeeLwgiub4YRXD8KI7j+SWEE1zfDCK4fhRFoYhU74KbTIuHUvLNnXce38m44w0cy74HiBZe8WSpEwQLXN4IFKHCw4DNHYiIKFri+ESxwfZHoEwmHfC8UEiJRnawG1kcZ1531/OmPTdwnBkQCVpZ1ytKhAwwaFAkOHVh9U0xtaRRO0qiUHmUGDtAyJOzPvPAS74/6nhA8QDUHD2KwqPABmnMigfBBtUro1/ED/D0iEtXZKomU6/eED1BRFgnJbhQ+uODK8IHrx8IHKJ4RiRkcAOedQEJCq42LxMULErOJCZG45E7O8GoD8GRr5x2f6I/AwSfe2SriBihmxIAL+/UcNRpRA9ePoga9yNQBA1rAiBegRPGCqpTMZEokES6gfhwscGuQQwoYuL4ZMECJAgYeITBZEkmOFlATBwvUfilW8AltwQgUmKShUMEkhwp4ghEx6NSqbqwThwsmiYAcKmDqpfqVBst4/nSlSrJpRAhcP4oQ0OwUIHD9yRkrVfckv5uRAdePIgOuH48MoIzIgCt9eNc3IgOub0QGXL8nMoCK0yKpAwOW0RGRAdeVezNjAq6vYwKufzwm4PpGTMD1xVAGzv60lcE/dl/R0nxthgFQLIoEhQG4UUUB8HdZDNURBiBEGGEA6klRAFdtPQoDuH5vGAA1HAbggSoKMEM+v+NbI5NQVyoewKuR6/9n+lO6/vjTFunJaE32/al/5Pm7vvb8WSC0508F5fa7vnT7L1InuP0Xa9MXL6kDLjMExXC2KoE2fX4UcyIBn79Kohvz+f8oPX7Uk8cfzliZqhQV+PzVeqxGe/0YMC4SzsXzjjqkeXb4/ZecczMKNOX3489+kXXZ73f9uN/v+sf9fteP+/2ub/r9rm/6/SiR3z9TV+e39vyrcP3RYVRknVCePdLxp7/h91dr1clJmify+1EYEHD3q59yx5i/7/o9/r7rG/7+JEud4e+baCKPf5I8Wp5ZOvyubzj81kf99dp552PqoBx/Bj83gvFOPS4sOUlC6D929J3YojF/H5MWpQOvcBZ3910/cvddP+7uo0zuPrx9LmpvHwX29iUSDW8fbfD2L8Hbl2AY/j5KCZF3P7MS0uGnpbW/7/qGvy/3Rh0ij9/14x4/ylnRY15Ij98hCdT+vutH/r7ra38ff8Lf/8yacB21onL4Xd9w+F1fO/z4Uyts5eoTNMrTtzBjpExjrj4Babr6KJKrL5eP+/p/jDx91+/19FFDnr4LR98zlJcn1zHce9eP3HvXj7n3Ll3oOu3I88R071EcEcXqecVIyrnnAvv2sjAmip+RA+X62rMnI57b4dhXybE3Wdd07wlm7d7j76Sw2bl3/ci5r7JJFffu0YG8+0+VYQ/n/lNn9qxTq1h/NPx69CS//lPnnOzZ49e7fo9f7/qmX+/6pl/v+jG/HkVbatdoHzHP/o+GX+/6vX696x/z61HFfj0vR279JU8x7Eluvetrt976o/TpTbSXBkRixuHh0qd3/ZhPjyJ8+k+UQ+/6pkPv+r0Ovesfc+hRRQ69J3lE+/P4u0jev0uSarrzf1TOvOsbzrzrG848CuTMX1J8aTjzrm848yjYyh6zQ+VKGn48ekyI8iXtx7u+8uNhuya8kCDs8eP/GHnwxLYxD971Iw9+xhpFID/G9KNpUf6T/aeTWiK33fVNt931Dbf9j8ppd/3IaXd9w2l3fTGeFOPktOPvyGl36XYBMyg7O1h3IicmtM04MSYmtNseiL4E/dJhVVxr1rGkB9/a9f/gBjHfPYDvzv56NbBal2vOWTewyr5H3msg3Xfy3lsPeHTkvl+M3Pcgct95VsN7D2Lee2B670GP9x7AeyfUWMqH51rDfQ963PfAcN8DuO/YeGCxE0+DtfN+Ue4h8t4vxr33IO69B4b33trgiqTob23MTuOHVjc47r4Hcfc9YPe91tqASRLE3PdAuu+tx9yUpbAk/Heo3NYGVWr3nVdn/721wcMNBz7QDrxr+S4frgpk9Ws/jTG894C893qosGL81I/iuOj33NlqrVYP5ArswcPDCLQH33pApYREOznyVEM/0rR+hg/pWAPu+epkrfUA8mlV3FmLnHPqN2j2I5e+6kxFpE+kzPb40CFh171LBNuJPnwAH17BVT1HFdqDD7QH3/qZ9h958EHcgw96Pfgg8uCDngsAmIc9+Etw4AM48EoS886niH1S7TjWuiCBnBCJGffPdV5rICn6Zt3zSnYGhsU4/+yPQkYMIBbt1BgM82d/NI+YuNK+PLdN0PUbKTKmDx/EfPiAfXjvQkjgJ4eE3XoA790amZz0Kq4fwjAJYo58AEc+GVxwWk+5BFfeq15oPaZi5Mrz4uTMX6QNDSr65JxZiCBxZY8rH8CV516Dbq1KKJO/+5O3EkhPXqq3VL8oup5fZS2GzuTHy2hTEPPjA5EaFPK3fYsjA4guBXFXPjBc+aDHlQ/Yla+6xv4MZz4wnflAOfPOLH7lB2SnZaOVnFQuPfUjX17tLubLB5EvH8R9+daDOtVFvnwAX77YlwTzRS79R+TPf0zNpkMfKIderisd+tZjamKPviZJGnfpg+iHfW6NXPpAuvQQXfpl3w0Mlz6AS68N4KqF47f1wPcmq6QkRiZbG45ftVqf+xUyngP9wz6mgXcvdZf+ZV/OKn/bp4Lh4AcxBz+IHHwexr/sSz7SP+3jb/5pvx4dg8rJRwwgiP2u7wbax2eswseHUQJ7r7V71pm1Llp5zyVPLYCnn4BHTxuGw0iMkSkatXadGM3w9wP4+1KxOd55BRO7+59EUGp/PyB/v/UYDj81JABT6FjZ+qQk6XGXP+hx+YOYyx9ol79KXcnjlw5/EDn89RqswoAcflfrGPb4Gbnw+J1are7KkwM+f2uXfuxHiZ1+R55yPV5/QF4/+Qm1UB6kPW5/IHJDElfK+adVY05/EDn9Qdzp9xBK9eD3B3G/P8AP/lK44P5XTcHKFaIlER4M4uSNnH/W4z2+f9Dr+weG7x9o33/SYwwo15/ZTbr+ddKvuTMi19r4Lyk80veXMtvj+gcx1z8g17/ufuqYzn9Azn/rMX7tdwN4/9ilJUMA1G74/kGP7x/A9y84rceBG1j9zidMbvb+6y48jCD6ud8NDPc/iNz/gNx/AmzC1RqHAgCS6VUEgC0T/s2/9TP9PQinHR69F1f3HAVo/exYPHOksQtDYhA/+auzSYcB1GIUB1DYQSDA8a2CW6lVrYsyDiD1SW8gIBAF29B9gaWCAoyWWBwgUHEA2WYGAgIEAhKOPI0QCHDrvicX5d/5HV/KuBEJCGQkQP6tf+J3AwQCOARg2U69wvrf7pcMTT/0y9l7AgGBDARI1OCH/rorDxMdCWBdhkAA6Z8k/c6PDmmRDHQoIIiFAi6aoYAAoQDq6iiTKf4jPw+PBwOCWDAgiAUDAiMYQD1tuQlT+dolzBeqeMBFMx4QHIsHBMfjAQHFAzwpGPiZvy7jAVyjIgK8rtU3WZfGVEmZ1qV6bdIJrIsyNECDVEggiEICAYUEHHNqxAQuegbXmkGB4FhQIIgHBdSYvCi1fvbdSzREBwUCGRT4c13ymBkWuKjDAoEZFghUWEBNbcYFAlHuE+XJmi5FcYEAcYEkfqavmk6qGRgIzMCAnH60TyS8OuABQWWQgGBCgMDxrVEP8QHXumgBz2wrKoGPBwkCBAmYHKOtx7iB4gaR1SJHpI/1qLhWMvknq1z+E3UwAgZBLGAQGAGDizpgEBgBg8AMGARGwCCIBQwCChiAi2W4IDDDBUEULqi7f5hyVGKw9tqvN/BN4B/oQ7G7v97AB1SpfQCpIqK0YKiir6VGKcHUx1v3F/dvWvxB6l9v0NhBdOxNBYaGFE+6+euNd9syFZjKFKWygaFbmrqhy6838MVYmjSLSpkNTK6DfEP49n7PKiN6uB5cFO0f2k/f3Uaf9uavN2RP4xuxP/x6A5+kvdJ++uuNdxtyGD4Tju8I/3qj/ZwqyqjQWcB+vSH7jdH0MkHRlKOzgCns6u8lo23CyPiDRX/gtGBEii21iX76dju+Vnv11xvW/pJFGcGeSZxwF8oLRriTH5TepWrODPZumzODMeD99O33hXe3sTmqUFnB3m1gPqIXJwWbcuJJwUzU9g9hycdAiKyIJwVjusikYLoPfbE7lhLsiuzYkxBsyoklBFuVvVRCMHCnrJIpDoCypxbIhe+Bt1d/vdFex4fIFxSykREMSZ2egZFopI2KNZ0JbMrpyQQ25ZyYCQzV+Bi8kQcMVZwH7Ncb7WeYPNEnAItOGYSqmJy929hfwv94dGJAvNsgSiFHlySESv015cRTf4FTQCSgvoHpkOvi6buN9jNGSSJlTqYSfgFhvHGZ7otwxKubyb440RdqM8fhpd5Z0X6OueRqOd4p0ltJjZHIi3ea5okRQLOwfwU6Qe6mSENUcq9IyhJl9NWykxgVhIo3f5+7gY2z1CTGuJNM6tVeZU6Vab0ALKmhdxuYmZKT8CZ1Wi8u9sjeu6ssDQNJqAyZ24sqkNcL3AQ24C0jr5fK6bUGuaFKldULG4+2FMvqBcrF+sglzGxe2AAqKZvXuw0jkxcqk1LrQCoYmCiPF9qHkFfgMSiOtX6ONHESuZywU2is/UUaic+Rt3f332ArijbI3bWGje4v7b/hFXTmrkhzUeYuKCnZI8Yq0B/7C+82ItkbRLIUwgnxiGLUwZHeYeAhauHsXbTgLkOb6hPv8PX2dcYZMnety/0Y4KcG0OupKXepJFW1t0z1lRoUEDbsGokCetdKqVkkpJSkS0m/TtH197kbKhUKJepCxyyNBA9E20/lULlmikAqL95tUy9esEDgqCRd+2+o8rTug2NV0h1IlkAVBZHpqt5USc2yKTOu8FplWb1/hYpjsWkBkiFGqXEDMejOqbmuvtv++xwx7Lvt9g0LqGg//vUGSRrQ+O42/dl+DI6FOUAipXTA8aRcmJi0AOW4aO/yjmRKLtJMUtaQlOtKe6v3+EkPQGRkWi5FpTSkAywYoR4JudZUQi6lANO5uKqA2UD1CkwqjCAxFRTBUzZNYryGtAqU26bBLARFbhmDTwudfgvFogRMgw/RA+INSCF4cokFxSaUfIsPLSpGqbfaq5GOoeRbMvEWehUEmTdvuJhJQl9qCaGEW2BXHFUmSjNDSpHDAKMjIJMmBUxiBttM0iQDlQ8yy8w0qEKeLRhUFg3YBDaWKHOOOmQyBUzPCFd5iuR0khGoRX5iX7awqseEVBw39cW7jf0vf72haIeUWg2ooP2GuSmZUAuHBIMRT6k15XBKLYIckgdOZKRSQq1fb3BCLZJ05Kdorymellm1fr2Bw4mMOkUOSqxFGHuqJ0NaLZ1UCxVIqoV97S9FVqxMqfVuQ6cSWGo/fUcMxUm1DLVPKbWwCA/N9UFMnrbX3m2wmWek0wIKqSpKp4WsNnJgUud52l/EVq5Cf8kBgxo1Mh2J2jyn0yIVC83W3sXe+YjKDcWUuplaa8qJp9aC/qAhKrWWBIlPi3hqLbINFz+mdiTWkhl+DVLnRlANBJAy+59rqVzhOOQMBqjew7S500Ll01KHOc2h8mlFyoKqo2xaYCWVUGvKMRJqcVGl01JikxtV7dDZTJYxVRVR8YzYvwK+0XCo3FlKdei8Weoc4n46cxazEjNwPgGBVMlHSXNg/1uw6llZ5ZMCW6N8WVOOyA/2KFQjY5ZcJ6UHKKpTvizAATy313DqtFdlU1aYpnt+BEA+3V96d5taKU/WG8VSMktWezXSRpQn69cbxoQTqoLMfzUx58nSpl6hT7AxFHkEhSSq5LSFQbH/BlxKcoL9HrMcCin0X9t/A7m2jHMWao0mHCLLCbPAvpILZ2jUuw1TdRWy0AaLsSqkNoK98gaoJg17BRjEKULtRQCo82FRE0NO7u1TZE3DmoB/kZhapcRCLTNKoQRYomRYGD2GqjWlSKnXOFX1Ei5KhzXliGJStJ+CLJha+QaxdFi8ok6GZW5VJ8RS7NKbEGvKoYRYr8yBlsp+xRPb/THfe5eQrqlN+a/gzrNRYA8II6ET2pPSTWJekXmvoO4Yx1Q5pDXkoiKCncZpjOxOS5G6toe5cqkn2xWkao0JciU6W+yMngIaXU4B51kaEQuMVqpW6a7kHNL4JLLb+Qhm3uQIKmSiKzmtSnUlnX+yEW3bQEbPoWyXhDIYZaarReLEN3IKhI7IftWJrmReasOPsc/0nixyM+MRMCRPRqIrU3Blmqtfb0SUR5orsLuy0kuJGPFJICBggBU6h4WDlWxpQLyDCiFCy0xXYGsqcqar2OrDVAXvJy6yMs/Vuw1TVEpZQf4UtO/TUyRum8zSMs3Vu43euJWZ5GrKiZJcvZOMXyrKgQpNMskV8P/rDZ3kijKRwZnWe5FZrpZklivMPaa2t6RXR5YrFNrPTNcKea7WELRoP404tZziym1TLsvSugXx4+FBGjIO5oY2NxRHGS5w+1l7PY7Q0T5BxEeYcENXRhYbYhpRZisrcv0UfMhotcQZrdqryiEaTcUOejg7T0HM/avH7Hzqnv5w9w9vEyms0FWlsMJEZSFzqKoUVlMOgr5UCZufjWYzfdWUE0tf9QNmPpMhqxghHSbreFJEhrBMXAWNy1YzElfFw0VxeTZSV6kdU+qqX28YqasW/zBVQ6S49ZdZF7/mz/597lt5s6zqU5u6WOZSKSla1+hmWWA5fK+s7nsBNcUfhU3VjFtlp9zwlLpWNlWLrpXNclHdK+Ml6F5Za0MV9cWyqZp5sYwbZQjdcs63NhATx8+A1FASffWw5k3OcL/ochmX1eUy/H2GNq8vl03Vostlre0K3oZN1aLvzJzC3bLWBl0uo7n05TIuxV6GTdX0yzD8HItiz92yqZpxt4x74G7ZTM1tbfPC0eUylIZFP1DDTbhc5vnhKfN52FQtul/GuIjul5nfmZmqRVfNeN3TouBcDE71O4E1iUdjrQ3X5wlw16y1EXizVIoum6GEp2LVuqS4+VYMjbht1tqYreLrNnIV/WBsqmY8GEMhIenAt82mavqRWPR1GVQO4lI0PWP6c9218BtPja+WSXqoR2KnzCdiqKeLZUHAhRNulqE6o2GonqN+fLOMcK/fhtUJ+/JmGU+nb5ZxMXazbKqmb5bhT9wsu3Bq1K2F1NV4GYYiM6Oln4dRH9wru3hBgjghSpMzrY0/1xmhdLMMV8umefvm27CpGn1TxvFdehw2VYvulkl2HBiJYbMib5fJbfETMUgWFZMJ0bqGV0puvUZldcOMW1OidY2+KkMl/BZMN8yq0Q0z1BdF61rtYjhTa23wMPz4d0FBj8/LXJOfl0E5umVGnemSWeUT2tmgolXOma3WleAP5jCBEwbWVGuj0tqotTZwTcPiu2dgYp5oRA4ebG3Uqq0NqtPvyKZq8vYZq0BcPqtedOrn3VNln9bWj8hoMlw+a23g9hkXcfvsouO71lTN8Vtrjsc8p66fubi1NFWL7p9N1eL3z6ZqsftnNFbePuOJ+PaZRGD8LRkqTqtmq7WhH5VxE66gtdblFs0raFM1fQUNf46pKdR7sqmacQdtqkZ30P7U9yf7T9bkjOdXvcCN3UfD/ObDMhTVwzJanO6htbZDQudQGT2nJZupa2iaWPoeGpf4HlrrEfFTOqePLlxEQw1dROOu+h6aV3Ots3QVh1hfnXRVuotmyU/STNX0TbSpmn5nNlWLLqLxrPIeGhWie2go8D201raUrugmGhfpJhrzlH5sNlVTF9FmvEv66FQ30SrTNNK8ijZV01fRWL/TVTQcA6w0qhdmXJy3ea+18WlrnbvQfTRwRKWOiz0VqiwalXad68ZEpvqZ29om5ZQZl1KScFrb5x0+DeLvz6Zq+j7aVE1/dwb98NUZx/ND9xQeoNE2jt1Gm6rFb6OhTLfRoHO4KK+jzVSppO+j8XyxB2iooPtoWiWpb8+gr/z0zCT9rR+gTdWMu2g0Rt5Fa234rQ3cRkPVoESwfIU2Veu5joaKIYkqfR0NlXQdrbVRqUqFqu6jTdVOuo/mfkwA6PtoPGTkJEGju2nUXIjW9RzfdwOTxNGVNMmR5p00rojdSUMFLhzMshLXd9K4ZLxGm6oZr9FQ4NdoahW+kuZJ+Y3fSZuqRXfSuJQQ+Wr9U9w3OpVwZoEuCZx+lIa/B2mj+loaquhammaV2L00lLPaoKFl6Erap611FincSWtt4FLaVC26lDZV05fS8OdEBNlEa0NrAPUybapmvExDISkKOE9oHr6XdpHVWM9RwFfTqnUn8NxTegmpcmlw/KnaVC26oybX5Stq8tgs5CSH8ms1iL9+robmIl07k5fUpmrGJTWv5gbWBXlJzZMGn3lNbapmXFNDwbimNlWL3qtN1RAXyjqWfrKGGjxZw1U1pqe6qcYF+WaNC/rN2lRNX1VzrcCp1iseM6PdLzldfpWG6mJ31VAxgCdfeO2FAu6qtdYrPFy/WmPjhS6rkXoaam3MttYZkfLpmvY+zAtrbmjcWJuq6cdryhKLX1jj4YoudGWNavLCZt3CnKfvrHF/4wEbirbcjaGh6c6avLEGOhdqHr9gQ9uo0NfVUDwTrR56vvXRBfy/a/mtDX5LAsuEdI581caTmK/apmonvmpDtTLX6ZqYGwAWHBN/rjMlSgOwUx2eQl5jw5/0sq21rWWJ7rFV9OM21NA9NogbC5K6yWZVa562I9VlNlBKD1RP3HhidZttqqZvs8kWeZktbG0Aanmbbapm3GZDAbfZWtufybn1bTaawnjlhlJKjNSnFfuWbWmCwlokXBh32VCaECP1S2dbG9GWR/t4iHzalfDC1gZoLq+1URd5nJ6SD98A+awbnDJev03V4hfbUE5JKo16+LRSYBpBBNpouqdDxQ2sHvCNq21TNfNq21QtutoGeOTdNlRLxxxLRHfbpmp0t621gcttUzXzcttUDZfb8A7uz3XTIFEX3LiH8R5uxkPY4qB55aC5d/Bw8aDZOGi+PGiuWwfN1YOHnx80Xxw8nDtoblLHAXHQ/IK6PDh4eP2guXPQfEMNSYHhaNg+eHj5oLlCQ5sWzbtz0Nziv57QXBh10GzSwEFBDY8Omq8PmreoYf2g+Raz0QxcQ11TevF71OMWrXTVOmjePGjuWAfNLQJoi6ofKMjSetQtuR2Mfaaas7z+M2rbMtbfVj1y4qB5FTOjxxNCUU+PEZ6Dl7huTF4Ucv8Yekdh9x4hYV11soVCzSK6YuaVg4dzFlDQfEITXqXZSuKg+ZVCzD0CeCcOSPmkHlj1WbzfmIarQVjj2jOMqWcHD5doMw96WUBB1DhovqIREzzPHSIeo36JiopmyIiN2usKqAhzEdleRRhqviYk3aIRL6ivwhPyYhOsciVQiplwj5oHdLMmNehAW1g8aL6kTknqhCWeqRUZL0+oeZCbLyuAVjQElmLRN7ST69Q9pbvzbLcUdAau+4eok9zknMJLmvHCK11WEjVHbcMaSMbWOtVmhSGg9yy1WQb0odrTIjESz5OjeYAtppkSCSTFxgKfKw5Yp3FL1DbCbcTFYNMX8d2cFsAKGIS3uaWaoS/WiU1WqS3iIhpXNJYEkpjUcwfNZ9RsUzOQ9AbQogdTFTz/mIC8Z3DVmOaqJm1jT0n2G4Nfxg1aS0w11LQTRhtgBjiYGTmumys8SYQwJLjGrq+rf5HgGn/OKckCy33878QFCa6bKwaLUpppyWSsdbFvtSiY8clBc42m3LYiNSkJN6f1xK2D5o8RYtG8Teuljq3397mvrRMI0Xyj9SINHBIGTt8qVaLFPE7bRFpjB2z5gHXX1YPmIg3foT4Z7oOT4d+iCZz+HSHlmWTlRE6z3RPNtNQAVv4ukqvEiIblGUGo+ZNxWtTNSkdGErsiJTYBLcrn13ZMQSKVNTaxeNDctHhv1OsBjRrrGbWjqXnQ/Jp6nNE9duQJB1bf68UH9QXz8lispgYR8yKLdfM7AmMltjuksG7eiU6q5rpEH5JXS1W3RTtqqgaQ5YFSNU8MhbJC44B21rFPlCY0EDUw8qHm9Q8z5YA8M5T+lfaAsQ1kqwbvrEikRIqeWAnZqptfRAR8eN08VagH+P6JYUTwODD1JqQMVcxfT/Q5+0oxAoNQZBB2DO7vAdLWIrLJ+KNaqKtFqGPuC9g2Y+OQqlqeJIywuCwNDpvNdySZBjOq9uE14OV/cEQjQ7UkrEbpjsQZwH4Zh25EQYc29Pu38xf1CK1SlJZFYmqonSXSONckjpCVWorIuoLrHqHwgakMb4HofCynoDdvKdAXe7UzUlQ37xw8vGaYEcaeUoNxCOUJ+p2lDMjt2LGBLNWwQ3eUubIniZAC/9yO902bcqX3/0ypFuK7VNaEfu4EoiMbtbQdnigz1NwAdNwtyS8SloKeU8sHnXOgO1nqqdNxIcKu7ymTnSd/Zh2btthDGiZYqWexe9LMaL6JTN1UWWiDXJFtTJgLnFL7YrV2jUaNi5OpgAzUUiYvHzRvWeoco8JHWuVb6oB6SSD9ZBm6Up+mUitZChk/UV82HHY+ptXytBqwA5qY6puaiyIuOl/0ys0QUPSKOF2pVuSsxg4aipsIw0hVDbI0lEo7SQqRpBoex/YJhy2yUjcXjbOYBZusqLT0VMAJFjkCEEwLpAJkRMw0tvpYKTtywdLS5NM6jr2rlxa2JKVxlWaRFGECyl0dNLdPxixNLbmQ4aQzBSmpm0uqimGyNdx7x/Zb4v3ei4yB4dgxp8QTOahRy3tVR9TwSI9ueCDXRAJq6ahsRpBkkvqEX5dHOc+TkabUWzXPLamgm69P8FQzUBUr0v4AwzyI0TeTNiaTRvSbiHMy0ti5rry/ncjLyeR7vYAV44jai3wq5iu23ngkNnxNUafJntdKXCMwdCDQakRStP34ob7S6LmMpYB3YqjMuGGtPVw8IZbQe4og3XRzxTBGvzkBq0g3LU35r+WmkG5aej1bMZ2VTfTi6RmtthcDH4mmpQH/neqrzQplUiLLtDQadpTF34g4LFvUUC0qnyKOpKytgYTnCOuA/cdsSS//UGnOuCWDtNIA8JY6116DJ5pbmgup0yh3IhZqvohPME4TPJzrPVRyfSJiL0nrFQlXLqHbGKW6YYAbrqkz7oHy02mxXFKPa6hTYl3581J5Ur/BXuKYlue9yOfNQeo4LvJA6Zw3ikQrUsfkhrSFwxO8ZPX3uwyHlNHA5Ar1a/TiPZfVcyqtk4NUPtFKgUCXq1ofGSRBHR0qOYjqk3hUyFxihJcAHx00l62P/p+PMksfi7z+MYFnY1ehSgGj4xu/j7DTPVjWQay3UrHnipo59DFyLJiWs+MsItnACAjmSibqpYtG85eN+bH2S6kEcqPGCJATUQsaMUYjpGy8UIQ8w4RcUkjck16LZM5xNej4wZyb0NBrTaV9TDrd8hCrdWXKHZM6JIPWJ8vDhqXU5DPJ48d9mXxST8inPvF8HhK0rnAdeQa9VKS+qfgMxx0upIGWy64o5nijrTnqkRW/F93JjxjgyOUXqaHADYvqlCU7JV/UEPGIy1RbplqsAROUqiZi6JJYfzjXuz4SPTe/VkxAqgOJnpubES14pwVgc1OyHK9R+LB7sGmI7Ul2GnI9y31t6lB0jLpx87owZDp3rPg3iVANycqFjJ5xxfBfjUMQuZ41YCYOCrlepcqO5xe01cXIMnii2F37Bc+M6Yt6ei3m1y3FMEpGCvbxjShIsR1s+MNGYQESvmlEWZtKsRN3II8zsMpQLVPVOFdpvjRY7LSM2L2meUg6kLVZs7h0ZmSQkppHqFmGLY6pqCI2t6dgi8tvsSxicURkZ8ZKL5QqUD4pkjJDgy5DYB7ORT+DUGM/NUoaMhj/1kSyI4vmpXL2PpdgIC9zc0/HeOU+kZdZkoXnVLavDb7dMxxtzcCEPnuol5NeqQWl70290tzrc7WJF6rZwJc9rDu9UAraDFs/iNhS/migD6jtKKpiZ44tFUmIXipLS2mWpyNNHbkYxDiRUrKklpKqgJmWDgA7MrGV8cJBantEk02fNiYARQLgYSOaEJ3IFbbtk23q4zQrEc1Q/tpSHvuTCEvaJHgrjVWpQ0gUkIAZfAbrZ08xyLH4mX3GpP7vWF82pG7vhACdPdFD1Q+oZSRdVoJv6AhpF1ComKjEDoRiLGRcbjYN9bASbX9FHdvyBP53QoN0y83vaD2GCJzPSL0lyY10y4jXvTloLh3bwDBtIAqTv/2gXkaiZUB9XfmPz6IoBxIto+9bZTzsnFLSJOehGXK9+1b8+YHfQ0tg1HvG6biuzCKDVqURvWMp67RW8dhafESVSj0k47VXaTOrESEYh1tEDo3JUT2pEnY+YpFfWQK+qNp4B2CkJuFb21NxBVLu0xEpHYBRaqEMYvKvzbd69W/Z/uDv0cci0mXw+TWl1+OnC3InN69J8NCwZcTdj1EEGZR1rE0yCxT7nvLRCcejx3zTF5E8G7US1dp3WlQq5YFCpTqGRpN6XVZZDO5lUoUqQjcqf608Icyjf8ngWOa/PYuQL5mP1oc7Kha/87+ZJy8Mh7UZU5nIegxeWVeecVNy09igiBis+TYW9dB2HAHX/E6HMrHamC16f1I/I3+Wuq4U+wvJy+PA6Db6Mq+Nl7SPsa1+dySdO9F3smY/6bc6psOEDFS9/mDAd2JM93hr9HijyAcO+4NXwbWNQn3WqasvGFd9qlXvTBwqUfIhmXSIvl1OtdGHix0eFH23uOL4+svFXiX2xMSrRE9MePbo08VexXhh4lXMFybcqF6YyDxPVBd9uNirmG9LuKzeluDvMyLjXsBmHd/qw50n6qG/XYzHJV5FPy7hXZgfL/Yq0fuSaoDB8QcmXkU/MOHOPc9LvIrxvIR7RJmHvIr5usSrRJmHvIp+XGKZj0u8SvS4hGfTuYe8SvSehJtO46UHLkVZGTwnkbX6u8VeJXpKQi066RAKxkMSFKOkQyjpNyRexchQjELCwHdP+iGsofIVm2mHUD0Y5Z7EFaRzjlVy/WmH8KtyFfckHUILJR0KCKZEWhTw7TvPSuE21jTVZWLQVM9RZVYkPDk+ylWMUl6UPErPyv1GREZ9rBjFosjIJyXnuEJmK8afo7ib9kndn5bfK/YqsXclKJ6RMhc9LEEtPl53QWIVCYfcc1X8OUCX2nHDFYVhMczfKvYqZqohrxK9JmGQBkYiNMbq8YzkE+eS49VQxGeKz3FqYq8SPSKhKZIpkXMD2U8/Iel3ak5IVdH3iVGiREP+xeostcnvEzOfxT5P7FV0riH8aZJFf6IYDUZiYp5kxOhJeYeotigKNcef9AiH+qEI/u4XaX96uuYFVtG5SFMY6Ya8ivmZYq+iH4o4ljmffCWCJwVeJXok4lXij0RQxiMRc5PRN4q9ivGNYq/S80gEFadlq5U8pz9SjPqiGK/7jk/Ujn2k2KvoFyJeJf6RYjwQ8SrGAxGvcjzhkMnc5tMQ9OXUwxLrOueQV4lyDnGTehqiCKRfhsii/kSxV8Enipnl8S7Eq0QfKMbfI2LMm3UuOsg5xASreEgsV6/UHGvI0/3kcxCvop+DeJXoOYjsJN+DUCF6D+JV9HeJWQyGR8S4+i4xGgti2L3gsKrQz0G8SpRyWOpClXD4ExpmPgXxKjrbMMmtegkiUw/hMOHkQ2grCEo7ZJVDZO1CTVHW2O6so9bSnyNGYdxgf05ERKvIByAKPvX+w6uY+Ye8in4Bgu8RM6qOPQDxKvEHIF7F/ByxVzHff3gV4/0HN+L9x7lA5h72KkYCIq8SfY7Yq6jXH6zeZP4hWYjyD3mVni8RexX99kNlIPIqxtsPZBxGxZCBJ/3+Aw14/3FOfmcXZfn8w6vEn39wDiKvYrz9YGhGMN6pH5OfXCG+Ir5GHBE2evXBnY03Hzxt7M0HKkqAK+RG+eaDUg6jTK8+nIr7GTfrVx8onBE591zgSUwaSYi8ivHqg6AwvkOMUkJk1BeIYWGQ/tDPPfD3oLFD9ebDq5ifIkYJTz7OyScfKGeVzUHzqaTDsrP6CrFXiR58eBX94AN/TggIg18xoFKPPbyK8djDq+g0RPjT/ASxocujPET1aY9VqvHpYSoarzq8SpSAiCFWzzpUEiIItH7X4VViXx9mGhRsrdjUl4d5JuNFh1eJEhB5ldiDDq8SPejwKrEERF5Ff3eYgYs+O+xVos8OexXjLYdXUW856KPDhAC73yCsDQuHJ49/dtirRPmH8DfnH+KFja8Oc1m+5FAZiLxKLAMRisPmmuo9B7AZPejwKvpBxzk5quc9h1fp+QCxVzE/QOxVosccPN54zIEifYHY8U11Gz3mcMn01485vErv54e9yrHPD6NqnNZgSzX2csOrnPhyA9WmkYwvAge0tvwAMToMiIRTwYzq4Qb+NFISeZWelERexfz6sFeJ3mxwjmHU8IMNT+ck8irRgw3Cl85JhL/pwcY54hj1XIO+9YvcWvxew6sY7zW8ipGUyKsYzzVoav1cg0v648MoyMyy53AOwvSno894seFVzK8P8/QyLdE5WVLnnHyaQVAa7zK8SvxdBsopgwYq+xCbjjRj+ngzHttSW/Qaw6uYrzG8Cl5jROPkJ4cBjHyY4VWihxlexXiY4VXoYYYj9ZzxLsOrqHcZ52KGgnqXQROZ7zK8EA5+OqhGKYrwGMoLYx5+GKUo8si99/DpZ9h8Ye+HJLzQdPPdyMkP405+GHPyw5iTH5pOftjj5IfmZyQ4PxHDa7j5YY+bHxpufgg3Hxt21SckvNDw8ac9YCw0nHy3YiUNFz+Mu/hhr4sfahe/SoVeFz+Mu/ih/oIE7Ksw5uKHhosfnvz9CC+Mufih6eKHMRc/lNmJHO+sZ1WhGNyA11A+PqFRO/kBlSIvP4x7+WGUnAhfi+AFIk8/NL4W4YXw9Bnlx7z88MTkwl6ovXwjKZHOSeSFJ383AvVDwv7Uu3TJhXEV0pcjyBHHlyP6PkWGE6rOaHjg4oSRnx/qD0fUCev4boSke/TZCC7GPhvhhfqzEfiTPhuhEhJ5YdzBD+HgY33H+HIEOo2LhHcBlmyID0ckOJ+wF0b5hGVxWKSkjx/KT0Z4nI/ICyMvXxall38sH5EXmjmFvdDMKeyFMUc/xNciktNeGBJoJ30sgjZp5CNCifz9aV5KpSPiDcTd/ZDd/WnazqCijEowTJWxbESoGFG9Wqs1q3UbVmioPwnBfSJXP4SrX3SnOSERb8L09MOYpx8anj7eAU+6Ae0u5uqHhqsfSlffmw1dqS57EhJ5oensh6azH5oJiXiyyNc3PgfhhUZGIhRizn4YOfvhCc5+aDr7IZz9Yp9tVTzjCxBeGHfzQ/UFiEu8XuTmh3Dzy/70jGKcuJsfRm4+DdSJiAiJlIkIjF/x2NMPDU8/ND4BUfOq+vUjpyLCFyAo13CVvgCBLDpeqFMNe2Hk84eRz8+zRi5/aLr8IVz+lCdzEXmhGB4RKU87/SGc/pTnXLggj8TI7Q+PfQUCzfIrEJKLYq5/GH0FgkbHXX9XOf7hsVxEdDz1pCLiusj3D+H7S30m/f5Q+/0aPO34h/rDD4TBXr8/PMHvD3v8/jDm94cxvz+M+/0h+/2B9Pu5g/7wA4/Wrn+oP/wwSR2j3MNeaHz6gXvGnf/wuPMf9nz4AYOGJKIixz+E42/oSOJMlZXIC6MwQPiBrEReGI8EhIgESAHjLz+gRyFamL78YAVuGOJzSF7VV9PrSACzY08oIOwNBYRGKCDsCQWEMhSAdMTUM4oEhBwJCAJP4tWMBIRGJICbzVBAiFBAvl6NpSTyQiMWECIWgJ1GKYm8MBYHCOMpidCKTz+4gWf1OwHbJBwL+NSVoqQzEnmhEQsIo1hAiFgAw6UyEnmhTknshToW0HqAzjIh0Sv624wGmPreCAdUo3xEXqiDAvjYA3U0owKhjAoozPR868FyjZBAqD/1UJOkMNMR6e88SDtNBgWq087sLHfWYYEwHhYIjbBASGEBj9ISkxIz8hJ7oRkWCGVYQLJBlI7IC/U3HhyODCD2FHI+Iigd+YkHmr4nMBAagYGQAgOfuvJEiT7xwDpR5iP6+9y3nJDIC+OBgTCWj8g1gwGhDgZMKpPpWDgg7A0HhLFwQBgLB4TxcEAowwFWtJNYamLXSEXkhcdiAeHxWEBIsQBPCkQ8FBCeHAoIEQqAZLkyDABtz0GAkIIAHqIAYRQFCM0oAC9En2+Y1nGAMBYHCKM4QDIIqbuZg0gOMIIAYfTVBnSmIIDCvxkGcHUQIDSDAKEZBAjjQYBQZSCStrcRBAgRBEBKYsMJNQMAYW8AIERe4oQXhq378exDXng8GOBGCYppZCwSECISwCQYdREF8Kx+ZaPMEOlH0z3tKocutxrBgDAWDAijTzNYrg4AhEYAIDQDACEFAChNsReaH2bwQpl1iH0l9DR8/zD2TYZPKOnQYeOHw8bfDud3Dxs/Hc6vHc7v/rb7ktoGqG1+93D+2uH8BlUluWrjcP6bf61+86+Vn3776y//mvvxX41Vah7k5pXDxtbh/PXDxqvDxtvD+V1qS+nZrh42fjxs7FDxb4fzXx7Ovz6c/4b+naOuadW18UQtnOWqR9T9+mHjB+qY07UPjNoRPfy5Gl5UHRuv0TB/WUFli//7dPdf928fNl4czv+gZiiJw8YmAGq8OpxfOpzfVA3lnoYNo21MA/Mllc+ovvMbtOa1fz7aU6idoL4ABt0PG83Dxt7h/I8Sqv4+cTh/B+ijTR3O/+1w/jZ1XThsrB02nh/Of304v4xV+vsFJpCIuXbYeEW1A1y7CzRj+UUi7kNqS4rD+W8xG7D7mqoGBRHg0WHj8eH8MhHwwWHjF2pL6bataLP9Q0IRbov+ZbjTqi+4aY+qhnm1ZdUFhHwByLApBvwq/XtPAZPjqe8dzj+MeKI/r6f+/LDRpKoRwtL8JrAhwTrNOGeEzxFdHwHq+W+ouciTbBC2H1GVzat9cTj/PZVLQvKi5tz+MV5n4XB+W2F9nHe1QQC9Pmz8RGMnuJY552dUJfrEYeMRbZ9YO5EQh41d+u/VR4Tt19QfhPo44o8ERG8Xm2g8/8fOV/+997ffrjz97f/c+O2vv/AoFtYfDxsvPqb+g+K/9x7/1nwixQ90eqSHUI9UNKMxl8TGS6Bw/hHPNSTAeWBJ4qUEpHEXW5pfIrzdITy8lghMZMRhY/twfv6wsW1sICtIyDapADFlGX0UMUsiTzBL8EYYPNqaJmcCYrur1Aj2RH0hhI8Vd5CsJUZ5hddEKGLu+UVqGNN9Nw4bv0gWS0A0t4F4iNj3h41XCuxx7n7rcP6x2j6IepnEjsAegPhcg8TOL1B5WBBWvjmcv0zljIC4YRvfA6DGNtWCqa8RU18HaXjXAyPxWpNgAxNaZe1hdS16SbDQOuAHra9JMiShmdeVWlKqMAmqr2OG+VuH80tUNST++fAtyeAXUjsmgeZ1oMPUdUmb+GD+O9or7T0JvbhO6Ng8nL8pOw5C6leg0+d3ta5D98Fh1QAACPODwM7KYWODUaO7R5wzCEzdw/bmHx/Ofw4+b/wQHTGDQNkKIQTCY4yDbK/ICZl0KQjfFrEEgZ/qF9gL1N6mpG4KgrZzOL8ViWgKmNyjk/A1/uBNpgbFf//tC1IoLGSkB1LAr1SA0SZTQ7qWe6U1i5DCgGYhoqWgDHekwuhBXgpCsxUTh1ReHDaeRNRNFWi4YgMC4xY1nBb/fNH81+Wv491BZt77T4fzP1LHkp7hKvEr8UOqrGvvU3ksWheKovHikImRgrhsEU4VRoYykOp/frH93z///I+dr7Sq+dfqt//Y2/6/1179tvTwnw9u/OPm299ukIYcgmL/q8LfQ+CASTMEcIkLAMhluYkh6OcvsCSL21CZyhhOxxNTIA2yNpXpIpmIGkDcH6jtUQzdaZACKg+1jXVJoDSY8fvIJErno3mpPCL+ef0pcYWs/cfz67/d2fnt3j1qxnHUpBlJC6SLqsyHYdpW0DRexaEpMTRvI3Eclmz5o2RL3uhwTtd+TyMI7GEICfZwOD8neX+4IP5x6+E/7myhPYO5nkackQEbP5YmAWM/Ax6mc3z+TiQFGXDyYwAFo4U7AoJd2E7zj6kMFJE5Ob9kKNklaVSAR/8GucYfz6E05DQF8duNW/+4c/dfT55QuSj+de9rqQszYMDtw8Yqyf1rqcky4L6n2DU4dzdSBRmozkeHjZe0pmGDZmFXbcBC4EWzMJ626Pz/i+qSMMCffxRxThZabvNw/v+QqPD5+xBTa+2YxUn4ijoRKfm0ykIvbUq7QE4Fm2OLjiPaSBY8Tcq38UNElCw4+xHtm+FhA40YKTtKG5lfipRDdlzETKEc9gqi0NFMAOYSXLWsygNcfkRSQgdpDqebstKhfXckWXODpt32vVJjPA9MxO/Ba40tYAAnMe09ByuRT0FonMP5RxGRcjgJvgcB569Fm87Bbvhe2g05SN89GihPU+sjbBh8/T3ZKrm8Ahc2Ma85crICwgSNR4eNzRN0UI6YDz2IRofzywacp4XcL/st89ejUz0H2v4gbbjGW2MbtkbjErHThiR1rqRma7yljmXu+FqrZcmauVFNm0ValgzYHExRqGmSBbKCc2cELKvGYwyXdAUrfC8tc83AORgyP5BU0Jmid5EHpywTphXz5MEp16WJBXJuxaiUB5dcV4aJsgTz8CL+QuXohJdYzINFlqWfY1ozeYgVjB/a1I6qzcbseKqCTvsL6KDUANUWBCmTrwwwQJRlIEiWgeLrKDfWqTxh7m3PdNDQPAJ9tinZlnVCAQj6CpOyj1DA9r8DKAxEQdsG83exM2W1UBs2/hUsysYPxrLGsVwY0uYTGVVyzQyt2XgcU2AFiMfdXpALOS2bN8k+Ayp+231JoqpNKXWGFYCfW9TvkTL4eEmblpwnGYbxvvzPxc1/PPubFJICWPeuHAT/nrBbAEd+BQw2Nqk8rssgpmTS07DEWGMoMhWBxK3D+VXCxKbcYBFUBl6JAApFRZyTf6MGxaFFUPVxhOci4HhEnoniGBuLwlQhRv0BMGrtaMMU/AUzzP8QOztsqf/h0xGb0S5t2BOvCCx1oNo4Q18ZZ9KiasBh+pOySO5GO7aHzKPlNu2e7EMbp+orAubbaNM2HItXystm/17qTyJsU2pFyNR6RF5bnkKPMQDsw/uFQP1EHTewMJzkz6kh4h3SfvN/oVp5iH8eEdqGh/aL1pFUVdQH3rI0FW1b/Pb5rWO4KulFlqCsAP4t5ff+dDj/HXWCD0dM2XgRxTPk4mcUTsGwf1PSSTpZLgFj4CcDtgmGbRvEN4WlBGFekmYJGJIjEXP4F5LyGhRg67gEXngrPRVs6W/ExE3YO41XEc+UcI5epnOKrLcSZH6Jxj2nMgj/VopCCWR9C6h6ZLsEiV+iA3FD2telrPjH3afq4PkhYqUSTsYlaR7MP+iNtZVwLF4m9X09Mt5KkK0lbLhBVmCpqCdhAEoEAMhynRDymgjwN6r8Uu0F59ICeEtCMiZ++2LnH6/eUAFIBxB0yihGLkMOV6W3B2uJmL4MMVlVLg4FR8oQ8x+IQeDr//bl0j8fXJUmYBn0hUqP6ZUyFlw9bPwM+6oHn6Mg9JfAAw6X3d/2GnKq0ZiJ9zWBK4u/fbF8eHnX8Aj57H0dUxGjMoD2OdYliaLalHIF5p9LQ7exFx+XVrHGD/YA3b4lsJRAjULPfXk4f4MwQxGHMZhiq3TCwpAFWyLksyARO2aLWDDzDBTCLsnuHQwfh+JinfFAompcWiXrsBXZUJnoY0GOwklUmxORAcHUnYBtjm0QmN8cNtb/cK6KuHL3m+fdhdfdK7et7sIW1Q2I7spS54uFzt3XVE6K7spC5/vPu7e+7GzvdVa3jpb3qGEQDUc3F97vzHWuL3WXm0fzC9SQEt2V5tH1nfc7c1Zn52bn5VznepNa0qK78uL9T0tq7qzortxAl+tL3RUenMOsnYfPOxtm7Yjornz7fnddDSzKXke31o+u73Qat2msTbUbNzurW91Xe1a3sdXZXFdTlET3dhNQLmMTqrZs1N5f6t59bbSN0XQ/rnd2blL5jOje3up8P9f5+o7VvX+n8wVPMYFuKD983tm52b37vPs99e/vE53d593lJu/DOrrV7Dx83n32/P3u/c6rBQl0f78AAp7d79593vliobtMmOofEJ2djfd7c527rzuf73Sv36HapOg8W3+/t9O99SWVBwWQ+/D5UaPZadw+unGje/UGNaRE5+USCKN30z9E62CPRL7+NI39catzk6EdFp1n9zvz16iQFd35u+j/8PnRlZud+0udbR6VE51nC50nTUnR/rzo7N3o/Ljeadzu3n1OXUZEZxdo7LxaUGufFp2FZufzO4y8zv1XVvfVc3TZY3CLNMvd1921JSrbgPVo5XZHlkuAVbJX/xihde9+99s5hcNx4AWo+nFdIXCCqla3uqs0RaJPdH/+stO4LQFPJET3l5v4b7lpEDMxILq/bLzfvX/qqLHenW92GyQUiUHRbX7VXfnWYpYHk7x6br3ffXH07c33Lx5Tn5QaKvfy/ZedV7S9xJDoLje7dxRxE2msfbS817kJ4QCTPSTUJTKi+/aBCU5WdF/d79znLeQEsLZxs/MjA5UHUGr1EdH95U7ngYH1RBHLgLfniQ9e8Rpl0X17X7J0YpSm3H0O0j983vl8h7qMiaO55527ryU3Jc6Io8tbmGK52VmDpEtdkRgXR43m0c2FzoM7am8TovvsefcXmn4gKTpfXOmyzA4Mi+7aHOh4nVYZyIjO5887a0tHl2mygZyA1tneg7gwrAMjsao4vgcgdzeAq1Uan0yI7q0vu7/sYAjjM5mkquXm0dX7kTJJpkT37vPu2ldSkSWHRHfhTrexBYZjWJNFDGRdF4lQ0gYdj/5yW4pQsoReR9d3urebRzdfy16DaXG0MsecjlGDwwKNa3NUyIijL7aOLj/vfH8nrkYGc6KzvQc8P7jTWWh2r99R842Io7/c7jyIdS4KYP37ryTWU30Cwv6chDfVL7q3L0sspQbE+5/2IMCa9VNJ0X2w012bkwukBqPJIJrQLytKPaVS4v3T5xL61BAtI1vSRM+9G50HzaMFImkqS4ut7kS7T+XE+50vI6ZM5cX7vZ3OdeLoVEG8333d+brZ+XH96CYDc1p071/ufLFgGd2KPO2W2m0Jw46u3OisfSkJliqL98+JWVNjcgGs2djqfseQjYv3O3Mgu97ZUEYcfft595eNj1iKrKPlV53dhaNvrnXvr7x/sfUxxg3lRff2eucrrCNXHyqSNDwAfUB73tdQiU6Bu88ldw+VxdGtu8AWc2d6QHTv7oE1Tdqm6XDFqfP9HYmwdJa4c7mJFW8RpOmc6KytqwM6nZczUWFE0hqE4+ruN19Z3et3O9uK59KnMWPn7mspaOkilb8nkqZtCXd3WR5tVFtCl6P5pYj5h5Oie39OTjmcE93vv+xs0GnHsjo8Irrf4bzoXCfbYrggsK9dkpRMUnRfz3Xm6dTKpKA1Og+fg9GWm5HhkBkS3Z8XOrvPOzdvylUzaeq7uoWTlQ/BTA5qrbO53nnA5bzoLux17+4dLe8dNZrdvfsWDptXSttmCqLz8vLRCncuis6NuaOVO53t11JCMmNQiN2/rmPHrBEy4wAXSFpbN6QuMwFt2f3+y6O7X0ZQZ/sESEVWBJX7BcRt52bnCrFmNhHB1/lxPSJ+Ni2gYb5fOrryZXd56Wh+TuqVbAZ6Bsfj919JTZgt0iJ3SVFrmmRtLNW9euPo6n2asUQzLqE90nfZsuj8uNG9siRtBeaC7ChmxCGkZTM7LmLHdq5PMJqlOs8lqNy4rcoDorNJ6pZPk1xSdDa3IJcLTQgs0yc3SLbE2hLMtfmnVJUSnbWlzvUmZHK5iSOIFXZuCA3dK6auy2Wo7vVc5wtjU7msgPSzcOVId3a/mZP2Qi4vOpu/vN8lRsyN0PhXz7u3m78n6jniE8BF+IVBE8FwGnNgA9ehlKQg5YqEj9Wto/k5qapyNqFgea+7sMfopdoSEHU0T7KTK8tR3Ss3JcvkRtEOa4DtodwYbe7u6+4dXv6M6GysdK9rQoxTO2y55xE/5SYwcfd7GJcSwnyfgMmzbRA5nxCda8+7r16DSU2M5pMCBbJjqTwoOlf2jj7/ReqdfApzYcU1w6zMp2nUK5gL73eJ4fPZmLVHVSOYC9YviRlVFdDr6AZxTr5Ic3+30/mRaJYv06zfKUM3P0FAry1FenIkB8u9s0YIK/SJoxs3sFVmgEKSzkyerNBzvh19QaanqYcLKYw/+moPhL/2PDp6CkPiaGUBQrVCxCtkqGPD0FqFrMCMeq+FHPgdR/6tLzsPn1t0qj/vPCDOLxQFVqEWGCWrW5KkBVsc3YChTRNDE1jdtbn3Lwj1hRKsBgxZ2nq/w4CNUf+7r+UBVxgHYEwEieHTfVBX4E0WrmIS4t29Q6bQbUJNcUTAdl9bitigaENdQY9rlimWRefeVoSt4pgAKb9dkAaF3Sf4pGW9DTtcj7X7Rffqzc6DO53NLUOobakUn3evkMqzB0R36bY8Ouwk9F/3r+udzwlwOyW615++35ljJFDVkKH0b22Bl9kqsdMCJ9mDO1CUelP2MGbs7Nxhl4bhOMUKo/vXLcnhdkaN3bmJk0SOzQps/RZMAOCPMWfnRLexA6WwutWRe6CDqLPQlCSyR0T3KtkoWlrsIm1zdavDDrhti05j3dh5SXTnLx8tbXU2blpg2lW4fkd/4a2Niu6t290GvMzIxrXPiO71+9DjrKAWGGXjADoSU3sCCOh+O9ddW4pYtdQnpIELpgM54RowIksJcbSwc3R5ixwkOg27f2vGvaXSgOjurKvJUuLo6v33u3QSlYbE0fwdecKWhgUOt7W5mNyUMujffb0UMXEpKzpffAuHfnNdbq+UE0AHHVsyJBDNkBdHy9C80iopjYijqy/e73ChSPA3iENLJblR2IXXm7C8IX07NxW4o7TKzh2pf0pjMBOkcilNiKP5Bdg7mp/KfaL7HZAjD2VGWTmF2vc7Si7KNo4liYTyOBo7P65HAlqeQFV3danz7H4MNaN95P9e36EoxnWFx1HTkni11F3A+RJZ9zcJiFHECO7Dg1i63b2lHJrRlGEndp4tdNcWeg3P0bSE9ne65DE3Vmf2HS0Dzs4S5jpaIhkaGxTdbyEU3eXmqaOv9oDWxm2DZ8ZsEYvLnMnAnIOg7BLBx5Oie3ups0GbGS/h3O1+d1MepBM4z9ZNHxy9JmCN3o90zsSY6K59Ccj2bnT/uv4HfxaRLv1uksrRs0mUcH205vredMXlh5PB5IwrP5Tk8oD4+0l/NvZ+MvpKkj8be0Dpz0YPKFuPaCL1glIW9RNKtEVPKFWzfkjr+MEpvmOK6pIYqQah69e8T87RNNFDSjlQvaRE4xkh31D6s/oNpetVLuFLSf6sfkTZemS58S8l+bOxZ5T+bM8zSn9WP6PkZXs/lYQO+h3lJy51iR5S+rPmQ0p/NnpI6c+e/JDSnzUfUvqzxkNKf9Z8SIkSPaT0iJhW3q1wej4Cgd5SXvIUsvRryr/PfRv84f9n7V+2m0qWfXG4nfUUaq41xmY9wOnZsiwbWbaRZBu7pwItmEgla+sytU0Lgw0GmwIKMGWgAHMzULh8pfAN0xD1b5zW1GrJ0IE9kGxa+xW+8YuMvEzJrHPOGF+jKGXkPTIyMiI8IyKbsRwqUbAcKrMZO2xSNmM5U2YzljMlCkFBbpQpSvonqag1+TYadgrpN5nMHJE+wclsIRXgqD8pOt9D/SgB7xIjZadYMHsLdotud3Q0ny4VilSOiODoaNq3CpV/O5vR4ZJGuUrm35brshwps5kmR8psRjtS4uegiCSTOTeVP8kotT0pUTwutA+lXArNMCyCYznGZHBEJApn4EwpR+gIiY6SUyyoxnYO7myGgyZRDm65dNujEvV9utiKTu1SKUnS8qnMZtinUk1LPpWUgBtVXWIIvvcZ50waDhUIpESNjEslSuRSmeWx4VJJubfltoxTJZU4ghJ+RoRyprQPy+9UiXZ9ojOZylf+TOWpXnpTphUn0P6U2YwIt2u+FoiMZh2rlfGrzGaMX6VcYriThiwcYV/EbMbnVZnNGK/KbMYfQCmb8TlV0mjapTKb0S6VhBp//CQAjgntTJnMHGFfHlTAp9JJpa1RbcfKbEY7VuLnEM+hHCuzGcuxMptRUZTSKoiSi0ANiupt90q0lVGUiowak2EbBZlhW3M2y8NStoaLpbmZ7GKJHNtoDBfLTCow+k/ysMxmjIclfveJ9rxTLJAPJT1G8KlMZovK7aj0Ew3JoZSyGe1WiZ9x0X1U3xvlVimXZPwqsxkdSkneZ5NYm5tSZm05pPSplGeoQimd5XbKofLkGVltOVRmM8ahUo7T5FGpYimhql/0jo7mTx6h+EnUOCZGSo4NQCLtVDnlEGeLDIPpOD8ls/Z9YYfKE6fVO60cKrMZ41BJa2nyqMxmWj0qsxm/R2U2Y3tUZjO2RyVK5FE5WirLpehM2qX8KSqTP6U6F3anlDhUoZTSNI7xp8xmLH9KbupzqMxmtEOlyqSdzVgOlYimBECXUK6UNrKiEaGcKHlw9qLMZlq9KP9ODbQLJXfowwjJUutFivJ5MrOEP1cyy9NqD0rkrsaoMXaK1MTn96HMZowPZTbj96HMZiwfSjm8dqJEAU6U/+UwLsmHMuWoC2qcKHkh2otS1sON0kmVykeUGyWDlR8lenQK5UGZzdgelNmM34MS5R7hk0PIf5KCKUm8aAfKbMY4UGYz2oESP0d4RSOplBpGOVBmM1YwpWxGB1PCT8XSKXu2ZlCcMjuVPCIHNRxXh1Q6w2dmu0+i2AN/TK5qdp60AiplM76ASvIY+uNwiYTX5Bk8zadSPzoppkg7plI2Y5wnsxmf82Q2Y5wnsxlfTCUU4TyZMkK2jKqkLpD0n1TIM4GVshk4UMaTo6mTzhHSAmT7eLtg18mCfXdsF0paOqXJTsGHEoWQGCmrTfl8KFHZhXLxCMdWymYsF0qe86iI55xi4cdU/lQqG0jZQZXQnPwoy8k0vzzNbpTZTJMbJQDGjTKbMW6UPJ/lR4nauOS81n6sDNmBlBVUKZtpdqQERDpSHlGOlADJbNg8ne1Jmc0c6kkJcBC+iunCEcwovSjtE0A27MKZZFIOwf6U2YztT0k1lAz7rI6rlM3Y/pQo9ZA2dYScKqlHk0MlQORQqS+O9qjMZnRYJXkUPo9KHVcpm7FcKrMZy6Uym/G5VGYzcKnsS6XzycpSlkYcCIu+1ClFogNxS1eFf6XEheVaiUYjoi9lfCuzGRVc6UQymS0UJcZanSqzgZOWYyWxVl+MJZTDUjBJwUn21MmU7+0d7La01cNbGNfKbMZ2rcxmbNdKHVwpmzG+ldmM5VuZzcC38iguOZZp+VZmM/CtJPKV2hTqjXdlNuPzrsyRbWBorJBLBoayTurH0XKh+GEy55C0kzOWAlkIiZEzqZPZ0RNnR7PKQDBGJoNC2klRE1845ZzPTuBoM0HObybIWWYCWWniLOVsI0HOMhKMZWUl4ycA1I+lk39N6KWYWEs5n4lA9lMWghxZCICCMRlO+azqr0wFZ07+tSM3Q6aCv+5lHWymK5U/kTo1WobknPNbCnJ+S8HZH3LGUiAbNxkKcmwo+Ov8qSQvWAVVPiNnNoaCnGUoyH3HUJCzDAWypA0FOW0oKPNEx0Svw8/yGOIqJ50fFQ7ZVDDGSFOWAjmkthLk/FaCnLQSOMm/zo/mS3/d+yFnGwpyGSu+MgpBRn5kND1aGJVEGewQHamfRtP5ZHHsxNlsUumv6WQgQownl9Gxlwj619386Mn0aHk0+U9FBXJ0jrRMv7tE/Gw5eeaEQjHiLlXWi6nAyf8+N9ftjjp5OXmEVwTLgRxFmgxkrYmwnNMWA0KIDK9cImUvl9HhlbEequboyvg5aC19xIGokDo1GujPnx3LfJg8ye0tG0KObAhYVTLQPvrXDlr3JvOjJ8+qS8LGBPo5IoJnUydO06I6IKylfjohC0dFx187MioTijIqE82m7Qdycm0/SPOJSpuBk2LUwWaQLpPNIKdsBqM8FNkMnFyRfuswTCPJE6dHT6omsBiMURAmlOKiyymczam1WHGYcrbJgMswGpz8a4e2YJkNaIcm3vLZM7J1Hx9mX/nEWTpAFYBJ1muDQY4MBkNOKpNOBtrzY0W1GisIE9qERDhf4sEpBtMZBEqFeHmixERnmwtylrkg12QuyLG5QIdgytn2gpy0F5RJJkWhX4TLiLeMEEwoH+PKQKyyVs466dGyhMNakFLbS6ATGwpyxlCQk4YC2Z8NBTk2FJzhtXZFRDzWFtDGgr8Rpf/9h5zfTJAjM0Hw9Gi+nORZdRwm/B4QQx/mTuUJFdpGwAi0gy2j2CO6z+aTqQy1jTLBw0CQ0wYCOkMyEIwVx86AUQUSqfyYtAqQnSBbgt1A1p0a/SFnDAU5YyjIZXwxl1FMiKG/zo/yvTGWgpy2FJyRx2ssBbJIhgJF/NpUkDOmAvUWqqjLeE9+yPltBTmyFVDcZZqyyVTgsKUgR5YCMgkE+itrf53Pjp6Qp6VDL/eP/nW+dDLrMDnIEEypM3QdyWhw5qdkVs7hMxbkjLEgZ4wFP+RaTAW5Q0wFuSZTQc5nKsj5TAU5ZSqQC/RHXEbloPhrfFTVqrBLOWMmoCHYSsAFWAn+On8yyW9aNCSCZ5P57OipylpeQprMBLlmM0GOzASguLGAMhbInsZKIMtsJMhJI8EZGAm0jSBn2whk8z66RTLosnWFov3YjJPMjpmDJNrmAEtjihWzdSClGZLfOJCzjAO5JuNAThkHUiedMh25MQ7k2DiQLozRxWDjAF9MYxqgSiu8EkpB0TtaTgZ0uOUfcpZVIOezCuR8VoEcWwVUfGWUe0SXik+cy/gCLOcsm0DOsgnkjE0gJ20CWM0IR1n+IWdsAjnbJpAzNoEc2QRkSKWzZ5rYt4qpdIQ2aRiwNgrI22ObBHLKJKCGaLIJOMYkkJMmAQRJyo9J6pUBlYiFJQPSNDBm5FjLJiA3p60COWMVKCfPoOoYMlfw0wKrQEoaBXLSKFD6qcSHZSIqoTAgYqMFee7GHpDLNAVUAkOV4Ha+JmwXkEDYAwpnUxmiYR1PCb9DELvUg6SNASVaZLyLmfxfd8vOh7li6owMuUwYjneL+F/nR8uKn9qhlRzLJJCTJgE0VS9Qs0kg12wSyPlMAjnLJCC3oy0CVFJxlg1vlQtMMCbU6k+RjSBZOAsFIVJwPkz+tfNh7oQcchCGFC0zsZEgoIwEOW0kkK1HRLyUPEv39DD7QM7YBwKOirEEYAc438kfcsYmkJM2geQZcz+kUeCvnTFDv5ZRIEdGAbIHBIYKUmz7IddqGMhpwwBzDW0XyCm7AB8HmQXGpFnAUVaBnG0VyLFV4EyZB7asAjmyCqhoy7mMFWgJBQq0lB0LWLrpDzltE+Cb4Q+3lCOTwFCyOJbmUrM1wGFLwJi87IMhMaQNATk2BGgBRBJBx1knVXZYyhzsFk3QAFZZWStb6xw7cZoG7xVDbBbI+cwCOdss4CirQM6yCuS0VaBMCNVWgRxbBc6MFovUbKTN0jkMHafOBNqgMhGhGTtBrslOICMwZ04j5CPHYK48GSW4+ZYgZ0IwnxwtBCrj+eSPqQICYJFSzGGYYcxxCkXVnewDlUV/IOacPxBzTgVirjwmVqAiMVdeqaIxETSFYs5ZoZhVmCvZJSEq48o+YGIxV+YzDNAGgiIMBN2Z08mCNBBQLRsHks4Z3ghsA5U1GYzZ9xVBzh+MOdccjDkngzFXXmZO4Y+3KJJ14D9Lxjyg4zFX5nm9VkDmnC8gc84KyJyTAZkrT0ZheimOZkZ/SpVoNP0VAZ2LZR1Q8Zgriy5PdEz0JyuLhVQh0J50/mu0EKDPClLZ07wZnX0p5wvMnJOBmUvqoNlCUGL068DM+coWhWbO2aGZcyY0c2UevRGbmU6gOTZz7vDYzDkVm7myZcVmHqUvDBQFqK8KmrIwoWuXiJcqi5UFWqoJzzwKyfCfzk8EjegVjaYJoI0EKjxzMl95kyJMsJlADkcfFlTWpZLXHKI5Z0I05zhE848qRHPOH6I5J0M041LqrwtowGFMloMxowiPlMSJ05WX/1mSs3WERFsm9VOS3pCi+qyADAJFMgg4+PsJwjTnir6PCnIqTLPCqKqRyIR9IH+q8pJvD+wD/ykTMqFE9oHKOt9WDtRM14YMBAjT3KeiNBNUfVHAHeIiVMipNUvzQGUtJyvZPlB5LB83DtR8hjbUqQ5J52TKWYGaKy+Z8Dr7uJlMyASINBIwBUgjARMyhWl2ZJjmkxICCwEbtDhMc2Utf0qOTTYCR9sIUpI4fSYCGaeZEem3EFhRms0GkZOp8ljRD39UUHlJBROnmfbBcZorL604zQSPiXC+8vIED2F/TpAzcZpzOk5z5aX6nCDHcZodXq+dlOk/ArG2EMkoith9hgIVrrkyz/MaO0FRpWVi9qYMBfqIrHjNOSteM3p2R/XLRKYCitZcuaqwpcM1V9YQrxn2ezwjiNc8Ku0FowFqfYIOU9oK8AfRHEdrrjwhOFkLzCHQhwX6tbDMBUX7wwJUSXOBfrqkvYCJKRISx0r6GYuExbGSkz9VMu+j+rwAclQRXxccK2mTgRWvmbALmwF4fRDMoLLm4ncq0Ft5SZYDHbbZSWLnvaP5Iu2R4zZLaLyUIRgZDZCzCYVhvh2crwmgERFMnk3+p7VQbTuQkZsri/jSAGOR9SBbTAZ6Klt87q3mg6bYzTkVu7nykglC2Q/kXGQ+KGmU2p8ayAb0rYFmOPjYoLLI90VaEfJ4R9AUdoTKOn1ukNPhmyvz/Pr54zfnZPxm4FWaEU5LmDYknJB3NtrFGFOGBAKyHUGvShsSZMxmx/ragHZGjxtbEyprTGjqi4PWqxbtN5PichZ8p8xWBc3L9TcHGolNZgUrbnNOx22uLJ5w5JbVNweSpbJZoSQ3c1xEKy/po4OcCtxcmed7TIaFyoL66CCnQjfr48FHBzp0s8YVrAuVRXx0gEIn7VTHbs6p2M2aWtSnB5V5sjIUjZVBlsjIUEpBU7ECN+eswM05E7g5JwM3c9xmwwHIyCCZgzIySGFFWxlM4GbiY74XwLIycOBmycGNlUGilswM9kWToZs1Zvqj8tL3p07mcd2loUG1ZUsDZAsJiNvMsKDDN8u7YH9+kLNiN+fs2M10xsfwrSe/UMrQwHPK2M2ap8HUUHmZ5/uErw+MwGfCN+c4fHNl3o7fnJPxm4mqydjAU9DHBw4+PkjRuqW5QVWGILypp82yN8jxupgb8dcHAHWLUCEz6uolfy+Ec45COMu280qggqVBGRpkb7+dwRfBOaciOOuHwxga6DLIEM44T8OMvx/GOdcSxjnXGsY5J8M4Vxb5bijLgkXJysCAWYyFQcZyJuQ3xXLOyVjOp5MnaQ3a0CCDOSd9Q5Ol4YxjU7BtajDxnI/IvE45FdH5xCj+Amw6kaWhssikY0wNKqizOpBDgzrn7KDOOTuoc04HddacUEZ1NkVjbaCwziF8QjBq67X68wO6G2xqsHdMsZ1PJk+mwJN1aOecDO0MrA86+AYhFcAHCEn6AEHeft8HCGgf5hNBgqdUtmDEGW7f3VSvdV5ZzR8gSK5lWxoOje2cs2I75+zYzrkivj/orrzCBwi5ov0BQo6CO1fGSdGyqBi2BSO0snGhMv9jKfVDvgTrgrd7xFsNeL94u95b7533pjruvfHeeRvVa9SgQ3jXvXfeurdbPVc95y0TMCS8B951b5EKncK7Xp3w1rxl6jZePY9fVBWmztXz3oa3Vr3gLQe8jYB3w1uunvNWqhe8dR6um5p5a96Gt12d4Yl7ANz2VnhYCYxirnPejm+Jfar7rrfNI8ZkuzW0o+nfcUUcbXe8N9Vz3oa3hbGr496Wt+u9CXjz3rK34+1yywRarlbHq+er5/RUAzZw2dvWFUM0YfUC1kuA42i5jQm8dZpiA1MA19xgBA3e0kqwx01v3ftTIa69TXg3vN3quNwloe0mmlYvem9oo6tmT+3tQuHUW6aDGidwB4EJMVjHuvemOkUVIVS8AaqBcrmB9k7h3aheqJ6jlb7zlo94T73l6ri3S7VhdAF61+jwVKcugJerk9Vz3htvk9fezSNVL2Dv3gYBj6IlHRq36hHePE7nCI1wrnree+Nte2+8He4QpQ7Vc95O9cLhGGzvxURymjfe5hHvFg10oTpeHaezWfZ2qF2fWvyGt6r3e0wC31WvepvVc/+B098ArRzxFnHm3jLIpnqhOk7IB2nwybbHaFpv2XsLetUDxjHgMl2Dneo0gRJoecHch/YhtNmtnq+OYxP6AIf5QPT6Zwg8osAb3lsAgm3Cuyu7qasTDAK0CwrGWXmr1Wk/koIdsgGoYDdwJODdxfFWp+hsaT/BTuE9x0komq7OeO/AFK5XJ5puyTJI8RGxiPdE6ljaFk8UbppI4eittwy0Y1xq1yW8F94q6KV60SamYDcNgBNY/+9zc96v3ipYRvUcVUaE94CvHYh/OeDdrV7Qc/cIbwFTSNoJRjES7jJuzAWJ+2AvgBt0GJsE6ANg19uuXvB2vBW9jBiBMRcuwSNrhwPCu2tueHAQDUEWu0e8X3A5vXXvDdUMoeHP2D+QLK9Q8LjitI+8Xe9PtKU51DkNY7QN7z3vdwQ7elOdVMvqCAnJAbwdmyF2HBXeLe9PbwP8FBRLwAiAdqsoALu4W947s6GOvhbw/8VBdxDj2sakcq2hoPAWvS2cCnEfCQwJvki7Zh2hsPBug+d477031fPUrkt4d7xl7z11fidnnveWq5M4ernOUAxjgQmDQt/wWHHh/QpCtDcaSqDlhLfh7XrvFbCzW3hPaefb1WuSJXHFUVkBxkuE0xkBAJxii3jRd1h3ZxTN3tAR4ozxvqx67+TVewG6qZ6vTge8+0A1Eew77w1xCzlLH7pjdyDPw8aPCe8pNu+9q15Uiw23gQCI3CRBh9sFyI6PZtdboat03kZHuEN4NzGPzTLCoCS6F291u05rQlQYIrhJd1ezqnBYkSEIhbYT7gLItOhGEfSI8bYJG0QR4R6zGH4X9fxR9Fm2r2G4V/Dc79SJh/slCISC1+sCzp66H9MECHoDHjQfszZhBorxSizsJnhseodwxViSCA+g7QX1loSHfMvC47WBO169QLXDqMVbrtHRFQEIdwBCxjtvLfA3b9677j36Ozp09aradXp7l+XT3RUT3jPayCq/Q7TuLlrkMp0yob5rQJLAO2/N8NHuDuH9ihEN3XFFCDfmXDPyu3vQ/i1mN1y6O6q41f3qVUlv3b1qXNUR76ohOk0zQCHJihvqNHAGqwHV29tkkWsnQC/XG/SWnLP7mFzisrdFxZgqyhXwlW/eQALg87Y4djQEzvYnXzDC1dGo8JaIeW4qTna0DzvCCtftW3O0Hy3f24wrEgJ3tmTOCL12GyRibhgUR7rUy4NrCr5CRBDpVq038Hqo44vQOwX+C9maRIZIL4tFeAbpuWIB5DfvDYhY0kekHx1/8255j6gYE4cxa7z1u4ZhRuhVusBPLzHfCD06oFfg5Z2UH2xOFBmRDd6CIduSfU+b8O7haZML6mlHEVxQ1Qetfdyr/oxTkCjq6aam1UmQCSSR6nkjO/ZEhPcCaDpCo79TJ9UTo07eCk5UjxQHEG/ULgiNQAmANsyz2jMgvHvVn70t0mp26JU8561R20G0Xaa2asRhtQ0GRNuEdx8MjAh1S04SDQII6ljWoA4J2qZbx3NHQ/INPwdxCuKXWXq0k9FzRA5POCDERcMYCSyOREm+VMRAo128GEhv3ra3rc7LJxhRywi1pLu1btNxtEdWsKAUjQrvPhQu7x1uRuBvxM6gXi0Te4r2qp1C5dC3K9qnhl/2dn38LNov97XKTwS9iLRmYsnvDnvtosfUts77xZNojCq8VcNj9ArivDASpXHL6R2mqgRvsTqDPgQasAai55YEwOigbklsaVVK7dEhBku5lUDHAXpDVGSWN2zabRoWEB0BGLo0v2eSlfW2Ce83vCIQ1VTb3iCAdGxgkbhp281H1htCmw1vDSilTp2KNf8GkqpO0NHIScJWWz1AtwRu0TEsS0WcKnpkhRRjmKlKHPT2oQrMA9xCK4m9/QC/wbvrbVO7GADLuFTyBewdAGCjeqF6lYojvg3e8d40M+++KEwJO4pv9LcJ7yGrODtymf0hgN6oGfq/L6s8ZCXP9+r1h9F9mVjjcuC/z90K2Ctqkgz6uyCakSLhvYcECRqmRUTkKFt+A0d/D8DQIWZwKQkU1Vf7YXUGqCX+TbIgbX+Xu8aE9xB4otu/qzQN4jD9cQxLxECzbfDripvT+rpKGu1PoA8I4nz1AliHua/9Q2o4llP6hwlAWFwD9ckLcUzql0Zki4Xkw/Kz9646o+TOWJ/wHkFnqk4bKo3Faddky1CgAXTeJW1cgYZkV0kBdLrxNnRcho5P7EwfJsw3y4YTx9vRDudL76X37rvML85Pz2Z1Cviuzhim09q2Q3jzNDcfaDzE2qkRDeNhjAciAVLfg9YJ3KWu4by3+o+Adxunpcgl3o0+WOuqLTXEj2J0KCYX+SkjAxURyBI/Bt6megriETOIpDw5CCwnkrKAIryUb7xdJUHFifyW9csCEpZvVFyJFnSj+QLH+wAESvWjEI9h/AvcleXeeFx4f3h/Yn/eZqAJZwk95WNIdcD0RsB7KEfA6eMmUctB2RIc16ctxo8zjqvnW17E+DDVGYYWHwFgVRtggOB1VCTahPe4es5c0SNY5BGSet4pu2KCLZ6Qjx5XYaTYYhHrLsuGLNMkOvB8L/PQYUGbW5O4THShSPKyt6ynPyqBEPs2mjlFIoLKXeYBfA8TPZDfcUUgKF9S2H3sbYDGJZ0lotgUjmNLmz3tcXupWoKpfZ+ciMiVADEewJB0IsGIQjfgyFsnDKAfbJFql4NoBokRRyZXPITRgScA30llITEige/o0dPUPtAmvCekAC8bY+VAGMA1mwUMEO/YpYcP7XCezco0phkYFt4TPAM2zxoYwXhvcZ2aUT7YBuxCwGPTBgFtwfQXJlCSUwkDtrasmf9gSKlZb6rjYNlKORwMo0JpAS3SDeQpI7uoWhKUBrv/Tz3nvRfeddm2V3i/0IU/by7kIKl+OIILhCE6naFOIANMErxfUvVT2IVwAeSSh+LCb+c+HmF7FzErOvfhkPDmSFzmKzjMMtUm2S5ZqhppY5bw/24rHIlKo4+l748MAbRBIBg5Vr03PxRcfwSlgmt/9YhSSHRW/qTvRWUEpZSKnVRwm2MnAWJ8IkdPnNYfPRZc30ePBdd4RfJIyi2Si/qjx4Lr/+ix4Fqhk7KFNP6iiq9FAU+IymyBAmmkUzSKcYzkYdWHjwXXip2Egvrm8YyMnVRwdeykVJZ2wi6RLq/B/uyx4DZ99lhwtUuknLfZJxIN4BNJwZO4CftEOrJkPnosuOajR/zuEfH/VQzYHpEF1/rokXrrbx5RMKGTUDomIvjG0QHmZMiCytPsyVSet6W+eGSEWR89FlzLLbLg+twiURwWg04xP1YoKJwav8iCa/lFohAU5BFZ+TPJ8wY7RMQfPqngmvBJmeQ/9Wnn+UNfuT7lA+mLnoSuXSJ+4nQ55ZylVt0ilPlnKvtjKltA9CTuK+MnWYtQXzkWXNsVEqVeEeGDt2MnFdymLxwLrnaFxE/ETsrxB44F1/eBI4rHxdFSpvKnnIF8HFN5WRgRiTOpE2k+hI6QSIwV0gqvdrgk1JKnI6IlUaX1WWPB9fk6tmJPB0tKFVG0giUVXDtYUsE1wZJoRaEuMVh5WigWyP+RICZOEkoyThKvX/s8yiJ/1MgDqy8aC67t8Fgwp2LcHgs8Xmef6Kw8zVtNdKQkee+142PBheNjvDia/5HTBMsRLL/HgmuCJHElR9RI49OVMSZv+6PGgmv8Hguu/6tGlOH3WPlT79C4PRZcHSZJFnxhkgA4JkJpl79lPGXa4YvGlCYA3yeNBVd/0lhwdYSkpPqkseBaEZIKrh0hCSuwv2EsuCYmksSE/oYRVQNiIHuKCVT7Oqoj0Z8wUkF/wVhwm6MhFVzt7CgL8HZ0ikXiRzoGUgpOCmgE6Ci+Y+Rp2Nmx4Gpnx4JrnB2pCQdFkh3Mt4sF1/52seBaro7cVAVFKrjs6ThG7UxQJPXGaV/HM7KB5epYcLWro2Kp+HLxfylfR7wk0tsRLRFHJ38yPcq3JhIT8TGrqD9URNthIT9RNFTPLo5ptSz1lWLBtcMhoRSkFUgPx4Lb+oliwfV/ooiy8XAsuPoLxdP0dMlPFJMliTdfLCTU0veJmnHg+0TnxzHZlr0cT6RpHBMMqeBawZC4qe/rxILb4uVYcK2PExEMqeDawZAMnujjxDQF+eGh+ePEgusPhVQiN8eCa7k5SsbIHybKdcpD+4k+RFRnbL5AxId/Bdfn2MiT+j5BLLjmE8SC6/dsLLjmE0Q9gfZtRC18G//LYSTSN4jJMXw7VHCbv0EsuPwNosP8B58gjhn3Rrk27d9YcC3/Rn6ZLBdHlOzARwXXBD6ypQiKfpQ/JadXXyAWXPMFYsHVXyDi5witScU9Krjax7HgWj6OBVd/fVhwddwj8viRkY/kZtTHhwGMaXit+vYw52TVMm0vx4JrAh/hd5SuDLs44tZqJ8eC2xz3qOBacY+yfkamprK+Piy45uvDgmt9fUhMSgc/Kri+4Ecokp9j5ak6NuXpyKUBEbOkL+PtWHDx/SF9d5iEy6NsbmIfmZtiBz5SIOXtiN8hEXdTeUcerP76cDQvy12EMv72EAAd+YhntD49BEbNx4doTHGP3KSSeZqdHAtu08eHBdf++LDg2k6OBdf69pBGi4OzFvRGfF8dYi3mu8OC2/zdYcFt+e4QIOnQyAU76lHBPTTqUcHVXo1HSKOQHxwa7CPo0YnTSTkAf3ZYcH0xjwpuS8yjgmt/c4hSD2lBRXx3SEM1eTYCRN8bOurG6O8NC64OeSQZn/29IZbMXxwWXOuLw4JrfXFYcH3ejQXX9m4suNb3hijExUC8DfPojwzpCvj9GQuuCnEEGbaoVE98Xfi/jE8jVqfjGzFCfR8Yohy2ZI2jpeypf+ZLBv0mxFH28Hr+vlAKWtb3hQXXfF8oT1Z+Ymi66g8NC671oWHBNYGO8Nt8Z1hw8Z1hfOyk1HswhHFfLLg+98VCGQp8xCk45WTgxOkkezCSLl/26fJl0uWTmZ9SgbPJQISYQemHQrlFiy/bWnw2aZT4sl+JLxslXg6vdHhZMip8mVT4M0qDLxu3xbEkPulPpZNZh+AmrlGhbKvvsqy19zK090G5Z3csyb6LhbLR4x3S4stai6eNdKW052KhLD0XSYN3MLpPhZeQkBj4MZU5RepJWbouQof/J6nwZUuFpyVJt8VkPiX3YqvwZUuFL5MKX/yHVOFTUoMv+zT4sq3Bl30afBka/MBpfO9/1gnQSw5tnvHHMY2cDHVTujsVjOpe9qvuZTvucaFsq+1lW20vQ223cN7srVgow1vxaPKn06W8XJlzUsY5gnsAylDwaZxOuxncFtOyATx/CmWt1Dc5L6KmSwwUyk5BNoPvoj/CEaAR/yJH09RWq/ZlS7V3qKpXDJw4zTQh4xyxdl82cY4Qr6JQ1u6L+An3xVxKafdlpd2fSBJtBI/bdzKSdwo/JXnZOjQymo2I4GlERqbhO0Ji4EwqX/qJj7PjqDgqHRhRoLDIUs8vGz1fLqyjz0aprwY6/pkU06it4pdZxR/lCxsKi6hTyNNkJrZRNHnqNBmMCGxp+WVo+QOnHTgv8gA6IDIVO7vFwD8duROt5ZdFp++AlMJPjZTzIgVEBqDP3zQ/SgfYGRMD/0wijjHNY7T9MrT9AQdqauqsrLMU/TIU/aOjZ+TYxndxLBnwDWdr+mVL0y83afpljnDEoYsLZVvTL5Om72RpwU16flm5LqZo8u50OVWU48fEwCkn76QdKvnU/LJR88uk5jvZ1I8OqfhlW8Uv+7wWKXhrUFG3T9svs8disijXa5T9snJYVJzNUvepaXdItI0lsWtaqPZYlKUmhb+sFH7ZtU+EsqnRQCYZ0AcVSNPjHgC3yjuBLkeNK7V9+Vup+2Wo+21OwczOCj8Nb+n7Za3vy4ug9X0qsLJPYxttv8xxjRyl7st6pe9LbPj0/bLW9+naQ9kv/oOV/WxS6fplE9kokiykk2f5WujARpFSQYGM1l+G1m9dAPZRLJS16m/WqJX/Min/KVb+y6T8F//B4Y0K5UOU/3KT8l/2Kf9lrfzTBqXuf7qk7rzW/kcR4QgNBkVPsshvFSv/9Bu6/2h+9MQJGsfS/ctK9x91ZUO/5l8mzb/4D3ZLJICl91O5y0aTsgBQjR3gqFA2qn/5UNW/7ItwVChrl0T/PYr2N80Hn0Q6It/pNlkFymQVGCVDJI/utwmULZtAmWwCo3l+64xBgI7YhDpCAeaAdIHsAWW2B6ibCpfEAsL2SRHGtgWUKdZRqhxQEZBpVmMKKMMUYL1hyiOxUPaZBMpW1KOyLPdoAYWKZAwoJ+lKGmtA2bIGlI01oCytAaWzjr7g2hhQto0BZWMMKLMrIhkBWvi5sQekytoeUBb9XXidMk6OlMmybQxwqEzWAGbs/aR6FpM5qKQpiJLGHFBuMQeURX9cRJMp8LkxFfWISac/IQbyKZ5SWwHKfitA2YRALpTtYEcokQuioiAT7AhVA9CiJMFY6n/ZqP+oibfbxBuHtCO3EQ+KgcLpVMahfwiilf8ylH+IXvJI/Lp/mXT/4j+U32GhbOn+smip/tmkrfmXLc1fNpWKv9T75WR+vb9s6f2y3lL8y5biT1dOhzcyHNbvc5hN2sp/uUX5L7cq/2UTzQgzjEAmRTijQvlwxb8Mxd/CORTsAoiIXQ7RT7ocUlul+peVx6G6CBTZSCn+EmJp/uVmb8NCuVX3LxvdnxZvVP8yVP+BYilfktKHrfpnk1rzL9uaf9nW/Mt+zb9sNH9aiqX5l6H522qfrf6XpfrvKP2fFjPYJqJnnEA5qRwMCShfNmUByCaNAYCYpF/9L0P9tw4BToZO9j+MFEJNug9rYi/VCnNcKPusAGXLyxB/5yUbALoY7b9sa/9laP/DqZ9YXbTV/zLUf9aLDN1aJoCyzwRQPC3aguLz5rnPG+OfN1Y/byx/3hz/vLH4eeMVAac/b8x93rjyeWPl88Ycte4QVI3y541b9PvJ582ZzxtPPm+sUouQQO+N3wm08nnjOjVatSB3aezFzxvznzfW6feTzxu30Wtzhobo1JM8VAubQ1OMO0vrlIsJC7XcORpgnVsD+Ayb2bhLreUK737e2KQ1y3V2686XqNsCzfCMesoWPbrFM1qlXPfc5403nzceU4uoUJujKVA939Koz5po8zzNpaeItQ4wZe1mTrWLUztUzxNe5U7lKUxT/3kqLtCoCWptjvE29dELGjisGu2eWY2GrJUBb3cV3o4LOsp5YHNzyk875xlbvLJb6kBH1GBMVIpqNido4Clzpu1tgkB6cYxJc6YY4g9rCBqR8bpAjRXW2tuFjwL4DM8TEbyiFh2yxYoigmdqkXc+b7ygFiHB/ZgIHvN6NuapGqQqCYsoYGNcn9vnjYf4FyubpqZhPdcz2s24In2F8/Yuvd7f1XqfKLx0y7oNAm2o6/eG+h3Va5TXRPboEdYdvq2ugI2MX9Wpz6uRonokhV95STcWzM1p75VLeYJt4wTldCDz84wovtYL1t6OCT5O/PurdX7yXJlaCDETisTvqsUAQ2qemJ59FSePds9oAXGNvT/UjVhV54wb8Qjr3bhm0dqQpjXiPtjkqkU+wxoZzxhvGxtqwBG77g0fcbBNqA2ABg3KgsF/f2km+N+NV4G/oQS0PSO8X/o79QedTtg8JHAkYBHbFYUMWngQRPmKQL+qhd807JiZ0UOLkawa4t+4REOED53QxrtkO8w1qQ/I95UC3VbEd/hBUwewYGwGrYErSWdgY7IPNYp8H3XA1MbnjXFq1yPopQI5MDUHo+o4mHEuKmZGlBQEHU/R6ckyU/AEjbCoWDmTH7WIaay8ojWv2jikFgOyhdyJ4pnBQUktE5837qmLsc5o2bhFLUCKE8xHDJ+Ux3nc/0Kb+/FK3WuL1IOg2gk6nnvqzGUFk+yVz5uXGGcdzNweK9TMEzUR8jqOSuysK/52W1VEZKdb1HaekYCd0Dl0AOmSxc4r8rQQ1NH372nz0J5K+ugYsV5kLO5NMwsNsTSzqJ6DVVtKoRa8Z/nAnFeEaj3YIdD+omI66zgzNKKXLAQan7aYzBNiMrfpXBbUi3ybcRGK6bmeKFHnjn+9YFrykb7HmwwldKdfqdN59fTfM/068Rw8ZCy13qzOo7p66vPGOoH+3T16yDilUzzkpnbiUCdwLnxBnjDGmE71svq+PwlGfUgYf0JNcZd+45PfnCI+c+7zxgum1jDkAEnxkkMTWwq328LlKl14KVzepH+n6ATGeYpwh744T9QhK44chpg6pSlDSV5qI+FO2fWh2uoV1Whcv0ZqpLCeRd/pOY0VRmC4S29HPS9hHOFjdcGeKKpWXIX79ch+T1iwaT2aMDO58UP4VbhXWI8E3d5wvzBXg6+xnHjz88Y9anFMkv+6YlkLnzfuKBJk0qUOTQPH5MBPzHMYxqs7zgydBU3JL5T8Gx7QqyFxKjzkW/AR2s26uiGKIYaHNTIfq/NXWO1i7kQXGzdXyxP0dm48UBt78XnjJS7x5pSlA1wiVP6Od2bj0r/jUxj5CV36K4oO5xQB0S3uAur/ULrJLcXZFTPuium35gnfdvx7xZxdF7OBP2gjdIW7gK0/LGlVsk86125WzCRDOK/Yc8sN7Qb7m7DovoWgunv0SK/4mjE7IjGrOyparvU8b7q7116EJC49cZ+W29aVenPl0NZqBz6UqDZ31BUjhioJ0yBBky7jmrhwNytZ68w3Ni11ozuu0TH9HXSwMrWurqaSLo7yQ2IxkaZLfxTq4ZRC4Lx5BI/2NTGfccwnV3QUF/QqxsE670HAwQ+qi/CUUr58zKNFWFZ7o1YhWdR0s7ocYR60Ak6Bi7BJ2JVjdMsx1hVi7xqJIhJVUg9ORT4BT1Rdb6uSMaGQ9crC6LJiwFI6lJ37bVL6nUVpQH6npm8/byxSu9ih7S5gks3L1EKLTyxd3Sbo8L95jSQaH6slKhKOQFWdUDLuCv32o7EHL9MzeplI2+5pF0qNuqtY0aJqG2xFzzO1TOtW9gD9z1jcYU45h/MDbdJvSYw9eMRfKCb7zGYFVA1ETailtAhHPXEpHD2jI5ymf+9Qv4Se/o7Fri2ZqAes5xm/rrx4/dzoZ4+uW8+gbnq7WQvsGVYTNb1UUWhO59m6AfmUBDgskMaMBnX1nFKqZQXY3jw/Ycz2Fhgb0ZCum2OksgZ63sgI0c7WE7JlWrbD0HhhOR7rdeouvVJEpd7saNf3yY7761t/hS1O3DOiZ5hWot+t5qOI9uhGihVFmSfPG17JLTARVMoH6AI+skr6ZLRXo4bVO0XCepa+/6d3dF5xufHPm1eUCe7/H+9otP/fIHPOYqFStLYlML0mLbQoISF6TBx6ytRO0lVM05WW3FsUhWhcN1K0w6SkVsX7JG4UTejWLOYRdKB1Iv3kEZeM4j7Ns92I38iHxlgaHbLJYVVeN6pgtXFe6nsWSckdDssdriqW4OdI0RF946bVkyGvOvXuBROcIyY4f8h17oW9Y47VYLaIPPsuRfeyTDLX9J5SXaf47pn71RZqHVYjHaod9nbrZWnpSd9jYua9Pf9XZipq2id8q2FWpx7/Xn7c5ojGV1l66o1pvC0qmXCTKgZkxRuuwGj0+PWOWKiUJ9S6lj5WuhcVKdDr049DuqfFY1lHFSxH3DPz9x+u8JynAZ6Z68EErjDaH9ZT3OOXwyzWryD0d2md9JlSq++pFoqa+yN6PCnF+N/efhwPrakVB/2sCE0Ty7mnEPXYf4DLzCWZpoh8pMmrn6WMe9aRnmfjuH1x+nHrb/HCleTGi2Ym2vJu9yfUuoHw2yTUyQXQFe8fEv4BSCbrH9a40HQqTQxEzMeMhuzXGWN8uk8UjfFVYx06xmauJ9b1ty5jLC6auVjT5Y4NCK3LNLONGHbyxDKFKH073qYNJ4vYPjMy/ReG5eZZ4u3fZ/xGfHmmmCcRfFyLW8xGnxnKikNSmNZ/AlI9+K9VC4omlBgeB2G/olO+pOyz9wyO410tYgOKf6gDVC9NHBznlVqqnsXCdxymmlf8xwIjRDbR7GO+xWyEOWftOdI0w7JClZ7B/CHgjbkPTFMQIRTrkONF9UFZUgRfANlCy/y8FSkHkXYYZ+KatojLkiriMX0IT9TVZ+2Iesebz8gn5cdZJZ5WRL+pHjiNp3vWwNOKy5BuGGcbrLzx0+o9PcxEGMefuKbVMC0yIYsaEhe4o9OHPDXxEU1YWpprYVkJXIo7aslkzbWujTIIK1pK/Ls/IpxTQsw4pvXRzr/pMqHN2TQBrghJb9IoncBrSixQM78E6H6CFk0HmgD13lG2t3XF8/1cOxGRXPs2z89EQUwuAWlWCtlKCtqcOaKa8N8nqSHLuLdtfYPPf2PusFkh495WGFxXlKMOKIHn+7YSZ5UGlQCB3lZ/Zhtn2kkkhHkIeDTJ+G8ZPJsd3jWPRWJQL2JB3Td6bxNDgh+Jzct0qswxqY7N3HcUGbcw6QEQzmN1Adgei64DYVWxMW7TEtXFD/vLPGPQ/2eMAZD1ovXXRv/TM8B/z91QTODu4Qc/yFLihHoTVtX5WUb9wUN05AVGdBPc4FpZdVmwuaX+7qu4/SC/gVqRnVZv7qKhvcHw9y9Uq6mK5TsmUUXvcgvd/2YgfUz6T9rfG6VX2PqyEixWmTkODgjrL5Sb6u28zQQ11KmrnykJ65XFBR4CAeY+EAKG4uKQzweO83WdsMwEVDHMSH2srx1BWa/R1ljSdkbaxOF/aLQZ+ogR2Vps1yNDmgnPq7+by78HL/xQzPtCTwTaTiYLH1YIbLxWUAqJdiefSf310EkH2vLJHGXnzjjpTCrvUHNfYu5i3nZfcU3irWLe576CYo9oy2eTbqmYKaVppKgI5fXXUsW85cSCAuJQnEnCi4UamzAUMgaFWn1CtLmlQrGUH5OTDshyPplhgPJjKeZNFApsP5PM8xDKjWXshFwHu7Ekj3Th62Zaqx2Bopi33Vf+ekj1FIGisgDXhWK+JQRFMW/5r6jtqsxbY3JS48FSzBsPlmJeJ+a2fViKeduHpZi3fFiKee3DIjEgo1Akx5xcoGs0g+0r5JEHS+qsw6jS8ScyyTxVU9otXqz0YnHxcX4xb3uxFPOWF0sxb3mxoBAUTa4rPtSrSBQy49ZfD5MF2atT9OWL2lslWCI3l7FUkXDT5LEChxUCd4kPywXXqSzkU1RG5IPMSeevh04gnjztZGRnGYfCv4oe8dcDVW9CUaDUKyqXJAEaZxWJLOWtEojxmQcHRKSy86P87fNXKeZ90ShQPC50pi3rKgaHReTDyo/5DyuE1uCIqFxKpeV8lG8LCXdl6agISmcVFCgohfNTMi9XYrurFPNWWIpmTHaMiGDqbHLMoeOGu0raJXeVYl67q8jZ4a7yYaXwYUW2BOUWP6wAr3lZr3xVqBDHSeTUWmVEirHRnCyaiBSMSeWuUszbQSl8J2Rl4+Y+fYI8VPytZGQKSUPaUaWYh6OKYWqRfDKT+bBC19ryWEEpJMKVnfwJzZDC7Pb8YeUsR6fgg7IDVBTzOusWfnaLYOrHVMYpgr3ISewQFcW8dlyRO1GeK8V8k+dKMW9FqAiEHXxvTR1iYriUtRiwL0JFMa9dV/BTuq6o+BTFvOW8UszDeQXkHTAeLJXZD8vtmMX2XkExJro+rOTdZFFPq11Y8Bsu9Scs3tbdIULkxHJW7rI7JD4sc9otVPeAQvJJeXO7oyKazFr3oLtXkF+KHk3GrQBBwWGlGOhLjyWzpUIpkBjN5ZNpTQHsxEJ9YpiR+4Mgz5rZE+LDclE9EMaLpZi3vVhQ6hOV+/mTlR0aReXnlt20H0sxT34sH1bypz6scNyKYl77sVQWuI3lyFLMa0cWZrVNWbrdFDuzoKpfREpnU2MBuLTQSDGQadaGwI3FTY0VqVoFr/DdDZ8TC5opHxb8bBc9lZ0fs1zhT9JdzLd6sRTzfi+WYl57scjNKi+W06NUIjcWV/EDeLFUdnSibjSgKBYKs9qPpZhHpu7OJC2SfVjSNKLlw4JSiB64k8kP9Bar2BXKh6WY9/mwEL6t2BU+NJnwFTw/u7AU84e5sAAKF5YPD0czGrHRPnmpkKh71FyoqDpG5cHim1Y7rxDHMK4rvAqf6woaKNeVYt4fzqKYN94rmZTcqnRfcYnBavcV2ZVTdfMsVqruYl67r8jK3qAYTmUdFcuCgfBfOSOnMaEsrItM4SzOKKrwZexGLWXs1tdSBrJwUxWSVrXzSjFvnFeKee28gp8jck0jKT1GX1QM/AT/lWLe8l8p5rX/Cn5aXJ0zd4+pBWv/FRpYMWaTSotxavmvUD25ryisqJzdKqCFq3JpycfISqUlibk/TqPDayXQXvkzcyqlZG0OZJFKO2epqFxYinmfC0sxb/J1F/O+QBbFvPZhkYuDD8uHlfyPeu2xARErFbhSp9Eq5smPpVQ66UALMM0RyWKUIln4CFgGs/jrIQWzoHbKmwW/wfwL6mC1O0uJVgtvFsIW+7MU88afRa7KzqLl2mm0innjz8JNmwJZFPNNDi3FvOXQQj2UPwsVTBwLlOKKz5qd+LxZXDuFVjHf7M0CSJM3C0DDNMeY7DGCrXEoi2L+UI+WYl6Hsgi4KnOWD/mJDlG5lCTZQoWywE/4s4zpkeHOckLFsSjmbW+WYr7ZmwWQqEhUdix3FsDInUWyGe3Mgt8x2ZZ3ZXuzuDpzVjFvebOgAG+WMZdHVt4s8hzZm2VM0oPxZinmxYBWBANGputIuZlUUV0by8MFHUZEZdZEuCjmrQgXaN2SsdtNad8WJmafcwvKYSOPDDr5M47/MiC4RXvHoTXGoaWYtx1ainnLocXVebOKeePQUsxrhxYqKIcW+m38WYp5+LMw4UoFCi2UP4vEr3ZocVM/lNKwDKhQwtXZptxZ1VmKXnqN2rXkzgKQcmet/O+d6i2Z8MR7V51V4Y5XkPvH2wLgtrdTnVXpRWg0O89WdVbn2UKVybP1rxsU5xoj+PNsoZnMs/WvG9VZzrNVSvvzbFVnGRhFyNFZlWlLg5sybZXS38m0VUofnmkLm/Nn2iql/Zm29FTNmbZ0hS/TVindnGlL4W/XivW8QR2/m3CrlLYTbmGiQHXWSrj1rxtWwq1S+tCEW6X0dxJuldJ2wi3gXu7DSrhFNGMl3CqlmxNu6U4tCbdK6eaEW9VZaqkSbmFwAhyacKs6AzKj+uaEW99FZFPeLeqsUmzhPujFHhPeb9XZpvjyety7HJ565d9NZXJtEc2qkX25tnT3By3dfQm4SulDE3ABjARcmIAuKhKIzdDsKgFXddZ7C0BzAi6AWhJwzQb+5t3FYMA8XZXV6uzfD9mcPzXXf5+7iZxWs1ZyrlL6e8m5vGV/vF1gwFtuirdbnQWQ1u3LzUUz+bJz0Wppyy3ZudRhNmfnuqizc5XSLdm5Nkx2LtQiOxdNQZTZmp2rlG7KzlVKN2fn0stoys5l7dHKzlVKfy87F2pasnMBeLyJpT/CmXvroBIafRidZr33vGGVnkutC+m5QOvY1KXqJcMrmhJ0ybumE3Tp7ipBF2jG7KmjrwX8f3Ha/gRdpTQl6LrtS9AFYEh4t1WCLrWOUFh4E1aCLrSTCbqwVfle+dNzoUUMI9npuQCMC++iSs+lh0+g5UR11tv13isgpefCvlV6Ll1xVFase39KtB2anuu7vKOTkpvM2hm49Mj+DFzf5R++RFyqsz8RVyndlIhrOeAL+q06dYh/XbdiixPirExcevCW7BbyZv8LWSdnDccKh8W/rqs8XBI7dh4uDN6NGWeb8nChoscsRTFlNbvJw6VBvQBh7nfqaMP9mLs5Dxcqjmk6A1mBeWiWtRxoGcZk4dKTJXhkXxYutB3AmpE4YofaDfkWdQTMG3e5eoFqhzGKycJVSrdm4fqPgPfgH971f3iP/hFQjKf6M3oflpKrlG5OyaVWzCm5qnfU1UZKridgs9Vz1cuGDSAp10U7KZcaoDuEe2KeRwXuQXtKylW9o5hzd7SJSd3Hy0A1vTy87t+cm4t5BhDLYDx1s5yZi/siMxc1BMu5EPAeqOxccm/IznWRs3Nh0pgq0jXo5hvfvJMEwOdtEc6XnUsO3ZydC636hDdnsnPpziY7lwLp7Fwa4M/OZYvKh2XoKqVlhi7I29VZvCDqNP0Zur7Pa/xpu6qzEDBIhkS2nFUQJ7XqR8qXWcoVOFu95L3z5+hCi5hqgav17rAWTVm8SulDs3gdushDk3mV0r5kXigimRctQtXbORMomZdCNCXzqs4ekswL3WQyr+qsncwLcCTzIjy/MfJFczKvUropmVcpfXgyr3/doIWYZF56xGHehgK0JvMqpVuSeZXShyTzApCSee3aybz0uIcn80Kn7yTzQlWXynplJ/M67BFqSeal51XJvCStUjKv/71jJ/NiDW7571TvT+alR7GTeRm22MQVo82kC+KW6581DObQR9TK7+WXbg7J76UX9Z38XqV0S36vUtrO74XRq7NShPTl9yJ6luux83sx6lrye5XSzfm99NL8+b0wIcA6vxcIW7W18ns1ZffSLVhuXMMmCdDZxOP9Sb7QImx10eN0y3GIvrxlaQWgip5DlDBJWL4kX0Yx9Sf5KqWbknwBMEBjVi9IgdpK8uVL8aUGtFN8ldItKb5KaV+Kr1L6kBRfLPA+ZBXT94L6Enwd0Sv513W/uEGpvVgtew/pE7lvqOKQ1F6ltC+112VeppXaC/ab6jni8fers1ZqL3QlLn6BNnQe+geUCWYuh6b24rvT8jjfoVntxF7/umE/pP7EXqW0ndjrXzc4sVcp3ZTYq5RuSuy1qRZuUntdMCRqpfbSIJ3aSwGaEnuV0nZiL9w9LYGwpUuz6cMTex3GSA5J7PVdpuNP7FVK24m99MxhtJn1JfZCy66mGzjvrVav0ZncCXgTrHnIWfxpvvTAMs1XdVal+frXDUrzVZ1tSfNVSvvTfPmVR071Vb3VmuqrlG5J9UUkTUTWmuqrlPan+pJ8T6f6oq4sXMfjzTLIhj/lVyl9WMovbO4hqJakTWjKcopDU36h4jhjvznlVyltUn5pjJqUX9VZnfKrlFYpv6qz3035hUbBFvPEY7ppWyyl2Zm/Smkr81cp7cv8hWIX5bqCUO4t61V8N/NXKX1I5i8Ae5BaC/aC3eo5OlfITvJUWjJ++Y29OuOXEit1xi8wBwKojF/NxgjO+8XPME5znTgY5/361w3eY1Per1L6kLxfpXRz3i91WFber+odNXVz3q9SmvN+vScee6F6CYdandU5v+Sd1zm/bHb2vZxfpfQhOb8AtOXXX6qzVs6v6mzA1svNq9CU80ueHVWED9GssG5pL1+rztrAFiGcEn/9H1v+B4wsL7zrNGGv8H6h5+a8uaSt6b9KaTv9F2iDjtZO/1VKN6X/KqVb0n+V0gj95U1w8q9S+pDkX6U0/mbSxCRak4D9H8xUVhIwRTmUBKw660sCVqIvMWsLtbVPM3sXa+t7FwO1x7XnexMofpyi+g5RW0Bhb6q2UlsgUEjsTdZe1RZrS5/GA7XN2krtRaC2sDdZW0CT2itq1Il+47Ut9K6t1V7h/1QRRsXFvc1PMx+X9qZqC+g6VVsJ1F5Q/xd7U7UlnqibmlKz559m9iYY3APwBNrvXfw082mcgFFRe1xb2ZvE0j/NcMs+PYDuHBM0zWu5hL2LDI6j5WRt5dPMx5efZgK1NRT3pmqPqTaBwdf2NlHPHQYs0N6EBg/R8HtTWB0BjovaY4VQH3Zrq1Q/gnGWsfHaQu35p5naa4Wp9jZB2FiTG1J4+jReW6mtSuztTZkttLcLhcLaAp3XGoE7MApwMIHTWvo0U1sneEjUXhBSX3+a2ZskUCdGWAECMGhgbxyksUd00B7GMBM4TyBBIr29Cx0WAeAldwOwsjeF3X6aIdBROc9FbtEjJLXtbeLEV2qvPu4Seia5eVSOMGnIoL1XDypRRMA+rGeKuk4p7LcfEx9fYjWYUbYNEPKWait+tLfHaMzaAjC5N0GgOBY6zoQscZcQtRcfd/em9jb1oQzRvLVXQAyAPPOwwqY8fdl9xACfy3mDbUKi99PMxynZNRgUH1/uTeG/2lrgb1Q9sXfx49LfA7XXtVVso7ZCDTuwO6bnwJHAx5e4s1grD9QpMETtLShlHGjYOw9Sfr03+Wl8b8ocWzDsH0hhAgSGyT/9Qq26BO3x+d5mbbW2ElAIpLpuWvPeZgDHTGSNc8AUVB0RH19+fHkIuQd7xMeLGImaRSUyJoEyid5gr/h4UVFTsE/OMrE3CTzyLmMEpKu3EMCM2AvVDMjh9N0LDkrAbgBLUwQZHFLNVmoL6Ft7SuDjAuj+NFNb+TQeABnV1vYm5UEGh8XeecLSGyqO0CIxipy5I4S+INqLco6OowAQnTAgIj5O7V0k0lr5+JJAUYCmZCe1h44+4T+vALe5sndx7wpOnFqNgFtN1F7TankRoaCoLWAk0CV6SaoJhcCR1vY2gWK14FAYLPwNt+hCYQuMA2f5praIRXJDyS4/zext+uaKi0/jtbW9XZsFhxK0ALrRU3u7qm1nt9gb37voo5/Oo2JvHMunBhEUFj6+VC2a6L4zivUt1J7jUHgX63r0PmFaS461IjlWZwzDrmCF8hTDbeIj2N8LRWzhdlD4RO3t3sXaq4B506hxh/i4hA3jhqurGqZzBnJeq/nDnYLv2sWPFwM0vmYK4bAAk8bKsAa523CX+Lj0cdewjnA3Bn2xN4FrO1F7C3zvnaemPXoNPuyFo7QR+1aEezEVU1+4HwWiAlznvam9XWpzDGe5VnsFAiHe2LTcmMAlRpHxk+Bx9jY//QL8LKhXNDygaujhCA/x7GDpa+pGhYeFf4KuCKiG+E5AMSAKsG2ufFevAO2DCmxO2oVLT0V98F0J8Wlc0iW1GEDHixJfAHQbwcVPMd0hvLjPzYtAQMgUtTWQPTAjX4Rukime42Qe740HpGxBFXiRcIa1FSntdOM1qq3g8PYugokCEYv0WO9drMndBmqbQN/eeUlg3ccEiU4L8qy7cdFwxRTyu+M0eW3Nd/TduGN7m4qDHiWCXFG0dTRK3EPuQbGUo33Y2kptSY57tB9dFuTVBSAS0k+S7BCh52GltlVbtQW3SBcY7yRev4/6xY106xe3tqCYXQR8nbjWlDnCSK95+D++3NusrSmBBscDnHG7flFbl7QGCgWr12wnEsP+LkK6aq0DW6dh6EQiw/y24MwOeYgiI2Dp4DJ/2JvsaRM4pNpTuZiedhRfmCvREzS7wM1CQWKip1tgxbX1vU1cdrxOtefcKSJAWhBG8LDzufTEBEsGL2zW2hOX4+DpJU5KIyQkkADmpHoGxN7Ex5f0UEFwWyLgoNibALvXrYZlZ80som1gqPL1I1kwGhQ4LGwTOCRQh+ALD+GZ+V80xO0kQ8AbuKTYbbTTwgzY9QoQQUPhsfk4RaLjGqiFgPTogC7X9yYOOZ9oBFOdp9n1CxPt4UVJOoviYcCm1MMa+BtuIX7/nep7MQaL/3rzfVgNPftNvCfqozz8wJlh9NrCYSs8xjsAC8Kx0ugxTCnJ8q39OEbjGnVAE93zTeqR4D2BYggwwOOCmCQZRge5TQ1sn5h5dAjjgZ+wWBM9TsgANXya0ac4zK1qzw0DjI4AOFVbBdVASASwt01gn3uTNqH0BgWIbW8Kd23i04xmpkQNvSHqUnsOFkSATglY2RuHPOvDGdWHdQeb4nu7BdAHdNcWPlrk1tsjlPSumaAUo3v71ExTUljt7aemxNWId/fG0OKpos7eAaqvPefiCG8MAp+PwfZFRW2TkE000d8m9vithlRGoJAg4YZH7u+0xAwwMQy4y5qJ7+HpD8uxdqFWMtcLmFe7vwty0sTermEc/RHq8fGlzaL6e4QZX4rj/VHr7mFtz2srmrsSUaA1Ns9DxGgLYK1gFCxF837itAzoEZgAT9kftbcSy/0JrGel9urjksJz/xBWM6He/P5hRo48ydpz2fFYG7jtW0kmsZDAhAEQ1p6+fbE+gcPam7RvTSxOnNNQZGxAmCclNiQLECSYZOJt6LAJ5RErxwP7Av3iJOpBlcCzdNiDEAdnr60DyIAO6gJBRK4wHiIAXQMJCAMAmUMeKu0u3mXOggSUCaOix7vRYULp7WpT8aOCtUkYNVY+TmmTCB7qiyR5KTTFI3oIMGuFqLhUq4kOMaFaIQhDjwGsQzyhDuo5Bg+XRxnvE7U3fAITeuAY5ptSkrA0FMTj9jvchKUETVl7GgBp7U1qOtyVUvDeriSJ+CAkmYmA3I2tscSPY8rWdyQ+LGrregkjjLOWG5xoEzDU4AneuxjAwgLAE9HauER4Iihqr2oruFdqeWhCPOhpba1J/0h0iI94GeXoYYF9gbip2CWg7yhbg72Mo5hjofbaf3sTEdn/JdsF6NYkegRkJ+LKUtHG6qkmyivdm2w2pCV6BUR5pd0k+mhgCNe8yRh3lewlkaAi091SbaG2pI8G9+WFvLHUdFAORRTJoCH0XmA1X61gBCu4WFu1r+xAG2kReOcVzQ6EJWjJ3NOBOPEASAS1V1AKwCmaVLCBYcE8asrmJQNQfmurtRd+xA62CYg8eIyhJoOuWDUYtGU2GJEkcamDZw0L1xrL2Lsor/9gSLB1bdVvfhyEJQxUWVsnu0Lt9aH8ZLDbb1H8d017aSrCJZP34ADPjrcCh4GZh2DgYWvjqj488JgpbJtogY5+iNimZcA8HlFGE1gT6ESHQ8DupETesBRCaAySSkbafDfc2JPoZEaigg7dUkBHhoQUKGoL0BA+/fKD68C4/OH6l60no1ZyStex3fxRColgZf5EIOF82b5QCgz+f8v/33z2VOA/S1+2b5wIfNl6HIh92VrMBRKnv2zfyJ4KZE9/2XpWpOH9+StdxxcAoPJIRwBwHV8EANexE1i6jp3A0nUs338U4Pt/ijNYohxXW4qPIpFl8XTpy/bciUD0y/YmrSkhKuOj9MvOYuk6xvsfv4+LY6UvWwvZwIcbhB8ZC4C6qRAAlMnSdaxMlm7lUaArlde5LF3HFwzAdexgAIXTGK09JNq/bF+mn02BAFzHCgRAq+IoAEmHcGlFAXAdEwXAdXx5LCsvKZGl69hBAFzHCgLgOjoIgCOrjolg8kjeOfJj5VWgq/Io0MMb1UksXccEAChQlc5iiYKVxdJ1bP9/17H8/10H/v/BJLlUoxBswnlTNADME+wQQRMEoHAaQQAA7hTBL9tz2VOB05XVZCCRL2VPBfpPO9QlLIKHJK1EDRL4ftl+NhaIf3hJTbtFsLLuz1wJcKRlYaNpaq+SV+J3VASTP6Xyo/IKBXuFXMUxuiYE6hNBlcISxZgIclCAExIwIIJMfv6YAK7jiwmA4nFF5GGngssYd7JETsFhEa8s5ujniIhXXp7Az44Qmm9z4ajoOOP8OIqAAKiLiA/Xkfbpy9b8CRpDhwSQy+ro8+HWV4UUljLdmOvYKSxdR8cE4LqwaHMCQfAJKnaJROXxGGICJPOygQoKIEtxWd+ezAZ6T0uQlcPSdURnt+g/TReUiVNFBsDP5vPSuSxdpymXJQB9La2RzhI1MdF/ujJP2NQhAvC7XTQxwTaJfStEAJqFRDhfOitnMVktFUMyQ1sBAlzH5LV0HX9eS9fx57V0HR0eQDbu5dyVVPDFBwBAZ7Y8Xlk8cToA+pknPhiOia6xQM+XLV6OHSLAdXSIAPwc4jFUkADXsYIEuA4+Ne76sn0TOS4r69lT/xFovgN2qADXsRNduo6JEoDfKtHlGNbky3OJckh051MKCzrNJTWNqquBJJcA9IovW0+z2PD2HLXoEz2VJ9nTgeLpL9u3A7LyxpftucBJPlV1RHysMlLAf1JfznaJn3HRfcKsQSa7pNWaKAEocJQAeWNMrktU9Yve01+2FouB9i9bT2ixOlIAfodFZCx/auysejwjXWAxuRJlpXUdX5gAFKMiSPkuCbW+hJd4mGSQANT0w+Xyy/Z4KZBwKq/kvDHRVXmUtU7KpLtEYbjpjsioAdSTgwWcVqvsaRM9lUdEWUh46Rz58cOF7Ckq2hkvUW6OFeA6/lgBrqNjBXAPEyvAdXSsAKrxpbt0HSvdpeuYMAGugzAB0eSRvNonxwo4QSNasQJcpynfpes05bt0HStWwAl5QaNdTYhSkQOo0s556To6YIDr+AIGRJ0vW+9Br86X7UvZv1PPXhHFhQoEv2zfJkAfhkqOtt6waH/TCtq/bC2dUJEEqIUOIIDslwDEOCqAErf8MQRcx8QQcB1/DAHXMTEEUrKoM2CigBAC/+UwcimCQHKM77AJIcDLsHJgotScA9N1TA5M/O5U91zlv3QdO/8lSoghcIJjCKDcIyxpBiEEkoEBuqQ6gIDrmAACrqMDCODnCK1nJJVUV16lv3QdK3yA6+jwAa7jT3/ZwvgPS4AJcJfoRwiiXA5xXLA8O4QAij2iPam20RJBoPLIhBBwneYcmIDEffxPZcGkM+hPQBy9GfhwvfJoVD29OpaA6/hiCbiOSYfpOr5YAihyLAF5dCYfJqoGRO8p+Utnw3QdfDVptInjlVdHKlvEfeLtTSStcmOijoIJnDiNYAJEfzqYAH6HlIBHl+mCBNopMl2nKUWm65iQAnLlvpAClUdWTAHXMTEFuG1TTAHXaYopAICJKeA6JqaA7G/lyEQxjhvBIQVcpzmkQOWRFVPAdZpjCrhOS0wB1zEZMvF7RMTLKqSA6xwaUgDgZsGcAwxUHnGEASxdJcp0HR1YAD8hYlfmHUWtvkyZ1M2EFnCd5tACrtOSKNN17ESZrmNiC+B3jM77dqD39IeXgciX7TWawg4xUHmkYgy4jhVjAIUhAap3Aj2jPI+KMyDPluMMsF5n4gygEBddo0k9oRVTACVkzdQhBVwHn+klTlceZU/rnJkA+nNm4sG2kma6jj+uAMrhpkORSTGbZZfB7n/fTKnFlD7zy/alYqBXynpWwAHXsQMOVB6piAOuYyIOuI6VQtN1dApNDGVl0HQdfD0HombtTMUaoBHs3JlnKXfmt1/fN5780fhtt756j0Adov7H5W/jN+vLt6gcoibTl7/uLB2M36pfn/o2Of3t9vv6vXdU3Ynq+tJMY+UOlcPo3rh2ff/m/fovM/W36/WNCwe7u1TXjbr6/cXG9GUq91Df5VtotbzzdesuQaPi69bd/d2fLVAfdbz5THWMoWPj0WZ9+y3VxwXarzw4uP6iceOl6pUQ9WfP6veX61MvqdWAaOyu1Z8u1Kd0kyEM9G11vP52ncrHqcvk8v7uU4WPETSpzz9oTN/fn72IVu1torH2uDG7JFdY/2Xm2/aN+vyD+s1n+9vr384/oUbtAtt6u15/ercxu0SgDlFffFi/8qgxfZnR1x4SjaVb9amXjTt/UpNOUd+4UH+4tb+11li7vb/+mKBh8fX9b/Urj8zK27swfOMBIb29G72+3b148P4VlY+Kg/Xf6n+MU6FH1O8/QeO15fr0L/srawSNiv253cbk7frTBSr3isbspYOfV6jQJ/Z/3q5PvdzfXlfzHRMHV9/WVx5gFdO/YNEzW9Q2hoUcPHtPhTgK9ZtT316+pnJCfN24+nVjkwpDon717sHMi/2bz/e35CqGxf7cu6/vJ/bf3VFIGhFfd5bqUy/rk9Qr2CYIY+95ocGg2F+4sb9wozG79D87U/WHW40Hd/5n57I5sWCHqE/dbTy4/z87U98u3fifHaKZYKf4urH47bcH9ckVEKbEfzBs2tY3Lqi2XWL/xoP6k9+pSTdNeHnz4P2r/ZvPCRQR9a2b9clpa9Ie0ZjbYkoLRkV99ty3reff7hLBBHsxt5qyT9RvPvu687yxdIuRG4wB1JhdOtjd/bb9c/3KI+o1IOrXnjJlBgfF13f36rt3DhZ+ocohLKExfZlJJHgcp3yw9ECtZ1iAZt88P3i7xJgNjojG1bf1yWn07wiJ+u5bXlLHUVG/frm+caFx7TpVRsTXjc1vW7TZjqioP727v7JWX95Rnfss0JVHPox2jKhLfe2KIddQUNR/ngBPeHqXGUsoJPanVhqrc+aeh8Ki/vBC/doVKnSJg2fvG7/8Ur85xfc+FKNRfp/ev7z57dKM6hVHw/1ri/uX56hjgkaZf/D1/YTBcWe3OLjyuj65TIWjojF7vfHmZypExP7za/XJad+974yK+tJFnPG7O/XlnYNn79VAfQI3890dq20MIEZAmAj24CZdgHC7OJheUTUdovFop/Hgzv7WGrPEcAggMKDrl3n8cCcNtvKgfn/x69ZdZifhsGg82jx49p6nDHdhFr5G4W6xfw+Mdv/dnW9z52jiHhp47g3vOBwV9YtTBh3hXlG/9Kh+cVKV+wVOZ/ctbuLcH9TkmDh48xQvga9hTNQ3Zg4m6YzCCVG/ulK/v/zt5Ws+o/CAqE+uqVUOmVkaS7f2t69Sr2Hh21tXhK/Ht+dzjY2l/cub9anfD+ZnGrfeM5Pp6hWN9VffLrxrTF9mau6KCT6c+8u8tK6EaLx5W79PG+4aEPWZqf3Lc3wnuztE/epyfeu57zC7iQjry7cYTd094uv2s4PLq/Up4qzdUYGtjb/kDXX38ihU2UeHu/JAghrr098eX+Tpu4+JrztXwOjllemOUfm+HDUu6heX1ZAJ0Zi4Jp8lVB4NicbaO3PSR6Ni//Lc181lZgtH+8T+1vPGHbqcR/tF486zxn0itkhIHJznR4nKYbrWy7fq14E1PFi/Ua9Il2g8fF1/eKu+9ILK3aL+cOvbJTzXjN5IFFyvcePl/jtacqQX/KX+cOtg5gXY5/LO/rZu2y8a93/7tnqfCjHx7eFLpvjIEEapvxjfv0zMPDIs6jvnGvd2zN2JjIj6zamD6y/qk9NmhT1t4uDceGPmNyq0C3DFt+v13+koe4K0lquPzTn2dIv61J3GraXG7ef1J7/vz20TNCIas0vY1+Ry/eo8gWICr+3SLcM/euLi4OeV/fXHjcu/Ujkh9n+f/jb3Z/0POqCeAVG/+rjx5I/6IznEoGhMXz6YWDGvY88wj8rlaJto3HjZWL9Xv/kM9dEgyvtba6rcgTLI7uldflOiIQEpYoUe4KjhAU++vXz9dYNe8mgYxHiwu4sHcXaJ6SHaBej+9tX68i2D1mgE0Prkn9/m6WWO9qD87dIMFaJif2dy/9YGFXrFwS9z9WtPqdDHV7Gxu/btzotDrmK0X9Rn7sixIKdtrVmTHsMkjWvXzVMRjYnG0j0cwfwtQ5nROObE6JCrLjN1RBPofnBu7ev2LLUa4L6NlfNMatFBNKkv3/q6/aT+lN7n6BBAGGWbXsrocVF//AL3h3E9TPW/PTPUEh0RBz//3pi/ATFGrrO3Texvre2vrJlD7A2KxuwyHvJLM/Wnd83qe0OivrzTuPOMX7LeTnGwtMVviMREb1g10U9gbzdAOLrpy/s36a709pBQJVlPb59ozLyqv6Cj7u1H48baFTVFTOzfmPu2KisHxP7Wu/rDC1QYwSqxXTlMXxTvb+PeDgr9bZDHGtPvWR7rD4n9hV95mH5FZI21x5C3bL7YH0bH+saFr1t3gQT7BejvEgfXVuu70/U/6A3rj1Dbh1vm+vb3QELmFfVHcV337/6BV3n5FrgHPazf7l40pNOPJ/Rx/eld3PTrEwSSwuT0L3Jc4q0PqSIhDm7MH1yf4Ae/f0jUN27Xr9Bb2D+MXmC89xdRPtYm6lfnifoIk7GQ2L8NmaOxQrJyrE/g1Xz52pxvLA7ZCddJX/DYgPBJqTHIso8bd7b4HY+3icbqHMQSpSthoHi7aFw+h+HvvDBbjQcx/MHUDK8o3iEOpq+xkBwPkeg5SVczHsbZQuL+jThPvAuYPHjze336l8Y03et4N1hcY/1eY/aS2UEc8s3S/s3nYDFv1+u/zDQuv8bF2SYtIx7hTl93rjP9x3uwKKBt6mXj1/PUis4Nva7cO1iiyx/vRav6xUmsiVffJ/bXHxveEo9hN7jwV+nhiMdF/eoda4MJjFp/ShreLzONuT8aD+5A0FuSww0KvAXLt7D4p4T7+HGletjMMD4s6tfmzPWK4x4s1V+M15/8zoSXaBM4kUszqPjt+RFwzq2bEkvmOBJBtOLX55eZ+qUlPHyzS1aLDvF182p9l/aTCEP2Zd6S6BKNtWV+7BJHBcjs+mXfS5aIiMblXyFdSvpM9IivG/frG781VugsEsDyQ8jy93asXr1i/9U4qFTyvUSfaKw8ZY6fiKHLwbm1+qQcIoEd7P8+DdFmdxcX7OldXB1e5KCo39sFPq+SqpAYEvVry41NItzEiKj/9rwxf8MQz0Cb+Lo5gxVLNA6Eqby5yrQ+ILVtuemBYVSa6zYwgnJ9Eu+GDw+DbaLx+M3BL+frF6fQcJAe8cbspcaTP4D/X2a0bPx1Y5y3PRgS9YVb9YuT+zcesHw5GGaha397He/A1mtzUIPdbAc4rK5XHPx5hYlycEAAH1deQVW5R7sa6iTQ1Esmg6UZIE3K10Nx4bMsHI9ArIHEsEo8eDgkvm4+/HaHhKlhesX2t57zqzYCBnTn228PUDkSFQdvHpg3ZmRINNaf4aw2Lnx7CEQd6Upmi37DzNqfBq6sM/tTuwboM9Es1a9PQZqcfF2/P23a+O00DCRjDSwkV+fr167wMsZvmWVIi82znyXyGUhmm/2pXWW2eWCqYLt5oGw3FtxvwGGg34rDQDblvD6YfUkItwaBPWdB23MYSEadxvqqgQxJdvEalp3FJak/cxXMOwsHvy6CZGysjmDZbOOZMHBp6AFDoe0cTF0C75WGnj8X99evmpZk7TlY/a3+9K4BWvYeA9QWn7nzBijNPtcv769f1WYfrvLZfgw2bAMQQ7rFwS+r3+6yCsLAowKrumo16xEH47P1t+v7Nx5KYxDDlUXIJoD2XtFY+fPg+hUD0bahPxd96zkGtB/8umgMRFfPmVrLSsQQbSp6/toALXsRQ4ZwBI37i1JXZKA2G+1OSk7B8BFsFgKjBWwyIDGQrEiSQUkr0rffptiKZBEGTEmX72lTUv3ptcbqnDQScYMmq5J144Jh0xemlenLB7MvfX0tKxNDusXByvz+5bnGm7f11Q0Dh73pd7Y32cuzjE4MYcvTM2l5YqA0P9lrIxtU/dzvxInMIWpDVP3pgjZEcZVljWLIoNj/c7G++1aapBgIu9Tv2i7FwOMguYNXt+1LFxwWB2vvGnN/QJixz8syU8mWbKuy1q8NVjapK6vVMwNpMV0xnOxXjV9/9tmv7PFHFItjI5ZBkW3JMsCQaEzcU7Ysq7Fl0GJIi1WL4TEMCzH8wXnfCH7jFgMT4mD+Cd4f//FJG9fBr4sGAkHweuP9QwNR1q5WNmhMXruTShuwBpd2r93J5l6Q3G/b2JMWsPo7sww2g9ltyBa2f+PBt/NPbIYTDon67OvG6rY9tTKJvSaz0QNpNuIqsos1xg39hLvEwfvnNnM25rHdSWkeY7iykdkYC0fF/q1fmxALa9nmbn1ywQeUJrP7y5C+5/4wcG03a+0ijWeXfjWQBA/y7Tlb0Bg+IL7duW/jOTxk1gBbmnW/wsPi4OZ0E2bYqnZ/2rKqPWNV3uLMXb2i/sc127TG8JhgSnh4oYkSbDsbQ/zGNglki9vyjoHQNdmf2rUR3mRyY2BUNO7t2Nu3LW8MYfPba2N+e/SrvaomGxwDLUMcQ6Q1zp4rIfZ3X2qTnGx2NCQau0+haFstpXHuYPWtzSphoVufkBY6hlhmOgmRtjobq5EweBp4znXfQTQZ6xjYjca2xY7hUXFw417jhll2pBe8F+o2PYv1p3dx/a9dqS/v7P+56OsrLTsPbxpIjERXG+I36DFwWHybnWarnoUcNu3NvrTx39Mm6kt/2OyiybzHwCCW/e3+ahPt2YY+qLhz26ZKWfsghFydN/CYOFhbx5W58cA3VBxwbfdjoN/4x8ABsX9vQVsAGchmwN+bBCHLFmiATQZBBkqr4PpVH5BMgyADZRpkeEiZzCYXIH/uTpoqxR6BseesIHNVWHx7/vrb+C3bZMhVXajaX9/cn9ptOjgYD5+T8fCROXpYEJ+/tm9ENCr2376WZkSGWLZEhvQxF4JBcfbc97gQWxVp/PofEIObl3QMs3/dGG96yqMxcTAziV5sX7RwTkbG+s1Vm9/Cxvj89f7UrrQxMnBANF7Mf7s0g6fZuhGwNlJjKB1PjZwGkyOxa2mtY6Df7sjAYbT8unuxiYylBfLrxmVtgZTtYYZcv6rMkKb9YbZIq1YZJA2kUxz8vssmSQuNsEs+m2qValqNkwzvYaneQCwzJUOUrdLWSXtjWMC3RYPM3gGxv34d4pC9nhEAD569t6ewTZgSYuyYlrLAxkxrCmXRfL3/8zZ4Y4sMY5k1H2AxLQ+0tm0erBnKbzVwMpysnPbK+6PgWtLUeTB1yWfntHbdauxkuN/ieXDpVWN1274mTZZPBsL8eW//uhEemmygEigNobasZUyhd7YMsE/s705+e/66icakURS8ws/u2DLqV8RiQ2CYZKkw07GN9MI1SDuWmZRr22FHxNSz55rQJQ2m9Y1Fm8BgNf31kq0q2qZThvjtpwzswhlpI6pvImVMhV7j3z4sqmSdr6+8ZtPq23Xco+XZxhOD+VbLKsN7xP7Vyfri9frKigEStQDVUy8Pfre2Jq2skwv4C6+9ZcgVj2V739piQAV4oKXms921CUUJzAgZYPfnJssrNxgUB7MvccrK/MpwtsEe8sTEh0Xj9q/NSxoR9Wur+zefN94/tC9Ik0kWD9MWW1eaDj0B++BDNiFeu/JtdtqYZe1mHaLx8Fl911iQbAMtQ6SV1hI5bFOtAWpL7fSD5ll6ROO3FW20ZSBbbn+3OZU23DZxHhhwf1ux398mKy4D8Qf1C98uzQAz165gPcqOyw0GRf369f2bz23WKi26++NG+Jdm3fry3fryztetBz8o+ECb2L/A2p9uPBAm4OaqfVvZymshbWAYzZq4ysAIgAczq/WpZzYyjbl31Vy9QRLqpM23fu2KVgBtcmKT7+SCNvkynO2+r0ncet10QMry+51ay/bLkAFxcP31t/NPGr+Zx842Acvx2RB8f/bb+SdNY8Im3GKibDIMy2GHQ+LgxuVvs4aNw0QM0WsC4pA1pm0qZkhU1JfvNgkDI0PiYPde/e16/cX4D/+/AQA/rek/2AECAA=="
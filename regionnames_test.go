@@ -0,0 +1,77 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRegionDisplayName(t *testing.T) {
+	tests := []struct {
+		region   string
+		lang     string
+		expected string
+	}{
+		{"DE", "en", "Germany"},
+		{"DE", "de", "Deutschland"},
+		{"DE", "fr", "Allemagne"},
+		{"de", "fr", "Allemagne"},
+		{"DE", "pt-BR", "Alemanha"},
+		{"DE", "pt_BR", "Alemanha"},
+		{"TW", "zh-Hant", "台灣"},
+		{"TW", "zh-hant", "台灣"},
+		{"DE", "xx", "Germany"},
+		{"DE", "", "Germany"},
+		{"XX", "en", ""},
+		{"001", "en", ""},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, GetRegionDisplayName(tc.region, tc.lang), "name mismatch for %s in %s", tc.region, tc.lang)
+	}
+
+	// every supported region has an English name
+	for region := range GetSupportedRegions() {
+		assert.NotEqual(t, "", GetRegionDisplayName(region, "en"), "no name for %s", region)
+	}
+}
+
+func TestBuildRegionNameData(t *testing.T) {
+	data, err := BuildRegionNameData([]string{"GB", "DE"}, []string{"en", "fr"})
+	require.NoError(t, err)
+	assert.Equal(t, "en\tDE\tGermany\nen\tGB\tUnited Kingdom\nfr\tDE\tAllemagne\nfr\tGB\tRoyaume-Uni\n", string(data))
+
+	names, err := readRegionNames(data)
+	require.NoError(t, err)
+	assert.Equal(t, "Royaume-Uni", names["fr"]["GB"])
+
+	_, err = BuildRegionNameData([]string{"GB"}, []string{"!!"})
+	assert.Error(t, err)
+}
+
+func TestRegionHelpers(t *testing.T) {
+	tests := []struct {
+		region    string
+		supported bool
+		flag      string
+		alpha3    string
+	}{
+		{"DE", true, "🇩🇪", "DEU"},
+		{"US", true, "🇺🇸", "USA"},
+		{"GB", true, "🇬🇧", "GBR"},
+		{"RW", true, "🇷🇼", "RWA"},
+		{"XK", true, "🇽🇰", "XKK"},
+		{"AC", true, "🇦🇨", "ASC"},
+		{"de", false, "", ""},
+		{"ZZ", false, "", ""},
+		{"001", false, "", ""},
+		{"", false, "", ""},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.supported, IsSupportedRegion(tc.region), "supported mismatch for %s", tc.region)
+		assert.Equal(t, tc.flag, GetRegionFlag(tc.region), "flag mismatch for %s", tc.region)
+		assert.Equal(t, tc.alpha3, GetRegionAlpha3(tc.region), "alpha-3 mismatch for %s", tc.region)
+	}
+}
@@ -0,0 +1,39 @@
+package phonenumbers
+
+// RegionInfo describes a region we have metadata for
+type RegionInfo struct {
+	Region      string // e.g. "US"
+	CountryCode int32  // e.g. 1
+
+	// whether this is the main region for its country code, e.g. US for 1, which is the region
+	// returned by GetRegionCodeForCountryCode
+	IsMainRegion bool
+}
+
+// LookupCountryCodeForRegion returns the country calling code for the passed in region, e.g. 1 for US,
+// and whether the region is valid. Unlike GetCountryCodeForRegion, callers don't have to treat a zero
+// country code as meaning the region is invalid.
+func LookupCountryCodeForRegion(regionCode string) (int32, bool) {
+	if !isValidRegionCode(regionCode) {
+		return 0, false
+	}
+	return getCountryCodeForValidRegion(regionCode), true
+}
+
+// RangeRegions calls f for each region we have metadata for, in order of country code and with the main
+// region of each country code first, until f returns false. Unlike GetSupportedRegions, this doesn't
+// give access to our internal maps, and it sees a consistent set of regions even if the metadata is
+// swapped while it's running.
+func RangeRegions(f func(RegionInfo) bool) {
+	tables := currentMetadata()
+	for countryCode, regions := range tables.countryCodeToRegion {
+		for i, region := range regions {
+			if region == REGION_CODE_FOR_NON_GEO_ENTITY {
+				continue
+			}
+			if !f(RegionInfo{Region: region, CountryCode: int32(countryCode), IsMainRegion: i == 0}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionUtilities(t *testing.T) {
+	tests := []struct {
+		region      string
+		countryCode int32
+		ok          bool
+	}{
+		{"US", 1, true},
+		{"CA", 1, true},
+		{"NZ", 64, true},
+		{"RW", 250, true},
+		{"001", 0, false},
+		{"ZZ", 0, false},
+		{"XX", 0, false},
+	}
+	for _, tc := range tests {
+		countryCode, ok := LookupCountryCodeForRegion(tc.region)
+		assert.Equal(t, tc.countryCode, countryCode, "country code mismatch for %s", tc.region)
+		assert.Equal(t, tc.ok, ok, "ok mismatch for %s", tc.region)
+	}
+
+	var regions []RegionInfo
+	RangeRegions(func(info RegionInfo) bool {
+		regions = append(regions, info)
+		return true
+	})
+	assert.Len(t, regions, len(GetSupportedRegions()))
+	assert.Equal(t, RegionInfo{Region: "US", CountryCode: 1, IsMainRegion: true}, regions[0])
+	assert.Contains(t, regions, RegionInfo{Region: "CA", CountryCode: 1, IsMainRegion: false})
+	assert.Contains(t, regions, RegionInfo{Region: "GB", CountryCode: 44, IsMainRegion: true})
+	assert.Contains(t, regions, RegionInfo{Region: "JE", CountryCode: 44, IsMainRegion: false})
+
+	for i, info := range regions {
+		assert.True(t, IsSupportedRegion(info.Region), "invalid region %s", info.Region)
+		assert.Equal(t, GetCountryCodeForRegion(info.Region), info.CountryCode, "country code mismatch for %s", info.Region)
+		assert.Equal(t, info.IsMainRegion, GetRegionCodeForCountryCode(info.CountryCode) == info.Region, "main region mismatch for %s", info.Region)
+		if i > 0 {
+			assert.GreaterOrEqual(t, info.CountryCode, regions[i-1].CountryCode, "regions out of order at %s", info.Region)
+		}
+	}
+
+	// stops when told to
+	count := 0
+	RangeRegions(func(info RegionInfo) bool {
+		count++
+		return count < 3
+	})
+	assert.Equal(t, 3, count)
+}
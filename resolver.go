@@ -0,0 +1,83 @@
+package phonenumbers
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// CarrierResolver looks up the carrier a number currently belongs to from a live source, such as an
+// HLR lookup or number intelligence provider, which unlike our offline data knows about ported numbers.
+type CarrierResolver interface {
+	// ResolveCarrier returns the name of the carrier for the number in the passed in language, or an
+	// empty string if it doesn't know. It's passed the answer from our offline data, which may be
+	// empty, so that it can decide whether a lookup is worth making.
+	ResolveCarrier(ctx context.Context, number *PhoneNumber, lang string, offline string) (string, error)
+}
+
+// LineTypeResolver looks up the type of line a number currently is from a live source, e.g. whether a
+// number which our metadata can't tell apart is a mobile or fixed line.
+type LineTypeResolver interface {
+	// ResolveLineType returns the type of the number, or UNKNOWN if it doesn't know. It's passed the
+	// type from our metadata so that it can decide whether a lookup is worth making.
+	ResolveLineType(ctx context.Context, number *PhoneNumber, offline PhoneNumberType) (PhoneNumberType, error)
+}
+
+// the resolvers set with SetCarrierResolver and SetLineTypeResolver, wrapped so that they can be
+// stored in an atomic.Value even when nil
+type carrierResolverHolder struct{ resolver CarrierResolver }
+type lineTypeResolverHolder struct{ resolver LineTypeResolver }
+
+var carrierResolver, lineTypeResolver atomic.Value
+
+// SetCarrierResolver sets the resolver consulted by LookupCarrierForNumber after our offline carrier
+// data. Passing nil removes it. It's safe to call at any time.
+func SetCarrierResolver(resolver CarrierResolver) {
+	carrierResolver.Store(carrierResolverHolder{resolver})
+}
+
+// SetLineTypeResolver sets the resolver consulted by LookupNumberType after our metadata. Passing nil
+// removes it. It's safe to call at any time.
+func SetLineTypeResolver(resolver LineTypeResolver) {
+	lineTypeResolver.Store(lineTypeResolverHolder{resolver})
+}
+
+// LookupCarrierForNumber returns the carrier the number belongs to like GetCarrierForNumber, but then
+// consults the resolver set with SetCarrierResolver, if any, whose answer is preferred if it has one.
+// If the resolver fails, the offline answer is returned along with its error. Without carrier data
+// loaded, only the resolver is used.
+func LookupCarrierForNumber(ctx context.Context, number *PhoneNumber, lang string) (string, error) {
+	holder, _ := carrierResolver.Load().(carrierResolverHolder)
+
+	offline, err := GetCarrierForNumber(number, lang)
+	if holder.resolver == nil {
+		return offline, err
+	}
+	if err != nil && !errors.Is(err, ErrCarrierDataNotLoaded) {
+		return "", err
+	}
+
+	resolved, err := holder.resolver.ResolveCarrier(ctx, number, lang, offline)
+	if err != nil || resolved == "" {
+		return offline, err
+	}
+	return resolved, nil
+}
+
+// LookupNumberType returns the type of the number like GetNumberType, but then consults the resolver
+// set with SetLineTypeResolver, if any, whose answer is preferred if it isn't UNKNOWN. If the resolver
+// fails, the type from our metadata is returned along with its error.
+func LookupNumberType(ctx context.Context, number *PhoneNumber) (PhoneNumberType, error) {
+	holder, _ := lineTypeResolver.Load().(lineTypeResolverHolder)
+
+	offline := GetNumberType(number)
+	if holder.resolver == nil {
+		return offline, nil
+	}
+
+	resolved, err := holder.resolver.ResolveLineType(ctx, number, offline)
+	if err != nil || resolved == UNKNOWN {
+		return offline, err
+	}
+	return resolved, nil
+}
@@ -0,0 +1,98 @@
+package phonenumbers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCarrierResolver func(number *PhoneNumber, offline string) (string, error)
+
+func (r testCarrierResolver) ResolveCarrier(ctx context.Context, number *PhoneNumber, lang string, offline string) (string, error) {
+	return r(number, offline)
+}
+
+type testLineTypeResolver func(number *PhoneNumber, offline PhoneNumberType) (PhoneNumberType, error)
+
+func (r testLineTypeResolver) ResolveLineType(ctx context.Context, number *PhoneNumber, offline PhoneNumberType) (PhoneNumberType, error) {
+	return r(number, offline)
+}
+
+func TestLookupCarrierForNumber(t *testing.T) {
+	ctx := context.Background()
+	number, err := Parse("+250788383383", UNKNOWN_REGION)
+	require.NoError(t, err)
+
+	// without a resolver we get the same as GetCarrierForNumber, which has no data loaded in these tests
+	_, err = LookupCarrierForNumber(ctx, number, "en")
+	assert.Equal(t, ErrCarrierDataNotLoaded, err)
+
+	var offlines []string
+	SetCarrierResolver(testCarrierResolver(func(number *PhoneNumber, offline string) (string, error) {
+		offlines = append(offlines, offline)
+		switch number.GetNationalNumber() {
+		case 788383383:
+			return "MTN", nil
+		case 788383384:
+			return "", errors.New("lookup failed")
+		}
+		return "", nil
+	}))
+	defer SetCarrierResolver(nil)
+
+	carrier, err := LookupCarrierForNumber(ctx, number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "MTN", carrier)
+
+	number.NationalNumber = 788383384
+	carrier, err = LookupCarrierForNumber(ctx, number, "en")
+	assert.EqualError(t, err, "lookup failed")
+	assert.Equal(t, "", carrier)
+
+	number.NationalNumber = 788383385
+	carrier, err = LookupCarrierForNumber(ctx, number, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "", carrier)
+
+	assert.Equal(t, []string{"", "", ""}, offlines)
+}
+
+func TestLookupNumberType(t *testing.T) {
+	ctx := context.Background()
+	number, err := Parse("+16502530000", UNKNOWN_REGION)
+	require.NoError(t, err)
+
+	numberType, err := LookupNumberType(ctx, number)
+	assert.NoError(t, err)
+	assert.Equal(t, FIXED_LINE_OR_MOBILE, numberType)
+
+	SetLineTypeResolver(testLineTypeResolver(func(number *PhoneNumber, offline PhoneNumberType) (PhoneNumberType, error) {
+		// only bother looking up numbers our metadata can't tell apart
+		if offline != FIXED_LINE_OR_MOBILE {
+			return UNKNOWN, nil
+		}
+		if number.GetNationalNumber() == 6502530001 {
+			return UNKNOWN, errors.New("lookup failed")
+		}
+		return MOBILE, nil
+	}))
+	defer SetLineTypeResolver(nil)
+
+	numberType, err = LookupNumberType(ctx, number)
+	assert.NoError(t, err)
+	assert.Equal(t, MOBILE, numberType)
+
+	number.NationalNumber = 6502530001
+	numberType, err = LookupNumberType(ctx, number)
+	assert.EqualError(t, err, "lookup failed")
+	assert.Equal(t, FIXED_LINE_OR_MOBILE, numberType)
+
+	number, err = Parse("+250788383383", UNKNOWN_REGION)
+	require.NoError(t, err)
+	numberType, err = LookupNumberType(ctx, number)
+	assert.NoError(t, err)
+	assert.Equal(t, MOBILE, numberType)
+}
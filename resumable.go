@@ -0,0 +1,129 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"net/url"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ErrInvalidState is returned when restoring an AsYouTypeFormatter or PhoneNumberMatcher from state
+// which wasn't written by MarshalText, or for a PhoneNumberMatcher, was written for different text
+var ErrInvalidState = errors.New("invalid saved state")
+
+// the version of the state written by our MarshalText methods
+const resumableStateVersion = "1"
+
+// MarshalText implements encoding.TextMarshaler, saving the state of the formatter so that it can be
+// restored with UnmarshalText, e.g. by a stateless server which formats numbers for a web client one
+// keystroke at a time and hands the state back to the client between keystrokes. The state is the
+// region and what has been input so far, as URL encoded form values, so it's only as private as the
+// number being typed, and grows with the input.
+func (f *AsYouTypeFormatter) MarshalText() ([]byte, error) {
+	values := url.Values{}
+	values.Set("v", resumableStateVersion)
+	values.Set("region", f.defaultCountry)
+	values.Set("input", f.accruedInput)
+	if f.originalPosition > 0 {
+		values.Set("remember", strconv.Itoa(f.originalPosition))
+	}
+	return []byte(values.Encode()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, restoring the state saved with MarshalText by
+// inputting the same characters again, so the formatter continues exactly as the one which was saved
+// would have, apart from using the current metadata. Unlike most methods it can be called on a zero
+// AsYouTypeFormatter, and the current output is returned by GetCurrentOutput. As the state will often
+// come from untrusted clients, input longer than the limit set with SetInputLimits is rejected with
+// ErrInputTooLong.
+func (f *AsYouTypeFormatter) UnmarshalText(text []byte) error {
+	values, err := url.ParseQuery(string(text))
+	if err != nil || values.Get("v") != resumableStateVersion {
+		return ErrInvalidState
+	}
+	input := values.Get("input")
+	if len(input) > GetInputLimits().MaxParseLength {
+		return ErrInputTooLong
+	}
+	remember := 0
+	if values.Has("remember") {
+		remember, err = strconv.Atoi(values.Get("remember"))
+		if err != nil || remember < 1 || remember > utf8.RuneCountInString(input) {
+			return ErrInvalidState
+		}
+	}
+
+	*f = *GetAsYouTypeFormatter(values.Get("region"))
+	position := 0
+	for _, r := range input {
+		position++
+		if position == remember {
+			f.InputDigitAndRememberPosition(r)
+		} else {
+			f.InputDigit(r)
+		}
+	}
+	return nil
+}
+
+// GetCurrentOutput returns the number formatted so far, i.e. what the last call to InputDigit or
+// InputDigitAndRememberPosition returned
+func (f *AsYouTypeFormatter) GetCurrentOutput() string {
+	return f.currentOutput
+}
+
+// MarshalText implements encoding.TextMarshaler, saving where the matcher has got to in its text so
+// that it can be resumed with ResumePhoneNumberMatcher, e.g. by a stateless server which returns the
+// matches in a document a page at a time. The text itself isn't saved, just a checksum of it, so it
+// must be passed to ResumePhoneNumberMatcher again.
+func (p *PhoneNumberMatcher) MarshalText() ([]byte, error) {
+	values := url.Values{}
+	values.Set("v", resumableStateVersion)
+	values.Set("region", p.preferredRegion)
+	values.Set("leniency", strconv.Itoa(int(p.leniency)))
+	values.Set("index", strconv.Itoa(p.searchIndex))
+	values.Set("tries", strconv.Itoa(p.maxTries))
+	values.Set("maxlen", strconv.Itoa(p.maxTextLength))
+	values.Set("crc", strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(p.text))), 10))
+	if p.state == done {
+		values.Set("done", "1")
+	}
+	return []byte(values.Encode()), nil
+}
+
+// ResumePhoneNumberMatcher returns a matcher for the passed in text which continues from where the
+// matcher whose state was saved with MarshalText had got to, its next match being the one that
+// matcher's would have been. The text must be the same text, and ErrInvalidState is returned if not.
+// The limits in place when the matcher was created are kept, including how many tries it had left.
+func ResumePhoneNumberMatcher(text string, state []byte) (PhoneNumberMatcher, error) {
+	values, err := url.ParseQuery(string(state))
+	if err != nil || values.Get("v") != resumableStateVersion {
+		return PhoneNumberMatcher{}, ErrInvalidState
+	}
+
+	var ints [4]int
+	for i, key := range []string{"leniency", "index", "tries", "maxlen"} {
+		if ints[i], err = strconv.Atoi(values.Get(key)); err != nil || ints[i] < 0 {
+			return PhoneNumberMatcher{}, fmt.Errorf("%w: invalid %s", ErrInvalidState, key)
+		}
+	}
+	if ints[0] > int(EXACT_GROUPING) {
+		return PhoneNumberMatcher{}, fmt.Errorf("%w: invalid leniency", ErrInvalidState)
+	}
+	checksum, err := strconv.ParseUint(values.Get("crc"), 10, 32)
+	if err != nil || uint32(checksum) != crc32.ChecksumIEEE([]byte(text)) || ints[1] > len(text) {
+		return PhoneNumberMatcher{}, fmt.Errorf("%w: not saved for this text", ErrInvalidState)
+	}
+
+	m := NewPhoneNumberMatcher(text, values.Get("region"))
+	m.leniency = Leniency(ints[0])
+	m.searchIndex = ints[1]
+	m.maxTries = ints[2]
+	m.maxTextLength = ints[3]
+	if values.Get("done") == "1" {
+		m.state = done
+	}
+	return m, nil
+}
@@ -0,0 +1,111 @@
+package phonenumbers
+
+import (
+	"encoding"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ encoding.TextMarshaler = &AsYouTypeFormatter{}
+var _ encoding.TextUnmarshaler = &AsYouTypeFormatter{}
+var _ encoding.TextMarshaler = &PhoneNumberMatcher{}
+
+func TestAsYouTypeFormatterResume(t *testing.T) {
+	tests := []struct {
+		region string
+		input  string
+	}{
+		{"US", "6502530000"},
+		{"US", "+447912345678"},
+		{"US", "011447912345678"},
+		{"GB", "02070313000"},
+		{"ZZ", "+48881231234"},
+		{"US", "＋４４７９１２"},
+		{"US", "650-253"},
+	}
+
+	for _, tc := range tests {
+		expected := GetAsYouTypeFormatter(tc.region)
+
+		// each keystroke goes to a new formatter restored from the state saved after the last one
+		var state []byte
+		for i, c := range []rune(tc.input) {
+			formatter := &AsYouTypeFormatter{}
+			if state != nil {
+				require.NoError(t, formatter.UnmarshalText(state))
+			} else {
+				formatter = GetAsYouTypeFormatter(tc.region)
+			}
+
+			var output string
+			if i == 3 {
+				output = formatter.InputDigitAndRememberPosition(c)
+				expected.InputDigitAndRememberPosition(c)
+			} else {
+				output = formatter.InputDigit(c)
+				expected.InputDigit(c)
+			}
+			assert.Equal(t, expected.GetCurrentOutput(), output, "output mismatch for %s in %s", tc.input, tc.region)
+
+			var err error
+			state, err = formatter.MarshalText()
+			require.NoError(t, err)
+		}
+
+		restored := &AsYouTypeFormatter{}
+		require.NoError(t, restored.UnmarshalText(state))
+		assert.Equal(t, expected.GetCurrentOutput(), restored.GetCurrentOutput(), "output mismatch for %s in %s", tc.input, tc.region)
+		assert.Equal(t, expected.GetRememberedPosition(), restored.GetRememberedPosition(), "remembered position mismatch for %s in %s", tc.input, tc.region)
+	}
+
+	formatter := GetAsYouTypeFormatter("GB")
+	state, _ := formatter.MarshalText()
+	assert.Equal(t, "input=&region=GB&v=1", string(state))
+
+	assert.Equal(t, ErrInvalidState, formatter.UnmarshalText([]byte("input=123&region=GB")))
+	assert.Equal(t, ErrInvalidState, formatter.UnmarshalText([]byte("input=123&region=GB&remember=4&v=1")))
+	assert.Equal(t, ErrInvalidState, formatter.UnmarshalText([]byte("%%%")))
+}
+
+func TestPhoneNumberMatcherResume(t *testing.T) {
+	text := "Call 650-253-0000 or (650) 253-0001, or from the UK +44 20 7031 3000."
+
+	// find each match with a new matcher resumed from the state saved after the last one
+	var state []byte
+	var found []string
+	for {
+		var matcher PhoneNumberMatcher
+		if state != nil {
+			var err error
+			matcher, err = ResumePhoneNumberMatcher(text, state)
+			require.NoError(t, err)
+		} else {
+			matcher = NewPhoneNumberMatcher(text, "US")
+		}
+
+		match, err := matcher.Next()
+		state, _ = matcher.MarshalText()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		found = append(found, match.RawString())
+	}
+	assert.Equal(t, []string{"650-253-0000", "(650) 253-0001", "+44 20 7031 3000"}, found)
+
+	// once done, resumed matchers are done too
+	matcher, err := ResumePhoneNumberMatcher(text, state)
+	require.NoError(t, err)
+	_, err = matcher.Next()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = ResumePhoneNumberMatcher("Call 650-253-0000", state)
+	assert.ErrorIs(t, err, ErrInvalidState)
+	_, err = ResumePhoneNumberMatcher(text, []byte("v=1&leniency=9&index=0&tries=10&maxlen=0&crc=0"))
+	assert.ErrorIs(t, err, ErrInvalidState)
+	_, err = ResumePhoneNumberMatcher(text, []byte("v=2"))
+	assert.ErrorIs(t, err, ErrInvalidState)
+}
@@ -9,6 +9,8 @@ import (
 	"io/ioutil"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // intStringMap is our data structure for maps from prefixes to a single string
@@ -16,6 +18,60 @@ import (
 type intStringMap struct {
 	Map       map[int32]string
 	MaxLength int
+	Bytes     int64 // estimated memory used by the map and its values
+}
+
+// lazyPrefixMap holds an encoded intStringMap which is only decoded the first time it is used. Maps
+// which are evictable may be dropped again to stay within the limit set by SetPrefixDataMemoryLimit,
+// in which case they are decoded again the next time they are used.
+type lazyPrefixMap struct {
+	mutex     sync.Mutex
+	encoded   string
+	evictable bool
+	prefixMap atomic.Value // *intStringMap, nil until decoded and after being evicted
+	err       error        // guarded by mutex
+	lastUsed  uint64       // value of prefixDataClock when last used, only kept while there is a limit
+}
+
+// get returns our map, decoding it if this is the first time we've been called or it has been evicted
+func (l *lazyPrefixMap) get() (*intStringMap, error) {
+	if prefixMap, _ := l.prefixMap.Load().(*intStringMap); prefixMap != nil {
+		if l.evictable && atomic.LoadInt64(&prefixDataMemoryLimit) > 0 {
+			atomic.StoreUint64(&l.lastUsed, atomic.AddUint64(&prefixDataClock, 1))
+		}
+		return prefixMap, nil
+	}
+
+	l.mutex.Lock()
+	prefixMap, _ := l.prefixMap.Load().(*intStringMap)
+	if prefixMap == nil && l.err == nil {
+		prefixMap, l.err = loadPrefixMap(l.encoded)
+		if l.err == nil {
+			atomic.StoreUint64(&l.lastUsed, atomic.AddUint64(&prefixDataClock, 1))
+			l.prefixMap.Store(prefixMap)
+		}
+	}
+	err := l.err
+	l.mutex.Unlock()
+
+	// we may now be over our limit, but never evict the map we're about to use
+	if l.evictable && err == nil {
+		enforcePrefixDataMemoryLimit(l)
+	}
+	return prefixMap, err
+}
+
+// evict drops our decoded map
+func (l *lazyPrefixMap) evict() {
+	l.prefixMap.Store((*intStringMap)(nil))
+}
+
+// bytes returns the estimated memory used by our map, or zero if it isn't decoded
+func (l *lazyPrefixMap) bytes() int64 {
+	if prefixMap, _ := l.prefixMap.Load().(*intStringMap); prefixMap != nil {
+		return prefixMap.Bytes
+	}
+	return 0
 }
 
 func loadPrefixMap(data string) (*intStringMap, error) {
@@ -23,24 +79,18 @@ func loadPrefixMap(data string) (*intStringMap, error) {
 	if err != nil {
 		return nil, err
 	}
+	return readPrefixMap(rawBytes)
+}
+
+// readPrefixMap reads a map of prefixes to single strings as written by buildmetadata
+func readPrefixMap(rawBytes []byte) (*intStringMap, error) {
 	reader := bytes.NewReader(rawBytes)
 
-	// ok, first read in our number of values
-	var valueSize uint32
-	err = binary.Read(reader, binary.LittleEndian, &valueSize)
+	values, valueSize, err := readInternedValues(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	// then our values
-	valueBytes := make([]byte, valueSize)
-	n, err := reader.Read(valueBytes)
-	if uint32(n) < valueSize {
-		return nil, fmt.Errorf("unable to read all values: %v", err)
-	}
-
-	values := strings.Split(string(valueBytes), "\n")
-
 	// read our # of mappings
 	var mappingCount uint32
 	err = binary.Read(reader, binary.LittleEndian, &mappingCount)
@@ -49,7 +99,7 @@ func loadPrefixMap(data string) (*intStringMap, error) {
 	}
 
 	maxLength := 0
-	mappings := make(map[int32]string, mappingCount)
+	mappings := make(map[int32]string, sizeHint(mappingCount, reader))
 	var prefix int32 = 0
 	for i := 0; i < int(mappingCount); i++ {
 		// first read our diff
@@ -64,7 +114,7 @@ func loadPrefixMap(data string) (*intStringMap, error) {
 		var valueIntern uint16
 		err = binary.Read(reader, binary.LittleEndian, &valueIntern)
 		if err != nil || int(valueIntern) >= len(values) {
-			return nil, fmt.Errorf("unable to read interned value: %v", err)
+			return nil, fmt.Errorf("unable to read interned value: %w", err)
 		}
 
 		mappings[prefix] = values[valueIntern]
@@ -75,10 +125,11 @@ func loadPrefixMap(data string) (*intStringMap, error) {
 		}
 	}
 
-	// return our values
+	// return our values, values are interned so each is only stored once
 	return &intStringMap{
 		Map:       mappings,
 		MaxLength: maxLength,
+		Bytes:     int64(valueSize) + int64(len(values))*stringHeaderSize + mapBytes(len(mappings), 4+stringHeaderSize),
 	}, nil
 }
 
@@ -87,6 +138,33 @@ func loadPrefixMap(data string) (*intStringMap, error) {
 type intStringArrayMap struct {
 	Map       map[int32][]string
 	MaxLength int
+	Bytes     int64 // estimated memory used by the map and its values
+}
+
+// lazyPrefixArrayMap holds an encoded intStringArrayMap which is only decoded the first time it is used
+type lazyPrefixArrayMap struct {
+	once      sync.Once
+	encoded   string
+	prefixMap *intStringArrayMap
+	err       error
+	loaded    uint32 // set to 1 once decoded, read atomically by GetMemStats
+}
+
+// get returns our map, decoding it if this is the first time we've been called
+func (l *lazyPrefixArrayMap) get() (*intStringArrayMap, error) {
+	l.once.Do(func() {
+		l.prefixMap, l.err = loadIntStringArrayMap(l.encoded)
+		atomic.StoreUint32(&l.loaded, 1)
+	})
+	return l.prefixMap, l.err
+}
+
+// bytes returns the estimated memory used by our map, or zero if it hasn't been decoded
+func (l *lazyPrefixArrayMap) bytes() int64 {
+	if atomic.LoadUint32(&l.loaded) == 0 || l.prefixMap == nil {
+		return 0
+	}
+	return l.prefixMap.Bytes
 }
 
 func loadIntStringArrayMap(data string) (*intStringArrayMap, error) {
@@ -94,24 +172,18 @@ func loadIntStringArrayMap(data string) (*intStringArrayMap, error) {
 	if err != nil {
 		return nil, err
 	}
+	return readIntStringArrayMap(rawBytes)
+}
+
+// readIntStringArrayMap reads a map of ints to lists of strings as written by buildmetadata
+func readIntStringArrayMap(rawBytes []byte) (*intStringArrayMap, error) {
 	reader := bytes.NewReader(rawBytes)
 
-	// ok, first read in our number of values
-	var valueSize uint32
-	err = binary.Read(reader, binary.LittleEndian, &valueSize)
+	values, valueSize, err := readInternedValues(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	// then our values
-	valueBytes := make([]byte, valueSize)
-	n, err := reader.Read(valueBytes)
-	if uint32(n) < valueSize {
-		return nil, fmt.Errorf("unable to read all values: %v", err)
-	}
-
-	values := strings.Split(string(valueBytes), "\n")
-
 	// read our # of mappings
 	var mappingCount uint32
 	err = binary.Read(reader, binary.LittleEndian, &mappingCount)
@@ -120,7 +192,8 @@ func loadIntStringArrayMap(data string) (*intStringArrayMap, error) {
 	}
 
 	maxLength := 0
-	mappings := make(map[int32][]string, mappingCount)
+	valuesBytes := int64(valueSize) + int64(len(values))*stringHeaderSize
+	mappings := make(map[int32][]string, sizeHint(mappingCount, reader))
 	var key int32 = 0
 	for i := 0; i < int(mappingCount); i++ {
 		// first read our diff
@@ -137,12 +210,13 @@ func loadIntStringArrayMap(data string) (*intStringArrayMap, error) {
 			return nil, err
 		}
 
+		valuesBytes += int64(valueCount) * stringHeaderSize
 		keyValues := make([]string, valueCount)
 		for i := 0; i < int(valueCount); i++ {
 			var valueIntern uint16
 			err = binary.Read(reader, binary.LittleEndian, &valueIntern)
 			if err != nil || int(valueIntern) >= len(values) {
-				return nil, fmt.Errorf("unable to read interned value: %v", err)
+				return nil, fmt.Errorf("unable to read interned value: %w", err)
 			}
 			keyValues[i] = values[valueIntern]
 		}
@@ -158,9 +232,37 @@ func loadIntStringArrayMap(data string) (*intStringArrayMap, error) {
 	return &intStringArrayMap{
 		Map:       mappings,
 		MaxLength: maxLength,
+		Bytes:     valuesBytes + mapBytes(len(mappings), 4+sliceHeaderSize),
 	}, nil
 }
 
+// readInternedValues reads the newline separated values which our maps refer to by index, returning them
+// along with their size in bytes
+func readInternedValues(reader *bytes.Reader) ([]string, uint32, error) {
+	var valueSize uint32
+	if err := binary.Read(reader, binary.LittleEndian, &valueSize); err != nil {
+		return nil, 0, err
+	}
+
+	// don't trust the size until we know we have that much data
+	if int64(valueSize) > int64(reader.Len()) {
+		return nil, 0, fmt.Errorf("unable to read all values: need %d bytes, have %d", valueSize, reader.Len())
+	}
+	valueBytes := make([]byte, valueSize)
+	reader.Read(valueBytes)
+
+	return strings.Split(string(valueBytes), "\n"), valueSize, nil
+}
+
+// sizeHint returns the passed in count of entries to preallocate for, limited by the data left in reader as every
+// entry takes at least a byte, so that corrupt counts can't make us allocate huge amounts of memory
+func sizeHint(count uint32, reader *bytes.Reader) int {
+	if int64(count) > int64(reader.Len()) {
+		return reader.Len()
+	}
+	return int(count)
+}
+
 func decodeUnzipString(data string) ([]byte, error) {
 	decodedBytes, err := base64.StdEncoding.DecodeString(data)
 	if err != nil {
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+
+	"github.com/nyaruka/phonenumbers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements PhoneNumbersServer using the phonenumbers package. Register it with RegisterPhoneNumbersServer.
+// Geocode requires the geocoding data to be loaded by importing github.com/nyaruka/phonenumbers/geocodingdata.
+type Server struct{}
+
+// NewServer creates a new server
+func NewServer() *Server {
+	return &Server{}
+}
+
+func (s *Server) Parse(ctx context.Context, req *ParseRequest) (*ParseResponse, error) {
+	var num *phonenumbers.PhoneNumber
+	var err error
+	if req.GetKeepRawInput() {
+		num, err = phonenumbers.ParseAndKeepRawInput(req.GetNumber(), req.GetRegion())
+	} else {
+		num, err = phonenumbers.Parse(req.GetNumber(), req.GetRegion())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &ParseResponse{
+		PhoneNumber: num,
+		Region:      phonenumbers.GetRegionCodeForNumber(num),
+		Type:        PhoneNumberType(phonenumbers.GetNumberType(num)),
+	}, nil
+}
+
+func (s *Server) Format(ctx context.Context, req *FormatRequest) (*FormatResponse, error) {
+	num, err := requestNumber(req.GetPhoneNumber())
+	if err != nil {
+		return nil, err
+	}
+	if _, valid := PhoneNumberFormat_name[int32(req.GetFormat())]; !valid {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown format: %d", req.GetFormat())
+	}
+
+	return &FormatResponse{Formatted: phonenumbers.Format(num, phonenumbers.PhoneNumberFormat(req.GetFormat()))}, nil
+}
+
+func (s *Server) Validate(ctx context.Context, req *ValidateRequest) (*ValidateResponse, error) {
+	num, err := requestNumber(req.GetPhoneNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	valid := phonenumbers.IsValidNumber(num)
+	if req.GetRegion() != "" {
+		valid = phonenumbers.IsValidNumberForRegion(num, req.GetRegion())
+	}
+
+	reason := phonenumbers.IsPossibleNumberWithReason(num)
+	return &ValidateResponse{
+		Valid:    valid,
+		Possible: reason == phonenumbers.IS_POSSIBLE,
+		Reason:   ValidationResult(reason),
+	}, nil
+}
+
+func (s *Server) Geocode(ctx context.Context, req *GeocodeRequest) (*GeocodeResponse, error) {
+	num, err := requestNumber(req.GetPhoneNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	lang := req.GetLanguage()
+	if lang == "" {
+		lang = "en"
+	}
+
+	description, err := phonenumbers.GetGeocodingForNumber(num, lang)
+	if err == phonenumbers.ErrGeocodingDataNotLoaded {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &GeocodeResponse{Description: description}, nil
+}
+
+// requestNumber checks that a number was included in a request
+func requestNumber(num *phonenumbers.PhoneNumber) (*phonenumbers.PhoneNumber, error) {
+	if num == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing phone_number")
+	}
+	return num, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+
+	parsed, err := server.Parse(ctx, &ParseRequest{Number: "6502530000", Region: "US"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), parsed.PhoneNumber.GetCountryCode())
+	assert.Equal(t, uint64(6502530000), parsed.PhoneNumber.GetNationalNumber())
+	assert.Equal(t, "US", parsed.Region)
+	assert.Equal(t, PhoneNumberType_FIXED_LINE_OR_MOBILE, parsed.Type)
+
+	_, err = server.Parse(ctx, &ParseRequest{Number: "foo", Region: "US"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	formatted, err := server.Format(ctx, &FormatRequest{PhoneNumber: parsed.PhoneNumber, Format: PhoneNumberFormat_NATIONAL})
+	assert.NoError(t, err)
+	assert.Equal(t, "(650) 253-0000", formatted.Formatted)
+
+	_, err = server.Format(ctx, &FormatRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	validated, err := server.Validate(ctx, &ValidateRequest{PhoneNumber: parsed.PhoneNumber})
+	assert.NoError(t, err)
+	assert.True(t, validated.Valid)
+	assert.True(t, validated.Possible)
+	assert.Equal(t, ValidationResult_IS_POSSIBLE, validated.Reason)
+
+	validated, err = server.Validate(ctx, &ValidateRequest{PhoneNumber: parsed.PhoneNumber, Region: "CA"})
+	assert.NoError(t, err)
+	assert.False(t, validated.Valid)
+
+	short, _ := phonenumbers.Parse("650253", "US")
+	validated, err = server.Validate(ctx, &ValidateRequest{PhoneNumber: short})
+	assert.NoError(t, err)
+	assert.False(t, validated.Valid)
+	assert.Equal(t, ValidationResult_TOO_SHORT, validated.Reason)
+
+	// we don't import the geocoding data
+	_, err = server.Geocode(ctx, &GeocodeRequest{PhoneNumber: parsed.PhoneNumber})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
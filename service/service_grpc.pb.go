@@ -0,0 +1,233 @@
+// Definition of a gRPC service exposing parsing, formatting, validation and
+// geocoding of phone numbers.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.4
+// source: service/service.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PhoneNumbers_Parse_FullMethodName    = "/phonenumbers.service.PhoneNumbers/Parse"
+	PhoneNumbers_Format_FullMethodName   = "/phonenumbers.service.PhoneNumbers/Format"
+	PhoneNumbers_Validate_FullMethodName = "/phonenumbers.service.PhoneNumbers/Validate"
+	PhoneNumbers_Geocode_FullMethodName  = "/phonenumbers.service.PhoneNumbers/Geocode"
+)
+
+// PhoneNumbersClient is the client API for PhoneNumbers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PhoneNumbersClient interface {
+	// Parses a number, which is in international format or else national
+	// format for the given region.
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// Formats a parsed number.
+	Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error)
+	// Checks whether a parsed number is possible and valid.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// Gets a text description of the geographical area a parsed number is
+	// from. This requires the server to have been built with geocoding data.
+	Geocode(ctx context.Context, in *GeocodeRequest, opts ...grpc.CallOption) (*GeocodeResponse, error)
+}
+
+type phoneNumbersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPhoneNumbersClient(cc grpc.ClientConnInterface) PhoneNumbersClient {
+	return &phoneNumbersClient{cc}
+}
+
+func (c *phoneNumbersClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, PhoneNumbers_Parse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *phoneNumbersClient) Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error) {
+	out := new(FormatResponse)
+	err := c.cc.Invoke(ctx, PhoneNumbers_Format_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *phoneNumbersClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, PhoneNumbers_Validate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *phoneNumbersClient) Geocode(ctx context.Context, in *GeocodeRequest, opts ...grpc.CallOption) (*GeocodeResponse, error) {
+	out := new(GeocodeResponse)
+	err := c.cc.Invoke(ctx, PhoneNumbers_Geocode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PhoneNumbersServer is the server API for PhoneNumbers service.
+// All implementations should embed UnimplementedPhoneNumbersServer
+// for forward compatibility
+type PhoneNumbersServer interface {
+	// Parses a number, which is in international format or else national
+	// format for the given region.
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// Formats a parsed number.
+	Format(context.Context, *FormatRequest) (*FormatResponse, error)
+	// Checks whether a parsed number is possible and valid.
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// Gets a text description of the geographical area a parsed number is
+	// from. This requires the server to have been built with geocoding data.
+	Geocode(context.Context, *GeocodeRequest) (*GeocodeResponse, error)
+}
+
+// UnimplementedPhoneNumbersServer should be embedded to have forward compatible implementations.
+type UnimplementedPhoneNumbersServer struct {
+}
+
+func (UnimplementedPhoneNumbersServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedPhoneNumbersServer) Format(context.Context, *FormatRequest) (*FormatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Format not implemented")
+}
+func (UnimplementedPhoneNumbersServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedPhoneNumbersServer) Geocode(context.Context, *GeocodeRequest) (*GeocodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Geocode not implemented")
+}
+
+// UnsafePhoneNumbersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PhoneNumbersServer will
+// result in compilation errors.
+type UnsafePhoneNumbersServer interface {
+	mustEmbedUnimplementedPhoneNumbersServer()
+}
+
+func RegisterPhoneNumbersServer(s grpc.ServiceRegistrar, srv PhoneNumbersServer) {
+	s.RegisterService(&PhoneNumbers_ServiceDesc, srv)
+}
+
+func _PhoneNumbers_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhoneNumbersServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhoneNumbers_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhoneNumbersServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhoneNumbers_Format_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhoneNumbersServer).Format(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhoneNumbers_Format_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhoneNumbersServer).Format(ctx, req.(*FormatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhoneNumbers_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhoneNumbersServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhoneNumbers_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhoneNumbersServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhoneNumbers_Geocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeocodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhoneNumbersServer).Geocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhoneNumbers_Geocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhoneNumbersServer).Geocode(ctx, req.(*GeocodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PhoneNumbers_ServiceDesc is the grpc.ServiceDesc for PhoneNumbers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PhoneNumbers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "phonenumbers.service.PhoneNumbers",
+	HandlerType: (*PhoneNumbersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _PhoneNumbers_Parse_Handler,
+		},
+		{
+			MethodName: "Format",
+			Handler:    _PhoneNumbers_Format_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _PhoneNumbers_Validate_Handler,
+		},
+		{
+			MethodName: "Geocode",
+			Handler:    _PhoneNumbers_Geocode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/service.proto",
+}
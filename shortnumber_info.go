@@ -1,19 +1,34 @@
 package phonenumbers
 
 import (
+	"sync"
+
 	proto "google.golang.org/protobuf/proto"
 )
 
 var (
-	shortNumberRegionToMetadataMap = make(map[string]*PhoneMetadata)
+	shortNumberRegionToMetadataMap = make(map[string]*lazyMetadata)
+)
+
+// ShortNumberCost is the expected cost of dialing a short number
+type ShortNumberCost int
+
+const (
+	SHORT_NUMBER_TOLL_FREE ShortNumberCost = iota
+	SHORT_NUMBER_STANDARD_RATE
+	SHORT_NUMBER_PREMIUM_RATE
+	SHORT_NUMBER_UNKNOWN_COST
 )
 
 func readFromShortNumberRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
 	v, ok := shortNumberRegionToMetadataMap[key]
-	return v, ok
+	if !ok {
+		return nil, false
+	}
+	return v.get(), true
 }
 
-func writeToShortNumberRegionToMetadataMap(key string, val *PhoneMetadata) {
+func writeToShortNumberRegionToMetadataMap(key string, val *lazyMetadata) {
 	shortNumberRegionToMetadataMap[key] = val
 }
 
@@ -27,9 +42,15 @@ func init() {
 var (
 	currShortNumberMetadataColl *PhoneMetadataCollection
 	shortNumberReloadMetadata   = true
+
+	// guards the above when ShortNumberMetadataCollection is called concurrently
+	shortNumberMetadataCollMutex sync.Mutex
 )
 
 func ShortNumberMetadataCollection() (*PhoneMetadataCollection, error) {
+	shortNumberMetadataCollMutex.Lock()
+	defer shortNumberMetadataCollMutex.Unlock()
+
 	if !shortNumberReloadMetadata {
 		return currShortNumberMetadataColl, nil
 	}
@@ -41,30 +62,37 @@ func ShortNumberMetadataCollection() (*PhoneMetadataCollection, error) {
 
 	metadataCollection := &PhoneMetadataCollection{}
 	err = proto.Unmarshal(rawBytes, metadataCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	currShortNumberMetadataColl = metadataCollection
 	shortNumberReloadMetadata = false
-	return metadataCollection, err
+	return metadataCollection, nil
 }
 
+// loadShortNumberMetadataFromFile splits our embedded short number metadata by region, leaving
+// each to be unmarshalled when first used
 func loadShortNumberMetadataFromFile() error {
-	metadataCollection, err := ShortNumberMetadataCollection()
+	rawBytes, err := decodeUnzipString(shortNumberMetadataData)
 	if err != nil {
 		return err
-	} else if currShortNumberMetadataColl == nil {
-		currShortNumberMetadataColl = metadataCollection
-	}
-
-	metadataList := metadataCollection.GetMetadata()
-	if len(metadataList) == 0 {
-		return ErrEmptyMetadata
 	}
 
-	for _, meta := range metadataList {
-		region := meta.GetId()
-		if region == "001" {
+	found := 0
+	err = splitMetadataCollection(rawBytes, func(id string, countryCode int32, encoded []byte) {
+		found++
+		if id == "001" {
 			// it's a non geographical entity, unused
-		} else {
-			writeToShortNumberRegionToMetadataMap(region, meta)
+			return
 		}
+		writeToShortNumberRegionToMetadataMap(id, newLazyMetadata(encoded))
+	})
+	if err != nil {
+		return err
+	}
+	if found == 0 {
+		return ErrEmptyMetadata
 	}
 	return nil
 }
@@ -136,6 +164,153 @@ func IsValidShortNumberForRegion(number *PhoneNumber, regionDialingFrom string)
 	return matchesPossibleNumberAndNationalNumber(shortNumber, shortNumberDesc)
 }
 
+// Gets the expected cost category of a short number when dialed from a region (however, nothing is
+// implied about its validity). If it is important that the number is valid, then its validity
+// must first be checked using IsValidShortNumberForRegion. Emergency numbers are reported as
+// SHORT_NUMBER_TOLL_FREE.
+func GetExpectedCostForRegion(number *PhoneNumber, regionDialingFrom string) ShortNumberCost {
+	if !regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+
+	shortNumber := GetNationalSignificantNumber(number)
+
+	// The possible lengths are not present for a particular sub-type if they match the general
+	// description; for this reason, we check the possible lengths against the general description
+	// first to allow an early exit if possible.
+	if !phoneMetadata.GeneralDesc.hasPossibleLength(int32(len(shortNumber))) {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+
+	// The cost categories are tested in order of decreasing expense, since if for some reason the
+	// patterns overlap the most expensive matching cost category should be returned.
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetPremiumRate()) {
+		return SHORT_NUMBER_PREMIUM_RATE
+	}
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetStandardRate()) {
+		return SHORT_NUMBER_STANDARD_RATE
+	}
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetTollFree()) {
+		return SHORT_NUMBER_TOLL_FREE
+	}
+	if isEmergencyNumber(shortNumber, phoneMetadata) {
+		// Emergency numbers are implicitly toll-free.
+		return SHORT_NUMBER_TOLL_FREE
+	}
+	return SHORT_NUMBER_UNKNOWN_COST
+}
+
+// Helper method to check whether the passed in short number is exactly one of the emergency
+// numbers in the passed in metadata.
+func isEmergencyNumber(shortNumber string, phoneMetadata *PhoneMetadata) bool {
+	emergency := phoneMetadata.GetEmergency()
+	if emergency == nil {
+		return false
+	}
+	return matchNationalNumber(shortNumber, emergency, false)
+}
+
+// Gets the expected cost category of a short number (however, nothing is implied about its
+// validity). If the country calling code is unique to a region, this method behaves exactly the
+// same as GetExpectedCostForRegion. However, if the country calling code is shared by
+// multiple regions, then it returns the highest cost in the sequence PREMIUM_RATE, UNKNOWN_COST,
+// STANDARD_RATE, TOLL_FREE. The reason for the position of UNKNOWN_COST in this order is that
+// if a number is UNKNOWN_COST in one region but STANDARD_RATE or TOLL_FREE in another, its
+// expected cost cannot be estimated as one of the latter since it might be a PREMIUM_RATE number.
+func GetExpectedCost(number *PhoneNumber) ShortNumberCost {
+	regionCodes := GetRegionCodesForCountryCode(number.GetCountryCode())
+	if len(regionCodes) == 0 {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+	if len(regionCodes) == 1 {
+		return GetExpectedCostForRegion(number, regionCodes[0])
+	}
+
+	cost := SHORT_NUMBER_TOLL_FREE
+	for _, regionCode := range regionCodes {
+		switch GetExpectedCostForRegion(number, regionCode) {
+		case SHORT_NUMBER_PREMIUM_RATE:
+			return SHORT_NUMBER_PREMIUM_RATE
+		case SHORT_NUMBER_UNKNOWN_COST:
+			cost = SHORT_NUMBER_UNKNOWN_COST
+		case SHORT_NUMBER_STANDARD_RATE:
+			if cost != SHORT_NUMBER_UNKNOWN_COST {
+				cost = SHORT_NUMBER_STANDARD_RATE
+			}
+		}
+	}
+	return cost
+}
+
+// Gets a valid short number for the specified region, or an empty string if there is no
+// metadata for the region.
+func GetExampleShortNumber(regionCode string) string {
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	if phoneMetadata == nil {
+		return ""
+	}
+	return phoneMetadata.GetShortCode().GetExampleNumber()
+}
+
+// Gets a valid short number for the specified cost category, or an empty string if there
+// is no example for that cost in the region.
+func GetExampleShortNumberForCost(regionCode string, cost ShortNumberCost) string {
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	if phoneMetadata == nil {
+		return ""
+	}
+
+	var desc *PhoneNumberDesc
+	switch cost {
+	case SHORT_NUMBER_TOLL_FREE:
+		desc = phoneMetadata.GetTollFree()
+	case SHORT_NUMBER_STANDARD_RATE:
+		desc = phoneMetadata.GetStandardRate()
+	case SHORT_NUMBER_PREMIUM_RATE:
+		desc = phoneMetadata.GetPremiumRate()
+	}
+	return desc.GetExampleNumber()
+}
+
+// Given a valid short number, determines whether it is carrier-specific (however, nothing is
+// implied about its validity). Carrier-specific numbers may connect to a different end-point, or
+// not connect at all, depending on the user's carrier. If it is important that the number is
+// valid, then its validity must first be checked using IsValidShortNumber.
+func IsCarrierSpecific(number *PhoneNumber) bool {
+	regionCodes := GetRegionCodesForCountryCode(number.GetCountryCode())
+	regionCode := getRegionCodeForShortNumberFromRegionList(number, regionCodes)
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	return phoneMetadata != nil && matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// Given a valid short number, determines whether it is carrier-specific when dialed from the
+// given region (however, nothing is implied about its validity).
+func IsCarrierSpecificForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	if !regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil && matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// Given a valid short number, determines whether it is an SMS service (however, nothing is
+// implied about its validity). An SMS service is where the primary or only intended usage is to
+// receive and/or send text messages (SMSs). This includes MMS as MMS numbers downgrade to SMS if
+// the other party isn't MMS-capable.
+func IsSmsServiceForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	if !regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil && matchesPossibleNumberAndNationalNumber(GetNationalSignificantNumber(number), phoneMetadata.GetSmsServices())
+}
+
 func getShortNumberMetadataForRegion(regionCode string) *PhoneMetadata {
 	val, _ := readFromShortNumberRegionToMetadataMap(regionCode)
 	return val
@@ -180,5 +355,5 @@ func matchesPossibleNumberAndNationalNumber(number string, numberDesc *PhoneNumb
 	if len(numberDesc.PossibleLength) > 0 && !numberDesc.hasPossibleLength(int32(len(number))) {
 		return false
 	}
-	return MatchNationalNumber(number, *numberDesc, false)
+	return matchNationalNumber(number, numberDesc, false)
 }
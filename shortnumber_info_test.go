@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 ////////// Copied from java-libphonenumber
@@ -72,3 +73,155 @@ func TestIsValidShortNumber(t *testing.T) {
 	}
 	assert.False(t, IsValidShortNumberForRegion(invalidNumber, "FR"))
 }
+
+func TestGetExpectedCost(t *testing.T) {
+	tests := []struct {
+		region string
+		cost   ShortNumberCost
+	}{
+		{"FR", SHORT_NUMBER_PREMIUM_RATE},
+		{"FR", SHORT_NUMBER_STANDARD_RATE},
+		{"FR", SHORT_NUMBER_TOLL_FREE},
+	}
+
+	for _, tc := range tests {
+		example := GetExampleShortNumberForCost(tc.region, tc.cost)
+		assert.NotEmpty(t, example, "no example for cost %d in %s", tc.cost, tc.region)
+
+		number, err := Parse(example, tc.region)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.cost, GetExpectedCostForRegion(number, tc.region), "cost mismatch for %s", example)
+		assert.Equal(t, tc.cost, GetExpectedCost(number), "cost mismatch for %s", example)
+	}
+
+	unknownCostNumber := &PhoneNumber{CountryCode: 33, NationalNumber: 12345}
+	assert.Equal(t, SHORT_NUMBER_UNKNOWN_COST, GetExpectedCostForRegion(unknownCostNumber, "FR"))
+	assert.Equal(t, SHORT_NUMBER_UNKNOWN_COST, GetExpectedCost(unknownCostNumber))
+
+	// Test that an invalid country code always leads to an unknown cost.
+	invalidNumber := &PhoneNumber{CountryCode: 0, NationalNumber: 12345}
+	assert.Equal(t, SHORT_NUMBER_UNKNOWN_COST, GetExpectedCostForRegion(invalidNumber, "FR"))
+	assert.Equal(t, SHORT_NUMBER_UNKNOWN_COST, GetExpectedCost(invalidNumber))
+
+	// Dialing from a region which doesn't match the number is always unknown
+	frNumber, err := Parse(GetExampleShortNumberForCost("FR", SHORT_NUMBER_TOLL_FREE), "FR")
+	assert.NoError(t, err)
+	assert.Equal(t, SHORT_NUMBER_UNKNOWN_COST, GetExpectedCostForRegion(frNumber, "US"))
+}
+
+func TestGetExampleShortNumber(t *testing.T) {
+	assert.NotEmpty(t, GetExampleShortNumber("FR"))
+	assert.Equal(t, "", GetExampleShortNumber("XX"))
+	assert.Equal(t, "", GetExampleShortNumberForCost("XX", SHORT_NUMBER_TOLL_FREE))
+	assert.Equal(t, "", GetExampleShortNumberForCost("FR", SHORT_NUMBER_UNKNOWN_COST))
+}
+
+func TestIsCarrierSpecific(t *testing.T) {
+	carrierSpecificNumber := &PhoneNumber{CountryCode: 1, NationalNumber: 33669}
+	assert.True(t, IsCarrierSpecific(carrierSpecificNumber))
+	assert.True(t, IsCarrierSpecificForRegion(carrierSpecificNumber, "US"))
+
+	notCarrierSpecificNumber := &PhoneNumber{CountryCode: 1, NationalNumber: 911}
+	assert.False(t, IsCarrierSpecific(notCarrierSpecificNumber))
+	assert.False(t, IsCarrierSpecificForRegion(notCarrierSpecificNumber, "US"))
+
+	carrierSpecificNumberForSomeRegion := &PhoneNumber{CountryCode: 1, NationalNumber: 211}
+	assert.True(t, IsCarrierSpecific(carrierSpecificNumberForSomeRegion))
+	assert.True(t, IsCarrierSpecificForRegion(carrierSpecificNumberForSomeRegion, "US"))
+	assert.False(t, IsCarrierSpecificForRegion(carrierSpecificNumberForSomeRegion, "BB"))
+}
+
+// usShortNumberXML is the short number metadata of the US, with its emergency and SMS service numbers,
+// in the format of ShortNumberMetadata.xml
+const usShortNumberXML = `<phoneNumberMetadata>
+  <territories>
+    <territory id="US">
+      <generalDesc>
+        <nationalNumberPattern>[1-9]\d{2,5}</nationalNumberPattern>
+      </generalDesc>
+      <tollFree>
+        <possibleLengths national="3"/>
+        <exampleNumber>112</exampleNumber>
+        <nationalNumberPattern>112|611|911</nationalNumberPattern>
+      </tollFree>
+      <premiumRate>
+        <possibleLengths national="5,6"/>
+        <exampleNumber>24280</exampleNumber>
+        <nationalNumberPattern>24280|(?:381|968)35|4(?:3[0-2]|6[57])\d\d</nationalNumberPattern>
+      </premiumRate>
+      <shortCode>
+        <possibleLengths national="[3-6]"/>
+        <exampleNumber>112</exampleNumber>
+        <nationalNumberPattern>112|[2-9]11|[2-9]\d{4,5}</nationalNumberPattern>
+      </shortCode>
+      <standardRate>
+        <possibleLengths national="5"/>
+        <exampleNumber>20000</exampleNumber>
+        <nationalNumberPattern>2\d{4}</nationalNumberPattern>
+      </standardRate>
+      <emergency>
+        <possibleLengths national="3"/>
+        <exampleNumber>112</exampleNumber>
+        <nationalNumberPattern>112|933|911</nationalNumberPattern>
+      </emergency>
+      <carrierSpecific>
+        <possibleLengths national="3,5"/>
+        <exampleNumber>211</exampleNumber>
+        <nationalNumberPattern>[2-8]11|33669</nationalNumberPattern>
+      </carrierSpecific>
+      <smsServices>
+        <possibleLengths national="5,6"/>
+        <exampleNumber>21234</exampleNumber>
+        <nationalNumberPattern>[2-9]\d{4,5}</nationalNumberPattern>
+      </smsServices>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`
+
+// withShortNumberMetadata calls fn with the short number metadata of a region replaced by that
+// built from the passed in XML
+func withShortNumberMetadata(t *testing.T, region string, xml string, fn func()) {
+	collection, err := BuildPhoneMetadataCollection([]byte(xml), false, false, true)
+	require.NoError(t, err)
+	require.Len(t, collection.GetMetadata(), 1)
+
+	original := shortNumberRegionToMetadataMap[region]
+	defer writeToShortNumberRegionToMetadataMap(region, original)
+
+	writeToShortNumberRegionToMetadataMap(region, newLoadedMetadata(collection.GetMetadata()[0]))
+	fn()
+}
+
+func TestIsSmsServiceForRegion(t *testing.T) {
+	withShortNumberMetadata(t, "US", usShortNumberXML, func() {
+		smsServiceNumber := &PhoneNumber{CountryCode: 1, NationalNumber: 21234}
+		assert.True(t, IsSmsServiceForRegion(smsServiceNumber, "US"))
+		assert.False(t, IsSmsServiceForRegion(smsServiceNumber, "BB"))
+
+		notSmsServiceNumber := &PhoneNumber{CountryCode: 1, NationalNumber: 14}
+		assert.False(t, IsSmsServiceForRegion(notSmsServiceNumber, "US"))
+		assert.False(t, IsSmsServiceForRegion(&PhoneNumber{CountryCode: 1, NationalNumber: 911}, "US"))
+	})
+}
+
+func TestGetExpectedCostForEmergencyNumbers(t *testing.T) {
+	withShortNumberMetadata(t, "US", usShortNumberXML, func() {
+		tests := []struct {
+			nationalNumber uint64
+			cost           ShortNumberCost
+		}{
+			// toll free as well as an emergency number
+			{911, SHORT_NUMBER_TOLL_FREE},
+			// only listed as an emergency number
+			{933, SHORT_NUMBER_TOLL_FREE},
+			{24280, SHORT_NUMBER_PREMIUM_RATE},
+			{211, SHORT_NUMBER_UNKNOWN_COST},
+			// emergency numbers have to match exactly
+			{9330, SHORT_NUMBER_UNKNOWN_COST},
+		}
+		for _, tc := range tests {
+			number := &PhoneNumber{CountryCode: 1, NationalNumber: tc.nationalNumber}
+			assert.Equal(t, tc.cost, GetExpectedCostForRegion(number, "US"), "cost mismatch for %d", tc.nationalNumber)
+		}
+	})
+}
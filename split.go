@@ -0,0 +1,29 @@
+package phonenumbers
+
+// NumberComponents is a number split into its structural parts
+type NumberComponents struct {
+	CountryCode             int32
+	NationalDestinationCode string // e.g. the area code, empty if the number doesn't have one
+	SubscriberNumber        string
+	Extension               string
+}
+
+// SplitNumber splits the passed in number into its country code, national destination code,
+// subscriber number and extension. The national destination code and subscriber number together
+// make up the national significant number, and where the one ends and the other begins is decided
+// by GetLengthOfNationalDestinationCode.
+func SplitNumber(number *PhoneNumber) NumberComponents {
+	nsn := GetNationalSignificantNumber(number)
+
+	ndcLength := GetLengthOfNationalDestinationCode(number)
+	if ndcLength > len(nsn) {
+		ndcLength = len(nsn)
+	}
+
+	return NumberComponents{
+		CountryCode:             number.GetCountryCode(),
+		NationalDestinationCode: nsn[:ndcLength],
+		SubscriberNumber:        nsn[ndcLength:],
+		Extension:               number.GetExtension(),
+	}
+}
@@ -0,0 +1,31 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitNumber(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected NumberComponents
+	}{
+		{"+16502530000", NumberComponents{1, "650", "2530000", ""}},
+		{"+16502530000;ext=123", NumberComponents{1, "650", "2530000", "123"}},
+		{"+442070313000", NumberComponents{44, "20", "70313000", ""}},
+		{"+4930123456", NumberComponents{49, "30", "123456", ""}},
+		{"+33123456789", NumberComponents{33, "1", "23456789", ""}},
+		{"+5491187654321", NumberComponents{54, "911", "87654321", ""}},
+		{"+6565218000", NumberComponents{65, "6521", "8000", ""}},
+		{"+80012345678;ext=1", NumberComponents{800, "1234", "5678", "1"}},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.number, UNKNOWN_REGION)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.expected, SplitNumber(number), "components mismatch for %s", tc.number)
+	}
+}
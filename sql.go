@@ -0,0 +1,32 @@
+package phonenumbers
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer so that numbers can be written directly to text columns with
+// database/sql, in E164 format. Note that E164 has no room for extensions, so they aren't stored.
+// A nil number is written as NULL.
+func (x *PhoneNumber) Value() (driver.Value, error) {
+	if x == nil {
+		return nil, nil
+	}
+	return Format(x, E164), nil
+}
+
+// Scan implements sql.Scanner so that numbers can be read directly from text columns holding
+// numbers in E164 format with database/sql. Scanning NULL resets the number, so for nullable
+// columns scan into a **PhoneNumber instead, which database/sql will set to nil.
+func (x *PhoneNumber) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		x.Reset()
+		return nil
+	case string:
+		return ParseInto(src, UNKNOWN_REGION, x)
+	case []byte:
+		return ParseInto(string(src), UNKNOWN_REGION, x)
+	}
+	return fmt.Errorf("unable to scan %T into PhoneNumber", src)
+}
@@ -0,0 +1,50 @@
+package phonenumbers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ driver.Valuer = &PhoneNumber{}
+var _ sql.Scanner = &PhoneNumber{}
+
+func TestSQLValue(t *testing.T) {
+	number, err := Parse("(650) 253-0000 ext. 123", "US")
+	require.NoError(t, err)
+
+	value, err := number.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "+16502530000", value)
+
+	var nilNumber *PhoneNumber
+	value, err = nilNumber.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	// and the value can be used as a parameter by database/sql
+	value, err = driver.DefaultParameterConverter.ConvertValue(number)
+	assert.NoError(t, err)
+	assert.Equal(t, "+16502530000", value)
+}
+
+func TestSQLScan(t *testing.T) {
+	number := &PhoneNumber{}
+	assert.NoError(t, number.Scan("+16502530000"))
+	assert.Equal(t, int32(1), number.GetCountryCode())
+	assert.Equal(t, uint64(6502530000), number.GetNationalNumber())
+
+	assert.NoError(t, number.Scan([]byte("+442070313000")))
+	assert.Equal(t, int32(44), number.GetCountryCode())
+	assert.Equal(t, uint64(2070313000), number.GetNationalNumber())
+
+	assert.NoError(t, number.Scan(nil))
+	assert.Equal(t, int32(0), number.GetCountryCode())
+	assert.Equal(t, uint64(0), number.GetNationalNumber())
+
+	assert.Equal(t, ErrInvalidCountryCode, number.Scan("6502530000"))
+	assert.EqualError(t, number.Scan(int64(16502530000)), "unable to scan int64 into PhoneNumber")
+}
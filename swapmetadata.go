@@ -0,0 +1,65 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// SwapMetadata replaces the metadata used by this package with the passed in collection, such as
+// one with updated numbering plans decoded from a newer release of libphonenumber, without having
+// to restart the process. It's safe to call at any time, as the metadata is replaced all at once
+// and every lookup sees either the old or the new metadata, never a mix of the two. A call which is
+// already running when it's replaced may however look up the old metadata for some steps and the
+// new for later ones, e.g. parsing a number with one and validating it with the other, so results
+// of calls made during a swap may not be consistent. Calls made afterwards only use the new
+// metadata. Our caches of compiled regular expressions are emptied so that they don't hold on to
+// patterns which are no longer used.
+//
+// The collection is checked before it's used and an error is returned if it's empty, if any of its
+// regions has no id or country code or if any of its patterns don't compile, in which case the
+// current metadata is kept. Use ResetMetadata to go back to the embedded metadata.
+func SwapMetadata(metadataCollection *PhoneMetadataCollection) error {
+	if len(metadataCollection.GetMetadata()) == 0 {
+		return ErrEmptyMetadata
+	}
+	for _, metadata := range metadataCollection.GetMetadata() {
+		if err := checkMetadata(metadata); err != nil {
+			return err
+		}
+	}
+
+	tables, err := loadMetadataTables(BuildCountryCodeToRegionMap(metadataCollection), metadataCollection)
+	if err != nil {
+		return err
+	}
+
+	useMetadata(tables, metadataCollection)
+	regexCache.reset()
+	strictRegexCache.reset()
+	return nil
+}
+
+// checkMetadata returns an error if the passed in metadata can't be used
+func checkMetadata(metadata *PhoneMetadata) error {
+	if metadata.GetId() == "" || metadata.GetCountryCode() <= 0 {
+		return fmt.Errorf("metadata for %q has no id or country code", metadata.GetId())
+	}
+
+	patterns := []string{metadata.GetInternationalPrefix(), metadata.GetNationalPrefixForParsing(), metadata.GetLeadingDigits()}
+	for _, desc := range metadata.descs() {
+		patterns = append(patterns, desc.GetNationalNumberPattern())
+	}
+	for _, formats := range [][]*NumberFormat{metadata.GetNumberFormat(), metadata.GetIntlNumberFormat()} {
+		for _, format := range formats {
+			patterns = append(patterns, format.GetPattern())
+			patterns = append(patterns, format.GetLeadingDigitsPattern()...)
+		}
+	}
+
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("metadata for %s has invalid pattern: %w", metadata.GetId(), err)
+		}
+	}
+	return nil
+}
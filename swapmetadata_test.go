@@ -0,0 +1,67 @@
+package phonenumbers
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSwapMetadata(t *testing.T) {
+	defer ResetMetadata()
+
+	assert.Equal(t, ErrEmptyMetadata, SwapMetadata(&PhoneMetadataCollection{}))
+
+	// collections which can't be used are rejected and the current metadata is kept
+	invalid := &PhoneMetadataCollection{Metadata: []*PhoneMetadata{
+		{Id: "AD", CountryCode: proto.Int32(376), GeneralDesc: &PhoneNumberDesc{NationalNumberPattern: proto.String("[1-9")}},
+	}}
+	assert.EqualError(t, SwapMetadata(invalid), "metadata for AD has invalid pattern: error parsing regexp: missing closing ]: `[1-9`")
+	assert.Error(t, SwapMetadata(&PhoneMetadataCollection{Metadata: []*PhoneMetadata{{Id: "AD"}}}))
+	assert.True(t, GetSupportedRegions()["US"])
+
+	embedded, err := MetadataCollection()
+	require.NoError(t, err)
+
+	// swap in a copy of our metadata where Rwandan mobile numbers can also start with 74
+	updated := proto.Clone(embedded).(*PhoneMetadataCollection)
+	for _, metadata := range updated.GetMetadata() {
+		if metadata.GetId() == "RW" {
+			metadata.GeneralDesc.NationalNumberPattern = proto.String("(?:74\\d|" + metadata.GetGeneralDesc().GetNationalNumberPattern() + ")\\d*")
+			metadata.Mobile.NationalNumberPattern = proto.String("74\\d{7}|" + metadata.GetMobile().GetNationalNumberPattern())
+		}
+	}
+
+	num, err := Parse("0748383383", "RW")
+	require.NoError(t, err)
+	assert.False(t, IsValidNumber(num))
+
+	require.NoError(t, SwapMetadata(updated))
+	assert.True(t, IsValidNumber(num))
+	assert.Equal(t, MOBILE, GetNumberType(num))
+	assert.True(t, GetSupportedRegions()["US"])
+
+	require.NoError(t, ResetMetadata())
+	assert.False(t, IsValidNumber(num))
+
+	// numbers can be parsed and validated while metadata is being swapped
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				num, err := Parse("0788383383", "RW")
+				assert.NoError(t, err)
+				assert.True(t, IsValidNumber(num))
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		require.NoError(t, SwapMetadata(updated))
+		require.NoError(t, ResetMetadata())
+	}
+	wg.Wait()
+}
@@ -0,0 +1,3 @@
+package testmetadata
+
+var metadataData = "H4sIAAAAAAAA/5RVP28jRRTXrON/4yO6G2SS+MIpMT7wWl7dmz87f9xERJzQuUBRgObGK6GTvwDteVaioKSgdoUoqSj4BEg0tPcNaKn4BmhmZx0750ug2J3Rm9/793vvzeA/EpyS8/HFjAnm9PhiBuCkdEo5rVNnANLFcqXKOcLXqE2+Jd8wwcYXM+6RzFnGZWFpZgonLGRM+F1uIZO6cMryLFeFM5ZleZE64b1YxlXhcuqkcEqlLgfmJJdOAfOeRMkwE4zLnDIurlF7kJLH0WWuHM+NE7lyea620bkCABh+RPq3MwjBM6wBmDeYy8kHpGMAotxs5OyAJF98Ogv/y/CfJ5dfXqGXDaD0FaKvEf0FBfnv1fJntfxVLfi3A/yYdHxMUyrKOW622p0u7j147/C6kTQOyAviQ7NMZLJYLFesdNwCz0yxWDqrtCl84JZ5/gKfi2XqM6RTXbIOhyr4YGqgyVM6vpjli+XKlE4tlitdOmmBFXHLQ3Yp69HAYi6VNvME94YnpKM3uWuIVtUc4cmEHBsAHyLluTckS2eiHWxuoAnu7aPqs+dX9GUC8ArBawRrpPHD8WK54mUalqkuU9Ic0bMRGxwzx63P3BmQzvqWCRnTYQNGdI2m+JHXYZWqmFJa6T4bsUHPclGAs1IXJsKfV55EBWdTdeOpGYwPnlhPurMqCywvlpZu0xztjDG5idh/K1mmpBMsnY34oKEBIjTDRzvJRd9iF28i/s62edPAD0nLFxrCjDVa7Q75kGBLqz4xJcM0tqjS4XhwSg6VpVnu8wkVZ1iZGjLsk7aGKNZ12fTkhPSMBaprDbM5Yk/Jke9N4cfVTy1krNgMjuARN+uTtqoNK6jFl33SzmUU5zJK9Tz5/PJqut0RAve3y7qPsgc+Kz8AShc114BP6nrw8tZCerXq2UgMkIwqFPe3XGzBbzx1NwRGnQz3d8FvR9fUVqji3pJ+lyD8T4KPyaEFrkOF8imFct6qbgRyRLqeRuOFDAPjUlLNAQYnpOMnV09NybpxbIHOky4enpP3tR9OryhL52G8TFlLhzrMk1Z34iHG37DhLMyuDBDDImTf3L746uoTX6U1Gt9fnyZYJos1erbT/zXP010wBkv9/Z+ZYo0+3p2Y/RqIr9HprXtD3twbSN85SX8neEROvU9ZsW5KxyxkPK/HJL5h2d0ohjd3Jvj35//B736DIu6tNyjK9xXo69036EeE1ugIH26RJDYUrdH5vW3883/ARJ5/ReidHf49wpg0I60dEuSD8B8+inLWqZmZhIM92TUA6NVPre2kthqvSurOov9Qh2HKOeruhBH2ExJPWXdTpnfF8aYd4ngS3oH0Viy3udkbzr8DAB6UJUJNCQAA"
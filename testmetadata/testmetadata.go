@@ -0,0 +1,63 @@
+// Package testmetadata contains the metadata libphonenumber uses for its own unit tests, built from
+// PhoneNumberMetadataForTesting.xml by the buildmetadata command. Unlike the real metadata, this
+// describes a small set of stable, partly fictional, numbering plans, so tests written against it
+// don't break every time a country changes its numbering plan.
+//
+// Load it from TestMain and reset it when you are done:
+//
+//	func TestMain(m *testing.M) {
+//		if err := testmetadata.Load(); err != nil {
+//			panic(err)
+//		}
+//		code := m.Run()
+//		phonenumbers.ResetMetadata()
+//		os.Exit(code)
+//	}
+package testmetadata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+
+	"github.com/nyaruka/phonenumbers"
+	"google.golang.org/protobuf/proto"
+)
+
+// Collection returns a new copy of the test metadata collection
+func Collection() (*phonenumbers.PhoneMetadataCollection, error) {
+	data, err := base64.StdEncoding.DecodeString(metadataData)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	rawBytes, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	collection := &phonenumbers.PhoneMetadataCollection{}
+	if err := proto.Unmarshal(rawBytes, collection); err != nil {
+		return nil, err
+	}
+	if len(collection.GetMetadata()) == 0 {
+		return nil, phonenumbers.ErrEmptyMetadata
+	}
+	return collection, nil
+}
+
+// Load replaces the metadata used by the phonenumbers package with the test metadata. Call
+// phonenumbers.ResetMetadata to go back to the real metadata.
+func Load() error {
+	collection, err := Collection()
+	if err != nil {
+		return err
+	}
+	return phonenumbers.LoadMetadataCollection(collection)
+}
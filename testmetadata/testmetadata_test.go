@@ -0,0 +1,62 @@
+package testmetadata
+
+import (
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollection(t *testing.T) {
+	collection, err := Collection()
+	require.NoError(t, err)
+
+	regions := make(map[string]bool)
+	for _, metadata := range collection.GetMetadata() {
+		regions[metadata.GetId()] = true
+	}
+	for _, region := range []string{"US", "GB", "DE", "001"} {
+		assert.True(t, regions[region], "missing region %s", region)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	require.NoError(t, Load())
+	defer phonenumbers.ResetMetadata()
+
+	tests := []struct {
+		number    string
+		region    string
+		valid     bool
+		numType   phonenumbers.PhoneNumberType
+		national  string
+		e164      string
+		numRegion string
+	}{
+		// not a valid number with the real metadata, as no NANPA area code starts with 1
+		{"123 456 7890", "US", true, phonenumbers.FIXED_LINE_OR_MOBILE, "123 456 7890", "+11234567890", "US"},
+		{"800 456 7890", "US", true, phonenumbers.TOLL_FREE, "800 456 7890", "+18004567890", "US"},
+		{"07912 345678", "GB", true, phonenumbers.MOBILE, "07912 345 678", "+447912345678", "GB"},
+		{"+44 70 1234 5678", "", true, phonenumbers.PERSONAL_NUMBER, "070 1234 5678", "+447012345678", "GB"},
+		{"+800 1234 5678", "", true, phonenumbers.TOLL_FREE, "1234 5678", "+80012345678", "001"},
+		{"+1 242 357 1234", "", true, phonenumbers.MOBILE, "242 357 1234", "+12423571234", "BS"},
+		{"+1 247 123 4567", "", false, phonenumbers.UNKNOWN, "247 123 4567", "+12471234567", ""},
+	}
+	for _, tc := range tests {
+		number, err := phonenumbers.Parse(tc.number, tc.region)
+		require.NoError(t, err, "error parsing %s", tc.number)
+
+		assert.Equal(t, tc.valid, phonenumbers.IsValidNumber(number), "valid mismatch for %s", tc.number)
+		assert.Equal(t, tc.numType, phonenumbers.GetNumberType(number), "type mismatch for %s", tc.number)
+		assert.Equal(t, tc.national, phonenumbers.Format(number, phonenumbers.NATIONAL), "national mismatch for %s", tc.number)
+		assert.Equal(t, tc.e164, phonenumbers.Format(number, phonenumbers.E164), "e164 mismatch for %s", tc.number)
+		assert.Equal(t, tc.numRegion, phonenumbers.GetRegionCodeForNumber(number), "region mismatch for %s", tc.number)
+	}
+
+	// and once reset we're back to the real metadata
+	phonenumbers.ResetMetadata()
+	number, err := phonenumbers.Parse("123 456 7890", "US")
+	require.NoError(t, err)
+	assert.False(t, phonenumbers.IsValidNumber(number))
+}
@@ -0,0 +1,12 @@
+module github.com/nyaruka/phonenumbers/timezonedata
+
+go 1.18
+
+replace github.com/nyaruka/phonenumbers => ../
+
+require github.com/nyaruka/phonenumbers v0.0.0-00010101000000-000000000000
+
+require (
+	golang.org/x/text v0.12.0 // indirect
+	google.golang.org/protobuf v1.31.0 // indirect
+)
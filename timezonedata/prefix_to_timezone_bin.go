@@ -1,3 +1,3 @@
-package phonenumbers
+package timezonedata
 
 var timezoneMapData = "H4sIAAAAAAAA/5S7C3gkV3Unfn63ulut1mOkmdGM7bE92NgYG9vD42/AIkKZhz2e0WgszWjGf1sm4nZ3TXdJ3VWa6qqxNbAbs4EQNsB4EyBgWNhlY4cAyxpiTBaQx06WZB3YOOFhWDBhbZbHEgjYCTYJr/1u9blVp0uS+Vbfd+rec+6555x77jn30VW6YpRo94nQq+ldu6tefVH7FYvWaqFOkXrd6yzsrupqRuq03Yxhj27rpSDD/EbsCWwxbqVYS/vRSuimeKhPn9anvFYrI8WLcbsaZ9L3ai9Mhe8NfL0Urlh0n17SYYaEC25n4ahuad1OiYteNYij1J59QaxbqezrQ9eNgtvSce/X1SAM/NSYG3SoM2sPBk3t+26nGocNS5vS7WUhcKqpwyiIU/VTXkO3vAzzO03dSbkP6UbQSRGvGro9rjgUtLN6rP161jGuxu2q7jS9jNLRS2n7tG7papBhy3EksI4bxilmpjUb8HTQ0HWv00zbDxvvV1M1h+uLuu36qaLDnm676XQcDmK9VGsGUWQpN8a6oetB3AhSiTNBGAVXHQ5OpRYd1cHCnBjrXOx7qVtu8vx6M3CXKrvbLoejFohfawahbriC0oi9lpmRlBB5jVjgYVwVWCf2a17gp4Q9OqzqupkWS3Bb3ulM/p6gEURaoF5HNMauH3QWdnuhmwnYq0Nd0xJfcX3flXhbZwbsbXo13QgyPOhEeuGIV8uU7o2NxIxln+ufcsMMDdqeL/mvq7cDPxKDvK5l0uSUrgdZr+uDMFo47LY6gm9/UI+auprhofbrC3NxuCRIrq/rma79sa67rSBediUpctu61cO0ok/GXktQVrSfMdygW94JfbvAT/U0u2E76HitVuaDg7qt5ZgPxr6r4xSdCnXL9eveYmb5Ib0wo09nqNfOeh8ys+g33JaYx0PBbW64MBN6fk1Qp7WvY4mGked7J2NXkE7rqCVmeNq93asFC3u9aCWjmS4t4frpwI/cU17dDXpIHTcMdZSSDutOR4zysHvbws2BmJ3DZjlraolHzYV9eimI9K69rh+JsJnRvhYumNGhbuvQq2YGzDQD1/eyWTGpfJWOr+r6pIe8EJxYOLqsvWxAM7EbRoEJ5EzgEbfhiWk94gULe0LtC46j2o88mQ6GECwkId5DDRZmdCzi4WgtCN1OdaUT+/WMGC0cDJp+RxKmvCjqIRyKa56WhLlm0NY9LMfNeP1sGubcRlwzS/1y1nGuGbcyn8x5i7GM4DkzMVEg8SgQGXLceCGWWX2T5/vestuo7A5rkVfbdSjwGyuuDqsrrl/Z3fHMypjWWm1tgiupt5PVJan6ur4Scv1kFFRdrneaDV01YWWwPbrRrOu6RZphMotdZCm2Nb+xFCwx4nphbDt7neaSaxvC2He9bn2vbtXiyKycCdYMvKpudaxle4NW0DaRZpB9uq07NZNUCdZMNrak6rVY2r64qtNqp6l9O5QbAr+xMBX4DYue4oEcCJfiqMOGHTTnBmvLQb2il5MDR4K5YdzRLbfdRad01RxfutV2rakja8yUWdabnkWitvbr7J2pUHf8YEWHVt9UXGt61qap+DbtsbumzfZonTCtl0w6hxbzvRbrmo47NTs9h71a0PG4wWyjHa/qpZpubNvaTNMP2gszrt/s4rM6sqKPaL8RBKz1iLei68xzVC81dctO91E3sGM/2tR+o2ldftTzG3o5CNnnc9pbttM8p838+2zrXNVreR3b5DZDO9S5ptdebrK75oKlFZ76Yy2t/arOTD3uuWYB8FnV8Zaue6eCTmRj72YtpvVmd0lHbuj53RNalxS6p4xWswKbtNl9Okg2Z4vvccN2bPYvS9irfW1OmBm+7C4cd8O6m9Gu124YCPyIu7K0qE95SxnpaLRwg9tKjkoZSfstc1qKO1GoW92MbWnPSE5Je0Kv0z2RpaTr4poJhBQ/FIT1hRuC2yTPjBtGTYEfXan77krlujgMlt1du9udyA3rup0S/HoQhjpFo6brdyy2x201Ql13Mzw0MWGxUEdep6VP6YwSdzpuK+sf15o6dDtRRqjrZYHvDZZdv6kbbip0X1wVKvZ71VC3TAxYQuyGficbzw1uq+P5S57FD3RartlxprWfkSLtm9xl/KAbCgFTK94pWz/kdapB2u/QYlxtLZql2hICvy6a49vddjUw8cWUaV0PvXqGtSKdIaHnNnU77T0d+LoWZFinFtxmsRs7rbRlRode6s6ZoN4IzBaQEkLdiNPZOWJOzlw/anZsnWH+gjHBDzJKqBfdUxm+FCwvZr2DE17WOQpqS82glYbMnBeasGTsuK7HpzMk8mqZ603OZv67SYcdnY7yFt0I3WqKxaFXa1YO+HVP++akrn2Tfd6pwNL2NpM7UoqFXicym7ElBDXRGrSDMO05rZtuVm/VvVNuJ8Pj0Iu8WBBWgihK+Y+4sW8uBTO65p0wi8aypzMkri21tF9PCXubOmrqdopfp022ZegJHbkZ5tfdsBqHKynler2kgxNBhnuLXobEvj5h7q+WsF+39HLik4zSrnpCnzmB61ZN+7olaZl9NwR+0IpbcUqY8ow32lqomQo6oc6sntaLcRgINDwZux2dGXFYx2Em8LAXZ30PB+GJoLUk8LjtZu6c0Q1zdmsEgtLSmayZwNfLrkDDaGG6e7ZLiUd0GESB38ikHtXess4mcE43PTG6OR3q2zLmOdM10suZ0rkwzgy+SbdaXufLg0S44kq6iq6mXfRiuoaupXGaoFfSJO2mvbSPDtAhmqZZOkI30y00T6+iV1OValSnBi3SErWoTT6F1KGIYvo4FVHCJmxFaeR2Ok2vobfS79M76F30Xnof/SHdSx+lj9HD9Bg9QUOoUB+2kIP/RsCfEfDfCfg7grqfvkIKf0WOepy+R8DDBPwDKfw9ofDX9Df0eXqUgM8S8B2COkVfJ+D7BPwFKcwQilN0jI7TPJ0k4CABLyUUjtKt5FKToE7Qzwj4/wh4GSn8AaH8aVqlB+gsPUgP0ZP0FME5Q3fSXQS8m6D+hX5KwB8RcAcNqNvpNaTwGCn8ZwLuJoU7SOEeKuBvCXg9AW8j4PcI+G0C3k8OPkQn8VwC7k9G5+AKUniYFEoEDBLwPAIKBAwQQARcRsClBJxHwPMJeJqA/0rACAEg4BwCLiGgSMAmAj5BwOUEXEjAuQSUCWo7nU/ADu75IwI+ScA/ETBGwDYCFAEXEzBMSKwbImArAf0E7CSFCwj4IUE9Qz8hYDMBLyCgj4DnEDBKwEUE/CONJZIdKuGFBPwpwdlDHi3T1fgKYQ38D4K6j75IwJcJ+Bzb910C/icB/56gHqGvEvBtGkhmXcKfEPAYAX9OwNcI+CYpfIGAzxDwjaRU+AEBXyLgf5PCtwh4nJDEkJLPlLoN/4uAvySF/0ObYWLqUYJjIuxRUlw6XFr6GL5OCibiNoYxfIqAlxBwPQG/TkBAwDgBryDgNwi4iQBNgzhOyIES9W1oEgQ4af0oKZwg4ERa5mEM1xFwIwE3ENR+UgBeTsCLCJgjYIGA/5+gfo0O0yAeIghw8BAV1JkkA86Qwl2kcCapGxjCvxB+BYzhTaQwBOC3TGQD2AxgO4ACgJ9TEsgYAXAugC0ABqHwzwTnF9SHbQCGkfw5DioYA/BLglPGKM4B0I/LcTsBH2N4jAp4mPqS6OjCtqT9durD7Um2WrrD5WSSuW8m4B5S+A8E/Bcq4p4EfzYYwO9QCR+gg/gxAa8jYIWANxLwrwh4JwH/iYDbCHhPkuXAvyXg3xHwrwn4jwR8mOC8gd5CHyHg7bQJv0vAawn4TQI+SMC/IYU/ps8C85RBzOEzm+AqKcdJJW2z3GZ5x3twp4cWkuI2xW0KEfOY+i1UwItZbkhAJGRZneNUSHjHmedq7r8r1Wn6FxL5swlfV16HighpMLVzQthcZ7xrp5PqXK/MxqdYPlhfRrc6bdlO7FfJ+K9N+Rz2Z7dPSCUeo8PjV8n4JhObTd1hmzeluiaFXRM9frJ+yNpn2V/Wb8ZmS58lBwd4LLGwe4JUgoeMf1zEwLyYy5DKmKUi6yqkeqwN+1iuiAc1Qa9OvDaam+HuSA7kZj7zNIT0DEKWWEl9OE5FIaEv8d88lZKxzeZidzZpc9aN4QkxnpDHFAvNkzScjqdLiRLsVbmcmE9jw8pZmzfW9ldz7M722FJM2uopfzGNk71p32I6rkOir82dmznnDL4VQBHG2tKaWDH2bYLRN8gjme31tfSeqtEi58z4OuuBzTUDR9jHGU+hZz0YZ/tsfM5Sievdfi0xd+Mcg68UkZHzt7qGzMxGuWwxke4LK2s03LMaSAuN9hKARi6j8quBzS6TBZOCt5GbWRllszzaeTGCRrqaqB5b5tfRb/DdBFQJWEpXRySRfijh3Z7gMpd47pQ5ckvpqiceJ4TWcRFvs+SIOJ9Px6R6VshaT/Znq+E85/GsmL1u/4FUo430ebFKTmSyeUbn6ZMOthNgTpnbe0B1z57J8wMF7CGsA4rLOwp8GvwinwudLr7mOYlHCHiEVK58spQ7nv2qUm1A/7DCKfqmg2MEhiKO0bsUn7iUOIWVcqcycxrLwE3KMeaz/S7Cz3pOZx9QfCTbT+uBwn56KQ4TcJgUfi0tPwX1aXqQ1j6vEOezDO5KYVMPfobOSU53FdxJYCjhTvpiAWPYgnNQtEctLn+h8FYCPkqX4Ql6Pt5HwHsZ3pGAg3eQEnXgrTSCj5LCu0jh95O+RdxLCvcS8Idcnqb3KLyGwDCC19B+x1ywsoNagXHkyguVuUR2j3VPpMe7XvwJcvAEFfAYPT0Mc97qhTdtTqI52WW7tW50J88f9/G2txE8cQLPUAk/oQG+InWhxOXPzscyKXj05UtxPxWTy9/99Bu4n0q4n35c4vjtha8m5Q+u2yBWN4pdWz5xbS58d6rj5g6szF3YxzEq4xgVBI/CMXoljtE3IIhdOJlcRMqYoq04SY4yF2olOBxMpZxdUScZP860kz383WvNMcGTQT/3/YASh9YBzNMvxnArKdxK4NLAMw7n0Je2Jzn02c1YpbepJKD/qVuco8zt/WVJ/RoR9ckthu81GdVhkLSBhOvOrri+bpfkWRRPJZ6ydTh5bkqe5eTZFXe+OkN3UhFnaKdJugRzRAoaxQUuLV0xFBI4Q39ZwU/pg5fwtagvvR514QwwiiLK+OTf9Ub7W5KytA7tvR83CVDMJUElTY1CPj0wQcNmm005EhAcSjzx7E/urbj8bDk5vWb7+ucvwzh9+AJM0N/fjHmqYJ6GeVsbwjz99C3ZpjtOF2Gc/vYgI4b4w2vTo+ss7Uq2+y78xfl8On3wAozTVmY3MIZx2oxxev2VmCaFaXIwTSVM0yCm6esfKaRZ/gyd/UQR95GD+wi4j35wbfeXiw2eag3lj3/a/Q3iQ7/r4K/pGnyebsHf0B27fkXOW9zy2f3vzWcL/68JXlFT1H0eo4pJQXWMTvZ0N0mu1iT4sXUT3G6dQ8z3AJLFZzGX0nffZXK4IPLawON9aNIeNOmfP+NglRQDsErDXBp6P9dLWKUy0wxfUfD3cWlhBKv0eLm7VeJBemi1gFGYNHEwCoVR9OEcPPThUbyB6ngDvfFtwxzgJU4LG6RDXJoAKgi6Suvd5CiIoDNgzlaDXDfy7MayKUmBothz1iZRl14ST8lTEjzOmqQrbCBTCb1YI3mtFqy1UIx+g6ROaw57KFtgSmss6u2xdoHYgMO29fCvU9vIciFpre2FNV7Cegterw0pvcgjNjFUwQT1c6+tuZhxcqXiegG9tjpJ3PTGnI3Bvp4Y7OoEx28paZulQelHjlOpz0llzCZlP8Tiz/ShnF1OKpPPUFwvcH4oS9vAh+tvHlarkV7cIF67z7KMdbbbSE1tf/YoWaN3ln8P6kpbG+9r+vXMR2/ubxL1gSQWZtM5U8L/G0VZYU02c34/S9atH6eOWI0KG6w1DvswiwMlYja1lcdQErOncnGkBL2YxmdX+xaOSSVkGnj6Rt6yN2GctvA+vI1pQxhPLtFKHAoMzyj/xFTgjXyz2PYt7wXcf5ug9Qk5lnYJ9zcyKxinSzFOv57TuQPjyU9XA3xGKLJ+xf2kTGcdvJ/xUvoT6jgN8plFMV3aNyBklETdHo6mBb1f2GN9oljWPd8HJmlL8pNIFw5zqTBJDibpekxSiXEImMAkfdtUXoZJKgsGWz76B0b5+cIQ65AiG1HmtjK3m3IE4/Rc4ZgiD7bM+BYxSDuQrezkvtykgCeuwjKszh25QEDORhs0fey8Qe5vnb6VeWyfipBRFBNmJ3OAA21EBOKWdQJO+ggsq7QBT6nnt/AubM/hsn0g13eQ9QyJgJNB2S8CZus6fnU4OI3OX77f5OwWTFCZ9zCDvwATdC4m6ApM0AW8Xpg1xMcETef2JVNeiAnSvB+eYFnf+VoJB+hFOEA/eQ7mqQ/zVOYDYwnzNCZ+aiuJg2RxnZ/jijkepC84ujL7uV4R/MX0x/z5nhcmZYYhBvuToJG/Jae7JGyyesvcPsz4KOMj6YuKLj4g5PaJNkuzsgu5MRUFHUKn9Z8j9GY/cWayi1wOCJkX5nQb/kHR3/pMiTlab26Qs03a74gxQbwoKuRstL6SMod6frrt1vsEfyVnZ94+Jey0POVcWzHnpy1iHu38GD1vfLsJbhcT9E6HI/kf7zAZ9FqM0+Pf7651E1RO17CJNbnlpBfOiZ6FHSI3u/SJZJ0YErncL3i7bfIkMp5mnTFyU89mN8Hr80RqQ34XB7eZ+kiSrXJjnUjf040k69ZEsi4Pch+zBl3IY+njssJjLCQwzieDcT69ZhvhYG7NHu45WXTpI2IM1jcF9vXO/Ml+DfSuj/1si0rX8a4suXn2buAT6VzsSG502VicdQ4WNi6sHxyxp5XYXrXO+l3IzesQ21cQsotC128bAXe3Wdu9z8i9rbs3dX04LPalgti/8ocVO0eO0FkWh5wCH9QKwjeOsNlZI6s7t3LfM74/N7cX9Yn5L4h4Kwkbij1xP5H2O4fnzuoos8xRjpciz4fimB1I4qsbY3KMJY7XSi5XTdxdnjvLOOv4IDtkyrjaeG+HOE9YOJfHVVhnj1fCT06P3RPpOcXAS9P46fJ97eVYJIVFKmORtmCRgEXahsXkpfMRLFIBi3QdFmmU62XmH8MiXcn1yxP+Ls/zWIZisH2MzH7ms/p2cLvDbaZfidtLjDtcGtjO/Fczz4uxSANJ2wF6IQ7Qd39urjQXpi+y+LqWXC669U3cVkouX7Ppq9x+bnsO0wYxSyOYTV6d2x9ldopf+B2WN5T8cNPFyyyrj3Ej8wUsq4/7W12mvHidNwf9XI4lfSZS3GEZKscvbbKXykrPZbv3+ir1lAVezMmA0DXEPkWurWSvjlxuZj1TmKXzhC1vNSe+5/Ep8HP3ADG9BDE91P3sIKZhxLQHMf2JQSqI6TOm8s2LxdUP4jo0xBk6JrL+5Rin/Rinncy3V6xG13N9gPntyrCdbyiDYsUx18FvvF/mZzG39zjrnJcHcms4chc65PJYgl0XB3MXtrKQVxT3oX4+k2ODNccRa7cSd478WryJ1y4l+g0KW8u5ced9MLDBHlESZYE9m1+zkNuz7B63aR2/yj4Vob/IUMnJ2Swu1YXc/azAkWOjqiR0lkQ0jYq7Z378m7kc3mCPKqZnlN6xjGzgT3s+GM3dAeWZwfrhC7+JiF6BiCYR0Qwiegkm6WpENIiYtiWfUkTJ9fs8TNIRTNKCuLNfjUl6BUIqYJIqiOiODxk1HzSPOsbpFs4oa9OL+C5u9V+LcXq7femxWbwA2cT1IqYJXFeCNoxpGhH4AKbpPOa14OTwItOGcnQjo4JpuoRtMLStTO/nfn05fkPbIXg3i/YC8wwkeAlFlt3Puk3bTn7JU+RSsYx+0b/AuNVp5W/J8RR4XI4Y83NEm9UBHucI4/0Mps/FmKZLMU2jmKY3vv5KPJO8dLqRy3cr8RbqdT+Zxvfoe3edwK1Uwq00ym9X7JuVQu4NqqVv5bKIW+mRaTSpj78v3Y4mVfjLhfPE1w+K2x4piE8filw+XBHEnxuOLynx9YOR/csCCzaEL73nubnXNL9n39uMcVnEKm3iUjLu4PLPIF4MmbLAL3schrJ4aTSRE2L5TftWxsvM6wgeU78Iq7SLXzpZ+m6mW3wzVukIVukCrNLFLLvMxr8CqzSUs7XIPBAvrgpcn8Iqffs4HiRHfN/RB1tz5Le6a74DeWgnniKFJ+nbv/VVqDfQR+i7fUnxlR99bwxH6ek/MnPxyHvMJDxwdx9uJWedYHG4HBJthtaXC6JB3EqbmM8GoGy3Mp1c4BVzPKZPOUezcCnLzdOtjSWuG5kD6/COCf2XMJ9MiMoGUMzZbeS+NueHMvP2s24l/Odwm7TnmTvkt0PfMsggmnROz6fdWVZdJNLvKq6bDHqxoFeYf1lkl4HzRd3wDaBJO9GkYaYNML3A/behSeeu+bCpSf1o0g406blo0otE3wLbbu0eE3WH+xj5o0y7gOmGtlnYNSyWiQFBL4iPrZTwSyH3IdYXbIcJofxiNOlTV60zmo9fhibd9zK29xd2Nq7KfWP/blN5p2KsT5hgy4vFNIHNMuaXmb+Qm9I3SStejia9blfOtB1cXpYrJVwuJqnA1vzpCFfe0idGYAifM8iNbJQSjXbukFvedwjaEPM6wtfrmWTG8j61zpdxd8sBPo9LE0GXcMQjZ+7HvvXnCmfpapylW3CWrsdZ2ouztANn6ZU4S1firJmXs/Qjw2Uqn9jPFQOjOEu7cZZuwlm6iuFNhvM8nKV/MJWvPrEjtwP8/CBW6fliGS4z/I7izeelWKVPjPDi/PnhXP8HzDL+aDG3X7xZ5di+aETeayQMYJV+KPlfyOUnTZ97vlPCkwQ8Refnyh1m9ef6QLLOj/AHDV1ad+0f2+DbwO7TbAqVbi15jnLf/jW0h6iQYIO9OvBgBmt1ZLtRz/+SPLmhPU99VDmfpgeMbi6/WXBMw5OEZy2fWlOOcvkqLnfk+M/P4SqH5/mvyeFXcmntq+TwKxjvY/yanN7Lc/L+asOBIROS53k6cSYz/t8AAAD//1opW/DlSAAA"
@@ -0,0 +1,12 @@
+// Package timezonedata contains the prefix to timezone data used by phonenumbers.GetTimezonesForPrefix
+// and phonenumbers.GetTimezonesForNumber. It is kept in its own module so that users who don't need
+// timezone lookups don't have to download it. Import it for its side effects:
+//
+//	import _ "github.com/nyaruka/phonenumbers/timezonedata"
+package timezonedata
+
+import "github.com/nyaruka/phonenumbers"
+
+func init() {
+	phonenumbers.RegisterTimezoneData(timezoneMapData)
+}
@@ -0,0 +1,225 @@
+package timezonedata_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/nyaruka/phonenumbers"
+	_ "github.com/nyaruka/phonenumbers/timezonedata"
+)
+
+// this runs first so that our data is decoded while goroutines are racing to look it up, use -race
+func TestConcurrentFirstUse(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			timezones, err := phonenumbers.GetTimezonesForPrefix("+442073238299")
+			if err != nil || !reflect.DeepEqual(timezones, []string{"Europe/London"}) {
+				t.Errorf("Expected Europe/London, got %v (%v)", timezones, err)
+			}
+			phonenumbers.GetMemStats()
+		}()
+	}
+	wg.Wait()
+}
+
+type timeZonesTestCases struct {
+	num               string
+	expectedTimeZones []string
+
+	// the timezones of the parsed number if they differ, as mobile numbers in most countries
+	// aren't geographical and so get the timezones of their whole country
+	expectedNumberTimeZones []string
+}
+
+func TestGetTimeZonesForPrefix(t *testing.T) {
+	tests := []timeZonesTestCases{
+		{
+			num:               "+442073238299",
+			expectedTimeZones: []string{"Europe/London"},
+		},
+		{
+			num:               "+61491570156",
+			expectedTimeZones: []string{"Australia/Sydney"},
+			expectedNumberTimeZones: []string{
+				"Australia/Adelaide", "Australia/Brisbane", "Australia/Eucla", "Australia/Lord_Howe",
+				"Australia/Perth", "Australia/Sydney", "Indian/Christmas", "Indian/Cocos",
+			},
+		},
+		{
+			num:               "+61255501234",
+			expectedTimeZones: []string{"Australia/Sydney"},
+		},
+		{
+			num:               "+12067798181",
+			expectedTimeZones: []string{"America/Los_Angeles"},
+		},
+		{
+			num:               "+390399123456",
+			expectedTimeZones: []string{"Europe/Rome"},
+		},
+		{
+			num:               "+541151123456",
+			expectedTimeZones: []string{"America/Buenos_Aires"},
+		},
+		{
+			num:               "+15167706076",
+			expectedTimeZones: []string{"America/New_York"},
+		},
+		{
+			num:               "+917999999543",
+			expectedTimeZones: []string{"Asia/Calcutta"},
+		},
+		{
+			num:               "+540111561234567",
+			expectedTimeZones: []string{"America/Buenos_Aires"},
+		},
+		{
+			num:               "+18504320800",
+			expectedTimeZones: []string{"America/Chicago"},
+		},
+		{
+			num:               "+14079395277",
+			expectedTimeZones: []string{"America/New_York"},
+		},
+		{
+			num:               "+18508632167",
+			expectedTimeZones: []string{"America/Chicago"},
+		},
+		{
+			num:               "+40213158207",
+			expectedTimeZones: []string{"Europe/Bucharest"},
+		},
+		// UTC +5:45
+		{
+			num:               "+97714240520",
+			expectedTimeZones: []string{"Asia/Katmandu"},
+		},
+		// UTC -3:30
+		{
+			num:               "+17097264534",
+			expectedTimeZones: []string{"America/St_Johns"},
+		},
+		{
+			num:               "0000000000",
+			expectedTimeZones: []string{"Etc/Unknown"},
+		},
+		{
+			num:               "+31112",
+			expectedTimeZones: []string{"Europe/Amsterdam"},
+		},
+		{
+			num:               "+6837000",
+			expectedTimeZones: []string{"Pacific/Niue"},
+		},
+		{
+			num: "+1911",
+			expectedTimeZones: []string{
+				"America/Adak", "America/Anchorage", "America/Anguilla", "America/Antigua",
+				"America/Barbados", "America/Boise", "America/Cayman", "America/Chicago",
+				"America/Denver", "America/Dominica", "America/Edmonton", "America/Fort_Nelson",
+				"America/Grand_Turk", "America/Grenada", "America/Halifax", "America/Jamaica",
+				"America/Juneau", "America/Los_Angeles", "America/Lower_Princes", "America/Montserrat",
+				"America/Nassau", "America/New_York", "America/North_Dakota/Center",
+				"America/Phoenix", "America/Port_of_Spain", "America/Puerto_Rico",
+				"America/Regina", "America/Santo_Domingo", "America/St_Johns", "America/St_Kitts",
+				"America/St_Lucia", "America/St_Thomas", "America/St_Vincent", "America/Toronto",
+				"America/Tortola", "America/Vancouver", "America/Winnipeg", "Atlantic/Bermuda",
+				"Pacific/Guam", "Pacific/Honolulu", "Pacific/Pago_Pago", "Pacific/Saipan",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		timeZones, err := phonenumbers.GetTimezonesForPrefix(test.num)
+		if err != nil {
+			t.Errorf("Failed to getTimezone for the number %s: %s", test.num, err)
+		}
+
+		if len(timeZones) == 0 {
+			t.Errorf("Expected at least 1 timezone.")
+		}
+
+		if !reflect.DeepEqual(timeZones, test.expectedTimeZones) {
+			t.Errorf("Expected '%v', got '%v' for '%s'", test.expectedTimeZones, timeZones, test.num)
+		}
+
+		num, err := phonenumbers.Parse(test.num, "")
+		if err != nil {
+			continue
+		}
+
+		timeZones, err = phonenumbers.GetTimezonesForGeographicalNumber(num)
+		if err != nil {
+			t.Errorf("Failed to getTimezone for the number %s: %s", num, err)
+		}
+
+		if !reflect.DeepEqual(timeZones, test.expectedTimeZones) {
+			t.Errorf("Expected '%v', got '%v' for '%s'", test.expectedTimeZones, timeZones, num)
+		}
+
+		expectedNumberTimeZones := test.expectedTimeZones
+		if test.expectedNumberTimeZones != nil {
+			expectedNumberTimeZones = test.expectedNumberTimeZones
+		}
+
+		timeZones, err = phonenumbers.GetTimezonesForNumber(num)
+		if err != nil {
+			t.Errorf("Failed to getTimezone for the number %s: %s", num, err)
+		}
+
+		if len(timeZones) == 0 {
+			t.Errorf("Expected at least 1 timezone.")
+		}
+
+		if !reflect.DeepEqual(timeZones, expectedNumberTimeZones) {
+			t.Errorf("Expected '%v', got '%v' for '%s'", expectedNumberTimeZones, timeZones, num)
+		}
+	}
+}
+
+func TestGetLocalTimeForNumber(t *testing.T) {
+	at := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		num        string
+		localTimes []string
+	}{
+		{"+442073238299", []string{"12:00 Europe/London"}},
+		{"+12067798181", []string{"04:00 America/Los_Angeles"}},
+		{"+17097264534", []string{"08:30 America/St_Johns"}},
+		{"+61491570156", []string{
+			"22:30 Australia/Adelaide", "22:00 Australia/Brisbane", "20:45 Australia/Eucla", "23:00 Australia/Lord_Howe",
+			"20:00 Australia/Perth", "23:00 Australia/Sydney", "19:00 Indian/Christmas", "18:30 Indian/Cocos",
+		}},
+		{"+80012345678", nil},
+	}
+
+	for _, test := range tests {
+		num, err := phonenumbers.Parse(test.num, "")
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %s", test.num, err)
+		}
+
+		times, err := phonenumbers.GetLocalTimeForNumber(num, at)
+		if err != nil {
+			t.Errorf("Failed to get local time for %s: %s", test.num, err)
+		}
+
+		var localTimes []string
+		for _, localTime := range times {
+			if !localTime.Equal(at) {
+				t.Errorf("Expected %s to be the same instant as %s for %s", localTime, at, test.num)
+			}
+			localTimes = append(localTimes, localTime.Format("15:04 ")+localTime.Location().String())
+		}
+		if !reflect.DeepEqual(localTimes, test.localTimes) {
+			t.Errorf("Expected '%v', got '%v' for '%s'", test.localTimes, localTimes, test.num)
+		}
+	}
+}
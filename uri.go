@@ -0,0 +1,41 @@
+package phonenumbers
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// FormatSMSURI returns an sms: URI for the passed in number as per RFC 5724, e.g. "sms:+16502530000",
+// with the passed in message as its body if it isn't empty. Extensions are dropped as they can't be
+// messaged.
+func FormatSMSURI(number *PhoneNumber, body string) string {
+	uri := "sms:" + Format(number, E164)
+	if body != "" {
+		uri += "?body=" + escapeURIQuery(body)
+	}
+	return uri
+}
+
+// FormatCallToURI returns a callto: URI for the passed in number, as used by Skype and other softphones,
+// e.g. "callto:+16502530000". Extensions are dropped.
+func FormatCallToURI(number *PhoneNumber) string {
+	return "callto:" + Format(number, E164)
+}
+
+// FormatWhatsAppLink returns a wa.me click to chat link for the passed in number, e.g.
+// "https://wa.me/16502530000", with the passed in message prefilled if it isn't empty. WhatsApp requires
+// the number in international format without the leading plus, which is easily gotten wrong.
+func FormatWhatsAppLink(number *PhoneNumber, text string) string {
+	link := "https://wa.me/" + strconv.Itoa(int(number.GetCountryCode())) + GetNationalSignificantNumber(number)
+	if text != "" {
+		link += "?text=" + escapeURIQuery(text)
+	}
+	return link
+}
+
+// escapeURIQuery escapes a query value using %20 for spaces rather than +, which messaging apps
+// would otherwise show as is
+func escapeURIQuery(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,33 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURIs(t *testing.T) {
+	tests := []struct {
+		input    string
+		region   string
+		message  string
+		sms      string
+		callto   string
+		whatsapp string
+	}{
+		{"6502530000", "US", "", "sms:+16502530000", "callto:+16502530000", "https://wa.me/16502530000"},
+		{"07400 123456", "GB", "Hi there", "sms:+447400123456?body=Hi%20there", "callto:+447400123456", "https://wa.me/447400123456?text=Hi%20there"},
+		{"0788 383 383 ext. 12", "RW", "1+1=2 & more?", "sms:+250788383383?body=1%2B1%3D2%20%26%20more%3F", "callto:+250788383383", "https://wa.me/250788383383?text=1%2B1%3D2%20%26%20more%3F"},
+		{"+49 30 1234567", "", "Grüße", "sms:+49301234567?body=Gr%C3%BC%C3%9Fe", "callto:+49301234567", "https://wa.me/49301234567?text=Gr%C3%BC%C3%9Fe"},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.input, tc.region)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.sms, FormatSMSURI(number, tc.message), "sms URI mismatch for %s", tc.input)
+		assert.Equal(t, tc.callto, FormatCallToURI(number), "callto URI mismatch for %s", tc.input)
+		assert.Equal(t, tc.whatsapp, FormatWhatsAppLink(number, tc.message), "WhatsApp link mismatch for %s", tc.input)
+	}
+}
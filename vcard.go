@@ -0,0 +1,96 @@
+package phonenumbers
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotVCardTel is returned when parsing a vCard property which isn't a TEL property
+var ErrNotVCardTel = errors.New("not a vCard TEL property")
+
+// the vCard TEL types which we can map to and from number types
+var vCardTelTypes = map[string]PhoneNumberType{
+	"cell":  MOBILE,
+	"pager": PAGER,
+}
+
+// FormatVCardTel returns a vCard 4.0 TEL property for the passed in number as per RFC 6350, with its
+// value as a tel URI, e.g. `TEL;VALUE=uri;TYPE="cell,home":tel:+44-7400-123456`. The TYPE parameter
+// is "cell" for mobile numbers, "pager" for pagers and "voice" for everything else, followed by the
+// passed in types, such as "home" or "work".
+func FormatVCardTel(number *PhoneNumber, types ...string) string {
+	typ := "voice"
+	switch GetNumberType(number) {
+	case MOBILE:
+		typ = "cell"
+	case PAGER:
+		typ = "pager"
+	}
+	param := strings.Join(append([]string{typ}, types...), ",")
+	if len(types) > 0 {
+		param = `"` + param + `"`
+	}
+	return "TEL;VALUE=uri;TYPE=" + param + ":" + Format(number, RFC3966)
+}
+
+// ParseVCardTel parses a vCard TEL property, e.g. `TEL;VALUE=uri;TYPE=cell:tel:+44-7400-123456`,
+// returning the number and the number type given by its TYPE parameters, which is MOBILE for "cell",
+// PAGER for "pager" and UNKNOWN otherwise. Values which aren't tel URIs, as written by vCard 3.0
+// and older, are parsed like any other number using the passed in default region. Properties should
+// already be unfolded, though they may be prefixed with a group, e.g. "item1.TEL".
+func ParseVCardTel(property string, defaultRegion string) (*PhoneNumber, PhoneNumberType, error) {
+	params, value, found := splitVCardProperty(property)
+	if !found || len(params) == 0 {
+		return nil, UNKNOWN, ErrNotVCardTel
+	}
+	name := params[0]
+	if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+		name = name[dot+1:]
+	}
+	if !strings.EqualFold(name, "TEL") {
+		return nil, UNKNOWN, ErrNotVCardTel
+	}
+
+	numberType := UNKNOWN
+	for _, param := range params[1:] {
+		key, types := "TYPE", param
+		if eq := strings.IndexByte(param, '='); eq >= 0 {
+			key, types = param[:eq], param[eq+1:]
+		}
+		// vCard 2.1 allows types without the TYPE= in front of them
+		if !strings.EqualFold(key, "TYPE") {
+			continue
+		}
+		for _, t := range strings.Split(strings.Trim(types, `"`), ",") {
+			if mapped, ok := vCardTelTypes[strings.ToLower(strings.TrimSpace(t))]; ok && numberType == UNKNOWN {
+				numberType = mapped
+			}
+		}
+	}
+
+	number, err := Parse(value, defaultRegion)
+	if err != nil {
+		return nil, UNKNOWN, err
+	}
+	return number, numberType, nil
+}
+
+// splitVCardProperty splits a vCard property into its name and parameters, and its value, ignoring
+// separators inside quoted parameter values
+func splitVCardProperty(property string) ([]string, string, bool) {
+	var params []string
+	quoted := false
+	start := 0
+	for i, r := range property {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ';' && !quoted:
+			params = append(params, property[start:i])
+			start = i + 1
+		case r == ':' && !quoted:
+			return append(params, property[start:i]), strings.TrimSpace(property[i+1:]), true
+		}
+	}
+	return nil, "", false
+}
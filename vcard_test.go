@@ -0,0 +1,64 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatVCardTel(t *testing.T) {
+	tests := []struct {
+		input    string
+		region   string
+		types    []string
+		expected string
+	}{
+		{"07400 123456", "GB", nil, "TEL;VALUE=uri;TYPE=cell:tel:+44-7400-123456"},
+		{"020 7031 3000", "GB", []string{"work"}, `TEL;VALUE=uri;TYPE="voice,work":tel:+44-20-7031-3000`},
+		{"6502530000 ext. 123", "US", []string{"home"}, `TEL;VALUE=uri;TYPE="voice,home":tel:+1-650-253-0000;ext=123`},
+	}
+
+	for _, tc := range tests {
+		number, err := Parse(tc.input, tc.region)
+		require.NoError(t, err)
+
+		formatted := FormatVCardTel(number, tc.types...)
+		assert.Equal(t, tc.expected, formatted, "vCard TEL mismatch for %s", tc.input)
+
+		parsed, _, err := ParseVCardTel(formatted, "")
+		if assert.NoError(t, err, "unexpected error parsing %s", formatted) {
+			assert.True(t, Equal(number, parsed, IgnoreRawInput, IgnoreCountryCodeSource), "round trip mismatch for %s", tc.input)
+		}
+	}
+}
+
+func TestParseVCardTel(t *testing.T) {
+	tests := []struct {
+		property   string
+		region     string
+		e164       string
+		numberType PhoneNumberType
+		err        error
+	}{
+		{"TEL;VALUE=uri;TYPE=cell:tel:+44-7400-123456", "", "+447400123456", MOBILE, nil},
+		{`TEL;VALUE=uri;PREF=1;TYPE="voice,home":tel:+1-555-555-5555;ext=5555`, "", "+15555555555", UNKNOWN, nil},
+		{`tel;type="home,pager";value=uri:tel:+250-788-383-383`, "", "+250788383383", PAGER, nil},
+		{"item1.TEL;TYPE=WORK;TYPE=CELL:(650) 253-0000", "US", "+16502530000", MOBILE, nil},
+		{"TEL;CELL:0788 383 383", "RW", "+250788383383", MOBILE, nil},
+		{"TEL:+49 30 1234567", "", "+49301234567", UNKNOWN, nil},
+		{"EMAIL;TYPE=work:bob@example.com", "", "", UNKNOWN, ErrNotVCardTel},
+		{"TEL;TYPE=cell", "", "", UNKNOWN, ErrNotVCardTel},
+		{"TEL;TYPE=cell:hello", "US", "", UNKNOWN, ErrNotANumber},
+	}
+
+	for _, tc := range tests {
+		number, numberType, err := ParseVCardTel(tc.property, tc.region)
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, "error mismatch for %s", tc.property)
+		} else if assert.NoError(t, err, "unexpected error for %s", tc.property) {
+			assert.Equal(t, tc.e164, Format(number, E164), "number mismatch for %s", tc.property)
+			assert.Equal(t, tc.numberType, numberType, "type mismatch for %s", tc.property)
+		}
+	}
+}